@@ -12,12 +12,21 @@ const (
 	CodeForbidden     Code = "FORBIDDEN"
 	CodeInternal      Code = "INTERNAL_ERROR"
 	CodeConflict      Code = "CONFLICT"
+	CodeTooMany       Code = "TOO_MANY_REQUESTS"
 )
 
+// FieldViolation describes one invalid field of a request, addressed by a
+// path such as "lines[0].options[1].option_id"
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
 type AppError struct {
-	message string
-	code    Code
-	cause   error
+	message    string
+	code       Code
+	cause      error
+	violations []FieldViolation
 }
 
 func (e *AppError) Error() string {
@@ -35,6 +44,10 @@ func (e *AppError) Code() Code {
 	return e.code
 }
 
+func (e *AppError) Violations() []FieldViolation {
+	return e.violations
+}
+
 // New creates a new AppError with the given message and code
 func New(message string, code Code) *AppError {
 	return &AppError{
@@ -81,6 +94,19 @@ func Conflict(message string) *AppError {
 	return New(message, CodeConflict)
 }
 
+func TooManyRequests(message string) *AppError {
+	return New(message, CodeTooMany)
+}
+
+// InvalidFields creates an invalid input error listing every offending field
+func InvalidFields(message string, violations []FieldViolation) *AppError {
+	return &AppError{
+		message:    message,
+		code:       CodeInvalidInput,
+		violations: violations,
+	}
+}
+
 // GetCode extracts the Code from an error if it's an AppError, otherwise returns CodeInternal
 func GetCode(err error) Code {
 	var appErr *AppError
@@ -89,3 +115,12 @@ func GetCode(err error) Code {
 	}
 	return CodeInternal
 }
+
+// GetViolations extracts the field violations from an error if it's an AppError
+func GetViolations(err error) []FieldViolation {
+	var appErr *AppError
+	if errors.As(err, &appErr) {
+		return appErr.Violations()
+	}
+	return nil
+}
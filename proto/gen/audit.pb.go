@@ -0,0 +1,532 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.30.2
+// source: audit.proto
+
+package corev1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AuditResourceType int32
+
+const (
+	AuditResourceType_AUDIT_RESOURCE_TYPE_UNSPECIFIED    AuditResourceType = 0
+	AuditResourceType_AUDIT_RESOURCE_TYPE_USER           AuditResourceType = 1
+	AuditResourceType_AUDIT_RESOURCE_TYPE_SHEET          AuditResourceType = 2
+	AuditResourceType_AUDIT_RESOURCE_TYPE_ORDER          AuditResourceType = 3
+	AuditResourceType_AUDIT_RESOURCE_TYPE_PAYMENT        AuditResourceType = 4
+	AuditResourceType_AUDIT_RESOURCE_TYPE_SHEET_TEMPLATE AuditResourceType = 5
+)
+
+// Enum value maps for AuditResourceType.
+var (
+	AuditResourceType_name = map[int32]string{
+		0: "AUDIT_RESOURCE_TYPE_UNSPECIFIED",
+		1: "AUDIT_RESOURCE_TYPE_USER",
+		2: "AUDIT_RESOURCE_TYPE_SHEET",
+		3: "AUDIT_RESOURCE_TYPE_ORDER",
+		4: "AUDIT_RESOURCE_TYPE_PAYMENT",
+		5: "AUDIT_RESOURCE_TYPE_SHEET_TEMPLATE",
+	}
+	AuditResourceType_value = map[string]int32{
+		"AUDIT_RESOURCE_TYPE_UNSPECIFIED":    0,
+		"AUDIT_RESOURCE_TYPE_USER":           1,
+		"AUDIT_RESOURCE_TYPE_SHEET":          2,
+		"AUDIT_RESOURCE_TYPE_ORDER":          3,
+		"AUDIT_RESOURCE_TYPE_PAYMENT":        4,
+		"AUDIT_RESOURCE_TYPE_SHEET_TEMPLATE": 5,
+	}
+)
+
+func (x AuditResourceType) Enum() *AuditResourceType {
+	p := new(AuditResourceType)
+	*p = x
+	return p
+}
+
+func (x AuditResourceType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditResourceType) Descriptor() protoreflect.EnumDescriptor {
+	return file_audit_proto_enumTypes[0].Descriptor()
+}
+
+func (AuditResourceType) Type() protoreflect.EnumType {
+	return &file_audit_proto_enumTypes[0]
+}
+
+func (x AuditResourceType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditResourceType.Descriptor instead.
+func (AuditResourceType) EnumDescriptor() ([]byte, []int) {
+	return file_audit_proto_rawDescGZIP(), []int{0}
+}
+
+// One field written by the operation. Values are JSON encoded; secrets such
+// as password hashes are replaced by "redacted".
+type AuditChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Before        string                 `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"` // empty when the field was not set
+	After         string                 `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditChange) Reset() {
+	*x = AuditChange{}
+	mi := &file_audit_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditChange) ProtoMessage() {}
+
+func (x *AuditChange) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditChange.ProtoReflect.Descriptor instead.
+func (*AuditChange) Descriptor() ([]byte, []int) {
+	return file_audit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AuditChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *AuditChange) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *AuditChange) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+// A state-changing RPC and what it changed on one resource
+type AuditEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActorId        string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"` // user ID, or svc:<name> for service callers
+	Method         string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`                  // full gRPC method name
+	ResourceType   AuditResourceType      `protobuf:"varint,4,opt,name=resource_type,json=resourceType,proto3,enum=core.v1.AuditResourceType" json:"resource_type,omitempty"`
+	ResourceId     string                 `protobuf:"bytes,5,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	Changes        []*AuditChange         `protobuf:"bytes,6,rep,name=changes,proto3" json:"changes,omitempty"` // empty for creates and RPCs without a field diff
+	IdempotencyKey string                 `protobuf:"bytes,7,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	OccurredAt     *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_audit_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_audit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AuditEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetResourceType() AuditResourceType {
+	if x != nil {
+		return x.ResourceType
+	}
+	return AuditResourceType_AUDIT_RESOURCE_TYPE_UNSPECIFIED
+}
+
+func (x *AuditEvent) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetChanges() []*AuditChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// resource_id needs resource_type
+type ListAuditEventsFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceType  AuditResourceType      `protobuf:"varint,1,opt,name=resource_type,json=resourceType,proto3,enum=core.v1.AuditResourceType" json:"resource_type,omitempty"`
+	ResourceId    string                 `protobuf:"bytes,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	ActorId       string                 `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsFilter) Reset() {
+	*x = ListAuditEventsFilter{}
+	mi := &file_audit_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsFilter) ProtoMessage() {}
+
+func (x *ListAuditEventsFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsFilter.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsFilter) Descriptor() ([]byte, []int) {
+	return file_audit_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListAuditEventsFilter) GetResourceType() AuditResourceType {
+	if x != nil {
+		return x.ResourceType
+	}
+	return AuditResourceType_AUDIT_RESOURCE_TYPE_UNSPECIFIED
+}
+
+func (x *ListAuditEventsFilter) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsFilter) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+type ListAuditEventsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor        *Cursor                `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Filter        *ListAuditEventsFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsReq) Reset() {
+	*x = ListAuditEventsReq{}
+	mi := &file_audit_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsReq) ProtoMessage() {}
+
+func (x *ListAuditEventsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsReq.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsReq) Descriptor() ([]byte, []int) {
+	return file_audit_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListAuditEventsReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuditEventsReq) GetCursor() *Cursor {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+func (x *ListAuditEventsReq) GetFilter() *ListAuditEventsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type ListAuditEventsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	NextCursor    *Cursor                `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsResp) Reset() {
+	*x = ListAuditEventsResp{}
+	mi := &file_audit_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResp) ProtoMessage() {}
+
+func (x *ListAuditEventsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResp.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResp) Descriptor() ([]byte, []int) {
+	return file_audit_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListAuditEventsResp) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListAuditEventsResp) GetNextCursor() *Cursor {
+	if x != nil {
+		return x.NextCursor
+	}
+	return nil
+}
+
+var File_audit_proto protoreflect.FileDescriptor
+
+const file_audit_proto_rawDesc = "" +
+	"\n" +
+	"\vaudit.proto\x12\acore.v1\x1a\fcommon.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"Q\n" +
+	"\vAuditChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x16\n" +
+	"\x06before\x18\x02 \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\x03 \x01(\tR\x05after\"\xc7\x02\n" +
+	"\n" +
+	"AuditEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\tR\aactorId\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\x12?\n" +
+	"\rresource_type\x18\x04 \x01(\x0e2\x1a.core.v1.AuditResourceTypeR\fresourceType\x12\x1f\n" +
+	"\vresource_id\x18\x05 \x01(\tR\n" +
+	"resourceId\x12.\n" +
+	"\achanges\x18\x06 \x03(\v2\x14.core.v1.AuditChangeR\achanges\x12'\n" +
+	"\x0fidempotency_key\x18\a \x01(\tR\x0eidempotencyKey\x12;\n" +
+	"\voccurred_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"\x9e\x01\n" +
+	"\x15ListAuditEventsFilter\x12I\n" +
+	"\rresource_type\x18\x01 \x01(\x0e2\x1a.core.v1.AuditResourceTypeB\b\xfaB\x05\x82\x01\x02\x10\x01R\fresourceType\x12\x1f\n" +
+	"\vresource_id\x18\x02 \x01(\tR\n" +
+	"resourceId\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\tR\aactorId\"\x9d\x01\n" +
+	"\x12ListAuditEventsReq\x12&\n" +
+	"\tpage_size\x18\x01 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x01R\bpageSize\x12'\n" +
+	"\x06cursor\x18\x02 \x01(\v2\x0f.core.v1.CursorR\x06cursor\x126\n" +
+	"\x06filter\x18\x03 \x01(\v2\x1e.core.v1.ListAuditEventsFilterR\x06filter\"\x89\x01\n" +
+	"\x13ListAuditEventsResp\x12+\n" +
+	"\x06events\x18\x01 \x03(\v2\x13.core.v1.AuditEventR\x06events\x125\n" +
+	"\vnext_cursor\x18\x02 \x01(\v2\x0f.core.v1.CursorH\x00R\n" +
+	"nextCursor\x88\x01\x01B\x0e\n" +
+	"\f_next_cursor*\xdd\x01\n" +
+	"\x11AuditResourceType\x12#\n" +
+	"\x1fAUDIT_RESOURCE_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18AUDIT_RESOURCE_TYPE_USER\x10\x01\x12\x1d\n" +
+	"\x19AUDIT_RESOURCE_TYPE_SHEET\x10\x02\x12\x1d\n" +
+	"\x19AUDIT_RESOURCE_TYPE_ORDER\x10\x03\x12\x1f\n" +
+	"\x1bAUDIT_RESOURCE_TYPE_PAYMENT\x10\x04\x12&\n" +
+	"\"AUDIT_RESOURCE_TYPE_SHEET_TEMPLATE\x10\x052\\\n" +
+	"\fAuditService\x12L\n" +
+	"\x0fListAuditEvents\x12\x1b.core.v1.ListAuditEventsReq\x1a\x1c.core.v1.ListAuditEventsRespB;Z9github.com/deni12345/dae-services/proto/gen/corev1;corev1b\x06proto3"
+
+var (
+	file_audit_proto_rawDescOnce sync.Once
+	file_audit_proto_rawDescData []byte
+)
+
+func file_audit_proto_rawDescGZIP() []byte {
+	file_audit_proto_rawDescOnce.Do(func() {
+		file_audit_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_audit_proto_rawDesc), len(file_audit_proto_rawDesc)))
+	})
+	return file_audit_proto_rawDescData
+}
+
+var file_audit_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_audit_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_audit_proto_goTypes = []any{
+	(AuditResourceType)(0),        // 0: core.v1.AuditResourceType
+	(*AuditChange)(nil),           // 1: core.v1.AuditChange
+	(*AuditEvent)(nil),            // 2: core.v1.AuditEvent
+	(*ListAuditEventsFilter)(nil), // 3: core.v1.ListAuditEventsFilter
+	(*ListAuditEventsReq)(nil),    // 4: core.v1.ListAuditEventsReq
+	(*ListAuditEventsResp)(nil),   // 5: core.v1.ListAuditEventsResp
+	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+	(*Cursor)(nil),                // 7: core.v1.Cursor
+}
+var file_audit_proto_depIdxs = []int32{
+	0, // 0: core.v1.AuditEvent.resource_type:type_name -> core.v1.AuditResourceType
+	1, // 1: core.v1.AuditEvent.changes:type_name -> core.v1.AuditChange
+	6, // 2: core.v1.AuditEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	0, // 3: core.v1.ListAuditEventsFilter.resource_type:type_name -> core.v1.AuditResourceType
+	7, // 4: core.v1.ListAuditEventsReq.cursor:type_name -> core.v1.Cursor
+	3, // 5: core.v1.ListAuditEventsReq.filter:type_name -> core.v1.ListAuditEventsFilter
+	2, // 6: core.v1.ListAuditEventsResp.events:type_name -> core.v1.AuditEvent
+	7, // 7: core.v1.ListAuditEventsResp.next_cursor:type_name -> core.v1.Cursor
+	4, // 8: core.v1.AuditService.ListAuditEvents:input_type -> core.v1.ListAuditEventsReq
+	5, // 9: core.v1.AuditService.ListAuditEvents:output_type -> core.v1.ListAuditEventsResp
+	9, // [9:10] is the sub-list for method output_type
+	8, // [8:9] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_audit_proto_init() }
+func file_audit_proto_init() {
+	if File_audit_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	file_audit_proto_msgTypes[4].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_audit_proto_rawDesc), len(file_audit_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_audit_proto_goTypes,
+		DependencyIndexes: file_audit_proto_depIdxs,
+		EnumInfos:         file_audit_proto_enumTypes,
+		MessageInfos:      file_audit_proto_msgTypes,
+	}.Build()
+	File_audit_proto = out.File
+	file_audit_proto_goTypes = nil
+	file_audit_proto_depIdxs = nil
+}
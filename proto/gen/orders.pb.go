@@ -23,6 +23,113 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type OrderStatus int32
+
+const (
+	OrderStatus_ORDER_STATUS_UNSPECIFIED OrderStatus = 0
+	OrderStatus_ORDER_STATUS_PENDING     OrderStatus = 1
+	OrderStatus_ORDER_STATUS_CONFIRMED   OrderStatus = 2
+	OrderStatus_ORDER_STATUS_CANCELLED   OrderStatus = 3 // excluded from sheet totals
+	OrderStatus_ORDER_STATUS_COMPLETED   OrderStatus = 4
+)
+
+// Enum value maps for OrderStatus.
+var (
+	OrderStatus_name = map[int32]string{
+		0: "ORDER_STATUS_UNSPECIFIED",
+		1: "ORDER_STATUS_PENDING",
+		2: "ORDER_STATUS_CONFIRMED",
+		3: "ORDER_STATUS_CANCELLED",
+		4: "ORDER_STATUS_COMPLETED",
+	}
+	OrderStatus_value = map[string]int32{
+		"ORDER_STATUS_UNSPECIFIED": 0,
+		"ORDER_STATUS_PENDING":     1,
+		"ORDER_STATUS_CONFIRMED":   2,
+		"ORDER_STATUS_CANCELLED":   3,
+		"ORDER_STATUS_COMPLETED":   4,
+	}
+)
+
+func (x OrderStatus) Enum() *OrderStatus {
+	p := new(OrderStatus)
+	*p = x
+	return p
+}
+
+func (x OrderStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_orders_proto_enumTypes[0].Descriptor()
+}
+
+func (OrderStatus) Type() protoreflect.EnumType {
+	return &file_orders_proto_enumTypes[0]
+}
+
+func (x OrderStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderStatus.Descriptor instead.
+func (OrderStatus) EnumDescriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{0}
+}
+
+type OrderEventType int32
+
+const (
+	OrderEventType_ORDER_EVENT_TYPE_UNSPECIFIED OrderEventType = 0
+	OrderEventType_ORDER_EVENT_TYPE_CREATED     OrderEventType = 1
+	OrderEventType_ORDER_EVENT_TYPE_UPDATED     OrderEventType = 2
+	OrderEventType_ORDER_EVENT_TYPE_CANCELLED   OrderEventType = 3
+)
+
+// Enum value maps for OrderEventType.
+var (
+	OrderEventType_name = map[int32]string{
+		0: "ORDER_EVENT_TYPE_UNSPECIFIED",
+		1: "ORDER_EVENT_TYPE_CREATED",
+		2: "ORDER_EVENT_TYPE_UPDATED",
+		3: "ORDER_EVENT_TYPE_CANCELLED",
+	}
+	OrderEventType_value = map[string]int32{
+		"ORDER_EVENT_TYPE_UNSPECIFIED": 0,
+		"ORDER_EVENT_TYPE_CREATED":     1,
+		"ORDER_EVENT_TYPE_UPDATED":     2,
+		"ORDER_EVENT_TYPE_CANCELLED":   3,
+	}
+)
+
+func (x OrderEventType) Enum() *OrderEventType {
+	p := new(OrderEventType)
+	*p = x
+	return p
+}
+
+func (x OrderEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_orders_proto_enumTypes[1].Descriptor()
+}
+
+func (OrderEventType) Type() protoreflect.EnumType {
+	return &file_orders_proto_enumTypes[1]
+}
+
+func (x OrderEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderEventType.Descriptor instead.
+func (OrderEventType) EnumDescriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{1}
+}
+
 type OrderLineOption struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`          // from MenuOptionGroup.id
@@ -30,6 +137,7 @@ type OrderLineOption struct {
 	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`                             // snapshot of the option title at order time
 	PriceDelta    *Money                 `protobuf:"bytes,4,opt,name=price_delta,json=priceDelta,proto3" json:"price_delta,omitempty"` // snapshot surcharge for ONE unit of this option
 	Quantity      int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	PerOrder      bool                   `protobuf:"varint,6,opt,name=per_order,json=perOrder,proto3" json:"per_order,omitempty"` // charged once per line instead of once per item
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -99,16 +207,24 @@ func (x *OrderLineOption) GetQuantity() int32 {
 	return 0
 }
 
+func (x *OrderLineOption) GetPerOrder() bool {
+	if x != nil {
+		return x.PerOrder
+	}
+	return false
+}
+
 type OrderLine struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	MenuItemId        string                 `protobuf:"bytes,1,opt,name=menu_item_id,json=menuItemId,proto3" json:"menu_item_id,omitempty"`
-	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                      // snapshot menu item title
-	Quantity          int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`                                             // item count
-	OrderBasePrice    *Money                 `protobuf:"bytes,4,opt,name=order_base_price,json=orderBasePrice,proto3" json:"order_base_price,omitempty"`          // snapshot of MenuItem.base_price
-	OrderOptionsTotal *Money                 `protobuf:"bytes,5,opt,name=order_options_total,json=orderOptionsTotal,proto3" json:"order_options_total,omitempty"` // sum(option.price_delta * option.quantity)
-	OrderTotal        *Money                 `protobuf:"bytes,6,opt,name=order_total,json=orderTotal,proto3" json:"order_total,omitempty"`                        // (unit_base_price + unit_options_delta) * quantity
-	Options           []*OrderLineOption     `protobuf:"bytes,7,rep,name=options,proto3" json:"options,omitempty"`
-	Note              string                 `protobuf:"bytes,8,opt,name=note,proto3" json:"note,omitempty"`
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MenuItemId     string                 `protobuf:"bytes,1,opt,name=menu_item_id,json=menuItemId,proto3" json:"menu_item_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                             // snapshot menu item title
+	Quantity       int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`                                    // item count
+	OrderBasePrice *Money                 `protobuf:"bytes,4,opt,name=order_base_price,json=orderBasePrice,proto3" json:"order_base_price,omitempty"` // snapshot of MenuItem.base_price
+	// sum(option.price_delta * option.quantity), times quantity unless per_order
+	OrderOptionsTotal *Money             `protobuf:"bytes,5,opt,name=order_options_total,json=orderOptionsTotal,proto3" json:"order_options_total,omitempty"`
+	OrderTotal        *Money             `protobuf:"bytes,6,opt,name=order_total,json=orderTotal,proto3" json:"order_total,omitempty"` // order_base_price * quantity + order_options_total
+	Options           []*OrderLineOption `protobuf:"bytes,7,rep,name=options,proto3" json:"options,omitempty"`
+	Note              string             `protobuf:"bytes,8,opt,name=note,proto3" json:"note,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -206,8 +322,12 @@ type Order struct {
 	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Lines         []*OrderLine           `protobuf:"bytes,4,rep,name=lines,proto3" json:"lines,omitempty"`
 	Subtotal      *Money                 `protobuf:"bytes,5,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
-	Total         *Money                 `protobuf:"bytes,6,opt,name=total,proto3" json:"total,omitempty"`
+	Total         *Money                 `protobuf:"bytes,6,opt,name=total,proto3" json:"total,omitempty"` // subtotal + fee_share - discount_share, derived on every read
 	Note          string                 `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	FeeShare      *Money                 `protobuf:"bytes,8,opt,name=fee_share,json=feeShare,proto3" json:"fee_share,omitempty"`                // this order's part of the sheet delivery fee
+	DiscountShare *Money                 `protobuf:"bytes,9,opt,name=discount_share,json=discountShare,proto3" json:"discount_share,omitempty"` // this order's part of the sheet discount
+	Status        OrderStatus            `protobuf:"varint,10,opt,name=status,proto3,enum=core.v1.OrderStatus" json:"status,omitempty"`
+	MenuId        string                 `protobuf:"bytes,11,opt,name=menu_id,json=menuId,proto3" json:"menu_id,omitempty"` // menu version the order was priced against
 	CreateAt      *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -293,6 +413,34 @@ func (x *Order) GetNote() string {
 	return ""
 }
 
+func (x *Order) GetFeeShare() *Money {
+	if x != nil {
+		return x.FeeShare
+	}
+	return nil
+}
+
+func (x *Order) GetDiscountShare() *Money {
+	if x != nil {
+		return x.DiscountShare
+	}
+	return nil
+}
+
+func (x *Order) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *Order) GetMenuId() string {
+	if x != nil {
+		return x.MenuId
+	}
+	return ""
+}
+
 func (x *Order) GetCreateAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreateAt
@@ -307,11 +455,13 @@ func (x *Order) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+// Results are newest first by created_at, or by updated_at when since is set
 type ListOrdersFilter struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SheetId       string                 `protobuf:"bytes,1,opt,name=sheet_id,json=sheetId,proto3" json:"sheet_id,omitempty"` // required
-	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Since         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=since,proto3" json:"since,omitempty"` // updated since (optional)
+	SheetId       string                 `protobuf:"bytes,1,opt,name=sheet_id,json=sheetId,proto3" json:"sheet_id,omitempty"`          // required
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // optional
+	Since         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=since,proto3" json:"since,omitempty"`                             // updated since (optional)
+	Status        OrderStatus            `protobuf:"varint,5,opt,name=status,proto3,enum=core.v1.OrderStatus" json:"status,omitempty"` // optional
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -367,6 +517,13 @@ func (x *ListOrdersFilter) GetSince() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *ListOrdersFilter) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
 type OrderLineOptionReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`    // from MenuOptionGroup.id
@@ -500,10 +657,13 @@ type CreateOrderReq struct {
 	IdempotencyKey string                 `protobuf:"bytes,1,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // dedupe client retries
 	SheetId        string                 `protobuf:"bytes,2,opt,name=sheet_id,json=sheetId,proto3" json:"sheet_id,omitempty"`
 	Lines          []*OrderLineReq        `protobuf:"bytes,4,rep,name=lines,proto3" json:"lines,omitempty"`
-	UserId         string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Note           string                 `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Ignored: orders are placed for the authenticated caller.
+	//
+	// Deprecated: Marked as deprecated in orders.proto.
+	UserId        string `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Note          string `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateOrderReq) Reset() {
@@ -557,6 +717,7 @@ func (x *CreateOrderReq) GetLines() []*OrderLineReq {
 	return nil
 }
 
+// Deprecated: Marked as deprecated in orders.proto.
 func (x *CreateOrderReq) GetUserId() string {
 	if x != nil {
 		return x.UserId
@@ -719,6 +880,182 @@ func (x *UpdateOrderResp) GetOrder() *Order {
 	return nil
 }
 
+type CancelOrderReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderReq) Reset() {
+	*x = CancelOrderReq{}
+	mi := &file_orders_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderReq) ProtoMessage() {}
+
+func (x *CancelOrderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderReq.ProtoReflect.Descriptor instead.
+func (*CancelOrderReq) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CancelOrderReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CancelOrderResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderResp) Reset() {
+	*x = CancelOrderResp{}
+	mi := &file_orders_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderResp) ProtoMessage() {}
+
+func (x *CancelOrderResp) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderResp.ProtoReflect.Descriptor instead.
+func (*CancelOrderResp) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CancelOrderResp) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type ConfirmOrderReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmOrderReq) Reset() {
+	*x = ConfirmOrderReq{}
+	mi := &file_orders_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmOrderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmOrderReq) ProtoMessage() {}
+
+func (x *ConfirmOrderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmOrderReq.ProtoReflect.Descriptor instead.
+func (*ConfirmOrderReq) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ConfirmOrderReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ConfirmOrderResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmOrderResp) Reset() {
+	*x = ConfirmOrderResp{}
+	mi := &file_orders_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmOrderResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmOrderResp) ProtoMessage() {}
+
+func (x *ConfirmOrderResp) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmOrderResp.ProtoReflect.Descriptor instead.
+func (*ConfirmOrderResp) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ConfirmOrderResp) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
 type GetOrderReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -728,7 +1065,7 @@ type GetOrderReq struct {
 
 func (x *GetOrderReq) Reset() {
 	*x = GetOrderReq{}
-	mi := &file_orders_proto_msgTypes[10]
+	mi := &file_orders_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -740,7 +1077,7 @@ func (x *GetOrderReq) String() string {
 func (*GetOrderReq) ProtoMessage() {}
 
 func (x *GetOrderReq) ProtoReflect() protoreflect.Message {
-	mi := &file_orders_proto_msgTypes[10]
+	mi := &file_orders_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -753,7 +1090,7 @@ func (x *GetOrderReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrderReq.ProtoReflect.Descriptor instead.
 func (*GetOrderReq) Descriptor() ([]byte, []int) {
-	return file_orders_proto_rawDescGZIP(), []int{10}
+	return file_orders_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetOrderReq) GetId() string {
@@ -772,7 +1109,7 @@ type GetOrderResp struct {
 
 func (x *GetOrderResp) Reset() {
 	*x = GetOrderResp{}
-	mi := &file_orders_proto_msgTypes[11]
+	mi := &file_orders_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -784,7 +1121,7 @@ func (x *GetOrderResp) String() string {
 func (*GetOrderResp) ProtoMessage() {}
 
 func (x *GetOrderResp) ProtoReflect() protoreflect.Message {
-	mi := &file_orders_proto_msgTypes[11]
+	mi := &file_orders_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -797,7 +1134,7 @@ func (x *GetOrderResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrderResp.ProtoReflect.Descriptor instead.
 func (*GetOrderResp) Descriptor() ([]byte, []int) {
-	return file_orders_proto_rawDescGZIP(), []int{11}
+	return file_orders_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetOrderResp) GetOrder() *Order {
@@ -818,7 +1155,7 @@ type ListOrdersReq struct {
 
 func (x *ListOrdersReq) Reset() {
 	*x = ListOrdersReq{}
-	mi := &file_orders_proto_msgTypes[12]
+	mi := &file_orders_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -830,7 +1167,7 @@ func (x *ListOrdersReq) String() string {
 func (*ListOrdersReq) ProtoMessage() {}
 
 func (x *ListOrdersReq) ProtoReflect() protoreflect.Message {
-	mi := &file_orders_proto_msgTypes[12]
+	mi := &file_orders_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -843,7 +1180,7 @@ func (x *ListOrdersReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListOrdersReq.ProtoReflect.Descriptor instead.
 func (*ListOrdersReq) Descriptor() ([]byte, []int) {
-	return file_orders_proto_rawDescGZIP(), []int{12}
+	return file_orders_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ListOrdersReq) GetPageSize() int32 {
@@ -877,7 +1214,7 @@ type ListOrdersResp struct {
 
 func (x *ListOrdersResp) Reset() {
 	*x = ListOrdersResp{}
-	mi := &file_orders_proto_msgTypes[13]
+	mi := &file_orders_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -889,7 +1226,7 @@ func (x *ListOrdersResp) String() string {
 func (*ListOrdersResp) ProtoMessage() {}
 
 func (x *ListOrdersResp) ProtoReflect() protoreflect.Message {
-	mi := &file_orders_proto_msgTypes[13]
+	mi := &file_orders_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -902,7 +1239,7 @@ func (x *ListOrdersResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListOrdersResp.ProtoReflect.Descriptor instead.
 func (*ListOrdersResp) Descriptor() ([]byte, []int) {
-	return file_orders_proto_rawDescGZIP(), []int{13}
+	return file_orders_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ListOrdersResp) GetOrders() []*Order {
@@ -919,18 +1256,139 @@ func (x *ListOrdersResp) GetNextCursor() *Cursor {
 	return nil
 }
 
+type StreamOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SheetId       string                 `protobuf:"bytes,1,opt,name=sheet_id,json=sheetId,proto3" json:"sheet_id,omitempty"`
+	ResumeCursor  string                 `protobuf:"bytes,2,opt,name=resume_cursor,json=resumeCursor,proto3" json:"resume_cursor,omitempty"` // cursor of the last event received; empty to start live
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamOrdersRequest) Reset() {
+	*x = StreamOrdersRequest{}
+	mi := &file_orders_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamOrdersRequest) ProtoMessage() {}
+
+func (x *StreamOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamOrdersRequest.ProtoReflect.Descriptor instead.
+func (*StreamOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *StreamOrdersRequest) GetSheetId() string {
+	if x != nil {
+		return x.SheetId
+	}
+	return ""
+}
+
+func (x *StreamOrdersRequest) GetResumeCursor() string {
+	if x != nil {
+		return x.ResumeCursor
+	}
+	return ""
+}
+
+type StreamOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          OrderEventType         `protobuf:"varint,1,opt,name=type,proto3,enum=core.v1.OrderEventType" json:"type,omitempty"`
+	Order         *Order                 `protobuf:"bytes,2,opt,name=order,proto3" json:"order,omitempty"`
+	Cursor        string                 `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"` // pass back as resume_cursor when reconnecting
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamOrdersResponse) Reset() {
+	*x = StreamOrdersResponse{}
+	mi := &file_orders_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamOrdersResponse) ProtoMessage() {}
+
+func (x *StreamOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamOrdersResponse.ProtoReflect.Descriptor instead.
+func (*StreamOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_orders_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *StreamOrdersResponse) GetType() OrderEventType {
+	if x != nil {
+		return x.Type
+	}
+	return OrderEventType_ORDER_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *StreamOrdersResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *StreamOrdersResponse) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *StreamOrdersResponse) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
 var File_orders_proto protoreflect.FileDescriptor
 
 const file_orders_proto_rawDesc = "" +
 	"\n" +
-	"\forders.proto\x12\acore.v1\x1a\fcommon.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\xac\x01\n" +
+	"\forders.proto\x12\acore.v1\x1a\fcommon.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\xc9\x01\n" +
 	"\x0fOrderLineOption\x12\x19\n" +
 	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1b\n" +
 	"\toption_id\x18\x02 \x01(\tR\boptionId\x12\x14\n" +
 	"\x05title\x18\x03 \x01(\tR\x05title\x12/\n" +
 	"\vprice_delta\x18\x04 \x01(\v2\x0e.core.v1.MoneyR\n" +
 	"priceDelta\x12\x1a\n" +
-	"\bquantity\x18\x05 \x01(\x05R\bquantity\"\xd0\x02\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12\x1b\n" +
+	"\tper_order\x18\x06 \x01(\bR\bperOrder\"\xd0\x02\n" +
 	"\tOrderLine\x12 \n" +
 	"\fmenu_item_id\x18\x01 \x01(\tR\n" +
 	"menuItemId\x12\x12\n" +
@@ -941,7 +1399,7 @@ const file_orders_proto_rawDesc = "" +
 	"\vorder_total\x18\x06 \x01(\v2\x0e.core.v1.MoneyR\n" +
 	"orderTotal\x122\n" +
 	"\aoptions\x18\a \x03(\v2\x18.core.v1.OrderLineOptionR\aoptions\x12\x12\n" +
-	"\x04note\x18\b \x01(\tR\x04note\"\xcf\x02\n" +
+	"\x04note\x18\b \x01(\tR\x04note\"\xfa\x03\n" +
 	"\x05Order\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\bsheet_id\x18\x02 \x01(\tR\asheetId\x12\x17\n" +
@@ -949,14 +1407,20 @@ const file_orders_proto_rawDesc = "" +
 	"\x05lines\x18\x04 \x03(\v2\x12.core.v1.OrderLineR\x05lines\x12*\n" +
 	"\bsubtotal\x18\x05 \x01(\v2\x0e.core.v1.MoneyR\bsubtotal\x12$\n" +
 	"\x05total\x18\x06 \x01(\v2\x0e.core.v1.MoneyR\x05total\x12\x12\n" +
-	"\x04note\x18\a \x01(\tR\x04note\x127\n" +
+	"\x04note\x18\a \x01(\tR\x04note\x12+\n" +
+	"\tfee_share\x18\b \x01(\v2\x0e.core.v1.MoneyR\bfeeShare\x125\n" +
+	"\x0ediscount_share\x18\t \x01(\v2\x0e.core.v1.MoneyR\rdiscountShare\x12,\n" +
+	"\x06status\x18\n" +
+	" \x01(\x0e2\x14.core.v1.OrderStatusR\x06status\x12\x17\n" +
+	"\amenu_id\x18\v \x01(\tR\x06menuId\x127\n" +
 	"\tcreate_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\bcreateAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x8a\x01\n" +
+	"updated_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb9\x01\n" +
 	"\x10ListOrdersFilter\x12\"\n" +
-	"\bsheet_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\asheetId\x12 \n" +
-	"\auser_id\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06userId\x120\n" +
-	"\x05since\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\"\x83\x01\n" +
+	"\bsheet_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\asheetId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x120\n" +
+	"\x05since\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x126\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x14.core.v1.OrderStatusB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06status\"\x83\x01\n" +
 	"\x12OrderLineOptionReq\x12\"\n" +
 	"\bgroup_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\agroupId\x12$\n" +
 	"\toption_id\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\boptionId\x12#\n" +
@@ -966,12 +1430,12 @@ const file_orders_proto_rawDesc = "" +
 	"menuItemId\x12#\n" +
 	"\bquantity\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\bquantity\x12?\n" +
 	"\aoptions\x18\x03 \x03(\v2\x1b.core.v1.OrderLineOptionReqB\b\xfaB\x05\x92\x01\x02\b\x00R\aoptions\x12\x1c\n" +
-	"\x04note\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\xf4\x03R\x04note\"\xdd\x01\n" +
+	"\x04note\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\xf4\x03R\x04note\"\xd8\x01\n" +
 	"\x0eCreateOrderReq\x120\n" +
 	"\x0fidempotency_key\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x0eidempotencyKey\x12\"\n" +
 	"\bsheet_id\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\asheetId\x125\n" +
-	"\x05lines\x18\x04 \x03(\v2\x15.core.v1.OrderLineReqB\b\xfaB\x05\x92\x01\x02\b\x01R\x05lines\x12 \n" +
-	"\auser_id\x18\x05 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06userId\x12\x1c\n" +
+	"\x05lines\x18\x04 \x03(\v2\x15.core.v1.OrderLineReqB\b\xfaB\x05\x92\x01\x02\b\x01R\x05lines\x12\x1b\n" +
+	"\auser_id\x18\x05 \x01(\tB\x02\x18\x01R\x06userId\x12\x1c\n" +
 	"\x04note\x18\a \x01(\tB\b\xfaB\x05r\x03\x18\xf4\x03R\x04note\"7\n" +
 	"\x0fCreateOrderResp\x12$\n" +
 	"\x05order\x18\x01 \x01(\v2\x0e.core.v1.OrderR\x05order\"\x8c\x01\n" +
@@ -981,6 +1445,14 @@ const file_orders_proto_rawDesc = "" +
 	"\x04note\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\xf4\x03H\x00R\x04note\x88\x01\x01B\a\n" +
 	"\x05_note\"7\n" +
 	"\x0fUpdateOrderResp\x12$\n" +
+	"\x05order\x18\x01 \x01(\v2\x0e.core.v1.OrderR\x05order\")\n" +
+	"\x0eCancelOrderReq\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\"7\n" +
+	"\x0fCancelOrderResp\x12$\n" +
+	"\x05order\x18\x01 \x01(\v2\x0e.core.v1.OrderR\x05order\"*\n" +
+	"\x0fConfirmOrderReq\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\"8\n" +
+	"\x10ConfirmOrderResp\x12$\n" +
 	"\x05order\x18\x01 \x01(\v2\x0e.core.v1.OrderR\x05order\"&\n" +
 	"\vGetOrderReq\x12\x17\n" +
 	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\"4\n" +
@@ -994,13 +1466,36 @@ const file_orders_proto_rawDesc = "" +
 	"\x06orders\x18\x01 \x03(\v2\x0e.core.v1.OrderR\x06orders\x125\n" +
 	"\vnext_cursor\x18\x02 \x01(\v2\x0f.core.v1.CursorH\x00R\n" +
 	"nextCursor\x88\x01\x01B\x0e\n" +
-	"\f_next_cursor2\x8b\x02\n" +
+	"\f_next_cursor\"^\n" +
+	"\x13StreamOrdersRequest\x12\"\n" +
+	"\bsheet_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\asheetId\x12#\n" +
+	"\rresume_cursor\x18\x02 \x01(\tR\fresumeCursor\"\xbe\x01\n" +
+	"\x14StreamOrdersResponse\x12+\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x17.core.v1.OrderEventTypeR\x04type\x12$\n" +
+	"\x05order\x18\x02 \x01(\v2\x0e.core.v1.OrderR\x05order\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\x12;\n" +
+	"\voccurred_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt*\x99\x01\n" +
+	"\vOrderStatus\x12\x1c\n" +
+	"\x18ORDER_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14ORDER_STATUS_PENDING\x10\x01\x12\x1a\n" +
+	"\x16ORDER_STATUS_CONFIRMED\x10\x02\x12\x1a\n" +
+	"\x16ORDER_STATUS_CANCELLED\x10\x03\x12\x1a\n" +
+	"\x16ORDER_STATUS_COMPLETED\x10\x04*\x8e\x01\n" +
+	"\x0eOrderEventType\x12 \n" +
+	"\x1cORDER_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18ORDER_EVENT_TYPE_CREATED\x10\x01\x12\x1c\n" +
+	"\x18ORDER_EVENT_TYPE_UPDATED\x10\x02\x12\x1e\n" +
+	"\x1aORDER_EVENT_TYPE_CANCELLED\x10\x032\xe1\x03\n" +
 	"\rOrdersService\x12@\n" +
 	"\vCreateOrder\x12\x17.core.v1.CreateOrderReq\x1a\x18.core.v1.CreateOrderResp\x12@\n" +
 	"\vUpdateOrder\x12\x17.core.v1.UpdateOrderReq\x1a\x18.core.v1.UpdateOrderResp\x127\n" +
 	"\bGetOrder\x12\x14.core.v1.GetOrderReq\x1a\x15.core.v1.GetOrderResp\x12=\n" +
 	"\n" +
-	"ListOrders\x12\x16.core.v1.ListOrdersReq\x1a\x17.core.v1.ListOrdersRespB;Z9github.com/deni12345/dae-services/proto/gen/corev1;corev1b\x06proto3"
+	"ListOrders\x12\x16.core.v1.ListOrdersReq\x1a\x17.core.v1.ListOrdersResp\x12@\n" +
+	"\vCancelOrder\x12\x17.core.v1.CancelOrderReq\x1a\x18.core.v1.CancelOrderResp\x12C\n" +
+	"\fConfirmOrder\x12\x18.core.v1.ConfirmOrderReq\x1a\x19.core.v1.ConfirmOrderResp\x12M\n" +
+	"\fStreamOrders\x12\x1c.core.v1.StreamOrdersRequest\x1a\x1d.core.v1.StreamOrdersResponse0\x01B;Z9github.com/deni12345/dae-services/proto/gen/corev1;corev1b\x06proto3"
 
 var (
 	file_orders_proto_rawDescOnce sync.Once
@@ -1014,61 +1509,85 @@ func file_orders_proto_rawDescGZIP() []byte {
 	return file_orders_proto_rawDescData
 }
 
-var file_orders_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_orders_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_orders_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_orders_proto_goTypes = []any{
-	(*OrderLineOption)(nil),       // 0: core.v1.OrderLineOption
-	(*OrderLine)(nil),             // 1: core.v1.OrderLine
-	(*Order)(nil),                 // 2: core.v1.Order
-	(*ListOrdersFilter)(nil),      // 3: core.v1.ListOrdersFilter
-	(*OrderLineOptionReq)(nil),    // 4: core.v1.OrderLineOptionReq
-	(*OrderLineReq)(nil),          // 5: core.v1.OrderLineReq
-	(*CreateOrderReq)(nil),        // 6: core.v1.CreateOrderReq
-	(*CreateOrderResp)(nil),       // 7: core.v1.CreateOrderResp
-	(*UpdateOrderReq)(nil),        // 8: core.v1.UpdateOrderReq
-	(*UpdateOrderResp)(nil),       // 9: core.v1.UpdateOrderResp
-	(*GetOrderReq)(nil),           // 10: core.v1.GetOrderReq
-	(*GetOrderResp)(nil),          // 11: core.v1.GetOrderResp
-	(*ListOrdersReq)(nil),         // 12: core.v1.ListOrdersReq
-	(*ListOrdersResp)(nil),        // 13: core.v1.ListOrdersResp
-	(*Money)(nil),                 // 14: core.v1.Money
-	(*timestamppb.Timestamp)(nil), // 15: google.protobuf.Timestamp
-	(*Cursor)(nil),                // 16: core.v1.Cursor
+	(OrderStatus)(0),              // 0: core.v1.OrderStatus
+	(OrderEventType)(0),           // 1: core.v1.OrderEventType
+	(*OrderLineOption)(nil),       // 2: core.v1.OrderLineOption
+	(*OrderLine)(nil),             // 3: core.v1.OrderLine
+	(*Order)(nil),                 // 4: core.v1.Order
+	(*ListOrdersFilter)(nil),      // 5: core.v1.ListOrdersFilter
+	(*OrderLineOptionReq)(nil),    // 6: core.v1.OrderLineOptionReq
+	(*OrderLineReq)(nil),          // 7: core.v1.OrderLineReq
+	(*CreateOrderReq)(nil),        // 8: core.v1.CreateOrderReq
+	(*CreateOrderResp)(nil),       // 9: core.v1.CreateOrderResp
+	(*UpdateOrderReq)(nil),        // 10: core.v1.UpdateOrderReq
+	(*UpdateOrderResp)(nil),       // 11: core.v1.UpdateOrderResp
+	(*CancelOrderReq)(nil),        // 12: core.v1.CancelOrderReq
+	(*CancelOrderResp)(nil),       // 13: core.v1.CancelOrderResp
+	(*ConfirmOrderReq)(nil),       // 14: core.v1.ConfirmOrderReq
+	(*ConfirmOrderResp)(nil),      // 15: core.v1.ConfirmOrderResp
+	(*GetOrderReq)(nil),           // 16: core.v1.GetOrderReq
+	(*GetOrderResp)(nil),          // 17: core.v1.GetOrderResp
+	(*ListOrdersReq)(nil),         // 18: core.v1.ListOrdersReq
+	(*ListOrdersResp)(nil),        // 19: core.v1.ListOrdersResp
+	(*StreamOrdersRequest)(nil),   // 20: core.v1.StreamOrdersRequest
+	(*StreamOrdersResponse)(nil),  // 21: core.v1.StreamOrdersResponse
+	(*Money)(nil),                 // 22: core.v1.Money
+	(*timestamppb.Timestamp)(nil), // 23: google.protobuf.Timestamp
+	(*Cursor)(nil),                // 24: core.v1.Cursor
 }
 var file_orders_proto_depIdxs = []int32{
-	14, // 0: core.v1.OrderLineOption.price_delta:type_name -> core.v1.Money
-	14, // 1: core.v1.OrderLine.order_base_price:type_name -> core.v1.Money
-	14, // 2: core.v1.OrderLine.order_options_total:type_name -> core.v1.Money
-	14, // 3: core.v1.OrderLine.order_total:type_name -> core.v1.Money
-	0,  // 4: core.v1.OrderLine.options:type_name -> core.v1.OrderLineOption
-	1,  // 5: core.v1.Order.lines:type_name -> core.v1.OrderLine
-	14, // 6: core.v1.Order.subtotal:type_name -> core.v1.Money
-	14, // 7: core.v1.Order.total:type_name -> core.v1.Money
-	15, // 8: core.v1.Order.create_at:type_name -> google.protobuf.Timestamp
-	15, // 9: core.v1.Order.updated_at:type_name -> google.protobuf.Timestamp
-	15, // 10: core.v1.ListOrdersFilter.since:type_name -> google.protobuf.Timestamp
-	4,  // 11: core.v1.OrderLineReq.options:type_name -> core.v1.OrderLineOptionReq
-	5,  // 12: core.v1.CreateOrderReq.lines:type_name -> core.v1.OrderLineReq
-	2,  // 13: core.v1.CreateOrderResp.order:type_name -> core.v1.Order
-	5,  // 14: core.v1.UpdateOrderReq.lines:type_name -> core.v1.OrderLineReq
-	2,  // 15: core.v1.UpdateOrderResp.order:type_name -> core.v1.Order
-	2,  // 16: core.v1.GetOrderResp.order:type_name -> core.v1.Order
-	16, // 17: core.v1.ListOrdersReq.cursor:type_name -> core.v1.Cursor
-	3,  // 18: core.v1.ListOrdersReq.filter:type_name -> core.v1.ListOrdersFilter
-	2,  // 19: core.v1.ListOrdersResp.orders:type_name -> core.v1.Order
-	16, // 20: core.v1.ListOrdersResp.next_cursor:type_name -> core.v1.Cursor
-	6,  // 21: core.v1.OrdersService.CreateOrder:input_type -> core.v1.CreateOrderReq
-	8,  // 22: core.v1.OrdersService.UpdateOrder:input_type -> core.v1.UpdateOrderReq
-	10, // 23: core.v1.OrdersService.GetOrder:input_type -> core.v1.GetOrderReq
-	12, // 24: core.v1.OrdersService.ListOrders:input_type -> core.v1.ListOrdersReq
-	7,  // 25: core.v1.OrdersService.CreateOrder:output_type -> core.v1.CreateOrderResp
-	9,  // 26: core.v1.OrdersService.UpdateOrder:output_type -> core.v1.UpdateOrderResp
-	11, // 27: core.v1.OrdersService.GetOrder:output_type -> core.v1.GetOrderResp
-	13, // 28: core.v1.OrdersService.ListOrders:output_type -> core.v1.ListOrdersResp
-	25, // [25:29] is the sub-list for method output_type
-	21, // [21:25] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	22, // 0: core.v1.OrderLineOption.price_delta:type_name -> core.v1.Money
+	22, // 1: core.v1.OrderLine.order_base_price:type_name -> core.v1.Money
+	22, // 2: core.v1.OrderLine.order_options_total:type_name -> core.v1.Money
+	22, // 3: core.v1.OrderLine.order_total:type_name -> core.v1.Money
+	2,  // 4: core.v1.OrderLine.options:type_name -> core.v1.OrderLineOption
+	3,  // 5: core.v1.Order.lines:type_name -> core.v1.OrderLine
+	22, // 6: core.v1.Order.subtotal:type_name -> core.v1.Money
+	22, // 7: core.v1.Order.total:type_name -> core.v1.Money
+	22, // 8: core.v1.Order.fee_share:type_name -> core.v1.Money
+	22, // 9: core.v1.Order.discount_share:type_name -> core.v1.Money
+	0,  // 10: core.v1.Order.status:type_name -> core.v1.OrderStatus
+	23, // 11: core.v1.Order.create_at:type_name -> google.protobuf.Timestamp
+	23, // 12: core.v1.Order.updated_at:type_name -> google.protobuf.Timestamp
+	23, // 13: core.v1.ListOrdersFilter.since:type_name -> google.protobuf.Timestamp
+	0,  // 14: core.v1.ListOrdersFilter.status:type_name -> core.v1.OrderStatus
+	6,  // 15: core.v1.OrderLineReq.options:type_name -> core.v1.OrderLineOptionReq
+	7,  // 16: core.v1.CreateOrderReq.lines:type_name -> core.v1.OrderLineReq
+	4,  // 17: core.v1.CreateOrderResp.order:type_name -> core.v1.Order
+	7,  // 18: core.v1.UpdateOrderReq.lines:type_name -> core.v1.OrderLineReq
+	4,  // 19: core.v1.UpdateOrderResp.order:type_name -> core.v1.Order
+	4,  // 20: core.v1.CancelOrderResp.order:type_name -> core.v1.Order
+	4,  // 21: core.v1.ConfirmOrderResp.order:type_name -> core.v1.Order
+	4,  // 22: core.v1.GetOrderResp.order:type_name -> core.v1.Order
+	24, // 23: core.v1.ListOrdersReq.cursor:type_name -> core.v1.Cursor
+	5,  // 24: core.v1.ListOrdersReq.filter:type_name -> core.v1.ListOrdersFilter
+	4,  // 25: core.v1.ListOrdersResp.orders:type_name -> core.v1.Order
+	24, // 26: core.v1.ListOrdersResp.next_cursor:type_name -> core.v1.Cursor
+	1,  // 27: core.v1.StreamOrdersResponse.type:type_name -> core.v1.OrderEventType
+	4,  // 28: core.v1.StreamOrdersResponse.order:type_name -> core.v1.Order
+	23, // 29: core.v1.StreamOrdersResponse.occurred_at:type_name -> google.protobuf.Timestamp
+	8,  // 30: core.v1.OrdersService.CreateOrder:input_type -> core.v1.CreateOrderReq
+	10, // 31: core.v1.OrdersService.UpdateOrder:input_type -> core.v1.UpdateOrderReq
+	16, // 32: core.v1.OrdersService.GetOrder:input_type -> core.v1.GetOrderReq
+	18, // 33: core.v1.OrdersService.ListOrders:input_type -> core.v1.ListOrdersReq
+	12, // 34: core.v1.OrdersService.CancelOrder:input_type -> core.v1.CancelOrderReq
+	14, // 35: core.v1.OrdersService.ConfirmOrder:input_type -> core.v1.ConfirmOrderReq
+	20, // 36: core.v1.OrdersService.StreamOrders:input_type -> core.v1.StreamOrdersRequest
+	9,  // 37: core.v1.OrdersService.CreateOrder:output_type -> core.v1.CreateOrderResp
+	11, // 38: core.v1.OrdersService.UpdateOrder:output_type -> core.v1.UpdateOrderResp
+	17, // 39: core.v1.OrdersService.GetOrder:output_type -> core.v1.GetOrderResp
+	19, // 40: core.v1.OrdersService.ListOrders:output_type -> core.v1.ListOrdersResp
+	13, // 41: core.v1.OrdersService.CancelOrder:output_type -> core.v1.CancelOrderResp
+	15, // 42: core.v1.OrdersService.ConfirmOrder:output_type -> core.v1.ConfirmOrderResp
+	21, // 43: core.v1.OrdersService.StreamOrders:output_type -> core.v1.StreamOrdersResponse
+	37, // [37:44] is the sub-list for method output_type
+	30, // [30:37] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
 }
 
 func init() { file_orders_proto_init() }
@@ -1078,19 +1597,20 @@ func file_orders_proto_init() {
 	}
 	file_common_proto_init()
 	file_orders_proto_msgTypes[8].OneofWrappers = []any{}
-	file_orders_proto_msgTypes[13].OneofWrappers = []any{}
+	file_orders_proto_msgTypes[17].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_orders_proto_rawDesc), len(file_orders_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   14,
+			NumEnums:      2,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_orders_proto_goTypes,
 		DependencyIndexes: file_orders_proto_depIdxs,
+		EnumInfos:         file_orders_proto_enumTypes,
 		MessageInfos:      file_orders_proto_msgTypes,
 	}.Build()
 	File_orders_proto = out.File
@@ -94,6 +94,8 @@ func (m *OrderLineOption) validate(all bool) error {
 
 	// no validation rules for Quantity
 
+	// no validation rules for PerOrder
+
 	if len(errors) > 0 {
 		return OrderLineOptionMultiError(errors)
 	}
@@ -521,6 +523,68 @@ func (m *Order) validate(all bool) error {
 
 	// no validation rules for Note
 
+	if all {
+		switch v := interface{}(m.GetFeeShare()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "FeeShare",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "FeeShare",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFeeShare()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderValidationError{
+				field:  "FeeShare",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDiscountShare()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "DiscountShare",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "DiscountShare",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDiscountShare()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderValidationError{
+				field:  "DiscountShare",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Status
+
+	// no validation rules for MenuId
+
 	if all {
 		switch v := interface{}(m.GetCreateAt()).(type) {
 		case interface{ ValidateAll() error }:
@@ -689,16 +753,7 @@ func (m *ListOrdersFilter) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetUserId()) < 1 {
-		err := ListOrdersFilterValidationError{
-			field:  "UserId",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for UserId
 
 	if all {
 		switch v := interface{}(m.GetSince()).(type) {
@@ -729,6 +784,17 @@ func (m *ListOrdersFilter) validate(all bool) error {
 		}
 	}
 
+	if _, ok := OrderStatus_name[int32(m.GetStatus())]; !ok {
+		err := ListOrdersFilterValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return ListOrdersFilterMultiError(errors)
 	}
@@ -1197,16 +1263,7 @@ func (m *CreateOrderReq) validate(all bool) error {
 
 	}
 
-	if utf8.RuneCountInString(m.GetUserId()) < 1 {
-		err := CreateOrderReqValidationError{
-			field:  "UserId",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for UserId
 
 	if utf8.RuneCountInString(m.GetNote()) > 500 {
 		err := CreateOrderReqValidationError{
@@ -1726,22 +1783,22 @@ var _ interface {
 	ErrorName() string
 } = UpdateOrderRespValidationError{}
 
-// Validate checks the field values on GetOrderReq with the rules defined in
+// Validate checks the field values on CancelOrderReq with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *GetOrderReq) Validate() error {
+func (m *CancelOrderReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetOrderReq with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetOrderReqMultiError, or
-// nil if none found.
-func (m *GetOrderReq) ValidateAll() error {
+// ValidateAll checks the field values on CancelOrderReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CancelOrderReqMultiError,
+// or nil if none found.
+func (m *CancelOrderReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetOrderReq) validate(all bool) error {
+func (m *CancelOrderReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1749,7 +1806,7 @@ func (m *GetOrderReq) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetId()) < 1 {
-		err := GetOrderReqValidationError{
+		err := CancelOrderReqValidationError{
 			field:  "Id",
 			reason: "value length must be at least 1 runes",
 		}
@@ -1760,18 +1817,19 @@ func (m *GetOrderReq) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetOrderReqMultiError(errors)
+		return CancelOrderReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetOrderReqMultiError is an error wrapping multiple validation errors
-// returned by GetOrderReq.ValidateAll() if the designated constraints aren't met.
-type GetOrderReqMultiError []error
+// CancelOrderReqMultiError is an error wrapping multiple validation errors
+// returned by CancelOrderReq.ValidateAll() if the designated constraints
+// aren't met.
+type CancelOrderReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetOrderReqMultiError) Error() string {
+func (m CancelOrderReqMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1780,11 +1838,11 @@ func (m GetOrderReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetOrderReqMultiError) AllErrors() []error { return m }
+func (m CancelOrderReqMultiError) AllErrors() []error { return m }
 
-// GetOrderReqValidationError is the validation error returned by
-// GetOrderReq.Validate if the designated constraints aren't met.
-type GetOrderReqValidationError struct {
+// CancelOrderReqValidationError is the validation error returned by
+// CancelOrderReq.Validate if the designated constraints aren't met.
+type CancelOrderReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1792,22 +1850,22 @@ type GetOrderReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetOrderReqValidationError) Field() string { return e.field }
+func (e CancelOrderReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetOrderReqValidationError) Reason() string { return e.reason }
+func (e CancelOrderReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetOrderReqValidationError) Cause() error { return e.cause }
+func (e CancelOrderReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetOrderReqValidationError) Key() bool { return e.key }
+func (e CancelOrderReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetOrderReqValidationError) ErrorName() string { return "GetOrderReqValidationError" }
+func (e CancelOrderReqValidationError) ErrorName() string { return "CancelOrderReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetOrderReqValidationError) Error() string {
+func (e CancelOrderReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1819,14 +1877,14 @@ func (e GetOrderReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetOrderReq.%s: %s%s",
+		"invalid %sCancelOrderReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetOrderReqValidationError{}
+var _ error = CancelOrderReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -1834,24 +1892,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetOrderReqValidationError{}
+} = CancelOrderReqValidationError{}
 
-// Validate checks the field values on GetOrderResp with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetOrderResp) Validate() error {
+// Validate checks the field values on CancelOrderResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CancelOrderResp) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetOrderResp with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetOrderRespMultiError, or
-// nil if none found.
-func (m *GetOrderResp) ValidateAll() error {
+// ValidateAll checks the field values on CancelOrderResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelOrderRespMultiError, or nil if none found.
+func (m *CancelOrderResp) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetOrderResp) validate(all bool) error {
+func (m *CancelOrderResp) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1862,7 +1920,7 @@ func (m *GetOrderResp) validate(all bool) error {
 		switch v := interface{}(m.GetOrder()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetOrderRespValidationError{
+				errors = append(errors, CancelOrderRespValidationError{
 					field:  "Order",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1870,7 +1928,7 @@ func (m *GetOrderResp) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetOrderRespValidationError{
+				errors = append(errors, CancelOrderRespValidationError{
 					field:  "Order",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1879,7 +1937,7 @@ func (m *GetOrderResp) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetOrder()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetOrderRespValidationError{
+			return CancelOrderRespValidationError{
 				field:  "Order",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -1888,18 +1946,19 @@ func (m *GetOrderResp) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetOrderRespMultiError(errors)
+		return CancelOrderRespMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetOrderRespMultiError is an error wrapping multiple validation errors
-// returned by GetOrderResp.ValidateAll() if the designated constraints aren't met.
-type GetOrderRespMultiError []error
+// CancelOrderRespMultiError is an error wrapping multiple validation errors
+// returned by CancelOrderResp.ValidateAll() if the designated constraints
+// aren't met.
+type CancelOrderRespMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetOrderRespMultiError) Error() string {
+func (m CancelOrderRespMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1908,11 +1967,11 @@ func (m GetOrderRespMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetOrderRespMultiError) AllErrors() []error { return m }
+func (m CancelOrderRespMultiError) AllErrors() []error { return m }
 
-// GetOrderRespValidationError is the validation error returned by
-// GetOrderResp.Validate if the designated constraints aren't met.
-type GetOrderRespValidationError struct {
+// CancelOrderRespValidationError is the validation error returned by
+// CancelOrderResp.Validate if the designated constraints aren't met.
+type CancelOrderRespValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1920,22 +1979,22 @@ type GetOrderRespValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetOrderRespValidationError) Field() string { return e.field }
+func (e CancelOrderRespValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetOrderRespValidationError) Reason() string { return e.reason }
+func (e CancelOrderRespValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetOrderRespValidationError) Cause() error { return e.cause }
+func (e CancelOrderRespValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetOrderRespValidationError) Key() bool { return e.key }
+func (e CancelOrderRespValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetOrderRespValidationError) ErrorName() string { return "GetOrderRespValidationError" }
+func (e CancelOrderRespValidationError) ErrorName() string { return "CancelOrderRespValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetOrderRespValidationError) Error() string {
+func (e CancelOrderRespValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1947,14 +2006,14 @@ func (e GetOrderRespValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetOrderResp.%s: %s%s",
+		"invalid %sCancelOrderResp.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetOrderRespValidationError{}
+var _ error = CancelOrderRespValidationError{}
 
 var _ interface {
 	Field() string
@@ -1962,34 +2021,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetOrderRespValidationError{}
+} = CancelOrderRespValidationError{}
 
-// Validate checks the field values on ListOrdersReq with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ListOrdersReq) Validate() error {
+// Validate checks the field values on ConfirmOrderReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ConfirmOrderReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListOrdersReq with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ListOrdersReqMultiError, or
-// nil if none found.
-func (m *ListOrdersReq) ValidateAll() error {
+// ValidateAll checks the field values on ConfirmOrderReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfirmOrderReqMultiError, or nil if none found.
+func (m *ConfirmOrderReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListOrdersReq) validate(all bool) error {
+func (m *ConfirmOrderReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if val := m.GetPageSize(); val < 1 || val > 100 {
-		err := ListOrdersReqValidationError{
-			field:  "PageSize",
-			reason: "value must be inside range [1, 100]",
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := ConfirmOrderReqValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -1997,78 +2056,20 @@ func (m *ListOrdersReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetCursor()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListOrdersReqValidationError{
-					field:  "Cursor",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ListOrdersReqValidationError{
-					field:  "Cursor",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCursor()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListOrdersReqValidationError{
-				field:  "Cursor",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetFilter()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListOrdersReqValidationError{
-					field:  "Filter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ListOrdersReqValidationError{
-					field:  "Filter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListOrdersReqValidationError{
-				field:  "Filter",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return ListOrdersReqMultiError(errors)
+		return ConfirmOrderReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListOrdersReqMultiError is an error wrapping multiple validation errors
-// returned by ListOrdersReq.ValidateAll() if the designated constraints
+// ConfirmOrderReqMultiError is an error wrapping multiple validation errors
+// returned by ConfirmOrderReq.ValidateAll() if the designated constraints
 // aren't met.
-type ListOrdersReqMultiError []error
+type ConfirmOrderReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListOrdersReqMultiError) Error() string {
+func (m ConfirmOrderReqMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2077,11 +2078,11 @@ func (m ListOrdersReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListOrdersReqMultiError) AllErrors() []error { return m }
+func (m ConfirmOrderReqMultiError) AllErrors() []error { return m }
 
-// ListOrdersReqValidationError is the validation error returned by
-// ListOrdersReq.Validate if the designated constraints aren't met.
-type ListOrdersReqValidationError struct {
+// ConfirmOrderReqValidationError is the validation error returned by
+// ConfirmOrderReq.Validate if the designated constraints aren't met.
+type ConfirmOrderReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2089,22 +2090,22 @@ type ListOrdersReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListOrdersReqValidationError) Field() string { return e.field }
+func (e ConfirmOrderReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListOrdersReqValidationError) Reason() string { return e.reason }
+func (e ConfirmOrderReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListOrdersReqValidationError) Cause() error { return e.cause }
+func (e ConfirmOrderReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListOrdersReqValidationError) Key() bool { return e.key }
+func (e ConfirmOrderReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListOrdersReqValidationError) ErrorName() string { return "ListOrdersReqValidationError" }
+func (e ConfirmOrderReqValidationError) ErrorName() string { return "ConfirmOrderReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListOrdersReqValidationError) Error() string {
+func (e ConfirmOrderReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2116,14 +2117,14 @@ func (e ListOrdersReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListOrdersReq.%s: %s%s",
+		"invalid %sConfirmOrderReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListOrdersReqValidationError{}
+var _ error = ConfirmOrderReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -2131,32 +2132,568 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListOrdersReqValidationError{}
+} = ConfirmOrderReqValidationError{}
 
-// Validate checks the field values on ListOrdersResp with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ListOrdersResp) Validate() error {
+// Validate checks the field values on ConfirmOrderResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ConfirmOrderResp) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListOrdersResp with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ListOrdersRespMultiError,
-// or nil if none found.
-func (m *ListOrdersResp) ValidateAll() error {
+// ValidateAll checks the field values on ConfirmOrderResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfirmOrderRespMultiError, or nil if none found.
+func (m *ConfirmOrderResp) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListOrdersResp) validate(all bool) error {
+func (m *ConfirmOrderResp) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetOrders() {
-		_, _ = idx, item
+	if all {
+		switch v := interface{}(m.GetOrder()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfirmOrderRespValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfirmOrderRespValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOrder()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfirmOrderRespValidationError{
+				field:  "Order",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ConfirmOrderRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfirmOrderRespMultiError is an error wrapping multiple validation errors
+// returned by ConfirmOrderResp.ValidateAll() if the designated constraints
+// aren't met.
+type ConfirmOrderRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfirmOrderRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfirmOrderRespMultiError) AllErrors() []error { return m }
+
+// ConfirmOrderRespValidationError is the validation error returned by
+// ConfirmOrderResp.Validate if the designated constraints aren't met.
+type ConfirmOrderRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfirmOrderRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfirmOrderRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfirmOrderRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfirmOrderRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfirmOrderRespValidationError) ErrorName() string { return "ConfirmOrderRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConfirmOrderRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfirmOrderResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfirmOrderRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfirmOrderRespValidationError{}
+
+// Validate checks the field values on GetOrderReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetOrderReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOrderReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetOrderReqMultiError, or
+// nil if none found.
+func (m *GetOrderReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOrderReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := GetOrderReqValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetOrderReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOrderReqMultiError is an error wrapping multiple validation errors
+// returned by GetOrderReq.ValidateAll() if the designated constraints aren't met.
+type GetOrderReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOrderReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOrderReqMultiError) AllErrors() []error { return m }
+
+// GetOrderReqValidationError is the validation error returned by
+// GetOrderReq.Validate if the designated constraints aren't met.
+type GetOrderReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOrderReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOrderReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOrderReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOrderReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOrderReqValidationError) ErrorName() string { return "GetOrderReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetOrderReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOrderReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOrderReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOrderReqValidationError{}
+
+// Validate checks the field values on GetOrderResp with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetOrderResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOrderResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetOrderRespMultiError, or
+// nil if none found.
+func (m *GetOrderResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOrderResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetOrder()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetOrderRespValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetOrderRespValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOrder()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetOrderRespValidationError{
+				field:  "Order",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetOrderRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOrderRespMultiError is an error wrapping multiple validation errors
+// returned by GetOrderResp.ValidateAll() if the designated constraints aren't met.
+type GetOrderRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOrderRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOrderRespMultiError) AllErrors() []error { return m }
+
+// GetOrderRespValidationError is the validation error returned by
+// GetOrderResp.Validate if the designated constraints aren't met.
+type GetOrderRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOrderRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOrderRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOrderRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOrderRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOrderRespValidationError) ErrorName() string { return "GetOrderRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetOrderRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOrderResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOrderRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOrderRespValidationError{}
+
+// Validate checks the field values on ListOrdersReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListOrdersReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOrdersReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListOrdersReqMultiError, or
+// nil if none found.
+func (m *ListOrdersReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOrdersReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetPageSize(); val < 1 || val > 100 {
+		err := ListOrdersReqValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCursor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListOrdersReqValidationError{
+					field:  "Cursor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListOrdersReqValidationError{
+					field:  "Cursor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCursor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListOrdersReqValidationError{
+				field:  "Cursor",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListOrdersReqValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListOrdersReqValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListOrdersReqValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListOrdersReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListOrdersReqMultiError is an error wrapping multiple validation errors
+// returned by ListOrdersReq.ValidateAll() if the designated constraints
+// aren't met.
+type ListOrdersReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOrdersReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOrdersReqMultiError) AllErrors() []error { return m }
+
+// ListOrdersReqValidationError is the validation error returned by
+// ListOrdersReq.Validate if the designated constraints aren't met.
+type ListOrdersReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOrdersReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOrdersReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOrdersReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOrdersReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOrdersReqValidationError) ErrorName() string { return "ListOrdersReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListOrdersReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOrdersReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOrdersReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOrdersReqValidationError{}
+
+// Validate checks the field values on ListOrdersResp with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListOrdersResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOrdersResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListOrdersRespMultiError,
+// or nil if none found.
+func (m *ListOrdersResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOrdersResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOrders() {
+		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
@@ -2299,3 +2836,282 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ListOrdersRespValidationError{}
+
+// Validate checks the field values on StreamOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StreamOrdersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StreamOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StreamOrdersRequestMultiError, or nil if none found.
+func (m *StreamOrdersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StreamOrdersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetSheetId()) < 1 {
+		err := StreamOrdersRequestValidationError{
+			field:  "SheetId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ResumeCursor
+
+	if len(errors) > 0 {
+		return StreamOrdersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StreamOrdersRequestMultiError is an error wrapping multiple validation
+// errors returned by StreamOrdersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type StreamOrdersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StreamOrdersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StreamOrdersRequestMultiError) AllErrors() []error { return m }
+
+// StreamOrdersRequestValidationError is the validation error returned by
+// StreamOrdersRequest.Validate if the designated constraints aren't met.
+type StreamOrdersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamOrdersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamOrdersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamOrdersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamOrdersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamOrdersRequestValidationError) ErrorName() string {
+	return "StreamOrdersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StreamOrdersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamOrdersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamOrdersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamOrdersRequestValidationError{}
+
+// Validate checks the field values on StreamOrdersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StreamOrdersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StreamOrdersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StreamOrdersResponseMultiError, or nil if none found.
+func (m *StreamOrdersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StreamOrdersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	if all {
+		switch v := interface{}(m.GetOrder()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StreamOrdersResponseValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StreamOrdersResponseValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOrder()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StreamOrdersResponseValidationError{
+				field:  "Order",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Cursor
+
+	if all {
+		switch v := interface{}(m.GetOccurredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StreamOrdersResponseValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StreamOrdersResponseValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOccurredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StreamOrdersResponseValidationError{
+				field:  "OccurredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StreamOrdersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StreamOrdersResponseMultiError is an error wrapping multiple validation
+// errors returned by StreamOrdersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type StreamOrdersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StreamOrdersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StreamOrdersResponseMultiError) AllErrors() []error { return m }
+
+// StreamOrdersResponseValidationError is the validation error returned by
+// StreamOrdersResponse.Validate if the designated constraints aren't met.
+type StreamOrdersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamOrdersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamOrdersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamOrdersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamOrdersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamOrdersResponseValidationError) ErrorName() string {
+	return "StreamOrdersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StreamOrdersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamOrdersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamOrdersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamOrdersResponseValidationError{}
@@ -19,10 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	OrdersService_CreateOrder_FullMethodName = "/core.v1.OrdersService/CreateOrder"
-	OrdersService_UpdateOrder_FullMethodName = "/core.v1.OrdersService/UpdateOrder"
-	OrdersService_GetOrder_FullMethodName    = "/core.v1.OrdersService/GetOrder"
-	OrdersService_ListOrders_FullMethodName  = "/core.v1.OrdersService/ListOrders"
+	OrdersService_CreateOrder_FullMethodName  = "/core.v1.OrdersService/CreateOrder"
+	OrdersService_UpdateOrder_FullMethodName  = "/core.v1.OrdersService/UpdateOrder"
+	OrdersService_GetOrder_FullMethodName     = "/core.v1.OrdersService/GetOrder"
+	OrdersService_ListOrders_FullMethodName   = "/core.v1.OrdersService/ListOrders"
+	OrdersService_CancelOrder_FullMethodName  = "/core.v1.OrdersService/CancelOrder"
+	OrdersService_ConfirmOrder_FullMethodName = "/core.v1.OrdersService/ConfirmOrder"
+	OrdersService_StreamOrders_FullMethodName = "/core.v1.OrdersService/StreamOrders"
 )
 
 // OrdersServiceClient is the client API for OrdersService service.
@@ -33,6 +36,10 @@ type OrdersServiceClient interface {
 	UpdateOrder(ctx context.Context, in *UpdateOrderReq, opts ...grpc.CallOption) (*UpdateOrderResp, error)
 	GetOrder(ctx context.Context, in *GetOrderReq, opts ...grpc.CallOption) (*GetOrderResp, error)
 	ListOrders(ctx context.Context, in *ListOrdersReq, opts ...grpc.CallOption) (*ListOrdersResp, error)
+	CancelOrder(ctx context.Context, in *CancelOrderReq, opts ...grpc.CallOption) (*CancelOrderResp, error)
+	ConfirmOrder(ctx context.Context, in *ConfirmOrderReq, opts ...grpc.CallOption) (*ConfirmOrderResp, error)
+	// Realtime stream for a sheet's orders.
+	StreamOrders(ctx context.Context, in *StreamOrdersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamOrdersResponse], error)
 }
 
 type ordersServiceClient struct {
@@ -83,6 +90,45 @@ func (c *ordersServiceClient) ListOrders(ctx context.Context, in *ListOrdersReq,
 	return out, nil
 }
 
+func (c *ordersServiceClient) CancelOrder(ctx context.Context, in *CancelOrderReq, opts ...grpc.CallOption) (*CancelOrderResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelOrderResp)
+	err := c.cc.Invoke(ctx, OrdersService_CancelOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ConfirmOrder(ctx context.Context, in *ConfirmOrderReq, opts ...grpc.CallOption) (*ConfirmOrderResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmOrderResp)
+	err := c.cc.Invoke(ctx, OrdersService_ConfirmOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) StreamOrders(ctx context.Context, in *StreamOrdersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamOrdersResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OrdersService_ServiceDesc.Streams[0], OrdersService_StreamOrders_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamOrdersRequest, StreamOrdersResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrdersService_StreamOrdersClient = grpc.ServerStreamingClient[StreamOrdersResponse]
+
 // OrdersServiceServer is the server API for OrdersService service.
 // All implementations must embed UnimplementedOrdersServiceServer
 // for forward compatibility.
@@ -91,6 +137,10 @@ type OrdersServiceServer interface {
 	UpdateOrder(context.Context, *UpdateOrderReq) (*UpdateOrderResp, error)
 	GetOrder(context.Context, *GetOrderReq) (*GetOrderResp, error)
 	ListOrders(context.Context, *ListOrdersReq) (*ListOrdersResp, error)
+	CancelOrder(context.Context, *CancelOrderReq) (*CancelOrderResp, error)
+	ConfirmOrder(context.Context, *ConfirmOrderReq) (*ConfirmOrderResp, error)
+	// Realtime stream for a sheet's orders.
+	StreamOrders(*StreamOrdersRequest, grpc.ServerStreamingServer[StreamOrdersResponse]) error
 	mustEmbedUnimplementedOrdersServiceServer()
 }
 
@@ -113,6 +163,15 @@ func (UnimplementedOrdersServiceServer) GetOrder(context.Context, *GetOrderReq)
 func (UnimplementedOrdersServiceServer) ListOrders(context.Context, *ListOrdersReq) (*ListOrdersResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListOrders not implemented")
 }
+func (UnimplementedOrdersServiceServer) CancelOrder(context.Context, *CancelOrderReq) (*CancelOrderResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (UnimplementedOrdersServiceServer) ConfirmOrder(context.Context, *ConfirmOrderReq) (*ConfirmOrderResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmOrder not implemented")
+}
+func (UnimplementedOrdersServiceServer) StreamOrders(*StreamOrdersRequest, grpc.ServerStreamingServer[StreamOrdersResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamOrders not implemented")
+}
 func (UnimplementedOrdersServiceServer) mustEmbedUnimplementedOrdersServiceServer() {}
 func (UnimplementedOrdersServiceServer) testEmbeddedByValue()                       {}
 
@@ -206,6 +265,53 @@ func _OrdersService_ListOrders_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrdersService_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_CancelOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).CancelOrder(ctx, req.(*CancelOrderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_ConfirmOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmOrderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ConfirmOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_ConfirmOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ConfirmOrder(ctx, req.(*ConfirmOrderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_StreamOrders_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamOrdersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrdersServiceServer).StreamOrders(m, &grpc.GenericServerStream[StreamOrdersRequest, StreamOrdersResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrdersService_StreamOrdersServer = grpc.ServerStreamingServer[StreamOrdersResponse]
+
 // OrdersService_ServiceDesc is the grpc.ServiceDesc for OrdersService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,7 +335,21 @@ var OrdersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListOrders",
 			Handler:    _OrdersService_ListOrders_Handler,
 		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _OrdersService_CancelOrder_Handler,
+		},
+		{
+			MethodName: "ConfirmOrder",
+			Handler:    _OrdersService_ConfirmOrder_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamOrders",
+			Handler:       _OrdersService_StreamOrders_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "orders.proto",
 }
@@ -0,0 +1,722 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.30.2
+// source: payments.proto
+
+package corev1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PaymentStatus int32
+
+const (
+	PaymentStatus_PAYMENT_STATUS_UNSPECIFIED PaymentStatus = 0
+	PaymentStatus_PAYMENT_STATUS_UNPAID      PaymentStatus = 1
+	PaymentStatus_PAYMENT_STATUS_PARTIAL     PaymentStatus = 2
+	PaymentStatus_PAYMENT_STATUS_PAID        PaymentStatus = 3
+	PaymentStatus_PAYMENT_STATUS_WAIVED      PaymentStatus = 4 // host forgave the balance
+)
+
+// Enum value maps for PaymentStatus.
+var (
+	PaymentStatus_name = map[int32]string{
+		0: "PAYMENT_STATUS_UNSPECIFIED",
+		1: "PAYMENT_STATUS_UNPAID",
+		2: "PAYMENT_STATUS_PARTIAL",
+		3: "PAYMENT_STATUS_PAID",
+		4: "PAYMENT_STATUS_WAIVED",
+	}
+	PaymentStatus_value = map[string]int32{
+		"PAYMENT_STATUS_UNSPECIFIED": 0,
+		"PAYMENT_STATUS_UNPAID":      1,
+		"PAYMENT_STATUS_PARTIAL":     2,
+		"PAYMENT_STATUS_PAID":        3,
+		"PAYMENT_STATUS_WAIVED":      4,
+	}
+)
+
+func (x PaymentStatus) Enum() *PaymentStatus {
+	p := new(PaymentStatus)
+	*p = x
+	return p
+}
+
+func (x PaymentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PaymentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_payments_proto_enumTypes[0].Descriptor()
+}
+
+func (PaymentStatus) Type() protoreflect.EnumType {
+	return &file_payments_proto_enumTypes[0]
+}
+
+func (x PaymentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PaymentStatus.Descriptor instead.
+func (PaymentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_payments_proto_rawDescGZIP(), []int{0}
+}
+
+// What a member has paid the host for one sheet. Each status change is a
+// new record; the highest sequence is the member's current payment.
+type Payment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SheetId       string                 `protobuf:"bytes,1,opt,name=sheet_id,json=sheetId,proto3" json:"sheet_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        PaymentStatus          `protobuf:"varint,3,opt,name=status,proto3,enum=core.v1.PaymentStatus" json:"status,omitempty"`
+	AmountPaid    *Money                 `protobuf:"bytes,4,opt,name=amount_paid,json=amountPaid,proto3" json:"amount_paid,omitempty"`
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	Id            string                 `protobuf:"bytes,6,opt,name=id,proto3" json:"id,omitempty"`
+	Sequence      int64                  `protobuf:"varint,7,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Payment) Reset() {
+	*x = Payment{}
+	mi := &file_payments_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Payment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payment) ProtoMessage() {}
+
+func (x *Payment) ProtoReflect() protoreflect.Message {
+	mi := &file_payments_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
+func (*Payment) Descriptor() ([]byte, []int) {
+	return file_payments_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Payment) GetSheetId() string {
+	if x != nil {
+		return x.SheetId
+	}
+	return ""
+}
+
+func (x *Payment) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Payment) GetStatus() PaymentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+}
+
+func (x *Payment) GetAmountPaid() *Money {
+	if x != nil {
+		return x.AmountPaid
+	}
+	return nil
+}
+
+func (x *Payment) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *Payment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Payment) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *Payment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Payment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// A member's settlement total on a sheet against what they paid
+type Balance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SheetId       string                 `protobuf:"bytes,1,opt,name=sheet_id,json=sheetId,proto3" json:"sheet_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        PaymentStatus          `protobuf:"varint,3,opt,name=status,proto3,enum=core.v1.PaymentStatus" json:"status,omitempty"`
+	Owed          *Money                 `protobuf:"bytes,4,opt,name=owed,proto3" json:"owed,omitempty"`
+	Paid          *Money                 `protobuf:"bytes,5,opt,name=paid,proto3" json:"paid,omitempty"`
+	Outstanding   *Money                 `protobuf:"bytes,6,opt,name=outstanding,proto3" json:"outstanding,omitempty"` // zero once paid or waived
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Balance) Reset() {
+	*x = Balance{}
+	mi := &file_payments_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Balance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Balance) ProtoMessage() {}
+
+func (x *Balance) ProtoReflect() protoreflect.Message {
+	mi := &file_payments_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Balance.ProtoReflect.Descriptor instead.
+func (*Balance) Descriptor() ([]byte, []int) {
+	return file_payments_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Balance) GetSheetId() string {
+	if x != nil {
+		return x.SheetId
+	}
+	return ""
+}
+
+func (x *Balance) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Balance) GetStatus() PaymentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+}
+
+func (x *Balance) GetOwed() *Money {
+	if x != nil {
+		return x.Owed
+	}
+	return nil
+}
+
+func (x *Balance) GetPaid() *Money {
+	if x != nil {
+		return x.Paid
+	}
+	return nil
+}
+
+func (x *Balance) GetOutstanding() *Money {
+	if x != nil {
+		return x.Outstanding
+	}
+	return nil
+}
+
+type SetPaymentStatusReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IdempotencyKey string                 `protobuf:"bytes,1,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	SheetId        string                 `protobuf:"bytes,2,opt,name=sheet_id,json=sheetId,proto3" json:"sheet_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status         PaymentStatus          `protobuf:"varint,4,opt,name=status,proto3,enum=core.v1.PaymentStatus" json:"status,omitempty"`
+	AmountPaid     *Money                 `protobuf:"bytes,5,opt,name=amount_paid,json=amountPaid,proto3" json:"amount_paid,omitempty"` // required for PARTIAL, ignored otherwise
+	Note           string                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetPaymentStatusReq) Reset() {
+	*x = SetPaymentStatusReq{}
+	mi := &file_payments_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPaymentStatusReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPaymentStatusReq) ProtoMessage() {}
+
+func (x *SetPaymentStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payments_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPaymentStatusReq.ProtoReflect.Descriptor instead.
+func (*SetPaymentStatusReq) Descriptor() ([]byte, []int) {
+	return file_payments_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetPaymentStatusReq) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *SetPaymentStatusReq) GetSheetId() string {
+	if x != nil {
+		return x.SheetId
+	}
+	return ""
+}
+
+func (x *SetPaymentStatusReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetPaymentStatusReq) GetStatus() PaymentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+}
+
+func (x *SetPaymentStatusReq) GetAmountPaid() *Money {
+	if x != nil {
+		return x.AmountPaid
+	}
+	return nil
+}
+
+func (x *SetPaymentStatusReq) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type SetPaymentStatusResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payment       *Payment               `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPaymentStatusResp) Reset() {
+	*x = SetPaymentStatusResp{}
+	mi := &file_payments_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPaymentStatusResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPaymentStatusResp) ProtoMessage() {}
+
+func (x *SetPaymentStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payments_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPaymentStatusResp.ProtoReflect.Descriptor instead.
+func (*SetPaymentStatusResp) Descriptor() ([]byte, []int) {
+	return file_payments_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SetPaymentStatusResp) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+type ListSheetBalancesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SheetId       string                 `protobuf:"bytes,1,opt,name=sheet_id,json=sheetId,proto3" json:"sheet_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSheetBalancesReq) Reset() {
+	*x = ListSheetBalancesReq{}
+	mi := &file_payments_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSheetBalancesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSheetBalancesReq) ProtoMessage() {}
+
+func (x *ListSheetBalancesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payments_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSheetBalancesReq.ProtoReflect.Descriptor instead.
+func (*ListSheetBalancesReq) Descriptor() ([]byte, []int) {
+	return file_payments_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListSheetBalancesReq) GetSheetId() string {
+	if x != nil {
+		return x.SheetId
+	}
+	return ""
+}
+
+type ListSheetBalancesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Balances      []*Balance             `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSheetBalancesResp) Reset() {
+	*x = ListSheetBalancesResp{}
+	mi := &file_payments_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSheetBalancesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSheetBalancesResp) ProtoMessage() {}
+
+func (x *ListSheetBalancesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payments_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSheetBalancesResp.ProtoReflect.Descriptor instead.
+func (*ListSheetBalancesResp) Descriptor() ([]byte, []int) {
+	return file_payments_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListSheetBalancesResp) GetBalances() []*Balance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+type ListUserDebtsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserDebtsReq) Reset() {
+	*x = ListUserDebtsReq{}
+	mi := &file_payments_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserDebtsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserDebtsReq) ProtoMessage() {}
+
+func (x *ListUserDebtsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_payments_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserDebtsReq.ProtoReflect.Descriptor instead.
+func (*ListUserDebtsReq) Descriptor() ([]byte, []int) {
+	return file_payments_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListUserDebtsReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListUserDebtsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Balances      []*Balance             `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"`
+	Totals        []*Money               `protobuf:"bytes,2,rep,name=totals,proto3" json:"totals,omitempty"` // outstanding sum per currency
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserDebtsResp) Reset() {
+	*x = ListUserDebtsResp{}
+	mi := &file_payments_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserDebtsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserDebtsResp) ProtoMessage() {}
+
+func (x *ListUserDebtsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_payments_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserDebtsResp.ProtoReflect.Descriptor instead.
+func (*ListUserDebtsResp) Descriptor() ([]byte, []int) {
+	return file_payments_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListUserDebtsResp) GetBalances() []*Balance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+func (x *ListUserDebtsResp) GetTotals() []*Money {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+var File_payments_proto protoreflect.FileDescriptor
+
+const file_payments_proto_rawDesc = "" +
+	"\n" +
+	"\x0epayments.proto\x12\acore.v1\x1a\fcommon.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\xd4\x02\n" +
+	"\aPayment\x12\x19\n" +
+	"\bsheet_id\x18\x01 \x01(\tR\asheetId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12.\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x16.core.v1.PaymentStatusR\x06status\x12/\n" +
+	"\vamount_paid\x18\x04 \x01(\v2\x0e.core.v1.MoneyR\n" +
+	"amountPaid\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\x12\x0e\n" +
+	"\x02id\x18\x06 \x01(\tR\x02id\x12\x1a\n" +
+	"\bsequence\x18\a \x01(\x03R\bsequence\x129\n" +
+	"\n" +
+	"created_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xe7\x01\n" +
+	"\aBalance\x12\x19\n" +
+	"\bsheet_id\x18\x01 \x01(\tR\asheetId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12.\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x16.core.v1.PaymentStatusR\x06status\x12\"\n" +
+	"\x04owed\x18\x04 \x01(\v2\x0e.core.v1.MoneyR\x04owed\x12\"\n" +
+	"\x04paid\x18\x05 \x01(\v2\x0e.core.v1.MoneyR\x04paid\x120\n" +
+	"\voutstanding\x18\x06 \x01(\v2\x0e.core.v1.MoneyR\voutstanding\"\x96\x02\n" +
+	"\x13SetPaymentStatusReq\x120\n" +
+	"\x0fidempotency_key\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x0eidempotencyKey\x12\"\n" +
+	"\bsheet_id\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\asheetId\x12 \n" +
+	"\auser_id\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06userId\x128\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x16.core.v1.PaymentStatusB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06status\x12/\n" +
+	"\vamount_paid\x18\x05 \x01(\v2\x0e.core.v1.MoneyR\n" +
+	"amountPaid\x12\x1c\n" +
+	"\x04note\x18\x06 \x01(\tB\b\xfaB\x05r\x03\x18\xf4\x03R\x04note\"B\n" +
+	"\x14SetPaymentStatusResp\x12*\n" +
+	"\apayment\x18\x01 \x01(\v2\x10.core.v1.PaymentR\apayment\":\n" +
+	"\x14ListSheetBalancesReq\x12\"\n" +
+	"\bsheet_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\asheetId\"E\n" +
+	"\x15ListSheetBalancesResp\x12,\n" +
+	"\bbalances\x18\x01 \x03(\v2\x10.core.v1.BalanceR\bbalances\"4\n" +
+	"\x10ListUserDebtsReq\x12 \n" +
+	"\auser_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06userId\"i\n" +
+	"\x11ListUserDebtsResp\x12,\n" +
+	"\bbalances\x18\x01 \x03(\v2\x10.core.v1.BalanceR\bbalances\x12&\n" +
+	"\x06totals\x18\x02 \x03(\v2\x0e.core.v1.MoneyR\x06totals*\x9a\x01\n" +
+	"\rPaymentStatus\x12\x1e\n" +
+	"\x1aPAYMENT_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15PAYMENT_STATUS_UNPAID\x10\x01\x12\x1a\n" +
+	"\x16PAYMENT_STATUS_PARTIAL\x10\x02\x12\x17\n" +
+	"\x13PAYMENT_STATUS_PAID\x10\x03\x12\x19\n" +
+	"\x15PAYMENT_STATUS_WAIVED\x10\x042\xfe\x01\n" +
+	"\x0fPaymentsService\x12O\n" +
+	"\x10SetPaymentStatus\x12\x1c.core.v1.SetPaymentStatusReq\x1a\x1d.core.v1.SetPaymentStatusResp\x12R\n" +
+	"\x11ListSheetBalances\x12\x1d.core.v1.ListSheetBalancesReq\x1a\x1e.core.v1.ListSheetBalancesResp\x12F\n" +
+	"\rListUserDebts\x12\x19.core.v1.ListUserDebtsReq\x1a\x1a.core.v1.ListUserDebtsRespB;Z9github.com/deni12345/dae-services/proto/gen/corev1;corev1b\x06proto3"
+
+var (
+	file_payments_proto_rawDescOnce sync.Once
+	file_payments_proto_rawDescData []byte
+)
+
+func file_payments_proto_rawDescGZIP() []byte {
+	file_payments_proto_rawDescOnce.Do(func() {
+		file_payments_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_payments_proto_rawDesc), len(file_payments_proto_rawDesc)))
+	})
+	return file_payments_proto_rawDescData
+}
+
+var file_payments_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_payments_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_payments_proto_goTypes = []any{
+	(PaymentStatus)(0),            // 0: core.v1.PaymentStatus
+	(*Payment)(nil),               // 1: core.v1.Payment
+	(*Balance)(nil),               // 2: core.v1.Balance
+	(*SetPaymentStatusReq)(nil),   // 3: core.v1.SetPaymentStatusReq
+	(*SetPaymentStatusResp)(nil),  // 4: core.v1.SetPaymentStatusResp
+	(*ListSheetBalancesReq)(nil),  // 5: core.v1.ListSheetBalancesReq
+	(*ListSheetBalancesResp)(nil), // 6: core.v1.ListSheetBalancesResp
+	(*ListUserDebtsReq)(nil),      // 7: core.v1.ListUserDebtsReq
+	(*ListUserDebtsResp)(nil),     // 8: core.v1.ListUserDebtsResp
+	(*Money)(nil),                 // 9: core.v1.Money
+	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
+}
+var file_payments_proto_depIdxs = []int32{
+	0,  // 0: core.v1.Payment.status:type_name -> core.v1.PaymentStatus
+	9,  // 1: core.v1.Payment.amount_paid:type_name -> core.v1.Money
+	10, // 2: core.v1.Payment.created_at:type_name -> google.protobuf.Timestamp
+	10, // 3: core.v1.Payment.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: core.v1.Balance.status:type_name -> core.v1.PaymentStatus
+	9,  // 5: core.v1.Balance.owed:type_name -> core.v1.Money
+	9,  // 6: core.v1.Balance.paid:type_name -> core.v1.Money
+	9,  // 7: core.v1.Balance.outstanding:type_name -> core.v1.Money
+	0,  // 8: core.v1.SetPaymentStatusReq.status:type_name -> core.v1.PaymentStatus
+	9,  // 9: core.v1.SetPaymentStatusReq.amount_paid:type_name -> core.v1.Money
+	1,  // 10: core.v1.SetPaymentStatusResp.payment:type_name -> core.v1.Payment
+	2,  // 11: core.v1.ListSheetBalancesResp.balances:type_name -> core.v1.Balance
+	2,  // 12: core.v1.ListUserDebtsResp.balances:type_name -> core.v1.Balance
+	9,  // 13: core.v1.ListUserDebtsResp.totals:type_name -> core.v1.Money
+	3,  // 14: core.v1.PaymentsService.SetPaymentStatus:input_type -> core.v1.SetPaymentStatusReq
+	5,  // 15: core.v1.PaymentsService.ListSheetBalances:input_type -> core.v1.ListSheetBalancesReq
+	7,  // 16: core.v1.PaymentsService.ListUserDebts:input_type -> core.v1.ListUserDebtsReq
+	4,  // 17: core.v1.PaymentsService.SetPaymentStatus:output_type -> core.v1.SetPaymentStatusResp
+	6,  // 18: core.v1.PaymentsService.ListSheetBalances:output_type -> core.v1.ListSheetBalancesResp
+	8,  // 19: core.v1.PaymentsService.ListUserDebts:output_type -> core.v1.ListUserDebtsResp
+	17, // [17:20] is the sub-list for method output_type
+	14, // [14:17] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_payments_proto_init() }
+func file_payments_proto_init() {
+	if File_payments_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_payments_proto_rawDesc), len(file_payments_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_payments_proto_goTypes,
+		DependencyIndexes: file_payments_proto_depIdxs,
+		EnumInfos:         file_payments_proto_enumTypes,
+		MessageInfos:      file_payments_proto_msgTypes,
+	}.Build()
+	File_payments_proto = out.File
+	file_payments_proto_goTypes = nil
+	file_payments_proto_depIdxs = nil
+}
@@ -0,0 +1,1271 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: payments.proto
+
+package corev1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Payment with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Payment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Payment with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in PaymentMultiError, or nil if none found.
+func (m *Payment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Payment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SheetId
+
+	// no validation rules for UserId
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetAmountPaid()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PaymentValidationError{
+					field:  "AmountPaid",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PaymentValidationError{
+					field:  "AmountPaid",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAmountPaid()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PaymentValidationError{
+				field:  "AmountPaid",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Note
+
+	// no validation rules for Id
+
+	// no validation rules for Sequence
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PaymentValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PaymentValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PaymentValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PaymentValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PaymentValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PaymentValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PaymentMultiError(errors)
+	}
+
+	return nil
+}
+
+// PaymentMultiError is an error wrapping multiple validation errors returned
+// by Payment.ValidateAll() if the designated constraints aren't met.
+type PaymentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PaymentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PaymentMultiError) AllErrors() []error { return m }
+
+// PaymentValidationError is the validation error returned by Payment.Validate
+// if the designated constraints aren't met.
+type PaymentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PaymentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PaymentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PaymentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PaymentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PaymentValidationError) ErrorName() string { return "PaymentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PaymentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPayment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PaymentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PaymentValidationError{}
+
+// Validate checks the field values on Balance with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Balance) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Balance with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in BalanceMultiError, or nil if none found.
+func (m *Balance) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Balance) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SheetId
+
+	// no validation rules for UserId
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetOwed()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BalanceValidationError{
+					field:  "Owed",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BalanceValidationError{
+					field:  "Owed",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOwed()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BalanceValidationError{
+				field:  "Owed",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPaid()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BalanceValidationError{
+					field:  "Paid",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BalanceValidationError{
+					field:  "Paid",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPaid()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BalanceValidationError{
+				field:  "Paid",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetOutstanding()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BalanceValidationError{
+					field:  "Outstanding",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BalanceValidationError{
+					field:  "Outstanding",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOutstanding()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BalanceValidationError{
+				field:  "Outstanding",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BalanceMultiError(errors)
+	}
+
+	return nil
+}
+
+// BalanceMultiError is an error wrapping multiple validation errors returned
+// by Balance.ValidateAll() if the designated constraints aren't met.
+type BalanceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BalanceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BalanceMultiError) AllErrors() []error { return m }
+
+// BalanceValidationError is the validation error returned by Balance.Validate
+// if the designated constraints aren't met.
+type BalanceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BalanceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BalanceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BalanceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BalanceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BalanceValidationError) ErrorName() string { return "BalanceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BalanceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBalance.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BalanceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BalanceValidationError{}
+
+// Validate checks the field values on SetPaymentStatusReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetPaymentStatusReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetPaymentStatusReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetPaymentStatusReqMultiError, or nil if none found.
+func (m *SetPaymentStatusReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetPaymentStatusReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetIdempotencyKey()) < 1 {
+		err := SetPaymentStatusReqValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSheetId()) < 1 {
+		err := SetPaymentStatusReqValidationError{
+			field:  "SheetId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUserId()) < 1 {
+		err := SetPaymentStatusReqValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := PaymentStatus_name[int32(m.GetStatus())]; !ok {
+		err := SetPaymentStatusReqValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAmountPaid()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetPaymentStatusReqValidationError{
+					field:  "AmountPaid",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetPaymentStatusReqValidationError{
+					field:  "AmountPaid",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAmountPaid()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetPaymentStatusReqValidationError{
+				field:  "AmountPaid",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetNote()) > 500 {
+		err := SetPaymentStatusReqValidationError{
+			field:  "Note",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetPaymentStatusReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetPaymentStatusReqMultiError is an error wrapping multiple validation
+// errors returned by SetPaymentStatusReq.ValidateAll() if the designated
+// constraints aren't met.
+type SetPaymentStatusReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetPaymentStatusReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetPaymentStatusReqMultiError) AllErrors() []error { return m }
+
+// SetPaymentStatusReqValidationError is the validation error returned by
+// SetPaymentStatusReq.Validate if the designated constraints aren't met.
+type SetPaymentStatusReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetPaymentStatusReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetPaymentStatusReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetPaymentStatusReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetPaymentStatusReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetPaymentStatusReqValidationError) ErrorName() string {
+	return "SetPaymentStatusReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetPaymentStatusReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetPaymentStatusReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetPaymentStatusReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetPaymentStatusReqValidationError{}
+
+// Validate checks the field values on SetPaymentStatusResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetPaymentStatusResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetPaymentStatusResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetPaymentStatusRespMultiError, or nil if none found.
+func (m *SetPaymentStatusResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetPaymentStatusResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPayment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetPaymentStatusRespValidationError{
+					field:  "Payment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetPaymentStatusRespValidationError{
+					field:  "Payment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPayment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetPaymentStatusRespValidationError{
+				field:  "Payment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetPaymentStatusRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetPaymentStatusRespMultiError is an error wrapping multiple validation
+// errors returned by SetPaymentStatusResp.ValidateAll() if the designated
+// constraints aren't met.
+type SetPaymentStatusRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetPaymentStatusRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetPaymentStatusRespMultiError) AllErrors() []error { return m }
+
+// SetPaymentStatusRespValidationError is the validation error returned by
+// SetPaymentStatusResp.Validate if the designated constraints aren't met.
+type SetPaymentStatusRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetPaymentStatusRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetPaymentStatusRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetPaymentStatusRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetPaymentStatusRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetPaymentStatusRespValidationError) ErrorName() string {
+	return "SetPaymentStatusRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetPaymentStatusRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetPaymentStatusResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetPaymentStatusRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetPaymentStatusRespValidationError{}
+
+// Validate checks the field values on ListSheetBalancesReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSheetBalancesReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSheetBalancesReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSheetBalancesReqMultiError, or nil if none found.
+func (m *ListSheetBalancesReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSheetBalancesReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetSheetId()) < 1 {
+		err := ListSheetBalancesReqValidationError{
+			field:  "SheetId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListSheetBalancesReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSheetBalancesReqMultiError is an error wrapping multiple validation
+// errors returned by ListSheetBalancesReq.ValidateAll() if the designated
+// constraints aren't met.
+type ListSheetBalancesReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSheetBalancesReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSheetBalancesReqMultiError) AllErrors() []error { return m }
+
+// ListSheetBalancesReqValidationError is the validation error returned by
+// ListSheetBalancesReq.Validate if the designated constraints aren't met.
+type ListSheetBalancesReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSheetBalancesReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSheetBalancesReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSheetBalancesReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSheetBalancesReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSheetBalancesReqValidationError) ErrorName() string {
+	return "ListSheetBalancesReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSheetBalancesReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSheetBalancesReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSheetBalancesReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSheetBalancesReqValidationError{}
+
+// Validate checks the field values on ListSheetBalancesResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSheetBalancesResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSheetBalancesResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSheetBalancesRespMultiError, or nil if none found.
+func (m *ListSheetBalancesResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSheetBalancesResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetBalances() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSheetBalancesRespValidationError{
+						field:  fmt.Sprintf("Balances[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSheetBalancesRespValidationError{
+						field:  fmt.Sprintf("Balances[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSheetBalancesRespValidationError{
+					field:  fmt.Sprintf("Balances[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListSheetBalancesRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSheetBalancesRespMultiError is an error wrapping multiple validation
+// errors returned by ListSheetBalancesResp.ValidateAll() if the designated
+// constraints aren't met.
+type ListSheetBalancesRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSheetBalancesRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSheetBalancesRespMultiError) AllErrors() []error { return m }
+
+// ListSheetBalancesRespValidationError is the validation error returned by
+// ListSheetBalancesResp.Validate if the designated constraints aren't met.
+type ListSheetBalancesRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSheetBalancesRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSheetBalancesRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSheetBalancesRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSheetBalancesRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSheetBalancesRespValidationError) ErrorName() string {
+	return "ListSheetBalancesRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSheetBalancesRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSheetBalancesResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSheetBalancesRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSheetBalancesRespValidationError{}
+
+// Validate checks the field values on ListUserDebtsReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListUserDebtsReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUserDebtsReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUserDebtsReqMultiError, or nil if none found.
+func (m *ListUserDebtsReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUserDebtsReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUserId()) < 1 {
+		err := ListUserDebtsReqValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListUserDebtsReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUserDebtsReqMultiError is an error wrapping multiple validation errors
+// returned by ListUserDebtsReq.ValidateAll() if the designated constraints
+// aren't met.
+type ListUserDebtsReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUserDebtsReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUserDebtsReqMultiError) AllErrors() []error { return m }
+
+// ListUserDebtsReqValidationError is the validation error returned by
+// ListUserDebtsReq.Validate if the designated constraints aren't met.
+type ListUserDebtsReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUserDebtsReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUserDebtsReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUserDebtsReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUserDebtsReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUserDebtsReqValidationError) ErrorName() string { return "ListUserDebtsReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListUserDebtsReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUserDebtsReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUserDebtsReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUserDebtsReqValidationError{}
+
+// Validate checks the field values on ListUserDebtsResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListUserDebtsResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUserDebtsResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUserDebtsRespMultiError, or nil if none found.
+func (m *ListUserDebtsResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUserDebtsResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetBalances() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListUserDebtsRespValidationError{
+						field:  fmt.Sprintf("Balances[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListUserDebtsRespValidationError{
+						field:  fmt.Sprintf("Balances[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListUserDebtsRespValidationError{
+					field:  fmt.Sprintf("Balances[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetTotals() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListUserDebtsRespValidationError{
+						field:  fmt.Sprintf("Totals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListUserDebtsRespValidationError{
+						field:  fmt.Sprintf("Totals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListUserDebtsRespValidationError{
+					field:  fmt.Sprintf("Totals[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListUserDebtsRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUserDebtsRespMultiError is an error wrapping multiple validation errors
+// returned by ListUserDebtsResp.ValidateAll() if the designated constraints
+// aren't met.
+type ListUserDebtsRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUserDebtsRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUserDebtsRespMultiError) AllErrors() []error { return m }
+
+// ListUserDebtsRespValidationError is the validation error returned by
+// ListUserDebtsResp.Validate if the designated constraints aren't met.
+type ListUserDebtsRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUserDebtsRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUserDebtsRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUserDebtsRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUserDebtsRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUserDebtsRespValidationError) ErrorName() string {
+	return "ListUserDebtsRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUserDebtsRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUserDebtsResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUserDebtsRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUserDebtsRespValidationError{}
@@ -0,0 +1,201 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.30.2
+// source: payments.proto
+
+package corev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PaymentsService_SetPaymentStatus_FullMethodName  = "/core.v1.PaymentsService/SetPaymentStatus"
+	PaymentsService_ListSheetBalances_FullMethodName = "/core.v1.PaymentsService/ListSheetBalances"
+	PaymentsService_ListUserDebts_FullMethodName     = "/core.v1.PaymentsService/ListUserDebts"
+)
+
+// PaymentsServiceClient is the client API for PaymentsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PaymentsServiceClient interface {
+	// Mark a member of a closed sheet as unpaid, partially paid, paid or waived.
+	SetPaymentStatus(ctx context.Context, in *SetPaymentStatusReq, opts ...grpc.CallOption) (*SetPaymentStatusResp, error)
+	ListSheetBalances(ctx context.Context, in *ListSheetBalancesReq, opts ...grpc.CallOption) (*ListSheetBalancesResp, error)
+	// Unsettled balances of a user across all closed sheets.
+	ListUserDebts(ctx context.Context, in *ListUserDebtsReq, opts ...grpc.CallOption) (*ListUserDebtsResp, error)
+}
+
+type paymentsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPaymentsServiceClient(cc grpc.ClientConnInterface) PaymentsServiceClient {
+	return &paymentsServiceClient{cc}
+}
+
+func (c *paymentsServiceClient) SetPaymentStatus(ctx context.Context, in *SetPaymentStatusReq, opts ...grpc.CallOption) (*SetPaymentStatusResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPaymentStatusResp)
+	err := c.cc.Invoke(ctx, PaymentsService_SetPaymentStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentsServiceClient) ListSheetBalances(ctx context.Context, in *ListSheetBalancesReq, opts ...grpc.CallOption) (*ListSheetBalancesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSheetBalancesResp)
+	err := c.cc.Invoke(ctx, PaymentsService_ListSheetBalances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentsServiceClient) ListUserDebts(ctx context.Context, in *ListUserDebtsReq, opts ...grpc.CallOption) (*ListUserDebtsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserDebtsResp)
+	err := c.cc.Invoke(ctx, PaymentsService_ListUserDebts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PaymentsServiceServer is the server API for PaymentsService service.
+// All implementations must embed UnimplementedPaymentsServiceServer
+// for forward compatibility.
+type PaymentsServiceServer interface {
+	// Mark a member of a closed sheet as unpaid, partially paid, paid or waived.
+	SetPaymentStatus(context.Context, *SetPaymentStatusReq) (*SetPaymentStatusResp, error)
+	ListSheetBalances(context.Context, *ListSheetBalancesReq) (*ListSheetBalancesResp, error)
+	// Unsettled balances of a user across all closed sheets.
+	ListUserDebts(context.Context, *ListUserDebtsReq) (*ListUserDebtsResp, error)
+	mustEmbedUnimplementedPaymentsServiceServer()
+}
+
+// UnimplementedPaymentsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPaymentsServiceServer struct{}
+
+func (UnimplementedPaymentsServiceServer) SetPaymentStatus(context.Context, *SetPaymentStatusReq) (*SetPaymentStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPaymentStatus not implemented")
+}
+func (UnimplementedPaymentsServiceServer) ListSheetBalances(context.Context, *ListSheetBalancesReq) (*ListSheetBalancesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSheetBalances not implemented")
+}
+func (UnimplementedPaymentsServiceServer) ListUserDebts(context.Context, *ListUserDebtsReq) (*ListUserDebtsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserDebts not implemented")
+}
+func (UnimplementedPaymentsServiceServer) mustEmbedUnimplementedPaymentsServiceServer() {}
+func (UnimplementedPaymentsServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafePaymentsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PaymentsServiceServer will
+// result in compilation errors.
+type UnsafePaymentsServiceServer interface {
+	mustEmbedUnimplementedPaymentsServiceServer()
+}
+
+func RegisterPaymentsServiceServer(s grpc.ServiceRegistrar, srv PaymentsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPaymentsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PaymentsService_ServiceDesc, srv)
+}
+
+func _PaymentsService_SetPaymentStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPaymentStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentsServiceServer).SetPaymentStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentsService_SetPaymentStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentsServiceServer).SetPaymentStatus(ctx, req.(*SetPaymentStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentsService_ListSheetBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSheetBalancesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentsServiceServer).ListSheetBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentsService_ListSheetBalances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentsServiceServer).ListSheetBalances(ctx, req.(*ListSheetBalancesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentsService_ListUserDebts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserDebtsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentsServiceServer).ListUserDebts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentsService_ListUserDebts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentsServiceServer).ListUserDebts(ctx, req.(*ListUserDebtsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PaymentsService_ServiceDesc is the grpc.ServiceDesc for PaymentsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PaymentsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "core.v1.PaymentsService",
+	HandlerType: (*PaymentsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetPaymentStatus",
+			Handler:    _PaymentsService_SetPaymentStatus_Handler,
+		},
+		{
+			MethodName: "ListSheetBalances",
+			Handler:    _PaymentsService_ListSheetBalances_Handler,
+		},
+		{
+			MethodName: "ListUserDebts",
+			Handler:    _PaymentsService_ListUserDebts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "payments.proto",
+}
@@ -0,0 +1,1094 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.30.2
+// source: sheet_templates.proto
+
+package corev1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RecurrenceFrequency int32
+
+const (
+	RecurrenceFrequency_RECURRENCE_FREQUENCY_UNSPECIFIED RecurrenceFrequency = 0
+	RecurrenceFrequency_RECURRENCE_FREQUENCY_DAILY       RecurrenceFrequency = 1
+	RecurrenceFrequency_RECURRENCE_FREQUENCY_WEEKDAYS    RecurrenceFrequency = 2 // Monday to Friday
+	RecurrenceFrequency_RECURRENCE_FREQUENCY_WEEKLY      RecurrenceFrequency = 3 // on weekdays
+	RecurrenceFrequency_RECURRENCE_FREQUENCY_CRON        RecurrenceFrequency = 4 // on cron
+)
+
+// Enum value maps for RecurrenceFrequency.
+var (
+	RecurrenceFrequency_name = map[int32]string{
+		0: "RECURRENCE_FREQUENCY_UNSPECIFIED",
+		1: "RECURRENCE_FREQUENCY_DAILY",
+		2: "RECURRENCE_FREQUENCY_WEEKDAYS",
+		3: "RECURRENCE_FREQUENCY_WEEKLY",
+		4: "RECURRENCE_FREQUENCY_CRON",
+	}
+	RecurrenceFrequency_value = map[string]int32{
+		"RECURRENCE_FREQUENCY_UNSPECIFIED": 0,
+		"RECURRENCE_FREQUENCY_DAILY":       1,
+		"RECURRENCE_FREQUENCY_WEEKDAYS":    2,
+		"RECURRENCE_FREQUENCY_WEEKLY":      3,
+		"RECURRENCE_FREQUENCY_CRON":        4,
+	}
+)
+
+func (x RecurrenceFrequency) Enum() *RecurrenceFrequency {
+	p := new(RecurrenceFrequency)
+	*p = x
+	return p
+}
+
+func (x RecurrenceFrequency) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RecurrenceFrequency) Descriptor() protoreflect.EnumDescriptor {
+	return file_sheet_templates_proto_enumTypes[0].Descriptor()
+}
+
+func (RecurrenceFrequency) Type() protoreflect.EnumType {
+	return &file_sheet_templates_proto_enumTypes[0]
+}
+
+func (x RecurrenceFrequency) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RecurrenceFrequency.Descriptor instead.
+func (RecurrenceFrequency) EnumDescriptor() ([]byte, []int) {
+	return file_sheet_templates_proto_rawDescGZIP(), []int{0}
+}
+
+// When a template creates its sheets. Times are wall-clock times in
+// time_zone.
+type Recurrence struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Frequency RecurrenceFrequency    `protobuf:"varint,1,opt,name=frequency,proto3,enum=core.v1.RecurrenceFrequency" json:"frequency,omitempty"`
+	TimeOfDay string                 `protobuf:"bytes,2,opt,name=time_of_day,json=timeOfDay,proto3" json:"time_of_day,omitempty"` // "HH:MM", not used by cron
+	// Days of the week for weekly, 0 is Sunday
+	Weekdays []int32 `protobuf:"varint,3,rep,packed,name=weekdays,proto3" json:"weekdays,omitempty"`
+	// "minute hour day-of-month month day-of-week" with *, lists, ranges and
+	// steps
+	Cron          string `protobuf:"bytes,4,opt,name=cron,proto3" json:"cron,omitempty"`
+	TimeZone      string `protobuf:"bytes,5,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"` // IANA name, UTC when empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Recurrence) Reset() {
+	*x = Recurrence{}
+	mi := &file_sheet_templates_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recurrence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recurrence) ProtoMessage() {}
+
+func (x *Recurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_sheet_templates_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recurrence.ProtoReflect.Descriptor instead.
+func (*Recurrence) Descriptor() ([]byte, []int) {
+	return file_sheet_templates_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Recurrence) GetFrequency() RecurrenceFrequency {
+	if x != nil {
+		return x.Frequency
+	}
+	return RecurrenceFrequency_RECURRENCE_FREQUENCY_UNSPECIFIED
+}
+
+func (x *Recurrence) GetTimeOfDay() string {
+	if x != nil {
+		return x.TimeOfDay
+	}
+	return ""
+}
+
+func (x *Recurrence) GetWeekdays() []int32 {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+func (x *Recurrence) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *Recurrence) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+// A saved sheet the scheduler creates again on every occurrence of its
+// recurrence
+type SheetTemplate struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description  string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	HostUserId   string                 `protobuf:"bytes,4,opt,name=host_user_id,json=hostUserId,proto3" json:"host_user_id,omitempty"`
+	MemberIds    []string               `protobuf:"bytes,5,rep,name=member_ids,json=memberIds,proto3" json:"member_ids,omitempty"`
+	DeliveryFee  *Money                 `protobuf:"bytes,6,opt,name=delivery_fee,json=deliveryFee,proto3" json:"delivery_fee,omitempty"`
+	Discount     int32                  `protobuf:"varint,7,opt,name=discount,proto3" json:"discount,omitempty"`
+	FeeSplitMode FeeSplitMode           `protobuf:"varint,8,opt,name=fee_split_mode,json=feeSplitMode,proto3,enum=core.v1.FeeSplitMode" json:"fee_split_mode,omitempty"`
+	Items        []*MenuItem            `protobuf:"bytes,9,rep,name=items,proto3" json:"items,omitempty"`
+	Recurrence   *Recurrence            `protobuf:"bytes,10,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	// Created sheets close this long after the occurrence; 0 means no cutoff
+	CloseAfterMinutes int32                  `protobuf:"varint,11,opt,name=close_after_minutes,json=closeAfterMinutes,proto3" json:"close_after_minutes,omitempty"`
+	Paused            bool                   `protobuf:"varint,12,opt,name=paused,proto3" json:"paused,omitempty"`
+	NextRunAt         *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"` // unset while paused
+	LastSheetId       string                 `protobuf:"bytes,14,opt,name=last_sheet_id,json=lastSheetId,proto3" json:"last_sheet_id,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SheetTemplate) Reset() {
+	*x = SheetTemplate{}
+	mi := &file_sheet_templates_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SheetTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SheetTemplate) ProtoMessage() {}
+
+func (x *SheetTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_sheet_templates_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SheetTemplate.ProtoReflect.Descriptor instead.
+func (*SheetTemplate) Descriptor() ([]byte, []int) {
+	return file_sheet_templates_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SheetTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SheetTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SheetTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SheetTemplate) GetHostUserId() string {
+	if x != nil {
+		return x.HostUserId
+	}
+	return ""
+}
+
+func (x *SheetTemplate) GetMemberIds() []string {
+	if x != nil {
+		return x.MemberIds
+	}
+	return nil
+}
+
+func (x *SheetTemplate) GetDeliveryFee() *Money {
+	if x != nil {
+		return x.DeliveryFee
+	}
+	return nil
+}
+
+func (x *SheetTemplate) GetDiscount() int32 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *SheetTemplate) GetFeeSplitMode() FeeSplitMode {
+	if x != nil {
+		return x.FeeSplitMode
+	}
+	return FeeSplitMode_FEE_SPLIT_MODE_UNSPECIFIED
+}
+
+func (x *SheetTemplate) GetItems() []*MenuItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *SheetTemplate) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *SheetTemplate) GetCloseAfterMinutes() int32 {
+	if x != nil {
+		return x.CloseAfterMinutes
+	}
+	return 0
+}
+
+func (x *SheetTemplate) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *SheetTemplate) GetNextRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return nil
+}
+
+func (x *SheetTemplate) GetLastSheetId() string {
+	if x != nil {
+		return x.LastSheetId
+	}
+	return ""
+}
+
+func (x *SheetTemplate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SheetTemplate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateSheetTemplateReq struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	IdempotencyKey    string                 `protobuf:"bytes,1,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	SheetId           string                 `protobuf:"bytes,2,opt,name=sheet_id,json=sheetId,proto3" json:"sheet_id,omitempty"`
+	Name              string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"` // defaults to the sheet's name
+	Recurrence        *Recurrence            `protobuf:"bytes,4,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	CloseAfterMinutes int32                  `protobuf:"varint,5,opt,name=close_after_minutes,json=closeAfterMinutes,proto3" json:"close_after_minutes,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateSheetTemplateReq) Reset() {
+	*x = CreateSheetTemplateReq{}
+	mi := &file_sheet_templates_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSheetTemplateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSheetTemplateReq) ProtoMessage() {}
+
+func (x *CreateSheetTemplateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sheet_templates_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSheetTemplateReq.ProtoReflect.Descriptor instead.
+func (*CreateSheetTemplateReq) Descriptor() ([]byte, []int) {
+	return file_sheet_templates_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateSheetTemplateReq) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateSheetTemplateReq) GetSheetId() string {
+	if x != nil {
+		return x.SheetId
+	}
+	return ""
+}
+
+func (x *CreateSheetTemplateReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSheetTemplateReq) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *CreateSheetTemplateReq) GetCloseAfterMinutes() int32 {
+	if x != nil {
+		return x.CloseAfterMinutes
+	}
+	return 0
+}
+
+type CreateSheetTemplateResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *SheetTemplate         `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSheetTemplateResp) Reset() {
+	*x = CreateSheetTemplateResp{}
+	mi := &file_sheet_templates_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSheetTemplateResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSheetTemplateResp) ProtoMessage() {}
+
+func (x *CreateSheetTemplateResp) ProtoReflect() protoreflect.Message {
+	mi := &file_sheet_templates_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSheetTemplateResp.ProtoReflect.Descriptor instead.
+func (*CreateSheetTemplateResp) Descriptor() ([]byte, []int) {
+	return file_sheet_templates_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateSheetTemplateResp) GetTemplate() *SheetTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type GetSheetTemplateReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSheetTemplateReq) Reset() {
+	*x = GetSheetTemplateReq{}
+	mi := &file_sheet_templates_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSheetTemplateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSheetTemplateReq) ProtoMessage() {}
+
+func (x *GetSheetTemplateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sheet_templates_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSheetTemplateReq.ProtoReflect.Descriptor instead.
+func (*GetSheetTemplateReq) Descriptor() ([]byte, []int) {
+	return file_sheet_templates_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetSheetTemplateReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetSheetTemplateResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *SheetTemplate         `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSheetTemplateResp) Reset() {
+	*x = GetSheetTemplateResp{}
+	mi := &file_sheet_templates_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSheetTemplateResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSheetTemplateResp) ProtoMessage() {}
+
+func (x *GetSheetTemplateResp) ProtoReflect() protoreflect.Message {
+	mi := &file_sheet_templates_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSheetTemplateResp.ProtoReflect.Descriptor instead.
+func (*GetSheetTemplateResp) Descriptor() ([]byte, []int) {
+	return file_sheet_templates_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetSheetTemplateResp) GetTemplate() *SheetTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type ListSheetTemplatesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HostUserId    string                 `protobuf:"bytes,1,opt,name=host_user_id,json=hostUserId,proto3" json:"host_user_id,omitempty"` // the caller when empty
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor        *Cursor                `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSheetTemplatesReq) Reset() {
+	*x = ListSheetTemplatesReq{}
+	mi := &file_sheet_templates_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSheetTemplatesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSheetTemplatesReq) ProtoMessage() {}
+
+func (x *ListSheetTemplatesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sheet_templates_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSheetTemplatesReq.ProtoReflect.Descriptor instead.
+func (*ListSheetTemplatesReq) Descriptor() ([]byte, []int) {
+	return file_sheet_templates_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListSheetTemplatesReq) GetHostUserId() string {
+	if x != nil {
+		return x.HostUserId
+	}
+	return ""
+}
+
+func (x *ListSheetTemplatesReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSheetTemplatesReq) GetCursor() *Cursor {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+type ListSheetTemplatesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*SheetTemplate       `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	NextCursor    *Cursor                `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSheetTemplatesResp) Reset() {
+	*x = ListSheetTemplatesResp{}
+	mi := &file_sheet_templates_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSheetTemplatesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSheetTemplatesResp) ProtoMessage() {}
+
+func (x *ListSheetTemplatesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_sheet_templates_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSheetTemplatesResp.ProtoReflect.Descriptor instead.
+func (*ListSheetTemplatesResp) Descriptor() ([]byte, []int) {
+	return file_sheet_templates_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListSheetTemplatesResp) GetTemplates() []*SheetTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+func (x *ListSheetTemplatesResp) GetNextCursor() *Cursor {
+	if x != nil {
+		return x.NextCursor
+	}
+	return nil
+}
+
+type UpdateSheetTemplateReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IdempotencyKey string                 `protobuf:"bytes,1,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	Id             string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name           *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description    *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	DeliveryFee    *Money                 `protobuf:"bytes,5,opt,name=delivery_fee,json=deliveryFee,proto3" json:"delivery_fee,omitempty"`
+	// Replaces the members when set_member_ids is true
+	MemberIds         []string    `protobuf:"bytes,6,rep,name=member_ids,json=memberIds,proto3" json:"member_ids,omitempty"`
+	SetMemberIds      bool        `protobuf:"varint,7,opt,name=set_member_ids,json=setMemberIds,proto3" json:"set_member_ids,omitempty"`
+	Recurrence        *Recurrence `protobuf:"bytes,8,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	CloseAfterMinutes *int32      `protobuf:"varint,9,opt,name=close_after_minutes,json=closeAfterMinutes,proto3,oneof" json:"close_after_minutes,omitempty"`
+	Paused            *bool       `protobuf:"varint,10,opt,name=paused,proto3,oneof" json:"paused,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateSheetTemplateReq) Reset() {
+	*x = UpdateSheetTemplateReq{}
+	mi := &file_sheet_templates_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSheetTemplateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSheetTemplateReq) ProtoMessage() {}
+
+func (x *UpdateSheetTemplateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sheet_templates_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSheetTemplateReq.ProtoReflect.Descriptor instead.
+func (*UpdateSheetTemplateReq) Descriptor() ([]byte, []int) {
+	return file_sheet_templates_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateSheetTemplateReq) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *UpdateSheetTemplateReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateSheetTemplateReq) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateSheetTemplateReq) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateSheetTemplateReq) GetDeliveryFee() *Money {
+	if x != nil {
+		return x.DeliveryFee
+	}
+	return nil
+}
+
+func (x *UpdateSheetTemplateReq) GetMemberIds() []string {
+	if x != nil {
+		return x.MemberIds
+	}
+	return nil
+}
+
+func (x *UpdateSheetTemplateReq) GetSetMemberIds() bool {
+	if x != nil {
+		return x.SetMemberIds
+	}
+	return false
+}
+
+func (x *UpdateSheetTemplateReq) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *UpdateSheetTemplateReq) GetCloseAfterMinutes() int32 {
+	if x != nil && x.CloseAfterMinutes != nil {
+		return *x.CloseAfterMinutes
+	}
+	return 0
+}
+
+func (x *UpdateSheetTemplateReq) GetPaused() bool {
+	if x != nil && x.Paused != nil {
+		return *x.Paused
+	}
+	return false
+}
+
+type UpdateSheetTemplateResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *SheetTemplate         `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSheetTemplateResp) Reset() {
+	*x = UpdateSheetTemplateResp{}
+	mi := &file_sheet_templates_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSheetTemplateResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSheetTemplateResp) ProtoMessage() {}
+
+func (x *UpdateSheetTemplateResp) ProtoReflect() protoreflect.Message {
+	mi := &file_sheet_templates_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSheetTemplateResp.ProtoReflect.Descriptor instead.
+func (*UpdateSheetTemplateResp) Descriptor() ([]byte, []int) {
+	return file_sheet_templates_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateSheetTemplateResp) GetTemplate() *SheetTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type DeleteSheetTemplateReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IdempotencyKey string                 `protobuf:"bytes,1,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	Id             string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteSheetTemplateReq) Reset() {
+	*x = DeleteSheetTemplateReq{}
+	mi := &file_sheet_templates_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSheetTemplateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSheetTemplateReq) ProtoMessage() {}
+
+func (x *DeleteSheetTemplateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sheet_templates_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSheetTemplateReq.ProtoReflect.Descriptor instead.
+func (*DeleteSheetTemplateReq) Descriptor() ([]byte, []int) {
+	return file_sheet_templates_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteSheetTemplateReq) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *DeleteSheetTemplateReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteSheetTemplateResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSheetTemplateResp) Reset() {
+	*x = DeleteSheetTemplateResp{}
+	mi := &file_sheet_templates_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSheetTemplateResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSheetTemplateResp) ProtoMessage() {}
+
+func (x *DeleteSheetTemplateResp) ProtoReflect() protoreflect.Message {
+	mi := &file_sheet_templates_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSheetTemplateResp.ProtoReflect.Descriptor instead.
+func (*DeleteSheetTemplateResp) Descriptor() ([]byte, []int) {
+	return file_sheet_templates_proto_rawDescGZIP(), []int{11}
+}
+
+var File_sheet_templates_proto protoreflect.FileDescriptor
+
+const file_sheet_templates_proto_rawDesc = "" +
+	"\n" +
+	"\x15sheet_templates.proto\x12\acore.v1\x1a\fcommon.proto\x1a\fsheets.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\xd1\x01\n" +
+	"\n" +
+	"Recurrence\x12F\n" +
+	"\tfrequency\x18\x01 \x01(\x0e2\x1c.core.v1.RecurrenceFrequencyB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\tfrequency\x12\x1e\n" +
+	"\vtime_of_day\x18\x02 \x01(\tR\ttimeOfDay\x12*\n" +
+	"\bweekdays\x18\x03 \x03(\x05B\x0e\xfaB\v\x92\x01\b\"\x06\x1a\x04\x18\x06(\x00R\bweekdays\x12\x12\n" +
+	"\x04cron\x18\x04 \x01(\tR\x04cron\x12\x1b\n" +
+	"\ttime_zone\x18\x05 \x01(\tR\btimeZone\"\x9e\x05\n" +
+	"\rSheetTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12 \n" +
+	"\fhost_user_id\x18\x04 \x01(\tR\n" +
+	"hostUserId\x12\x1d\n" +
+	"\n" +
+	"member_ids\x18\x05 \x03(\tR\tmemberIds\x121\n" +
+	"\fdelivery_fee\x18\x06 \x01(\v2\x0e.core.v1.MoneyR\vdeliveryFee\x12\x1a\n" +
+	"\bdiscount\x18\a \x01(\x05R\bdiscount\x12;\n" +
+	"\x0efee_split_mode\x18\b \x01(\x0e2\x15.core.v1.FeeSplitModeR\ffeeSplitMode\x12'\n" +
+	"\x05items\x18\t \x03(\v2\x11.core.v1.MenuItemR\x05items\x123\n" +
+	"\n" +
+	"recurrence\x18\n" +
+	" \x01(\v2\x13.core.v1.RecurrenceR\n" +
+	"recurrence\x12.\n" +
+	"\x13close_after_minutes\x18\v \x01(\x05R\x11closeAfterMinutes\x12\x16\n" +
+	"\x06paused\x18\f \x01(\bR\x06paused\x12:\n" +
+	"\vnext_run_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tnextRunAt\x12\"\n" +
+	"\rlast_sheet_id\x18\x0e \x01(\tR\vlastSheetId\x129\n" +
+	"\n" +
+	"created_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x84\x02\n" +
+	"\x16CreateSheetTemplateReq\x120\n" +
+	"\x0fidempotency_key\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x0eidempotencyKey\x12\"\n" +
+	"\bsheet_id\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\asheetId\x12\x1c\n" +
+	"\x04name\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\x04name\x12=\n" +
+	"\n" +
+	"recurrence\x18\x04 \x01(\v2\x13.core.v1.RecurrenceB\b\xfaB\x05\x8a\x01\x02\x10\x01R\n" +
+	"recurrence\x127\n" +
+	"\x13close_after_minutes\x18\x05 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x11closeAfterMinutes\"M\n" +
+	"\x17CreateSheetTemplateResp\x122\n" +
+	"\btemplate\x18\x01 \x01(\v2\x16.core.v1.SheetTemplateR\btemplate\".\n" +
+	"\x13GetSheetTemplateReq\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\"J\n" +
+	"\x14GetSheetTemplateResp\x122\n" +
+	"\btemplate\x18\x01 \x01(\v2\x16.core.v1.SheetTemplateR\btemplate\"\x8a\x01\n" +
+	"\x15ListSheetTemplatesReq\x12 \n" +
+	"\fhost_user_id\x18\x01 \x01(\tR\n" +
+	"hostUserId\x12&\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\bpageSize\x12'\n" +
+	"\x06cursor\x18\x03 \x01(\v2\x0f.core.v1.CursorR\x06cursor\"\x95\x01\n" +
+	"\x16ListSheetTemplatesResp\x124\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x16.core.v1.SheetTemplateR\ttemplates\x125\n" +
+	"\vnext_cursor\x18\x02 \x01(\v2\x0f.core.v1.CursorH\x00R\n" +
+	"nextCursor\x88\x01\x01B\x0e\n" +
+	"\f_next_cursor\"\xfd\x03\n" +
+	"\x16UpdateSheetTemplateReq\x120\n" +
+	"\x0fidempotency_key\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x0eidempotencyKey\x12\x17\n" +
+	"\x02id\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\x12#\n" +
+	"\x04name\x18\x03 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xff\x01H\x00R\x04name\x88\x01\x01\x12/\n" +
+	"\vdescription\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\xe8\aH\x01R\vdescription\x88\x01\x01\x121\n" +
+	"\fdelivery_fee\x18\x05 \x01(\v2\x0e.core.v1.MoneyR\vdeliveryFee\x12\x1d\n" +
+	"\n" +
+	"member_ids\x18\x06 \x03(\tR\tmemberIds\x12$\n" +
+	"\x0eset_member_ids\x18\a \x01(\bR\fsetMemberIds\x123\n" +
+	"\n" +
+	"recurrence\x18\b \x01(\v2\x13.core.v1.RecurrenceR\n" +
+	"recurrence\x12<\n" +
+	"\x13close_after_minutes\x18\t \x01(\x05B\a\xfaB\x04\x1a\x02(\x00H\x02R\x11closeAfterMinutes\x88\x01\x01\x12\x1b\n" +
+	"\x06paused\x18\n" +
+	" \x01(\bH\x03R\x06paused\x88\x01\x01B\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_descriptionB\x16\n" +
+	"\x14_close_after_minutesB\t\n" +
+	"\a_paused\"M\n" +
+	"\x17UpdateSheetTemplateResp\x122\n" +
+	"\btemplate\x18\x01 \x01(\v2\x16.core.v1.SheetTemplateR\btemplate\"c\n" +
+	"\x16DeleteSheetTemplateReq\x120\n" +
+	"\x0fidempotency_key\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x0eidempotencyKey\x12\x17\n" +
+	"\x02id\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\"\x19\n" +
+	"\x17DeleteSheetTemplateResp*\xbe\x01\n" +
+	"\x13RecurrenceFrequency\x12$\n" +
+	" RECURRENCE_FREQUENCY_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aRECURRENCE_FREQUENCY_DAILY\x10\x01\x12!\n" +
+	"\x1dRECURRENCE_FREQUENCY_WEEKDAYS\x10\x02\x12\x1f\n" +
+	"\x1bRECURRENCE_FREQUENCY_WEEKLY\x10\x03\x12\x1d\n" +
+	"\x19RECURRENCE_FREQUENCY_CRON\x10\x042\xcd\x03\n" +
+	"\x15SheetTemplatesService\x12X\n" +
+	"\x13CreateSheetTemplate\x12\x1f.core.v1.CreateSheetTemplateReq\x1a .core.v1.CreateSheetTemplateResp\x12O\n" +
+	"\x10GetSheetTemplate\x12\x1c.core.v1.GetSheetTemplateReq\x1a\x1d.core.v1.GetSheetTemplateResp\x12U\n" +
+	"\x12ListSheetTemplates\x12\x1e.core.v1.ListSheetTemplatesReq\x1a\x1f.core.v1.ListSheetTemplatesResp\x12X\n" +
+	"\x13UpdateSheetTemplate\x12\x1f.core.v1.UpdateSheetTemplateReq\x1a .core.v1.UpdateSheetTemplateResp\x12X\n" +
+	"\x13DeleteSheetTemplate\x12\x1f.core.v1.DeleteSheetTemplateReq\x1a .core.v1.DeleteSheetTemplateRespB;Z9github.com/deni12345/dae-services/proto/gen/corev1;corev1b\x06proto3"
+
+var (
+	file_sheet_templates_proto_rawDescOnce sync.Once
+	file_sheet_templates_proto_rawDescData []byte
+)
+
+func file_sheet_templates_proto_rawDescGZIP() []byte {
+	file_sheet_templates_proto_rawDescOnce.Do(func() {
+		file_sheet_templates_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_sheet_templates_proto_rawDesc), len(file_sheet_templates_proto_rawDesc)))
+	})
+	return file_sheet_templates_proto_rawDescData
+}
+
+var file_sheet_templates_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_sheet_templates_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_sheet_templates_proto_goTypes = []any{
+	(RecurrenceFrequency)(0),        // 0: core.v1.RecurrenceFrequency
+	(*Recurrence)(nil),              // 1: core.v1.Recurrence
+	(*SheetTemplate)(nil),           // 2: core.v1.SheetTemplate
+	(*CreateSheetTemplateReq)(nil),  // 3: core.v1.CreateSheetTemplateReq
+	(*CreateSheetTemplateResp)(nil), // 4: core.v1.CreateSheetTemplateResp
+	(*GetSheetTemplateReq)(nil),     // 5: core.v1.GetSheetTemplateReq
+	(*GetSheetTemplateResp)(nil),    // 6: core.v1.GetSheetTemplateResp
+	(*ListSheetTemplatesReq)(nil),   // 7: core.v1.ListSheetTemplatesReq
+	(*ListSheetTemplatesResp)(nil),  // 8: core.v1.ListSheetTemplatesResp
+	(*UpdateSheetTemplateReq)(nil),  // 9: core.v1.UpdateSheetTemplateReq
+	(*UpdateSheetTemplateResp)(nil), // 10: core.v1.UpdateSheetTemplateResp
+	(*DeleteSheetTemplateReq)(nil),  // 11: core.v1.DeleteSheetTemplateReq
+	(*DeleteSheetTemplateResp)(nil), // 12: core.v1.DeleteSheetTemplateResp
+	(*Money)(nil),                   // 13: core.v1.Money
+	(FeeSplitMode)(0),               // 14: core.v1.FeeSplitMode
+	(*MenuItem)(nil),                // 15: core.v1.MenuItem
+	(*timestamppb.Timestamp)(nil),   // 16: google.protobuf.Timestamp
+	(*Cursor)(nil),                  // 17: core.v1.Cursor
+}
+var file_sheet_templates_proto_depIdxs = []int32{
+	0,  // 0: core.v1.Recurrence.frequency:type_name -> core.v1.RecurrenceFrequency
+	13, // 1: core.v1.SheetTemplate.delivery_fee:type_name -> core.v1.Money
+	14, // 2: core.v1.SheetTemplate.fee_split_mode:type_name -> core.v1.FeeSplitMode
+	15, // 3: core.v1.SheetTemplate.items:type_name -> core.v1.MenuItem
+	1,  // 4: core.v1.SheetTemplate.recurrence:type_name -> core.v1.Recurrence
+	16, // 5: core.v1.SheetTemplate.next_run_at:type_name -> google.protobuf.Timestamp
+	16, // 6: core.v1.SheetTemplate.created_at:type_name -> google.protobuf.Timestamp
+	16, // 7: core.v1.SheetTemplate.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 8: core.v1.CreateSheetTemplateReq.recurrence:type_name -> core.v1.Recurrence
+	2,  // 9: core.v1.CreateSheetTemplateResp.template:type_name -> core.v1.SheetTemplate
+	2,  // 10: core.v1.GetSheetTemplateResp.template:type_name -> core.v1.SheetTemplate
+	17, // 11: core.v1.ListSheetTemplatesReq.cursor:type_name -> core.v1.Cursor
+	2,  // 12: core.v1.ListSheetTemplatesResp.templates:type_name -> core.v1.SheetTemplate
+	17, // 13: core.v1.ListSheetTemplatesResp.next_cursor:type_name -> core.v1.Cursor
+	13, // 14: core.v1.UpdateSheetTemplateReq.delivery_fee:type_name -> core.v1.Money
+	1,  // 15: core.v1.UpdateSheetTemplateReq.recurrence:type_name -> core.v1.Recurrence
+	2,  // 16: core.v1.UpdateSheetTemplateResp.template:type_name -> core.v1.SheetTemplate
+	3,  // 17: core.v1.SheetTemplatesService.CreateSheetTemplate:input_type -> core.v1.CreateSheetTemplateReq
+	5,  // 18: core.v1.SheetTemplatesService.GetSheetTemplate:input_type -> core.v1.GetSheetTemplateReq
+	7,  // 19: core.v1.SheetTemplatesService.ListSheetTemplates:input_type -> core.v1.ListSheetTemplatesReq
+	9,  // 20: core.v1.SheetTemplatesService.UpdateSheetTemplate:input_type -> core.v1.UpdateSheetTemplateReq
+	11, // 21: core.v1.SheetTemplatesService.DeleteSheetTemplate:input_type -> core.v1.DeleteSheetTemplateReq
+	4,  // 22: core.v1.SheetTemplatesService.CreateSheetTemplate:output_type -> core.v1.CreateSheetTemplateResp
+	6,  // 23: core.v1.SheetTemplatesService.GetSheetTemplate:output_type -> core.v1.GetSheetTemplateResp
+	8,  // 24: core.v1.SheetTemplatesService.ListSheetTemplates:output_type -> core.v1.ListSheetTemplatesResp
+	10, // 25: core.v1.SheetTemplatesService.UpdateSheetTemplate:output_type -> core.v1.UpdateSheetTemplateResp
+	12, // 26: core.v1.SheetTemplatesService.DeleteSheetTemplate:output_type -> core.v1.DeleteSheetTemplateResp
+	22, // [22:27] is the sub-list for method output_type
+	17, // [17:22] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
+}
+
+func init() { file_sheet_templates_proto_init() }
+func file_sheet_templates_proto_init() {
+	if File_sheet_templates_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	file_sheets_proto_init()
+	file_sheet_templates_proto_msgTypes[7].OneofWrappers = []any{}
+	file_sheet_templates_proto_msgTypes[8].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sheet_templates_proto_rawDesc), len(file_sheet_templates_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_sheet_templates_proto_goTypes,
+		DependencyIndexes: file_sheet_templates_proto_depIdxs,
+		EnumInfos:         file_sheet_templates_proto_enumTypes,
+		MessageInfos:      file_sheet_templates_proto_msgTypes,
+	}.Build()
+	File_sheet_templates_proto = out.File
+	file_sheet_templates_proto_goTypes = nil
+	file_sheet_templates_proto_depIdxs = nil
+}
@@ -0,0 +1,1944 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: sheet_templates.proto
+
+package corev1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Recurrence with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Recurrence) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Recurrence with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RecurrenceMultiError, or
+// nil if none found.
+func (m *Recurrence) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Recurrence) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _Recurrence_Frequency_NotInLookup[m.GetFrequency()]; ok {
+		err := RecurrenceValidationError{
+			field:  "Frequency",
+			reason: "value must not be in list [RECURRENCE_FREQUENCY_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := RecurrenceFrequency_name[int32(m.GetFrequency())]; !ok {
+		err := RecurrenceValidationError{
+			field:  "Frequency",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for TimeOfDay
+
+	for idx, item := range m.GetWeekdays() {
+		_, _ = idx, item
+
+		if val := item; val < 0 || val > 6 {
+			err := RecurrenceValidationError{
+				field:  fmt.Sprintf("Weekdays[%v]", idx),
+				reason: "value must be inside range [0, 6]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Cron
+
+	// no validation rules for TimeZone
+
+	if len(errors) > 0 {
+		return RecurrenceMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecurrenceMultiError is an error wrapping multiple validation errors
+// returned by Recurrence.ValidateAll() if the designated constraints aren't met.
+type RecurrenceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecurrenceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecurrenceMultiError) AllErrors() []error { return m }
+
+// RecurrenceValidationError is the validation error returned by
+// Recurrence.Validate if the designated constraints aren't met.
+type RecurrenceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecurrenceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecurrenceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecurrenceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecurrenceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecurrenceValidationError) ErrorName() string { return "RecurrenceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RecurrenceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecurrence.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecurrenceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecurrenceValidationError{}
+
+var _Recurrence_Frequency_NotInLookup = map[RecurrenceFrequency]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on SheetTemplate with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SheetTemplate) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SheetTemplate with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SheetTemplateMultiError, or
+// nil if none found.
+func (m *SheetTemplate) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SheetTemplate) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for HostUserId
+
+	if all {
+		switch v := interface{}(m.GetDeliveryFee()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SheetTemplateValidationError{
+					field:  "DeliveryFee",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SheetTemplateValidationError{
+					field:  "DeliveryFee",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeliveryFee()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SheetTemplateValidationError{
+				field:  "DeliveryFee",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Discount
+
+	// no validation rules for FeeSplitMode
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SheetTemplateValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SheetTemplateValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SheetTemplateValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetRecurrence()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SheetTemplateValidationError{
+					field:  "Recurrence",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SheetTemplateValidationError{
+					field:  "Recurrence",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRecurrence()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SheetTemplateValidationError{
+				field:  "Recurrence",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CloseAfterMinutes
+
+	// no validation rules for Paused
+
+	if all {
+		switch v := interface{}(m.GetNextRunAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SheetTemplateValidationError{
+					field:  "NextRunAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SheetTemplateValidationError{
+					field:  "NextRunAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextRunAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SheetTemplateValidationError{
+				field:  "NextRunAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LastSheetId
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SheetTemplateValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SheetTemplateValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SheetTemplateValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SheetTemplateValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SheetTemplateValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SheetTemplateValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SheetTemplateMultiError(errors)
+	}
+
+	return nil
+}
+
+// SheetTemplateMultiError is an error wrapping multiple validation errors
+// returned by SheetTemplate.ValidateAll() if the designated constraints
+// aren't met.
+type SheetTemplateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SheetTemplateMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SheetTemplateMultiError) AllErrors() []error { return m }
+
+// SheetTemplateValidationError is the validation error returned by
+// SheetTemplate.Validate if the designated constraints aren't met.
+type SheetTemplateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SheetTemplateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SheetTemplateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SheetTemplateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SheetTemplateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SheetTemplateValidationError) ErrorName() string { return "SheetTemplateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SheetTemplateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSheetTemplate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SheetTemplateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SheetTemplateValidationError{}
+
+// Validate checks the field values on CreateSheetTemplateReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSheetTemplateReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSheetTemplateReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSheetTemplateReqMultiError, or nil if none found.
+func (m *CreateSheetTemplateReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSheetTemplateReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetIdempotencyKey()) < 1 {
+		err := CreateSheetTemplateReqValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSheetId()) < 1 {
+		err := CreateSheetTemplateReqValidationError{
+			field:  "SheetId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) > 255 {
+		err := CreateSheetTemplateReqValidationError{
+			field:  "Name",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetRecurrence() == nil {
+		err := CreateSheetTemplateReqValidationError{
+			field:  "Recurrence",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetRecurrence()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSheetTemplateReqValidationError{
+					field:  "Recurrence",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSheetTemplateReqValidationError{
+					field:  "Recurrence",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRecurrence()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSheetTemplateReqValidationError{
+				field:  "Recurrence",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetCloseAfterMinutes() < 0 {
+		err := CreateSheetTemplateReqValidationError{
+			field:  "CloseAfterMinutes",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateSheetTemplateReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSheetTemplateReqMultiError is an error wrapping multiple validation
+// errors returned by CreateSheetTemplateReq.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSheetTemplateReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSheetTemplateReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSheetTemplateReqMultiError) AllErrors() []error { return m }
+
+// CreateSheetTemplateReqValidationError is the validation error returned by
+// CreateSheetTemplateReq.Validate if the designated constraints aren't met.
+type CreateSheetTemplateReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSheetTemplateReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSheetTemplateReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSheetTemplateReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSheetTemplateReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSheetTemplateReqValidationError) ErrorName() string {
+	return "CreateSheetTemplateReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSheetTemplateReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSheetTemplateReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSheetTemplateReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSheetTemplateReqValidationError{}
+
+// Validate checks the field values on CreateSheetTemplateResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSheetTemplateResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSheetTemplateResp with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSheetTemplateRespMultiError, or nil if none found.
+func (m *CreateSheetTemplateResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSheetTemplateResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTemplate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSheetTemplateRespValidationError{
+					field:  "Template",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSheetTemplateRespValidationError{
+					field:  "Template",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTemplate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSheetTemplateRespValidationError{
+				field:  "Template",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateSheetTemplateRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSheetTemplateRespMultiError is an error wrapping multiple validation
+// errors returned by CreateSheetTemplateResp.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSheetTemplateRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSheetTemplateRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSheetTemplateRespMultiError) AllErrors() []error { return m }
+
+// CreateSheetTemplateRespValidationError is the validation error returned by
+// CreateSheetTemplateResp.Validate if the designated constraints aren't met.
+type CreateSheetTemplateRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSheetTemplateRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSheetTemplateRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSheetTemplateRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSheetTemplateRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSheetTemplateRespValidationError) ErrorName() string {
+	return "CreateSheetTemplateRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSheetTemplateRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSheetTemplateResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSheetTemplateRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSheetTemplateRespValidationError{}
+
+// Validate checks the field values on GetSheetTemplateReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSheetTemplateReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSheetTemplateReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSheetTemplateReqMultiError, or nil if none found.
+func (m *GetSheetTemplateReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSheetTemplateReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := GetSheetTemplateReqValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetSheetTemplateReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSheetTemplateReqMultiError is an error wrapping multiple validation
+// errors returned by GetSheetTemplateReq.ValidateAll() if the designated
+// constraints aren't met.
+type GetSheetTemplateReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSheetTemplateReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSheetTemplateReqMultiError) AllErrors() []error { return m }
+
+// GetSheetTemplateReqValidationError is the validation error returned by
+// GetSheetTemplateReq.Validate if the designated constraints aren't met.
+type GetSheetTemplateReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSheetTemplateReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSheetTemplateReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSheetTemplateReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSheetTemplateReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSheetTemplateReqValidationError) ErrorName() string {
+	return "GetSheetTemplateReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSheetTemplateReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSheetTemplateReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSheetTemplateReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSheetTemplateReqValidationError{}
+
+// Validate checks the field values on GetSheetTemplateResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSheetTemplateResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSheetTemplateResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSheetTemplateRespMultiError, or nil if none found.
+func (m *GetSheetTemplateResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSheetTemplateResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTemplate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSheetTemplateRespValidationError{
+					field:  "Template",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSheetTemplateRespValidationError{
+					field:  "Template",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTemplate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSheetTemplateRespValidationError{
+				field:  "Template",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetSheetTemplateRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSheetTemplateRespMultiError is an error wrapping multiple validation
+// errors returned by GetSheetTemplateResp.ValidateAll() if the designated
+// constraints aren't met.
+type GetSheetTemplateRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSheetTemplateRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSheetTemplateRespMultiError) AllErrors() []error { return m }
+
+// GetSheetTemplateRespValidationError is the validation error returned by
+// GetSheetTemplateResp.Validate if the designated constraints aren't met.
+type GetSheetTemplateRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSheetTemplateRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSheetTemplateRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSheetTemplateRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSheetTemplateRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSheetTemplateRespValidationError) ErrorName() string {
+	return "GetSheetTemplateRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSheetTemplateRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSheetTemplateResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSheetTemplateRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSheetTemplateRespValidationError{}
+
+// Validate checks the field values on ListSheetTemplatesReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSheetTemplatesReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSheetTemplatesReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSheetTemplatesReqMultiError, or nil if none found.
+func (m *ListSheetTemplatesReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSheetTemplatesReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for HostUserId
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := ListSheetTemplatesReqValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCursor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListSheetTemplatesReqValidationError{
+					field:  "Cursor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListSheetTemplatesReqValidationError{
+					field:  "Cursor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCursor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListSheetTemplatesReqValidationError{
+				field:  "Cursor",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListSheetTemplatesReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSheetTemplatesReqMultiError is an error wrapping multiple validation
+// errors returned by ListSheetTemplatesReq.ValidateAll() if the designated
+// constraints aren't met.
+type ListSheetTemplatesReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSheetTemplatesReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSheetTemplatesReqMultiError) AllErrors() []error { return m }
+
+// ListSheetTemplatesReqValidationError is the validation error returned by
+// ListSheetTemplatesReq.Validate if the designated constraints aren't met.
+type ListSheetTemplatesReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSheetTemplatesReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSheetTemplatesReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSheetTemplatesReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSheetTemplatesReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSheetTemplatesReqValidationError) ErrorName() string {
+	return "ListSheetTemplatesReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSheetTemplatesReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSheetTemplatesReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSheetTemplatesReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSheetTemplatesReqValidationError{}
+
+// Validate checks the field values on ListSheetTemplatesResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSheetTemplatesResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSheetTemplatesResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSheetTemplatesRespMultiError, or nil if none found.
+func (m *ListSheetTemplatesResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSheetTemplatesResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTemplates() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSheetTemplatesRespValidationError{
+						field:  fmt.Sprintf("Templates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSheetTemplatesRespValidationError{
+						field:  fmt.Sprintf("Templates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSheetTemplatesRespValidationError{
+					field:  fmt.Sprintf("Templates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.NextCursor != nil {
+
+		if all {
+			switch v := interface{}(m.GetNextCursor()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSheetTemplatesRespValidationError{
+						field:  "NextCursor",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSheetTemplatesRespValidationError{
+						field:  "NextCursor",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetNextCursor()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSheetTemplatesRespValidationError{
+					field:  "NextCursor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListSheetTemplatesRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSheetTemplatesRespMultiError is an error wrapping multiple validation
+// errors returned by ListSheetTemplatesResp.ValidateAll() if the designated
+// constraints aren't met.
+type ListSheetTemplatesRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSheetTemplatesRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSheetTemplatesRespMultiError) AllErrors() []error { return m }
+
+// ListSheetTemplatesRespValidationError is the validation error returned by
+// ListSheetTemplatesResp.Validate if the designated constraints aren't met.
+type ListSheetTemplatesRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSheetTemplatesRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSheetTemplatesRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSheetTemplatesRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSheetTemplatesRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSheetTemplatesRespValidationError) ErrorName() string {
+	return "ListSheetTemplatesRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSheetTemplatesRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSheetTemplatesResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSheetTemplatesRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSheetTemplatesRespValidationError{}
+
+// Validate checks the field values on UpdateSheetTemplateReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateSheetTemplateReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateSheetTemplateReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateSheetTemplateReqMultiError, or nil if none found.
+func (m *UpdateSheetTemplateReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateSheetTemplateReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetIdempotencyKey()) < 1 {
+		err := UpdateSheetTemplateReqValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := UpdateSheetTemplateReqValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetDeliveryFee()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateSheetTemplateReqValidationError{
+					field:  "DeliveryFee",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateSheetTemplateReqValidationError{
+					field:  "DeliveryFee",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeliveryFee()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateSheetTemplateReqValidationError{
+				field:  "DeliveryFee",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SetMemberIds
+
+	if all {
+		switch v := interface{}(m.GetRecurrence()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateSheetTemplateReqValidationError{
+					field:  "Recurrence",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateSheetTemplateReqValidationError{
+					field:  "Recurrence",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRecurrence()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateSheetTemplateReqValidationError{
+				field:  "Recurrence",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Name != nil {
+
+		if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 255 {
+			err := UpdateSheetTemplateReqValidationError{
+				field:  "Name",
+				reason: "value length must be between 1 and 255 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Description != nil {
+
+		if utf8.RuneCountInString(m.GetDescription()) > 1000 {
+			err := UpdateSheetTemplateReqValidationError{
+				field:  "Description",
+				reason: "value length must be at most 1000 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.CloseAfterMinutes != nil {
+
+		if m.GetCloseAfterMinutes() < 0 {
+			err := UpdateSheetTemplateReqValidationError{
+				field:  "CloseAfterMinutes",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Paused != nil {
+		// no validation rules for Paused
+	}
+
+	if len(errors) > 0 {
+		return UpdateSheetTemplateReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateSheetTemplateReqMultiError is an error wrapping multiple validation
+// errors returned by UpdateSheetTemplateReq.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateSheetTemplateReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateSheetTemplateReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateSheetTemplateReqMultiError) AllErrors() []error { return m }
+
+// UpdateSheetTemplateReqValidationError is the validation error returned by
+// UpdateSheetTemplateReq.Validate if the designated constraints aren't met.
+type UpdateSheetTemplateReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateSheetTemplateReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateSheetTemplateReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateSheetTemplateReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateSheetTemplateReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateSheetTemplateReqValidationError) ErrorName() string {
+	return "UpdateSheetTemplateReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateSheetTemplateReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateSheetTemplateReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateSheetTemplateReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateSheetTemplateReqValidationError{}
+
+// Validate checks the field values on UpdateSheetTemplateResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateSheetTemplateResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateSheetTemplateResp with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateSheetTemplateRespMultiError, or nil if none found.
+func (m *UpdateSheetTemplateResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateSheetTemplateResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTemplate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateSheetTemplateRespValidationError{
+					field:  "Template",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateSheetTemplateRespValidationError{
+					field:  "Template",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTemplate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateSheetTemplateRespValidationError{
+				field:  "Template",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateSheetTemplateRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateSheetTemplateRespMultiError is an error wrapping multiple validation
+// errors returned by UpdateSheetTemplateResp.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateSheetTemplateRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateSheetTemplateRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateSheetTemplateRespMultiError) AllErrors() []error { return m }
+
+// UpdateSheetTemplateRespValidationError is the validation error returned by
+// UpdateSheetTemplateResp.Validate if the designated constraints aren't met.
+type UpdateSheetTemplateRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateSheetTemplateRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateSheetTemplateRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateSheetTemplateRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateSheetTemplateRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateSheetTemplateRespValidationError) ErrorName() string {
+	return "UpdateSheetTemplateRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateSheetTemplateRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateSheetTemplateResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateSheetTemplateRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateSheetTemplateRespValidationError{}
+
+// Validate checks the field values on DeleteSheetTemplateReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSheetTemplateReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSheetTemplateReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSheetTemplateReqMultiError, or nil if none found.
+func (m *DeleteSheetTemplateReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSheetTemplateReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetIdempotencyKey()) < 1 {
+		err := DeleteSheetTemplateReqValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := DeleteSheetTemplateReqValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteSheetTemplateReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSheetTemplateReqMultiError is an error wrapping multiple validation
+// errors returned by DeleteSheetTemplateReq.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSheetTemplateReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSheetTemplateReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSheetTemplateReqMultiError) AllErrors() []error { return m }
+
+// DeleteSheetTemplateReqValidationError is the validation error returned by
+// DeleteSheetTemplateReq.Validate if the designated constraints aren't met.
+type DeleteSheetTemplateReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSheetTemplateReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSheetTemplateReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSheetTemplateReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSheetTemplateReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSheetTemplateReqValidationError) ErrorName() string {
+	return "DeleteSheetTemplateReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSheetTemplateReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSheetTemplateReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSheetTemplateReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSheetTemplateReqValidationError{}
+
+// Validate checks the field values on DeleteSheetTemplateResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSheetTemplateResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSheetTemplateResp with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSheetTemplateRespMultiError, or nil if none found.
+func (m *DeleteSheetTemplateResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSheetTemplateResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteSheetTemplateRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSheetTemplateRespMultiError is an error wrapping multiple validation
+// errors returned by DeleteSheetTemplateResp.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSheetTemplateRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSheetTemplateRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSheetTemplateRespMultiError) AllErrors() []error { return m }
+
+// DeleteSheetTemplateRespValidationError is the validation error returned by
+// DeleteSheetTemplateResp.Validate if the designated constraints aren't met.
+type DeleteSheetTemplateRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSheetTemplateRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSheetTemplateRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSheetTemplateRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSheetTemplateRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSheetTemplateRespValidationError) ErrorName() string {
+	return "DeleteSheetTemplateRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSheetTemplateRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSheetTemplateResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSheetTemplateRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSheetTemplateRespValidationError{}
@@ -0,0 +1,277 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.30.2
+// source: sheet_templates.proto
+
+package corev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SheetTemplatesService_CreateSheetTemplate_FullMethodName = "/core.v1.SheetTemplatesService/CreateSheetTemplate"
+	SheetTemplatesService_GetSheetTemplate_FullMethodName    = "/core.v1.SheetTemplatesService/GetSheetTemplate"
+	SheetTemplatesService_ListSheetTemplates_FullMethodName  = "/core.v1.SheetTemplatesService/ListSheetTemplates"
+	SheetTemplatesService_UpdateSheetTemplate_FullMethodName = "/core.v1.SheetTemplatesService/UpdateSheetTemplate"
+	SheetTemplatesService_DeleteSheetTemplate_FullMethodName = "/core.v1.SheetTemplatesService/DeleteSheetTemplate"
+)
+
+// SheetTemplatesServiceClient is the client API for SheetTemplatesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SheetTemplatesServiceClient interface {
+	// Save a sheet and its current menu as a recurring template.
+	CreateSheetTemplate(ctx context.Context, in *CreateSheetTemplateReq, opts ...grpc.CallOption) (*CreateSheetTemplateResp, error)
+	GetSheetTemplate(ctx context.Context, in *GetSheetTemplateReq, opts ...grpc.CallOption) (*GetSheetTemplateResp, error)
+	ListSheetTemplates(ctx context.Context, in *ListSheetTemplatesReq, opts ...grpc.CallOption) (*ListSheetTemplatesResp, error)
+	UpdateSheetTemplate(ctx context.Context, in *UpdateSheetTemplateReq, opts ...grpc.CallOption) (*UpdateSheetTemplateResp, error)
+	// Sheets already created from the template are kept.
+	DeleteSheetTemplate(ctx context.Context, in *DeleteSheetTemplateReq, opts ...grpc.CallOption) (*DeleteSheetTemplateResp, error)
+}
+
+type sheetTemplatesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSheetTemplatesServiceClient(cc grpc.ClientConnInterface) SheetTemplatesServiceClient {
+	return &sheetTemplatesServiceClient{cc}
+}
+
+func (c *sheetTemplatesServiceClient) CreateSheetTemplate(ctx context.Context, in *CreateSheetTemplateReq, opts ...grpc.CallOption) (*CreateSheetTemplateResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSheetTemplateResp)
+	err := c.cc.Invoke(ctx, SheetTemplatesService_CreateSheetTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sheetTemplatesServiceClient) GetSheetTemplate(ctx context.Context, in *GetSheetTemplateReq, opts ...grpc.CallOption) (*GetSheetTemplateResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSheetTemplateResp)
+	err := c.cc.Invoke(ctx, SheetTemplatesService_GetSheetTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sheetTemplatesServiceClient) ListSheetTemplates(ctx context.Context, in *ListSheetTemplatesReq, opts ...grpc.CallOption) (*ListSheetTemplatesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSheetTemplatesResp)
+	err := c.cc.Invoke(ctx, SheetTemplatesService_ListSheetTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sheetTemplatesServiceClient) UpdateSheetTemplate(ctx context.Context, in *UpdateSheetTemplateReq, opts ...grpc.CallOption) (*UpdateSheetTemplateResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSheetTemplateResp)
+	err := c.cc.Invoke(ctx, SheetTemplatesService_UpdateSheetTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sheetTemplatesServiceClient) DeleteSheetTemplate(ctx context.Context, in *DeleteSheetTemplateReq, opts ...grpc.CallOption) (*DeleteSheetTemplateResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSheetTemplateResp)
+	err := c.cc.Invoke(ctx, SheetTemplatesService_DeleteSheetTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SheetTemplatesServiceServer is the server API for SheetTemplatesService service.
+// All implementations must embed UnimplementedSheetTemplatesServiceServer
+// for forward compatibility.
+type SheetTemplatesServiceServer interface {
+	// Save a sheet and its current menu as a recurring template.
+	CreateSheetTemplate(context.Context, *CreateSheetTemplateReq) (*CreateSheetTemplateResp, error)
+	GetSheetTemplate(context.Context, *GetSheetTemplateReq) (*GetSheetTemplateResp, error)
+	ListSheetTemplates(context.Context, *ListSheetTemplatesReq) (*ListSheetTemplatesResp, error)
+	UpdateSheetTemplate(context.Context, *UpdateSheetTemplateReq) (*UpdateSheetTemplateResp, error)
+	// Sheets already created from the template are kept.
+	DeleteSheetTemplate(context.Context, *DeleteSheetTemplateReq) (*DeleteSheetTemplateResp, error)
+	mustEmbedUnimplementedSheetTemplatesServiceServer()
+}
+
+// UnimplementedSheetTemplatesServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSheetTemplatesServiceServer struct{}
+
+func (UnimplementedSheetTemplatesServiceServer) CreateSheetTemplate(context.Context, *CreateSheetTemplateReq) (*CreateSheetTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSheetTemplate not implemented")
+}
+func (UnimplementedSheetTemplatesServiceServer) GetSheetTemplate(context.Context, *GetSheetTemplateReq) (*GetSheetTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSheetTemplate not implemented")
+}
+func (UnimplementedSheetTemplatesServiceServer) ListSheetTemplates(context.Context, *ListSheetTemplatesReq) (*ListSheetTemplatesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSheetTemplates not implemented")
+}
+func (UnimplementedSheetTemplatesServiceServer) UpdateSheetTemplate(context.Context, *UpdateSheetTemplateReq) (*UpdateSheetTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSheetTemplate not implemented")
+}
+func (UnimplementedSheetTemplatesServiceServer) DeleteSheetTemplate(context.Context, *DeleteSheetTemplateReq) (*DeleteSheetTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSheetTemplate not implemented")
+}
+func (UnimplementedSheetTemplatesServiceServer) mustEmbedUnimplementedSheetTemplatesServiceServer() {}
+func (UnimplementedSheetTemplatesServiceServer) testEmbeddedByValue()                               {}
+
+// UnsafeSheetTemplatesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SheetTemplatesServiceServer will
+// result in compilation errors.
+type UnsafeSheetTemplatesServiceServer interface {
+	mustEmbedUnimplementedSheetTemplatesServiceServer()
+}
+
+func RegisterSheetTemplatesServiceServer(s grpc.ServiceRegistrar, srv SheetTemplatesServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSheetTemplatesServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SheetTemplatesService_ServiceDesc, srv)
+}
+
+func _SheetTemplatesService_CreateSheetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSheetTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SheetTemplatesServiceServer).CreateSheetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SheetTemplatesService_CreateSheetTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SheetTemplatesServiceServer).CreateSheetTemplate(ctx, req.(*CreateSheetTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SheetTemplatesService_GetSheetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSheetTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SheetTemplatesServiceServer).GetSheetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SheetTemplatesService_GetSheetTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SheetTemplatesServiceServer).GetSheetTemplate(ctx, req.(*GetSheetTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SheetTemplatesService_ListSheetTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSheetTemplatesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SheetTemplatesServiceServer).ListSheetTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SheetTemplatesService_ListSheetTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SheetTemplatesServiceServer).ListSheetTemplates(ctx, req.(*ListSheetTemplatesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SheetTemplatesService_UpdateSheetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSheetTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SheetTemplatesServiceServer).UpdateSheetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SheetTemplatesService_UpdateSheetTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SheetTemplatesServiceServer).UpdateSheetTemplate(ctx, req.(*UpdateSheetTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SheetTemplatesService_DeleteSheetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSheetTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SheetTemplatesServiceServer).DeleteSheetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SheetTemplatesService_DeleteSheetTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SheetTemplatesServiceServer).DeleteSheetTemplate(ctx, req.(*DeleteSheetTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SheetTemplatesService_ServiceDesc is the grpc.ServiceDesc for SheetTemplatesService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SheetTemplatesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "core.v1.SheetTemplatesService",
+	HandlerType: (*SheetTemplatesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateSheetTemplate",
+			Handler:    _SheetTemplatesService_CreateSheetTemplate_Handler,
+		},
+		{
+			MethodName: "GetSheetTemplate",
+			Handler:    _SheetTemplatesService_GetSheetTemplate_Handler,
+		},
+		{
+			MethodName: "ListSheetTemplates",
+			Handler:    _SheetTemplatesService_ListSheetTemplates_Handler,
+		},
+		{
+			MethodName: "UpdateSheetTemplate",
+			Handler:    _SheetTemplatesService_UpdateSheetTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteSheetTemplate",
+			Handler:    _SheetTemplatesService_DeleteSheetTemplate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sheet_templates.proto",
+}
@@ -75,16 +75,172 @@ func (SheetStatus) EnumDescriptor() ([]byte, []int) {
 	return file_sheets_proto_rawDescGZIP(), []int{0}
 }
 
+// How the sheet's delivery fee is divided between participating orders
+type FeeSplitMode int32
+
+const (
+	FeeSplitMode_FEE_SPLIT_MODE_UNSPECIFIED  FeeSplitMode = 0 // treated as equal
+	FeeSplitMode_FEE_SPLIT_MODE_EQUAL        FeeSplitMode = 1
+	FeeSplitMode_FEE_SPLIT_MODE_PROPORTIONAL FeeSplitMode = 2 // proportional to order subtotal
+)
+
+// Enum value maps for FeeSplitMode.
+var (
+	FeeSplitMode_name = map[int32]string{
+		0: "FEE_SPLIT_MODE_UNSPECIFIED",
+		1: "FEE_SPLIT_MODE_EQUAL",
+		2: "FEE_SPLIT_MODE_PROPORTIONAL",
+	}
+	FeeSplitMode_value = map[string]int32{
+		"FEE_SPLIT_MODE_UNSPECIFIED":  0,
+		"FEE_SPLIT_MODE_EQUAL":        1,
+		"FEE_SPLIT_MODE_PROPORTIONAL": 2,
+	}
+)
+
+func (x FeeSplitMode) Enum() *FeeSplitMode {
+	p := new(FeeSplitMode)
+	*p = x
+	return p
+}
+
+func (x FeeSplitMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FeeSplitMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_sheets_proto_enumTypes[1].Descriptor()
+}
+
+func (FeeSplitMode) Type() protoreflect.EnumType {
+	return &file_sheets_proto_enumTypes[1]
+}
+
+func (x FeeSplitMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FeeSplitMode.Descriptor instead.
+func (FeeSplitMode) EnumDescriptor() ([]byte, []int) {
+	return file_sheets_proto_rawDescGZIP(), []int{1}
+}
+
+type MenuImportFormat int32
+
+const (
+	MenuImportFormat_MENU_IMPORT_FORMAT_UNSPECIFIED MenuImportFormat = 0
+	MenuImportFormat_MENU_IMPORT_FORMAT_JSON        MenuImportFormat = 1
+	MenuImportFormat_MENU_IMPORT_FORMAT_CSV         MenuImportFormat = 2
+)
+
+// Enum value maps for MenuImportFormat.
+var (
+	MenuImportFormat_name = map[int32]string{
+		0: "MENU_IMPORT_FORMAT_UNSPECIFIED",
+		1: "MENU_IMPORT_FORMAT_JSON",
+		2: "MENU_IMPORT_FORMAT_CSV",
+	}
+	MenuImportFormat_value = map[string]int32{
+		"MENU_IMPORT_FORMAT_UNSPECIFIED": 0,
+		"MENU_IMPORT_FORMAT_JSON":        1,
+		"MENU_IMPORT_FORMAT_CSV":         2,
+	}
+)
+
+func (x MenuImportFormat) Enum() *MenuImportFormat {
+	p := new(MenuImportFormat)
+	*p = x
+	return p
+}
+
+func (x MenuImportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MenuImportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_sheets_proto_enumTypes[2].Descriptor()
+}
+
+func (MenuImportFormat) Type() protoreflect.EnumType {
+	return &file_sheets_proto_enumTypes[2]
+}
+
+func (x MenuImportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MenuImportFormat.Descriptor instead.
+func (MenuImportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_sheets_proto_rawDescGZIP(), []int{2}
+}
+
+type MenuChangeType int32
+
+const (
+	MenuChangeType_MENU_CHANGE_TYPE_UNSPECIFIED MenuChangeType = 0
+	MenuChangeType_MENU_CHANGE_TYPE_ADDED       MenuChangeType = 1
+	MenuChangeType_MENU_CHANGE_TYPE_REMOVED     MenuChangeType = 2
+	MenuChangeType_MENU_CHANGE_TYPE_CHANGED     MenuChangeType = 3
+)
+
+// Enum value maps for MenuChangeType.
+var (
+	MenuChangeType_name = map[int32]string{
+		0: "MENU_CHANGE_TYPE_UNSPECIFIED",
+		1: "MENU_CHANGE_TYPE_ADDED",
+		2: "MENU_CHANGE_TYPE_REMOVED",
+		3: "MENU_CHANGE_TYPE_CHANGED",
+	}
+	MenuChangeType_value = map[string]int32{
+		"MENU_CHANGE_TYPE_UNSPECIFIED": 0,
+		"MENU_CHANGE_TYPE_ADDED":       1,
+		"MENU_CHANGE_TYPE_REMOVED":     2,
+		"MENU_CHANGE_TYPE_CHANGED":     3,
+	}
+)
+
+func (x MenuChangeType) Enum() *MenuChangeType {
+	p := new(MenuChangeType)
+	*p = x
+	return p
+}
+
+func (x MenuChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MenuChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_sheets_proto_enumTypes[3].Descriptor()
+}
+
+func (MenuChangeType) Type() protoreflect.EnumType {
+	return &file_sheets_proto_enumTypes[3]
+}
+
+func (x MenuChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MenuChangeType.Descriptor instead.
+func (MenuChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_sheets_proto_rawDescGZIP(), []int{3}
+}
+
 type Sheet struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	HostUserId    string                 `protobuf:"bytes,4,opt,name=host_user_id,json=hostUserId,proto3" json:"host_user_id,omitempty"`
-	DeliveryFee   *Money                 `protobuf:"bytes,5,opt,name=delivery_fee,json=deliveryFee,proto3" json:"delivery_fee,omitempty"`
-	Discount      int32                  `protobuf:"varint,6,opt,name=discount,proto3" json:"discount,omitempty"`
-	ActiveMenuId  string                 `protobuf:"bytes,7,opt,name=active_menu_id,json=activeMenuId,proto3" json:"active_menu_id,omitempty"`
-	Status        SheetStatus            `protobuf:"varint,8,opt,name=status,proto3,enum=core.v1.SheetStatus" json:"status,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description  string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	HostUserId   string                 `protobuf:"bytes,4,opt,name=host_user_id,json=hostUserId,proto3" json:"host_user_id,omitempty"`
+	DeliveryFee  *Money                 `protobuf:"bytes,5,opt,name=delivery_fee,json=deliveryFee,proto3" json:"delivery_fee,omitempty"`
+	Discount     int32                  `protobuf:"varint,6,opt,name=discount,proto3" json:"discount,omitempty"` // percentage, 0..100
+	ActiveMenuId string                 `protobuf:"bytes,7,opt,name=active_menu_id,json=activeMenuId,proto3" json:"active_menu_id,omitempty"`
+	Status       SheetStatus            `protobuf:"varint,8,opt,name=status,proto3,enum=core.v1.SheetStatus" json:"status,omitempty"`
+	FeeSplitMode FeeSplitMode           `protobuf:"varint,9,opt,name=fee_split_mode,json=feeSplitMode,proto3,enum=core.v1.FeeSplitMode" json:"fee_split_mode,omitempty"`
+	// The sheet opens for orders at opens_at and closes at closes_at, the
+	// order cutoff. Either may be unset.
+	OpensAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=opens_at,json=opensAt,proto3" json:"opens_at,omitempty"`
+	ClosesAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=closes_at,json=closesAt,proto3" json:"closes_at,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -177,6 +333,27 @@ func (x *Sheet) GetStatus() SheetStatus {
 	return SheetStatus_SHEET_STATUS_UNSPECIFIED
 }
 
+func (x *Sheet) GetFeeSplitMode() FeeSplitMode {
+	if x != nil {
+		return x.FeeSplitMode
+	}
+	return FeeSplitMode_FEE_SPLIT_MODE_UNSPECIFIED
+}
+
+func (x *Sheet) GetOpensAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpensAt
+	}
+	return nil
+}
+
+func (x *Sheet) GetClosesAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosesAt
+	}
+	return nil
+}
+
 func (x *Sheet) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
@@ -195,6 +372,7 @@ type SheetMember struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	SheetId       string                 `protobuf:"bytes,2,opt,name=sheet_id,json=sheetId,proto3" json:"sheet_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"` // "host" | "member"
 	JoinedAt      *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -244,6 +422,13 @@ func (x *SheetMember) GetSheetId() string {
 	return ""
 }
 
+func (x *SheetMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
 func (x *SheetMember) GetJoinedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.JoinedAt
@@ -308,13 +493,20 @@ type CreateSheetReq struct {
 	IdempotencyKey string                 `protobuf:"bytes,1,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	HostUserId     string                 `protobuf:"bytes,4,opt,name=host_user_id,json=hostUserId,proto3" json:"host_user_id,omitempty"`
-	DeliveryFee    *Money                 `protobuf:"bytes,5,opt,name=delivery_fee,json=deliveryFee,proto3" json:"delivery_fee,omitempty"`
-	Discount       int32                  `protobuf:"varint,6,opt,name=discount,proto3" json:"discount,omitempty"`
-	MemberIds      []string               `protobuf:"bytes,7,rep,name=member_ids,json=memberIds,proto3" json:"member_ids,omitempty"`
-	Items          []*MenuItem            `protobuf:"bytes,10,rep,name=items,proto3" json:"items,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Ignored: the host is the authenticated caller.
+	//
+	// Deprecated: Marked as deprecated in sheets.proto.
+	HostUserId   string       `protobuf:"bytes,4,opt,name=host_user_id,json=hostUserId,proto3" json:"host_user_id,omitempty"`
+	DeliveryFee  *Money       `protobuf:"bytes,5,opt,name=delivery_fee,json=deliveryFee,proto3" json:"delivery_fee,omitempty"`
+	Discount     int32        `protobuf:"varint,6,opt,name=discount,proto3" json:"discount,omitempty"`
+	MemberIds    []string     `protobuf:"bytes,7,rep,name=member_ids,json=memberIds,proto3" json:"member_ids,omitempty"`
+	FeeSplitMode FeeSplitMode `protobuf:"varint,8,opt,name=fee_split_mode,json=feeSplitMode,proto3,enum=core.v1.FeeSplitMode" json:"fee_split_mode,omitempty"`
+	Items        []*MenuItem  `protobuf:"bytes,10,rep,name=items,proto3" json:"items,omitempty"`
+	// A sheet with opens_at in the future is created pending
+	OpensAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=opens_at,json=opensAt,proto3" json:"opens_at,omitempty"`
+	ClosesAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=closes_at,json=closesAt,proto3" json:"closes_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateSheetReq) Reset() {
@@ -368,6 +560,7 @@ func (x *CreateSheetReq) GetDescription() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in sheets.proto.
 func (x *CreateSheetReq) GetHostUserId() string {
 	if x != nil {
 		return x.HostUserId
@@ -396,6 +589,13 @@ func (x *CreateSheetReq) GetMemberIds() []string {
 	return nil
 }
 
+func (x *CreateSheetReq) GetFeeSplitMode() FeeSplitMode {
+	if x != nil {
+		return x.FeeSplitMode
+	}
+	return FeeSplitMode_FEE_SPLIT_MODE_UNSPECIFIED
+}
+
 func (x *CreateSheetReq) GetItems() []*MenuItem {
 	if x != nil {
 		return x.Items
@@ -403,6 +603,20 @@ func (x *CreateSheetReq) GetItems() []*MenuItem {
 	return nil
 }
 
+func (x *CreateSheetReq) GetOpensAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpensAt
+	}
+	return nil
+}
+
+func (x *CreateSheetReq) GetClosesAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosesAt
+	}
+	return nil
+}
+
 type CreateSheetResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Sheet         *Sheet                 `protobuf:"bytes,1,opt,name=sheet,proto3" json:"sheet,omitempty"`
@@ -542,6 +756,9 @@ type UpdateSheetReq struct {
 	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
 	ActiveMenuId  *string                `protobuf:"bytes,5,opt,name=active_menu_id,json=activeMenuId,proto3,oneof" json:"active_menu_id,omitempty"`
 	Status        *SheetStatus           `protobuf:"varint,8,opt,name=status,proto3,enum=core.v1.SheetStatus,oneof" json:"status,omitempty"`
+	FeeSplitMode  *FeeSplitMode          `protobuf:"varint,9,opt,name=fee_split_mode,json=feeSplitMode,proto3,enum=core.v1.FeeSplitMode,oneof" json:"fee_split_mode,omitempty"`
+	OpensAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=opens_at,json=opensAt,proto3" json:"opens_at,omitempty"`
+	ClosesAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=closes_at,json=closesAt,proto3" json:"closes_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -611,6 +828,27 @@ func (x *UpdateSheetReq) GetStatus() SheetStatus {
 	return SheetStatus_SHEET_STATUS_UNSPECIFIED
 }
 
+func (x *UpdateSheetReq) GetFeeSplitMode() FeeSplitMode {
+	if x != nil && x.FeeSplitMode != nil {
+		return *x.FeeSplitMode
+	}
+	return FeeSplitMode_FEE_SPLIT_MODE_UNSPECIFIED
+}
+
+func (x *UpdateSheetReq) GetOpensAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpensAt
+	}
+	return nil
+}
+
+func (x *UpdateSheetReq) GetClosesAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosesAt
+	}
+	return nil
+}
+
 type UpdateSheetResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Sheet         *Sheet                 `protobuf:"bytes,1,opt,name=sheet,proto3" json:"sheet,omitempty"`
@@ -771,9 +1009,11 @@ type JoinSheetRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	IdempotencyKey string                 `protobuf:"bytes,1,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 	SheetId        string                 `protobuf:"bytes,2,opt,name=sheet_id,json=sheetId,proto3" json:"sheet_id,omitempty"`
-	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Member to add, the caller when empty. Only the host or an admin may add
+	// members this way; everyone else joins by redeeming an invite.
+	UserId        string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *JoinSheetRequest) Reset() {
@@ -874,7 +1114,7 @@ func (x *JoinSheetResponse) GetMember() *SheetMember {
 type RemoveMemberRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SheetId       string                 `protobuf:"bytes,1,opt,name=sheet_id,json=sheetId,proto3" json:"sheet_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // defaults to the caller; only the host may remove others
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -959,29 +1199,35 @@ func (*RemoveMemberResponse) Descriptor() ([]byte, []int) {
 	return file_sheets_proto_rawDescGZIP(), []int{14}
 }
 
-type ListMembersRequest struct {
+type SheetInvite struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SheetId       string                 `protobuf:"bytes,1,opt,name=sheet_id,json=sheetId,proto3" json:"sheet_id,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Cursor        *Cursor                `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` // e.g. "ABCD-2345"; case, dashes and spaces are ignored when redeeming
+	SheetId       string                 `protobuf:"bytes,2,opt,name=sheet_id,json=sheetId,proto3" json:"sheet_id,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`                       // "member", given to members who join with it
+	MaxUses       int32                  `protobuf:"varint,5,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"` // 0 = unlimited
+	Uses          int32                  `protobuf:"varint,6,opt,name=uses,proto3" json:"uses,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // unset never expires
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMembersRequest) Reset() {
-	*x = ListMembersRequest{}
+func (x *SheetInvite) Reset() {
+	*x = SheetInvite{}
 	mi := &file_sheets_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMembersRequest) String() string {
+func (x *SheetInvite) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMembersRequest) ProtoMessage() {}
+func (*SheetInvite) ProtoMessage() {}
 
-func (x *ListMembersRequest) ProtoReflect() protoreflect.Message {
+func (x *SheetInvite) ProtoReflect() protoreflect.Message {
 	mi := &file_sheets_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -993,54 +1239,99 @@ func (x *ListMembersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMembersRequest.ProtoReflect.Descriptor instead.
-func (*ListMembersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SheetInvite.ProtoReflect.Descriptor instead.
+func (*SheetInvite) Descriptor() ([]byte, []int) {
 	return file_sheets_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ListMembersRequest) GetSheetId() string {
+func (x *SheetInvite) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *SheetInvite) GetSheetId() string {
 	if x != nil {
 		return x.SheetId
 	}
 	return ""
 }
 
-func (x *ListMembersRequest) GetPageSize() int32 {
+func (x *SheetInvite) GetCreatedBy() string {
 	if x != nil {
-		return x.PageSize
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *SheetInvite) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *SheetInvite) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
 	}
 	return 0
 }
 
-func (x *ListMembersRequest) GetCursor() *Cursor {
+func (x *SheetInvite) GetUses() int32 {
 	if x != nil {
-		return x.Cursor
+		return x.Uses
+	}
+	return 0
+}
+
+func (x *SheetInvite) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
 	}
 	return nil
 }
 
-type ListMembersResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Members       []*SheetMember         `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
-	NextCursor    *Cursor                `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SheetInvite) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
 }
 
-func (x *ListMembersResponse) Reset() {
-	*x = ListMembersResponse{}
+func (x *SheetInvite) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateSheetInviteReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IdempotencyKey string                 `protobuf:"bytes,1,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	SheetId        string                 `protobuf:"bytes,2,opt,name=sheet_id,json=sheetId,proto3" json:"sheet_id,omitempty"`
+	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	MaxUses        int32                  `protobuf:"varint,4,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	Role           string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"` // member when empty
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateSheetInviteReq) Reset() {
+	*x = CreateSheetInviteReq{}
 	mi := &file_sheets_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMembersResponse) String() string {
+func (x *CreateSheetInviteReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMembersResponse) ProtoMessage() {}
+func (*CreateSheetInviteReq) ProtoMessage() {}
 
-func (x *ListMembersResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateSheetInviteReq) ProtoReflect() protoreflect.Message {
 	mi := &file_sheets_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1052,52 +1343,67 @@ func (x *ListMembersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMembersResponse.ProtoReflect.Descriptor instead.
-func (*ListMembersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateSheetInviteReq.ProtoReflect.Descriptor instead.
+func (*CreateSheetInviteReq) Descriptor() ([]byte, []int) {
 	return file_sheets_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ListMembersResponse) GetMembers() []*SheetMember {
+func (x *CreateSheetInviteReq) GetIdempotencyKey() string {
 	if x != nil {
-		return x.Members
+		return x.IdempotencyKey
 	}
-	return nil
+	return ""
 }
 
-func (x *ListMembersResponse) GetNextCursor() *Cursor {
+func (x *CreateSheetInviteReq) GetSheetId() string {
 	if x != nil {
-		return x.NextCursor
+		return x.SheetId
+	}
+	return ""
+}
+
+func (x *CreateSheetInviteReq) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
 	}
 	return nil
 }
 
-type MenuItem struct {
-	state       protoimpl.MessageState `protogen:"open.v1"`
-	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Price       *Money                 `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
-	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Available   bool                   `protobuf:"varint,5,opt,name=available,proto3" json:"available,omitempty"`
-	// Option group contains options like bubbles, sugar level, etc.
-	OptionGroups  []*MenuOptionGroup `protobuf:"bytes,10,rep,name=option_groups,json=optionGroups,proto3" json:"option_groups,omitempty"`
+func (x *CreateSheetInviteReq) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *CreateSheetInviteReq) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type CreateSheetInviteResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invite        *SheetInvite           `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MenuItem) Reset() {
-	*x = MenuItem{}
+func (x *CreateSheetInviteResp) Reset() {
+	*x = CreateSheetInviteResp{}
 	mi := &file_sheets_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MenuItem) String() string {
+func (x *CreateSheetInviteResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MenuItem) ProtoMessage() {}
+func (*CreateSheetInviteResp) ProtoMessage() {}
 
-func (x *MenuItem) ProtoReflect() protoreflect.Message {
+func (x *CreateSheetInviteResp) ProtoReflect() protoreflect.Message {
 	mi := &file_sheets_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1109,82 +1415,1151 @@ func (x *MenuItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MenuItem.ProtoReflect.Descriptor instead.
-func (*MenuItem) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateSheetInviteResp.ProtoReflect.Descriptor instead.
+func (*CreateSheetInviteResp) Descriptor() ([]byte, []int) {
 	return file_sheets_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *MenuItem) GetId() string {
+func (x *CreateSheetInviteResp) GetInvite() *SheetInvite {
 	if x != nil {
-		return x.Id
+		return x.Invite
 	}
-	return ""
+	return nil
 }
 
-func (x *MenuItem) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+// Joins the caller; redeeming again as a member uses nothing.
+type RedeemSheetInviteReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MenuItem) GetPrice() *Money {
-	if x != nil {
-		return x.Price
-	}
+func (x *RedeemSheetInviteReq) Reset() {
+	*x = RedeemSheetInviteReq{}
+	mi := &file_sheets_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeemSheetInviteReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemSheetInviteReq) ProtoMessage() {}
+
+func (x *RedeemSheetInviteReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sheets_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemSheetInviteReq.ProtoReflect.Descriptor instead.
+func (*RedeemSheetInviteReq) Descriptor() ([]byte, []int) {
+	return file_sheets_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RedeemSheetInviteReq) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type RedeemSheetInviteResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *SheetMember           `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeemSheetInviteResp) Reset() {
+	*x = RedeemSheetInviteResp{}
+	mi := &file_sheets_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeemSheetInviteResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemSheetInviteResp) ProtoMessage() {}
+
+func (x *RedeemSheetInviteResp) ProtoReflect() protoreflect.Message {
+	mi := &file_sheets_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemSheetInviteResp.ProtoReflect.Descriptor instead.
+func (*RedeemSheetInviteResp) Descriptor() ([]byte, []int) {
+	return file_sheets_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RedeemSheetInviteResp) GetMember() *SheetMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type RevokeSheetInviteReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSheetInviteReq) Reset() {
+	*x = RevokeSheetInviteReq{}
+	mi := &file_sheets_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSheetInviteReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSheetInviteReq) ProtoMessage() {}
+
+func (x *RevokeSheetInviteReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sheets_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSheetInviteReq.ProtoReflect.Descriptor instead.
+func (*RevokeSheetInviteReq) Descriptor() ([]byte, []int) {
+	return file_sheets_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RevokeSheetInviteReq) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type RevokeSheetInviteResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invite        *SheetInvite           `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSheetInviteResp) Reset() {
+	*x = RevokeSheetInviteResp{}
+	mi := &file_sheets_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSheetInviteResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSheetInviteResp) ProtoMessage() {}
+
+func (x *RevokeSheetInviteResp) ProtoReflect() protoreflect.Message {
+	mi := &file_sheets_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSheetInviteResp.ProtoReflect.Descriptor instead.
+func (*RevokeSheetInviteResp) Descriptor() ([]byte, []int) {
+	return file_sheets_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RevokeSheetInviteResp) GetInvite() *SheetInvite {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+type ListMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SheetId       string                 `protobuf:"bytes,1,opt,name=sheet_id,json=sheetId,proto3" json:"sheet_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor        *Cursor                `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMembersRequest) Reset() {
+	*x = ListMembersRequest{}
+	mi := &file_sheets_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMembersRequest) ProtoMessage() {}
+
+func (x *ListMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sheets_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListMembersRequest) Descriptor() ([]byte, []int) {
+	return file_sheets_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListMembersRequest) GetSheetId() string {
+	if x != nil {
+		return x.SheetId
+	}
+	return ""
+}
+
+func (x *ListMembersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMembersRequest) GetCursor() *Cursor {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+type ListMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*SheetMember         `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	NextCursor    *Cursor                `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMembersResponse) Reset() {
+	*x = ListMembersResponse{}
+	mi := &file_sheets_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMembersResponse) ProtoMessage() {}
+
+func (x *ListMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sheets_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListMembersResponse) Descriptor() ([]byte, []int) {
+	return file_sheets_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListMembersResponse) GetMembers() []*SheetMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *ListMembersResponse) GetNextCursor() *Cursor {
+	if x != nil {
+		return x.NextCursor
+	}
+	return nil
+}
+
+type MenuItem struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Price       *Money                 `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Available   bool                   `protobuf:"varint,5,opt,name=available,proto3" json:"available,omitempty"`
+	// Option group contains options like bubbles, sugar level, etc.
+	OptionGroups  []*MenuOptionGroup `protobuf:"bytes,10,rep,name=option_groups,json=optionGroups,proto3" json:"option_groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MenuItem) Reset() {
+	*x = MenuItem{}
+	mi := &file_sheets_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuItem) ProtoMessage() {}
+
+func (x *MenuItem) ProtoReflect() protoreflect.Message {
+	mi := &file_sheets_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuItem.ProtoReflect.Descriptor instead.
+func (*MenuItem) Descriptor() ([]byte, []int) {
+	return file_sheets_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MenuItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MenuItem) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *MenuItem) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *MenuItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MenuItem) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *MenuItem) GetOptionGroups() []*MenuOptionGroup {
+	if x != nil {
+		return x.OptionGroups
+	}
+	return nil
+}
+
+type MenuOptionGroup struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// Selection rules
+	Required      bool          `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`                          // e.g., size must be chosen
+	MultiSelect   bool          `protobuf:"varint,4,opt,name=multi_select,json=multiSelect,proto3" json:"multi_select,omitempty"` // true => can pick many
+	MinSelect     int32         `protobuf:"varint,5,opt,name=min_select,json=minSelect,proto3" json:"min_select,omitempty"`       // 0..n
+	MaxSelect     int32         `protobuf:"varint,6,opt,name=max_select,json=maxSelect,proto3" json:"max_select,omitempty"`       // 1..n
+	Options       []*MenuOption `protobuf:"bytes,10,rep,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MenuOptionGroup) Reset() {
+	*x = MenuOptionGroup{}
+	mi := &file_sheets_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuOptionGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuOptionGroup) ProtoMessage() {}
+
+func (x *MenuOptionGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_sheets_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuOptionGroup.ProtoReflect.Descriptor instead.
+func (*MenuOptionGroup) Descriptor() ([]byte, []int) {
+	return file_sheets_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MenuOptionGroup) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MenuOptionGroup) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *MenuOptionGroup) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *MenuOptionGroup) GetMultiSelect() bool {
+	if x != nil {
+		return x.MultiSelect
+	}
+	return false
+}
+
+func (x *MenuOptionGroup) GetMinSelect() int32 {
+	if x != nil {
+		return x.MinSelect
+	}
+	return 0
+}
+
+func (x *MenuOptionGroup) GetMaxSelect() int32 {
+	if x != nil {
+		return x.MaxSelect
+	}
+	return 0
+}
+
+func (x *MenuOptionGroup) GetOptions() []*MenuOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type MenuOption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	PriceDelta    *Money                 `protobuf:"bytes,3,opt,name=price_delta,json=priceDelta,proto3" json:"price_delta,omitempty"`     // surcharge/discount for ONE unit
+	MaxQuantity   int32                  `protobuf:"varint,4,opt,name=max_quantity,json=maxQuantity,proto3" json:"max_quantity,omitempty"` // 1 if not quantifiable; >1 for “double boba”
+	Available     bool                   `protobuf:"varint,5,opt,name=available,proto3" json:"available,omitempty"`
+	PerOrder      bool                   `protobuf:"varint,6,opt,name=per_order,json=perOrder,proto3" json:"per_order,omitempty"` // charged once per order line instead of once per item
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MenuOption) Reset() {
+	*x = MenuOption{}
+	mi := &file_sheets_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuOption) ProtoMessage() {}
+
+func (x *MenuOption) ProtoReflect() protoreflect.Message {
+	mi := &file_sheets_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuOption.ProtoReflect.Descriptor instead.
+func (*MenuOption) Descriptor() ([]byte, []int) {
+	return file_sheets_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MenuOption) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MenuOption) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *MenuOption) GetPriceDelta() *Money {
+	if x != nil {
+		return x.PriceDelta
+	}
+	return nil
+}
+
+func (x *MenuOption) GetMaxQuantity() int32 {
+	if x != nil {
+		return x.MaxQuantity
+	}
+	return 0
+}
+
+func (x *MenuOption) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *MenuOption) GetPerOrder() bool {
+	if x != nil {
+		return x.PerOrder
+	}
+	return false
+}
+
+type AttachMenuWithPayloadReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IdempotencyKey string                 `protobuf:"bytes,1,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	SheetId        string                 `protobuf:"bytes,2,opt,name=sheet_id,json=sheetId,proto3" json:"sheet_id,omitempty"`
+	// The normalized menu items
+	Items         []*MenuItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachMenuWithPayloadReq) Reset() {
+	*x = AttachMenuWithPayloadReq{}
+	mi := &file_sheets_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachMenuWithPayloadReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachMenuWithPayloadReq) ProtoMessage() {}
+
+func (x *AttachMenuWithPayloadReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sheets_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachMenuWithPayloadReq.ProtoReflect.Descriptor instead.
+func (*AttachMenuWithPayloadReq) Descriptor() ([]byte, []int) {
+	return file_sheets_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AttachMenuWithPayloadReq) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *AttachMenuWithPayloadReq) GetSheetId() string {
+	if x != nil {
+		return x.SheetId
+	}
+	return ""
+}
+
+func (x *AttachMenuWithPayloadReq) GetItems() []*MenuItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type AttachMenuWithPayloadResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*MenuItem            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // stored menu items
+	Sheet         *Sheet                 `protobuf:"bytes,2,opt,name=sheet,proto3" json:"sheet,omitempty"` // sheet with active_menu_id updated
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachMenuWithPayloadResp) Reset() {
+	*x = AttachMenuWithPayloadResp{}
+	mi := &file_sheets_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachMenuWithPayloadResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachMenuWithPayloadResp) ProtoMessage() {}
+
+func (x *AttachMenuWithPayloadResp) ProtoReflect() protoreflect.Message {
+	mi := &file_sheets_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachMenuWithPayloadResp.ProtoReflect.Descriptor instead.
+func (*AttachMenuWithPayloadResp) Descriptor() ([]byte, []int) {
+	return file_sheets_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AttachMenuWithPayloadResp) GetItems() []*MenuItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *AttachMenuWithPayloadResp) GetSheet() *Sheet {
+	if x != nil {
+		return x.Sheet
+	}
+	return nil
+}
+
+type ImportMenuReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IdempotencyKey string                 `protobuf:"bytes,1,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	SheetId        string                 `protobuf:"bytes,2,opt,name=sheet_id,json=sheetId,proto3" json:"sheet_id,omitempty"`
+	Format         MenuImportFormat       `protobuf:"varint,3,opt,name=format,proto3,enum=core.v1.MenuImportFormat" json:"format,omitempty"`
+	Payload        []byte                 `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	DryRun         bool                   `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ImportMenuReq) Reset() {
+	*x = ImportMenuReq{}
+	mi := &file_sheets_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportMenuReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportMenuReq) ProtoMessage() {}
+
+func (x *ImportMenuReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sheets_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportMenuReq.ProtoReflect.Descriptor instead.
+func (*ImportMenuReq) Descriptor() ([]byte, []int) {
+	return file_sheets_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ImportMenuReq) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *ImportMenuReq) GetSheetId() string {
+	if x != nil {
+		return x.SheetId
+	}
+	return ""
+}
+
+func (x *ImportMenuReq) GetFormat() MenuImportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return MenuImportFormat_MENU_IMPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportMenuReq) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ImportMenuReq) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// A rejected row of an imported menu. row is the 1-based item position for
+// JSON and the line number for CSV.
+type MenuImportError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Field         string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MenuImportError) Reset() {
+	*x = MenuImportError{}
+	mi := &file_sheets_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuImportError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuImportError) ProtoMessage() {}
+
+func (x *MenuImportError) ProtoReflect() protoreflect.Message {
+	mi := &file_sheets_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuImportError.ProtoReflect.Descriptor instead.
+func (*MenuImportError) Descriptor() ([]byte, []int) {
+	return file_sheets_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *MenuImportError) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *MenuImportError) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *MenuImportError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImportMenuResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*MenuItem            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // normalized items, without rejected rows
+	Errors        []*MenuImportError     `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	Sheet         *Sheet                 `protobuf:"bytes,3,opt,name=sheet,proto3" json:"sheet,omitempty"` // unset on dry runs
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportMenuResp) Reset() {
+	*x = ImportMenuResp{}
+	mi := &file_sheets_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportMenuResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportMenuResp) ProtoMessage() {}
+
+func (x *ImportMenuResp) ProtoReflect() protoreflect.Message {
+	mi := &file_sheets_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportMenuResp.ProtoReflect.Descriptor instead.
+func (*ImportMenuResp) Descriptor() ([]byte, []int) {
+	return file_sheets_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ImportMenuResp) GetItems() []*MenuItem {
+	if x != nil {
+		return x.Items
+	}
 	return nil
 }
 
-func (x *MenuItem) GetDescription() string {
+func (x *ImportMenuResp) GetErrors() []*MenuImportError {
 	if x != nil {
-		return x.Description
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportMenuResp) GetSheet() *Sheet {
+	if x != nil {
+		return x.Sheet
+	}
+	return nil
+}
+
+type GetMenuReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SheetId       string                 `protobuf:"bytes,1,opt,name=sheet_id,json=sheetId,proto3" json:"sheet_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMenuReq) Reset() {
+	*x = GetMenuReq{}
+	mi := &file_sheets_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMenuReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMenuReq) ProtoMessage() {}
+
+func (x *GetMenuReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sheets_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMenuReq.ProtoReflect.Descriptor instead.
+func (*GetMenuReq) Descriptor() ([]byte, []int) {
+	return file_sheets_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetMenuReq) GetSheetId() string {
+	if x != nil {
+		return x.SheetId
 	}
 	return ""
 }
 
-func (x *MenuItem) GetAvailable() bool {
+type GetMenuResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*MenuItem            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMenuResp) Reset() {
+	*x = GetMenuResp{}
+	mi := &file_sheets_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMenuResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMenuResp) ProtoMessage() {}
+
+func (x *GetMenuResp) ProtoReflect() protoreflect.Message {
+	mi := &file_sheets_proto_msgTypes[33]
 	if x != nil {
-		return x.Available
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMenuResp.ProtoReflect.Descriptor instead.
+func (*GetMenuResp) Descriptor() ([]byte, []int) {
+	return file_sheets_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetMenuResp) GetItems() []*MenuItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// Immutable snapshot of a sheet's menu, created on every attach
+type MenuVersion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SheetId       string                 `protobuf:"bytes,2,opt,name=sheet_id,json=sheetId,proto3" json:"sheet_id,omitempty"`
+	Version       int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	ItemCount     int32                  `protobuf:"varint,4,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`
+	Active        bool                   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"` // the sheet's current menu
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MenuVersion) Reset() {
+	*x = MenuVersion{}
+	mi := &file_sheets_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuVersion) ProtoMessage() {}
+
+func (x *MenuVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_sheets_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuVersion.ProtoReflect.Descriptor instead.
+func (*MenuVersion) Descriptor() ([]byte, []int) {
+	return file_sheets_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *MenuVersion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MenuVersion) GetSheetId() string {
+	if x != nil {
+		return x.SheetId
+	}
+	return ""
+}
+
+func (x *MenuVersion) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *MenuVersion) GetItemCount() int32 {
+	if x != nil {
+		return x.ItemCount
+	}
+	return 0
+}
+
+func (x *MenuVersion) GetActive() bool {
+	if x != nil {
+		return x.Active
 	}
 	return false
 }
 
-func (x *MenuItem) GetOptionGroups() []*MenuOptionGroup {
+func (x *MenuVersion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type MenuItemChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          MenuChangeType         `protobuf:"varint,1,opt,name=type,proto3,enum=core.v1.MenuChangeType" json:"type,omitempty"`
+	ItemId        string                 `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Before        *MenuItem              `protobuf:"bytes,3,opt,name=before,proto3" json:"before,omitempty"`   // unset when added
+	After         *MenuItem              `protobuf:"bytes,4,opt,name=after,proto3" json:"after,omitempty"`     // unset when removed
+	Changes       []string               `protobuf:"bytes,5,rep,name=changes,proto3" json:"changes,omitempty"` // e.g. "price: 30000 -> 35000"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MenuItemChange) Reset() {
+	*x = MenuItemChange{}
+	mi := &file_sheets_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuItemChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuItemChange) ProtoMessage() {}
+
+func (x *MenuItemChange) ProtoReflect() protoreflect.Message {
+	mi := &file_sheets_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuItemChange.ProtoReflect.Descriptor instead.
+func (*MenuItemChange) Descriptor() ([]byte, []int) {
+	return file_sheets_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *MenuItemChange) GetType() MenuChangeType {
+	if x != nil {
+		return x.Type
+	}
+	return MenuChangeType_MENU_CHANGE_TYPE_UNSPECIFIED
+}
+
+func (x *MenuItemChange) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *MenuItemChange) GetBefore() *MenuItem {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *MenuItemChange) GetAfter() *MenuItem {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *MenuItemChange) GetChanges() []string {
 	if x != nil {
-		return x.OptionGroups
+		return x.Changes
 	}
 	return nil
 }
 
-type MenuOptionGroup struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	// Selection rules
-	Required      bool          `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`                          // e.g., size must be chosen
-	MultiSelect   bool          `protobuf:"varint,4,opt,name=multi_select,json=multiSelect,proto3" json:"multi_select,omitempty"` // true => can pick many
-	MinSelect     int32         `protobuf:"varint,5,opt,name=min_select,json=minSelect,proto3" json:"min_select,omitempty"`       // 0..n
-	MaxSelect     int32         `protobuf:"varint,6,opt,name=max_select,json=maxSelect,proto3" json:"max_select,omitempty"`       // 1..n
-	Options       []*MenuOption `protobuf:"bytes,10,rep,name=options,proto3" json:"options,omitempty"`
+type ListMenuVersionsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SheetId       string                 `protobuf:"bytes,1,opt,name=sheet_id,json=sheetId,proto3" json:"sheet_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MenuOptionGroup) Reset() {
-	*x = MenuOptionGroup{}
-	mi := &file_sheets_proto_msgTypes[18]
+func (x *ListMenuVersionsReq) Reset() {
+	*x = ListMenuVersionsReq{}
+	mi := &file_sheets_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MenuOptionGroup) String() string {
+func (x *ListMenuVersionsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MenuOptionGroup) ProtoMessage() {}
+func (*ListMenuVersionsReq) ProtoMessage() {}
 
-func (x *MenuOptionGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_sheets_proto_msgTypes[18]
+func (x *ListMenuVersionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sheets_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1195,86 +2570,86 @@ func (x *MenuOptionGroup) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MenuOptionGroup.ProtoReflect.Descriptor instead.
-func (*MenuOptionGroup) Descriptor() ([]byte, []int) {
-	return file_sheets_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ListMenuVersionsReq.ProtoReflect.Descriptor instead.
+func (*ListMenuVersionsReq) Descriptor() ([]byte, []int) {
+	return file_sheets_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *MenuOptionGroup) GetId() string {
+func (x *ListMenuVersionsReq) GetSheetId() string {
 	if x != nil {
-		return x.Id
+		return x.SheetId
 	}
 	return ""
 }
 
-func (x *MenuOptionGroup) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+type ListMenuVersionsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Versions      []*MenuVersion         `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MenuOptionGroup) GetRequired() bool {
-	if x != nil {
-		return x.Required
-	}
-	return false
+func (x *ListMenuVersionsResp) Reset() {
+	*x = ListMenuVersionsResp{}
+	mi := &file_sheets_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *MenuOptionGroup) GetMultiSelect() bool {
-	if x != nil {
-		return x.MultiSelect
-	}
-	return false
+func (x *ListMenuVersionsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *MenuOptionGroup) GetMinSelect() int32 {
+func (*ListMenuVersionsResp) ProtoMessage() {}
+
+func (x *ListMenuVersionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_sheets_proto_msgTypes[37]
 	if x != nil {
-		return x.MinSelect
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *MenuOptionGroup) GetMaxSelect() int32 {
-	if x != nil {
-		return x.MaxSelect
-	}
-	return 0
+// Deprecated: Use ListMenuVersionsResp.ProtoReflect.Descriptor instead.
+func (*ListMenuVersionsResp) Descriptor() ([]byte, []int) {
+	return file_sheets_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *MenuOptionGroup) GetOptions() []*MenuOption {
+func (x *ListMenuVersionsResp) GetVersions() []*MenuVersion {
 	if x != nil {
-		return x.Options
+		return x.Versions
 	}
 	return nil
 }
 
-type MenuOption struct {
+type DiffMenuVersionsReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	PriceDelta    *Money                 `protobuf:"bytes,3,opt,name=price_delta,json=priceDelta,proto3" json:"price_delta,omitempty"`     // surcharge/discount for ONE unit
-	MaxQuantity   int32                  `protobuf:"varint,4,opt,name=max_quantity,json=maxQuantity,proto3" json:"max_quantity,omitempty"` // 1 if not quantifiable; >1 for “double boba”
-	Available     bool                   `protobuf:"varint,5,opt,name=available,proto3" json:"available,omitempty"`
+	SheetId       string                 `protobuf:"bytes,1,opt,name=sheet_id,json=sheetId,proto3" json:"sheet_id,omitempty"`
+	FromMenuId    string                 `protobuf:"bytes,2,opt,name=from_menu_id,json=fromMenuId,proto3" json:"from_menu_id,omitempty"`
+	ToMenuId      string                 `protobuf:"bytes,3,opt,name=to_menu_id,json=toMenuId,proto3" json:"to_menu_id,omitempty"` // defaults to the active menu
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MenuOption) Reset() {
-	*x = MenuOption{}
-	mi := &file_sheets_proto_msgTypes[19]
+func (x *DiffMenuVersionsReq) Reset() {
+	*x = DiffMenuVersionsReq{}
+	mi := &file_sheets_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MenuOption) String() string {
+func (x *DiffMenuVersionsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MenuOption) ProtoMessage() {}
+func (*DiffMenuVersionsReq) ProtoMessage() {}
 
-func (x *MenuOption) ProtoReflect() protoreflect.Message {
-	mi := &file_sheets_proto_msgTypes[19]
+func (x *DiffMenuVersionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sheets_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1285,71 +2660,56 @@ func (x *MenuOption) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MenuOption.ProtoReflect.Descriptor instead.
-func (*MenuOption) Descriptor() ([]byte, []int) {
-	return file_sheets_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use DiffMenuVersionsReq.ProtoReflect.Descriptor instead.
+func (*DiffMenuVersionsReq) Descriptor() ([]byte, []int) {
+	return file_sheets_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *MenuOption) GetId() string {
+func (x *DiffMenuVersionsReq) GetSheetId() string {
 	if x != nil {
-		return x.Id
+		return x.SheetId
 	}
 	return ""
 }
 
-func (x *MenuOption) GetTitle() string {
+func (x *DiffMenuVersionsReq) GetFromMenuId() string {
 	if x != nil {
-		return x.Title
+		return x.FromMenuId
 	}
 	return ""
 }
 
-func (x *MenuOption) GetPriceDelta() *Money {
-	if x != nil {
-		return x.PriceDelta
-	}
-	return nil
-}
-
-func (x *MenuOption) GetMaxQuantity() int32 {
-	if x != nil {
-		return x.MaxQuantity
-	}
-	return 0
-}
-
-func (x *MenuOption) GetAvailable() bool {
+func (x *DiffMenuVersionsReq) GetToMenuId() string {
 	if x != nil {
-		return x.Available
+		return x.ToMenuId
 	}
-	return false
+	return ""
 }
 
-type AttachMenuWithPayloadReq struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	IdempotencyKey string                 `protobuf:"bytes,1,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
-	SheetId        string                 `protobuf:"bytes,2,opt,name=sheet_id,json=sheetId,proto3" json:"sheet_id,omitempty"`
-	// The normalized menu items
-	Items         []*MenuItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+type DiffMenuVersionsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *MenuVersion           `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *MenuVersion           `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Changes       []*MenuItemChange      `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AttachMenuWithPayloadReq) Reset() {
-	*x = AttachMenuWithPayloadReq{}
-	mi := &file_sheets_proto_msgTypes[20]
+func (x *DiffMenuVersionsResp) Reset() {
+	*x = DiffMenuVersionsResp{}
+	mi := &file_sheets_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AttachMenuWithPayloadReq) String() string {
+func (x *DiffMenuVersionsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AttachMenuWithPayloadReq) ProtoMessage() {}
+func (*DiffMenuVersionsResp) ProtoMessage() {}
 
-func (x *AttachMenuWithPayloadReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sheets_proto_msgTypes[20]
+func (x *DiffMenuVersionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_sheets_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1360,55 +2720,60 @@ func (x *AttachMenuWithPayloadReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AttachMenuWithPayloadReq.ProtoReflect.Descriptor instead.
-func (*AttachMenuWithPayloadReq) Descriptor() ([]byte, []int) {
-	return file_sheets_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use DiffMenuVersionsResp.ProtoReflect.Descriptor instead.
+func (*DiffMenuVersionsResp) Descriptor() ([]byte, []int) {
+	return file_sheets_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *AttachMenuWithPayloadReq) GetIdempotencyKey() string {
+func (x *DiffMenuVersionsResp) GetFrom() *MenuVersion {
 	if x != nil {
-		return x.IdempotencyKey
+		return x.From
 	}
-	return ""
+	return nil
 }
 
-func (x *AttachMenuWithPayloadReq) GetSheetId() string {
+func (x *DiffMenuVersionsResp) GetTo() *MenuVersion {
 	if x != nil {
-		return x.SheetId
+		return x.To
 	}
-	return ""
+	return nil
 }
 
-func (x *AttachMenuWithPayloadReq) GetItems() []*MenuItem {
+func (x *DiffMenuVersionsResp) GetChanges() []*MenuItemChange {
 	if x != nil {
-		return x.Items
+		return x.Changes
 	}
 	return nil
 }
 
-type AttachMenuWithPayloadResp struct {
+type SettlementLine struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*MenuItem            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // stored menu items
-	Sheet         *Sheet                 `protobuf:"bytes,2,opt,name=sheet,proto3" json:"sheet,omitempty"` // sheet with active_menu_id updated
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsHost        bool                   `protobuf:"varint,2,opt,name=is_host,json=isHost,proto3" json:"is_host,omitempty"`
+	OrderCount    int32                  `protobuf:"varint,3,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty"`
+	Subtotal      *Money                 `protobuf:"bytes,4,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	FeeShare      *Money                 `protobuf:"bytes,5,opt,name=fee_share,json=feeShare,proto3" json:"fee_share,omitempty"`
+	DiscountShare *Money                 `protobuf:"bytes,6,opt,name=discount_share,json=discountShare,proto3" json:"discount_share,omitempty"`
+	Total         *Money                 `protobuf:"bytes,7,opt,name=total,proto3" json:"total,omitempty"` // owed to the host; the host's own share on the host line
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AttachMenuWithPayloadResp) Reset() {
-	*x = AttachMenuWithPayloadResp{}
-	mi := &file_sheets_proto_msgTypes[21]
+func (x *SettlementLine) Reset() {
+	*x = SettlementLine{}
+	mi := &file_sheets_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AttachMenuWithPayloadResp) String() string {
+func (x *SettlementLine) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AttachMenuWithPayloadResp) ProtoMessage() {}
+func (*SettlementLine) ProtoMessage() {}
 
-func (x *AttachMenuWithPayloadResp) ProtoReflect() protoreflect.Message {
-	mi := &file_sheets_proto_msgTypes[21]
+func (x *SettlementLine) ProtoReflect() protoreflect.Message {
+	mi := &file_sheets_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1419,47 +2784,82 @@ func (x *AttachMenuWithPayloadResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AttachMenuWithPayloadResp.ProtoReflect.Descriptor instead.
-func (*AttachMenuWithPayloadResp) Descriptor() ([]byte, []int) {
-	return file_sheets_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use SettlementLine.ProtoReflect.Descriptor instead.
+func (*SettlementLine) Descriptor() ([]byte, []int) {
+	return file_sheets_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *AttachMenuWithPayloadResp) GetItems() []*MenuItem {
+func (x *SettlementLine) GetUserId() string {
 	if x != nil {
-		return x.Items
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SettlementLine) GetIsHost() bool {
+	if x != nil {
+		return x.IsHost
+	}
+	return false
+}
+
+func (x *SettlementLine) GetOrderCount() int32 {
+	if x != nil {
+		return x.OrderCount
+	}
+	return 0
+}
+
+func (x *SettlementLine) GetSubtotal() *Money {
+	if x != nil {
+		return x.Subtotal
 	}
 	return nil
 }
 
-func (x *AttachMenuWithPayloadResp) GetSheet() *Sheet {
+func (x *SettlementLine) GetFeeShare() *Money {
 	if x != nil {
-		return x.Sheet
+		return x.FeeShare
 	}
 	return nil
 }
 
-type GetMenuReq struct {
+func (x *SettlementLine) GetDiscountShare() *Money {
+	if x != nil {
+		return x.DiscountShare
+	}
+	return nil
+}
+
+func (x *SettlementLine) GetTotal() *Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+type GetSheetSettlementReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SheetId       string                 `protobuf:"bytes,1,opt,name=sheet_id,json=sheetId,proto3" json:"sheet_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMenuReq) Reset() {
-	*x = GetMenuReq{}
-	mi := &file_sheets_proto_msgTypes[22]
+func (x *GetSheetSettlementReq) Reset() {
+	*x = GetSheetSettlementReq{}
+	mi := &file_sheets_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMenuReq) String() string {
+func (x *GetSheetSettlementReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMenuReq) ProtoMessage() {}
+func (*GetSheetSettlementReq) ProtoMessage() {}
 
-func (x *GetMenuReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sheets_proto_msgTypes[22]
+func (x *GetSheetSettlementReq) ProtoReflect() protoreflect.Message {
+	mi := &file_sheets_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1470,40 +2870,49 @@ func (x *GetMenuReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMenuReq.ProtoReflect.Descriptor instead.
-func (*GetMenuReq) Descriptor() ([]byte, []int) {
-	return file_sheets_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use GetSheetSettlementReq.ProtoReflect.Descriptor instead.
+func (*GetSheetSettlementReq) Descriptor() ([]byte, []int) {
+	return file_sheets_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GetMenuReq) GetSheetId() string {
+func (x *GetSheetSettlementReq) GetSheetId() string {
 	if x != nil {
 		return x.SheetId
 	}
 	return ""
 }
 
-type GetMenuResp struct {
+type GetSheetSettlementResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*MenuItem            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	SheetId       string                 `protobuf:"bytes,1,opt,name=sheet_id,json=sheetId,proto3" json:"sheet_id,omitempty"`
+	HostUserId    string                 `protobuf:"bytes,2,opt,name=host_user_id,json=hostUserId,proto3" json:"host_user_id,omitempty"`
+	Lines         []*SettlementLine      `protobuf:"bytes,3,rep,name=lines,proto3" json:"lines,omitempty"`
+	Subtotal      *Money                 `protobuf:"bytes,4,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	FeeTotal      *Money                 `protobuf:"bytes,5,opt,name=fee_total,json=feeTotal,proto3" json:"fee_total,omitempty"`
+	DiscountTotal *Money                 `protobuf:"bytes,6,opt,name=discount_total,json=discountTotal,proto3" json:"discount_total,omitempty"`
+	Total         *Money                 `protobuf:"bytes,7,opt,name=total,proto3" json:"total,omitempty"`
+	OwedToHost    *Money                 `protobuf:"bytes,8,opt,name=owed_to_host,json=owedToHost,proto3" json:"owed_to_host,omitempty"` // total minus the host's own share
+	// true while the sheet is not closed and orders may still change
+	Provisional   bool `protobuf:"varint,9,opt,name=provisional,proto3" json:"provisional,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMenuResp) Reset() {
-	*x = GetMenuResp{}
-	mi := &file_sheets_proto_msgTypes[23]
+func (x *GetSheetSettlementResp) Reset() {
+	*x = GetSheetSettlementResp{}
+	mi := &file_sheets_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMenuResp) String() string {
+func (x *GetSheetSettlementResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMenuResp) ProtoMessage() {}
+func (*GetSheetSettlementResp) ProtoMessage() {}
 
-func (x *GetMenuResp) ProtoReflect() protoreflect.Message {
-	mi := &file_sheets_proto_msgTypes[23]
+func (x *GetSheetSettlementResp) ProtoReflect() protoreflect.Message {
+	mi := &file_sheets_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1514,23 +2923,79 @@ func (x *GetMenuResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMenuResp.ProtoReflect.Descriptor instead.
-func (*GetMenuResp) Descriptor() ([]byte, []int) {
-	return file_sheets_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use GetSheetSettlementResp.ProtoReflect.Descriptor instead.
+func (*GetSheetSettlementResp) Descriptor() ([]byte, []int) {
+	return file_sheets_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *GetMenuResp) GetItems() []*MenuItem {
+func (x *GetSheetSettlementResp) GetSheetId() string {
 	if x != nil {
-		return x.Items
+		return x.SheetId
+	}
+	return ""
+}
+
+func (x *GetSheetSettlementResp) GetHostUserId() string {
+	if x != nil {
+		return x.HostUserId
+	}
+	return ""
+}
+
+func (x *GetSheetSettlementResp) GetLines() []*SettlementLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *GetSheetSettlementResp) GetSubtotal() *Money {
+	if x != nil {
+		return x.Subtotal
+	}
+	return nil
+}
+
+func (x *GetSheetSettlementResp) GetFeeTotal() *Money {
+	if x != nil {
+		return x.FeeTotal
+	}
+	return nil
+}
+
+func (x *GetSheetSettlementResp) GetDiscountTotal() *Money {
+	if x != nil {
+		return x.DiscountTotal
+	}
+	return nil
+}
+
+func (x *GetSheetSettlementResp) GetTotal() *Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *GetSheetSettlementResp) GetOwedToHost() *Money {
+	if x != nil {
+		return x.OwedToHost
 	}
 	return nil
 }
 
+func (x *GetSheetSettlementResp) GetProvisional() bool {
+	if x != nil {
+		return x.Provisional
+	}
+	return false
+}
+
 var File_sheets_proto protoreflect.FileDescriptor
 
 const file_sheets_proto_rawDesc = "" +
 	"\n" +
-	"\fsheets.proto\x12\acore.v1\x1a\fcommon.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\x88\x03\n" +
+	"\fsheets.proto\x12\acore.v1\x1a\fcommon.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\xb5\x04\n" +
 	"\x05Sheet\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -1540,48 +3005,62 @@ const file_sheets_proto_rawDesc = "" +
 	"\fdelivery_fee\x18\x05 \x01(\v2\x0e.core.v1.MoneyR\vdeliveryFee\x12\x1a\n" +
 	"\bdiscount\x18\x06 \x01(\x05R\bdiscount\x12$\n" +
 	"\x0eactive_menu_id\x18\a \x01(\tR\factiveMenuId\x12,\n" +
-	"\x06status\x18\b \x01(\x0e2\x14.core.v1.SheetStatusR\x06status\x129\n" +
+	"\x06status\x18\b \x01(\x0e2\x14.core.v1.SheetStatusR\x06status\x12;\n" +
+	"\x0efee_split_mode\x18\t \x01(\x0e2\x15.core.v1.FeeSplitModeR\ffeeSplitMode\x125\n" +
+	"\bopens_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\aopensAt\x127\n" +
+	"\tcloses_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\bclosesAt\x129\n" +
 	"\n" +
 	"created_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"z\n" +
+	"updated_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x8e\x01\n" +
 	"\vSheetMember\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
-	"\bsheet_id\x18\x02 \x01(\tR\asheetId\x127\n" +
+	"\bsheet_id\x18\x02 \x01(\tR\asheetId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x127\n" +
 	"\tjoined_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\bjoinedAt\"U\n" +
 	"\x10ListSheetsFilter\x12\"\n" +
 	"\rowner_user_id\x18\x01 \x01(\tR\vownerUserId\x12\x1d\n" +
 	"\n" +
-	"name_query\x18\x02 \x01(\tR\tnameQuery\"\xea\x02\n" +
+	"name_query\x18\x02 \x01(\tR\tnameQuery\"\xa3\x04\n" +
 	"\x0eCreateSheetReq\x120\n" +
 	"\x0fidempotency_key\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x0eidempotencyKey\x12\x1b\n" +
 	"\x04name\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04name\x12*\n" +
-	"\vdescription\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xe8\aR\vdescription\x12)\n" +
-	"\fhost_user_id\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xe8\aR\vdescription\x12$\n" +
+	"\fhost_user_id\x18\x04 \x01(\tB\x02\x18\x01R\n" +
 	"hostUserId\x121\n" +
-	"\fdelivery_fee\x18\x05 \x01(\v2\x0e.core.v1.MoneyR\vdeliveryFee\x12#\n" +
-	"\bdiscount\x18\x06 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\bdiscount\x12'\n" +
+	"\fdelivery_fee\x18\x05 \x01(\v2\x0e.core.v1.MoneyR\vdeliveryFee\x12%\n" +
+	"\bdiscount\x18\x06 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\bdiscount\x12)\n" +
 	"\n" +
-	"member_ids\x18\a \x03(\tB\b\xfaB\x05\x92\x01\x02\b\x00R\tmemberIds\x121\n" +
+	"member_ids\x18\a \x03(\tB\n" +
+	"\xfaB\a\x92\x01\x04\b\x00\x10dR\tmemberIds\x12E\n" +
+	"\x0efee_split_mode\x18\b \x01(\x0e2\x15.core.v1.FeeSplitModeB\b\xfaB\x05\x82\x01\x02\x10\x01R\ffeeSplitMode\x124\n" +
 	"\x05items\x18\n" +
-	" \x03(\v2\x11.core.v1.MenuItemB\b\xfaB\x05\x92\x01\x02\b\x00R\x05items\"7\n" +
+	" \x03(\v2\x11.core.v1.MenuItemB\v\xfaB\b\x92\x01\x05\b\x00\x10\xac\x02R\x05items\x125\n" +
+	"\bopens_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\aopensAt\x127\n" +
+	"\tcloses_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\bclosesAt\"7\n" +
 	"\x0fCreateSheetResp\x12$\n" +
 	"\x05sheet\x18\x01 \x01(\v2\x0e.core.v1.SheetR\x05sheet\"&\n" +
 	"\vGetSheetReq\x12\x17\n" +
 	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\"4\n" +
 	"\fGetSheetResp\x12$\n" +
-	"\x05sheet\x18\x01 \x01(\v2\x0e.core.v1.SheetR\x05sheet\"\x94\x02\n" +
+	"\x05sheet\x18\x01 \x01(\v2\x0e.core.v1.SheetR\x05sheet\"\xe3\x03\n" +
 	"\x0eUpdateSheetReq\x12\x17\n" +
 	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\x12#\n" +
 	"\x04name\x18\x03 \x01(\tB\n" +
 	"\xfaB\ar\x05\x10\x01\x18\xff\x01H\x00R\x04name\x88\x01\x01\x12/\n" +
 	"\vdescription\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\xe8\aH\x01R\vdescription\x88\x01\x01\x12)\n" +
 	"\x0eactive_menu_id\x18\x05 \x01(\tH\x02R\factiveMenuId\x88\x01\x01\x121\n" +
-	"\x06status\x18\b \x01(\x0e2\x14.core.v1.SheetStatusH\x03R\x06status\x88\x01\x01B\a\n" +
+	"\x06status\x18\b \x01(\x0e2\x14.core.v1.SheetStatusH\x03R\x06status\x88\x01\x01\x12J\n" +
+	"\x0efee_split_mode\x18\t \x01(\x0e2\x15.core.v1.FeeSplitModeB\b\xfaB\x05\x82\x01\x02\x10\x01H\x04R\ffeeSplitMode\x88\x01\x01\x125\n" +
+	"\bopens_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\aopensAt\x127\n" +
+	"\tcloses_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\bclosesAtB\a\n" +
 	"\x05_nameB\x0e\n" +
 	"\f_descriptionB\x11\n" +
 	"\x0f_active_menu_idB\t\n" +
-	"\a_status\"7\n" +
+	"\a_statusB\x11\n" +
+	"\x0f_fee_split_mode\"7\n" +
 	"\x0fUpdateSheetResp\x12$\n" +
 	"\x05sheet\x18\x01 \x01(\v2\x0e.core.v1.SheetR\x05sheet\"\x93\x01\n" +
 	"\rListSheetsReq\x12&\n" +
@@ -1592,17 +3071,49 @@ const file_sheets_proto_rawDesc = "" +
 	"\x06sheets\x18\x01 \x03(\v2\x0e.core.v1.SheetR\x06sheets\x125\n" +
 	"\vnext_cursor\x18\x02 \x01(\v2\x0f.core.v1.CursorH\x00R\n" +
 	"nextCursor\x88\x01\x01B\x0e\n" +
-	"\f_next_cursor\"\x8a\x01\n" +
+	"\f_next_cursor\"\x81\x01\n" +
 	"\x10JoinSheetRequest\x120\n" +
 	"\x0fidempotency_key\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x0eidempotencyKey\x12\"\n" +
-	"\bsheet_id\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\asheetId\x12 \n" +
-	"\auser_id\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06userId\"A\n" +
+	"\bsheet_id\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\asheetId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"A\n" +
 	"\x11JoinSheetResponse\x12,\n" +
-	"\x06member\x18\x01 \x01(\v2\x14.core.v1.SheetMemberR\x06member\"[\n" +
+	"\x06member\x18\x01 \x01(\v2\x14.core.v1.SheetMemberR\x06member\"R\n" +
 	"\x13RemoveMemberRequest\x12\"\n" +
-	"\bsheet_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\asheetId\x12 \n" +
-	"\auser_id\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06userId\"\x16\n" +
-	"\x14RemoveMemberResponse\"\x89\x01\n" +
+	"\bsheet_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\asheetId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x16\n" +
+	"\x14RemoveMemberResponse\"\xcf\x02\n" +
+	"\vSheetInvite\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x19\n" +
+	"\bsheet_id\x18\x02 \x01(\tR\asheetId\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x03 \x01(\tR\tcreatedBy\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12\x19\n" +
+	"\bmax_uses\x18\x05 \x01(\x05R\amaxUses\x12\x12\n" +
+	"\x04uses\x18\x06 \x01(\x05R\x04uses\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"revoked_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\x129\n" +
+	"\n" +
+	"created_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xf0\x01\n" +
+	"\x14CreateSheetInviteReq\x120\n" +
+	"\x0fidempotency_key\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x0eidempotencyKey\x12\"\n" +
+	"\bsheet_id\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\asheetId\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\"\n" +
+	"\bmax_uses\x18\x04 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\amaxUses\x12#\n" +
+	"\x04role\x18\x05 \x01(\tB\x0f\xfaB\fr\n" +
+	"R\x00R\x06memberR\x04role\"E\n" +
+	"\x15CreateSheetInviteResp\x12,\n" +
+	"\x06invite\x18\x01 \x01(\v2\x14.core.v1.SheetInviteR\x06invite\"5\n" +
+	"\x14RedeemSheetInviteReq\x12\x1d\n" +
+	"\x04code\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18 R\x04code\"E\n" +
+	"\x15RedeemSheetInviteResp\x12,\n" +
+	"\x06member\x18\x01 \x01(\v2\x14.core.v1.SheetMemberR\x06member\"5\n" +
+	"\x14RevokeSheetInviteReq\x12\x1d\n" +
+	"\x04code\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18 R\x04code\"E\n" +
+	"\x15RevokeSheetInviteResp\x12,\n" +
+	"\x06invite\x18\x01 \x01(\v2\x14.core.v1.SheetInviteR\x06invite\"\x89\x01\n" +
 	"\x12ListMembersRequest\x12\"\n" +
 	"\bsheet_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\asheetId\x12&\n" +
 	"\tpage_size\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x01R\bpageSize\x12'\n" +
@@ -1630,7 +3141,7 @@ const file_sheets_proto_rawDesc = "" +
 	"\n" +
 	"max_select\x18\x06 \x01(\x05R\tmaxSelect\x12-\n" +
 	"\aoptions\x18\n" +
-	" \x03(\v2\x13.core.v1.MenuOptionR\aoptions\"\xbf\x01\n" +
+	" \x03(\v2\x13.core.v1.MenuOptionR\aoptions\"\xdc\x01\n" +
 	"\n" +
 	"MenuOption\x12\x17\n" +
 	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\x12\x1d\n" +
@@ -1638,24 +3149,105 @@ const file_sheets_proto_rawDesc = "" +
 	"\vprice_delta\x18\x03 \x01(\v2\x0e.core.v1.MoneyR\n" +
 	"priceDelta\x12*\n" +
 	"\fmax_quantity\x18\x04 \x01(\x05B\a\xfaB\x04\x1a\x02(\x01R\vmaxQuantity\x12\x1c\n" +
-	"\tavailable\x18\x05 \x01(\bR\tavailable\"\xa3\x01\n" +
+	"\tavailable\x18\x05 \x01(\bR\tavailable\x12\x1b\n" +
+	"\tper_order\x18\x06 \x01(\bR\bperOrder\"\xa6\x01\n" +
 	"\x18AttachMenuWithPayloadReq\x120\n" +
 	"\x0fidempotency_key\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x0eidempotencyKey\x12\"\n" +
-	"\bsheet_id\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\asheetId\x121\n" +
-	"\x05items\x18\x03 \x03(\v2\x11.core.v1.MenuItemB\b\xfaB\x05\x92\x01\x02\b\x01R\x05items\"j\n" +
+	"\bsheet_id\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\asheetId\x124\n" +
+	"\x05items\x18\x03 \x03(\v2\x11.core.v1.MenuItemB\v\xfaB\b\x92\x01\x05\b\x01\x10\xac\x02R\x05items\"j\n" +
 	"\x19AttachMenuWithPayloadResp\x12'\n" +
 	"\x05items\x18\x01 \x03(\v2\x11.core.v1.MenuItemR\x05items\x12$\n" +
-	"\x05sheet\x18\x02 \x01(\v2\x0e.core.v1.SheetR\x05sheet\"0\n" +
+	"\x05sheet\x18\x02 \x01(\v2\x0e.core.v1.SheetR\x05sheet\"\xdb\x01\n" +
+	"\rImportMenuReq\x12'\n" +
+	"\x0fidempotency_key\x18\x01 \x01(\tR\x0eidempotencyKey\x12\"\n" +
+	"\bsheet_id\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\asheetId\x12=\n" +
+	"\x06format\x18\x03 \x01(\x0e2\x19.core.v1.MenuImportFormatB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\x06format\x12%\n" +
+	"\apayload\x18\x04 \x01(\fB\v\xfaB\bz\x06\x10\x01\x18\x80\x80@R\apayload\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRun\"S\n" +
+	"\x0fMenuImportError\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\x91\x01\n" +
+	"\x0eImportMenuResp\x12'\n" +
+	"\x05items\x18\x01 \x03(\v2\x11.core.v1.MenuItemR\x05items\x120\n" +
+	"\x06errors\x18\x02 \x03(\v2\x18.core.v1.MenuImportErrorR\x06errors\x12$\n" +
+	"\x05sheet\x18\x03 \x01(\v2\x0e.core.v1.SheetR\x05sheet\"0\n" +
 	"\n" +
 	"GetMenuReq\x12\"\n" +
 	"\bsheet_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\asheetId\"6\n" +
 	"\vGetMenuResp\x12'\n" +
-	"\x05items\x18\x01 \x03(\v2\x11.core.v1.MenuItemR\x05items*u\n" +
+	"\x05items\x18\x01 \x03(\v2\x11.core.v1.MenuItemR\x05items\"\xc4\x01\n" +
+	"\vMenuVersion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bsheet_id\x18\x02 \x01(\tR\asheetId\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversion\x12\x1d\n" +
+	"\n" +
+	"item_count\x18\x04 \x01(\x05R\titemCount\x12\x16\n" +
+	"\x06active\x18\x05 \x01(\bR\x06active\x129\n" +
+	"\n" +
+	"created_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xc4\x01\n" +
+	"\x0eMenuItemChange\x12+\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x17.core.v1.MenuChangeTypeR\x04type\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\tR\x06itemId\x12)\n" +
+	"\x06before\x18\x03 \x01(\v2\x11.core.v1.MenuItemR\x06before\x12'\n" +
+	"\x05after\x18\x04 \x01(\v2\x11.core.v1.MenuItemR\x05after\x12\x18\n" +
+	"\achanges\x18\x05 \x03(\tR\achanges\"9\n" +
+	"\x13ListMenuVersionsReq\x12\"\n" +
+	"\bsheet_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\asheetId\"H\n" +
+	"\x14ListMenuVersionsResp\x120\n" +
+	"\bversions\x18\x01 \x03(\v2\x14.core.v1.MenuVersionR\bversions\"\x82\x01\n" +
+	"\x13DiffMenuVersionsReq\x12\"\n" +
+	"\bsheet_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\asheetId\x12)\n" +
+	"\ffrom_menu_id\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"fromMenuId\x12\x1c\n" +
+	"\n" +
+	"to_menu_id\x18\x03 \x01(\tR\btoMenuId\"\x99\x01\n" +
+	"\x14DiffMenuVersionsResp\x12(\n" +
+	"\x04from\x18\x01 \x01(\v2\x14.core.v1.MenuVersionR\x04from\x12$\n" +
+	"\x02to\x18\x02 \x01(\v2\x14.core.v1.MenuVersionR\x02to\x121\n" +
+	"\achanges\x18\x03 \x03(\v2\x17.core.v1.MenuItemChangeR\achanges\"\x99\x02\n" +
+	"\x0eSettlementLine\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\ais_host\x18\x02 \x01(\bR\x06isHost\x12\x1f\n" +
+	"\vorder_count\x18\x03 \x01(\x05R\n" +
+	"orderCount\x12*\n" +
+	"\bsubtotal\x18\x04 \x01(\v2\x0e.core.v1.MoneyR\bsubtotal\x12+\n" +
+	"\tfee_share\x18\x05 \x01(\v2\x0e.core.v1.MoneyR\bfeeShare\x125\n" +
+	"\x0ediscount_share\x18\x06 \x01(\v2\x0e.core.v1.MoneyR\rdiscountShare\x12$\n" +
+	"\x05total\x18\a \x01(\v2\x0e.core.v1.MoneyR\x05total\";\n" +
+	"\x15GetSheetSettlementReq\x12\"\n" +
+	"\bsheet_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\asheetId\"\x8e\x03\n" +
+	"\x16GetSheetSettlementResp\x12\x19\n" +
+	"\bsheet_id\x18\x01 \x01(\tR\asheetId\x12 \n" +
+	"\fhost_user_id\x18\x02 \x01(\tR\n" +
+	"hostUserId\x12-\n" +
+	"\x05lines\x18\x03 \x03(\v2\x17.core.v1.SettlementLineR\x05lines\x12*\n" +
+	"\bsubtotal\x18\x04 \x01(\v2\x0e.core.v1.MoneyR\bsubtotal\x12+\n" +
+	"\tfee_total\x18\x05 \x01(\v2\x0e.core.v1.MoneyR\bfeeTotal\x125\n" +
+	"\x0ediscount_total\x18\x06 \x01(\v2\x0e.core.v1.MoneyR\rdiscountTotal\x12$\n" +
+	"\x05total\x18\a \x01(\v2\x0e.core.v1.MoneyR\x05total\x120\n" +
+	"\fowed_to_host\x18\b \x01(\v2\x0e.core.v1.MoneyR\n" +
+	"owedToHost\x12 \n" +
+	"\vprovisional\x18\t \x01(\bR\vprovisional*u\n" +
 	"\vSheetStatus\x12\x1c\n" +
 	"\x18SHEET_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14SHEET_STATUS_PENDING\x10\x01\x12\x15\n" +
 	"\x11SHEET_STATUS_OPEN\x10\x02\x12\x17\n" +
-	"\x13SHEET_STATUS_CLOSED\x10\x032\xfc\x04\n" +
+	"\x13SHEET_STATUS_CLOSED\x10\x03*i\n" +
+	"\fFeeSplitMode\x12\x1e\n" +
+	"\x1aFEE_SPLIT_MODE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14FEE_SPLIT_MODE_EQUAL\x10\x01\x12\x1f\n" +
+	"\x1bFEE_SPLIT_MODE_PROPORTIONAL\x10\x02*o\n" +
+	"\x10MenuImportFormat\x12\"\n" +
+	"\x1eMENU_IMPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17MENU_IMPORT_FORMAT_JSON\x10\x01\x12\x1a\n" +
+	"\x16MENU_IMPORT_FORMAT_CSV\x10\x02*\x8a\x01\n" +
+	"\x0eMenuChangeType\x12 \n" +
+	"\x1cMENU_CHANGE_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16MENU_CHANGE_TYPE_ADDED\x10\x01\x12\x1c\n" +
+	"\x18MENU_CHANGE_TYPE_REMOVED\x10\x02\x12\x1c\n" +
+	"\x18MENU_CHANGE_TYPE_CHANGED\x10\x032\xb0\t\n" +
 	"\rSheetsService\x12@\n" +
 	"\vCreateSheet\x12\x17.core.v1.CreateSheetReq\x1a\x18.core.v1.CreateSheetResp\x127\n" +
 	"\bGetSheet\x12\x14.core.v1.GetSheetReq\x1a\x15.core.v1.GetSheetResp\x12@\n" +
@@ -1664,9 +3256,17 @@ const file_sheets_proto_rawDesc = "" +
 	"ListSheets\x12\x16.core.v1.ListSheetsReq\x1a\x17.core.v1.ListSheetsResp\x12B\n" +
 	"\tJoinSheet\x12\x19.core.v1.JoinSheetRequest\x1a\x1a.core.v1.JoinSheetResponse\x12K\n" +
 	"\fRemoveMember\x12\x1c.core.v1.RemoveMemberRequest\x1a\x1d.core.v1.RemoveMemberResponse\x12H\n" +
-	"\vListMembers\x12\x1b.core.v1.ListMembersRequest\x1a\x1c.core.v1.ListMembersResponse\x12^\n" +
-	"\x15AttachMenuWithPayload\x12!.core.v1.AttachMenuWithPayloadReq\x1a\".core.v1.AttachMenuWithPayloadResp\x124\n" +
-	"\aGetMenu\x12\x13.core.v1.GetMenuReq\x1a\x14.core.v1.GetMenuRespB;Z9github.com/deni12345/dae-services/proto/gen/corev1;corev1b\x06proto3"
+	"\vListMembers\x12\x1b.core.v1.ListMembersRequest\x1a\x1c.core.v1.ListMembersResponse\x12R\n" +
+	"\x11CreateSheetInvite\x12\x1d.core.v1.CreateSheetInviteReq\x1a\x1e.core.v1.CreateSheetInviteResp\x12R\n" +
+	"\x11RedeemSheetInvite\x12\x1d.core.v1.RedeemSheetInviteReq\x1a\x1e.core.v1.RedeemSheetInviteResp\x12R\n" +
+	"\x11RevokeSheetInvite\x12\x1d.core.v1.RevokeSheetInviteReq\x1a\x1e.core.v1.RevokeSheetInviteResp\x12^\n" +
+	"\x15AttachMenuWithPayload\x12!.core.v1.AttachMenuWithPayloadReq\x1a\".core.v1.AttachMenuWithPayloadResp\x12=\n" +
+	"\n" +
+	"ImportMenu\x12\x16.core.v1.ImportMenuReq\x1a\x17.core.v1.ImportMenuResp\x124\n" +
+	"\aGetMenu\x12\x13.core.v1.GetMenuReq\x1a\x14.core.v1.GetMenuResp\x12O\n" +
+	"\x10ListMenuVersions\x12\x1c.core.v1.ListMenuVersionsReq\x1a\x1d.core.v1.ListMenuVersionsResp\x12O\n" +
+	"\x10DiffMenuVersions\x12\x1c.core.v1.DiffMenuVersionsReq\x1a\x1d.core.v1.DiffMenuVersionsResp\x12U\n" +
+	"\x12GetSheetSettlement\x12\x1e.core.v1.GetSheetSettlementReq\x1a\x1f.core.v1.GetSheetSettlementRespB;Z9github.com/deni12345/dae-services/proto/gen/corev1;corev1b\x06proto3"
 
 var (
 	file_sheets_proto_rawDescOnce sync.Once
@@ -1680,89 +3280,163 @@ func file_sheets_proto_rawDescGZIP() []byte {
 	return file_sheets_proto_rawDescData
 }
 
-var file_sheets_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_sheets_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_sheets_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_sheets_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
 var file_sheets_proto_goTypes = []any{
 	(SheetStatus)(0),                  // 0: core.v1.SheetStatus
-	(*Sheet)(nil),                     // 1: core.v1.Sheet
-	(*SheetMember)(nil),               // 2: core.v1.SheetMember
-	(*ListSheetsFilter)(nil),          // 3: core.v1.ListSheetsFilter
-	(*CreateSheetReq)(nil),            // 4: core.v1.CreateSheetReq
-	(*CreateSheetResp)(nil),           // 5: core.v1.CreateSheetResp
-	(*GetSheetReq)(nil),               // 6: core.v1.GetSheetReq
-	(*GetSheetResp)(nil),              // 7: core.v1.GetSheetResp
-	(*UpdateSheetReq)(nil),            // 8: core.v1.UpdateSheetReq
-	(*UpdateSheetResp)(nil),           // 9: core.v1.UpdateSheetResp
-	(*ListSheetsReq)(nil),             // 10: core.v1.ListSheetsReq
-	(*ListSheetsResp)(nil),            // 11: core.v1.ListSheetsResp
-	(*JoinSheetRequest)(nil),          // 12: core.v1.JoinSheetRequest
-	(*JoinSheetResponse)(nil),         // 13: core.v1.JoinSheetResponse
-	(*RemoveMemberRequest)(nil),       // 14: core.v1.RemoveMemberRequest
-	(*RemoveMemberResponse)(nil),      // 15: core.v1.RemoveMemberResponse
-	(*ListMembersRequest)(nil),        // 16: core.v1.ListMembersRequest
-	(*ListMembersResponse)(nil),       // 17: core.v1.ListMembersResponse
-	(*MenuItem)(nil),                  // 18: core.v1.MenuItem
-	(*MenuOptionGroup)(nil),           // 19: core.v1.MenuOptionGroup
-	(*MenuOption)(nil),                // 20: core.v1.MenuOption
-	(*AttachMenuWithPayloadReq)(nil),  // 21: core.v1.AttachMenuWithPayloadReq
-	(*AttachMenuWithPayloadResp)(nil), // 22: core.v1.AttachMenuWithPayloadResp
-	(*GetMenuReq)(nil),                // 23: core.v1.GetMenuReq
-	(*GetMenuResp)(nil),               // 24: core.v1.GetMenuResp
-	(*Money)(nil),                     // 25: core.v1.Money
-	(*timestamppb.Timestamp)(nil),     // 26: google.protobuf.Timestamp
-	(*Cursor)(nil),                    // 27: core.v1.Cursor
+	(FeeSplitMode)(0),                 // 1: core.v1.FeeSplitMode
+	(MenuImportFormat)(0),             // 2: core.v1.MenuImportFormat
+	(MenuChangeType)(0),               // 3: core.v1.MenuChangeType
+	(*Sheet)(nil),                     // 4: core.v1.Sheet
+	(*SheetMember)(nil),               // 5: core.v1.SheetMember
+	(*ListSheetsFilter)(nil),          // 6: core.v1.ListSheetsFilter
+	(*CreateSheetReq)(nil),            // 7: core.v1.CreateSheetReq
+	(*CreateSheetResp)(nil),           // 8: core.v1.CreateSheetResp
+	(*GetSheetReq)(nil),               // 9: core.v1.GetSheetReq
+	(*GetSheetResp)(nil),              // 10: core.v1.GetSheetResp
+	(*UpdateSheetReq)(nil),            // 11: core.v1.UpdateSheetReq
+	(*UpdateSheetResp)(nil),           // 12: core.v1.UpdateSheetResp
+	(*ListSheetsReq)(nil),             // 13: core.v1.ListSheetsReq
+	(*ListSheetsResp)(nil),            // 14: core.v1.ListSheetsResp
+	(*JoinSheetRequest)(nil),          // 15: core.v1.JoinSheetRequest
+	(*JoinSheetResponse)(nil),         // 16: core.v1.JoinSheetResponse
+	(*RemoveMemberRequest)(nil),       // 17: core.v1.RemoveMemberRequest
+	(*RemoveMemberResponse)(nil),      // 18: core.v1.RemoveMemberResponse
+	(*SheetInvite)(nil),               // 19: core.v1.SheetInvite
+	(*CreateSheetInviteReq)(nil),      // 20: core.v1.CreateSheetInviteReq
+	(*CreateSheetInviteResp)(nil),     // 21: core.v1.CreateSheetInviteResp
+	(*RedeemSheetInviteReq)(nil),      // 22: core.v1.RedeemSheetInviteReq
+	(*RedeemSheetInviteResp)(nil),     // 23: core.v1.RedeemSheetInviteResp
+	(*RevokeSheetInviteReq)(nil),      // 24: core.v1.RevokeSheetInviteReq
+	(*RevokeSheetInviteResp)(nil),     // 25: core.v1.RevokeSheetInviteResp
+	(*ListMembersRequest)(nil),        // 26: core.v1.ListMembersRequest
+	(*ListMembersResponse)(nil),       // 27: core.v1.ListMembersResponse
+	(*MenuItem)(nil),                  // 28: core.v1.MenuItem
+	(*MenuOptionGroup)(nil),           // 29: core.v1.MenuOptionGroup
+	(*MenuOption)(nil),                // 30: core.v1.MenuOption
+	(*AttachMenuWithPayloadReq)(nil),  // 31: core.v1.AttachMenuWithPayloadReq
+	(*AttachMenuWithPayloadResp)(nil), // 32: core.v1.AttachMenuWithPayloadResp
+	(*ImportMenuReq)(nil),             // 33: core.v1.ImportMenuReq
+	(*MenuImportError)(nil),           // 34: core.v1.MenuImportError
+	(*ImportMenuResp)(nil),            // 35: core.v1.ImportMenuResp
+	(*GetMenuReq)(nil),                // 36: core.v1.GetMenuReq
+	(*GetMenuResp)(nil),               // 37: core.v1.GetMenuResp
+	(*MenuVersion)(nil),               // 38: core.v1.MenuVersion
+	(*MenuItemChange)(nil),            // 39: core.v1.MenuItemChange
+	(*ListMenuVersionsReq)(nil),       // 40: core.v1.ListMenuVersionsReq
+	(*ListMenuVersionsResp)(nil),      // 41: core.v1.ListMenuVersionsResp
+	(*DiffMenuVersionsReq)(nil),       // 42: core.v1.DiffMenuVersionsReq
+	(*DiffMenuVersionsResp)(nil),      // 43: core.v1.DiffMenuVersionsResp
+	(*SettlementLine)(nil),            // 44: core.v1.SettlementLine
+	(*GetSheetSettlementReq)(nil),     // 45: core.v1.GetSheetSettlementReq
+	(*GetSheetSettlementResp)(nil),    // 46: core.v1.GetSheetSettlementResp
+	(*Money)(nil),                     // 47: core.v1.Money
+	(*timestamppb.Timestamp)(nil),     // 48: google.protobuf.Timestamp
+	(*Cursor)(nil),                    // 49: core.v1.Cursor
 }
 var file_sheets_proto_depIdxs = []int32{
-	25, // 0: core.v1.Sheet.delivery_fee:type_name -> core.v1.Money
+	47, // 0: core.v1.Sheet.delivery_fee:type_name -> core.v1.Money
 	0,  // 1: core.v1.Sheet.status:type_name -> core.v1.SheetStatus
-	26, // 2: core.v1.Sheet.created_at:type_name -> google.protobuf.Timestamp
-	26, // 3: core.v1.Sheet.updated_at:type_name -> google.protobuf.Timestamp
-	26, // 4: core.v1.SheetMember.joined_at:type_name -> google.protobuf.Timestamp
-	25, // 5: core.v1.CreateSheetReq.delivery_fee:type_name -> core.v1.Money
-	18, // 6: core.v1.CreateSheetReq.items:type_name -> core.v1.MenuItem
-	1,  // 7: core.v1.CreateSheetResp.sheet:type_name -> core.v1.Sheet
-	1,  // 8: core.v1.GetSheetResp.sheet:type_name -> core.v1.Sheet
-	0,  // 9: core.v1.UpdateSheetReq.status:type_name -> core.v1.SheetStatus
-	1,  // 10: core.v1.UpdateSheetResp.sheet:type_name -> core.v1.Sheet
-	27, // 11: core.v1.ListSheetsReq.cursor:type_name -> core.v1.Cursor
-	3,  // 12: core.v1.ListSheetsReq.filter:type_name -> core.v1.ListSheetsFilter
-	1,  // 13: core.v1.ListSheetsResp.sheets:type_name -> core.v1.Sheet
-	27, // 14: core.v1.ListSheetsResp.next_cursor:type_name -> core.v1.Cursor
-	2,  // 15: core.v1.JoinSheetResponse.member:type_name -> core.v1.SheetMember
-	27, // 16: core.v1.ListMembersRequest.cursor:type_name -> core.v1.Cursor
-	2,  // 17: core.v1.ListMembersResponse.members:type_name -> core.v1.SheetMember
-	27, // 18: core.v1.ListMembersResponse.next_cursor:type_name -> core.v1.Cursor
-	25, // 19: core.v1.MenuItem.price:type_name -> core.v1.Money
-	19, // 20: core.v1.MenuItem.option_groups:type_name -> core.v1.MenuOptionGroup
-	20, // 21: core.v1.MenuOptionGroup.options:type_name -> core.v1.MenuOption
-	25, // 22: core.v1.MenuOption.price_delta:type_name -> core.v1.Money
-	18, // 23: core.v1.AttachMenuWithPayloadReq.items:type_name -> core.v1.MenuItem
-	18, // 24: core.v1.AttachMenuWithPayloadResp.items:type_name -> core.v1.MenuItem
-	1,  // 25: core.v1.AttachMenuWithPayloadResp.sheet:type_name -> core.v1.Sheet
-	18, // 26: core.v1.GetMenuResp.items:type_name -> core.v1.MenuItem
-	4,  // 27: core.v1.SheetsService.CreateSheet:input_type -> core.v1.CreateSheetReq
-	6,  // 28: core.v1.SheetsService.GetSheet:input_type -> core.v1.GetSheetReq
-	8,  // 29: core.v1.SheetsService.UpdateSheet:input_type -> core.v1.UpdateSheetReq
-	10, // 30: core.v1.SheetsService.ListSheets:input_type -> core.v1.ListSheetsReq
-	12, // 31: core.v1.SheetsService.JoinSheet:input_type -> core.v1.JoinSheetRequest
-	14, // 32: core.v1.SheetsService.RemoveMember:input_type -> core.v1.RemoveMemberRequest
-	16, // 33: core.v1.SheetsService.ListMembers:input_type -> core.v1.ListMembersRequest
-	21, // 34: core.v1.SheetsService.AttachMenuWithPayload:input_type -> core.v1.AttachMenuWithPayloadReq
-	23, // 35: core.v1.SheetsService.GetMenu:input_type -> core.v1.GetMenuReq
-	5,  // 36: core.v1.SheetsService.CreateSheet:output_type -> core.v1.CreateSheetResp
-	7,  // 37: core.v1.SheetsService.GetSheet:output_type -> core.v1.GetSheetResp
-	9,  // 38: core.v1.SheetsService.UpdateSheet:output_type -> core.v1.UpdateSheetResp
-	11, // 39: core.v1.SheetsService.ListSheets:output_type -> core.v1.ListSheetsResp
-	13, // 40: core.v1.SheetsService.JoinSheet:output_type -> core.v1.JoinSheetResponse
-	15, // 41: core.v1.SheetsService.RemoveMember:output_type -> core.v1.RemoveMemberResponse
-	17, // 42: core.v1.SheetsService.ListMembers:output_type -> core.v1.ListMembersResponse
-	22, // 43: core.v1.SheetsService.AttachMenuWithPayload:output_type -> core.v1.AttachMenuWithPayloadResp
-	24, // 44: core.v1.SheetsService.GetMenu:output_type -> core.v1.GetMenuResp
-	36, // [36:45] is the sub-list for method output_type
-	27, // [27:36] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
+	1,  // 2: core.v1.Sheet.fee_split_mode:type_name -> core.v1.FeeSplitMode
+	48, // 3: core.v1.Sheet.opens_at:type_name -> google.protobuf.Timestamp
+	48, // 4: core.v1.Sheet.closes_at:type_name -> google.protobuf.Timestamp
+	48, // 5: core.v1.Sheet.created_at:type_name -> google.protobuf.Timestamp
+	48, // 6: core.v1.Sheet.updated_at:type_name -> google.protobuf.Timestamp
+	48, // 7: core.v1.SheetMember.joined_at:type_name -> google.protobuf.Timestamp
+	47, // 8: core.v1.CreateSheetReq.delivery_fee:type_name -> core.v1.Money
+	1,  // 9: core.v1.CreateSheetReq.fee_split_mode:type_name -> core.v1.FeeSplitMode
+	28, // 10: core.v1.CreateSheetReq.items:type_name -> core.v1.MenuItem
+	48, // 11: core.v1.CreateSheetReq.opens_at:type_name -> google.protobuf.Timestamp
+	48, // 12: core.v1.CreateSheetReq.closes_at:type_name -> google.protobuf.Timestamp
+	4,  // 13: core.v1.CreateSheetResp.sheet:type_name -> core.v1.Sheet
+	4,  // 14: core.v1.GetSheetResp.sheet:type_name -> core.v1.Sheet
+	0,  // 15: core.v1.UpdateSheetReq.status:type_name -> core.v1.SheetStatus
+	1,  // 16: core.v1.UpdateSheetReq.fee_split_mode:type_name -> core.v1.FeeSplitMode
+	48, // 17: core.v1.UpdateSheetReq.opens_at:type_name -> google.protobuf.Timestamp
+	48, // 18: core.v1.UpdateSheetReq.closes_at:type_name -> google.protobuf.Timestamp
+	4,  // 19: core.v1.UpdateSheetResp.sheet:type_name -> core.v1.Sheet
+	49, // 20: core.v1.ListSheetsReq.cursor:type_name -> core.v1.Cursor
+	6,  // 21: core.v1.ListSheetsReq.filter:type_name -> core.v1.ListSheetsFilter
+	4,  // 22: core.v1.ListSheetsResp.sheets:type_name -> core.v1.Sheet
+	49, // 23: core.v1.ListSheetsResp.next_cursor:type_name -> core.v1.Cursor
+	5,  // 24: core.v1.JoinSheetResponse.member:type_name -> core.v1.SheetMember
+	48, // 25: core.v1.SheetInvite.expires_at:type_name -> google.protobuf.Timestamp
+	48, // 26: core.v1.SheetInvite.revoked_at:type_name -> google.protobuf.Timestamp
+	48, // 27: core.v1.SheetInvite.created_at:type_name -> google.protobuf.Timestamp
+	48, // 28: core.v1.CreateSheetInviteReq.expires_at:type_name -> google.protobuf.Timestamp
+	19, // 29: core.v1.CreateSheetInviteResp.invite:type_name -> core.v1.SheetInvite
+	5,  // 30: core.v1.RedeemSheetInviteResp.member:type_name -> core.v1.SheetMember
+	19, // 31: core.v1.RevokeSheetInviteResp.invite:type_name -> core.v1.SheetInvite
+	49, // 32: core.v1.ListMembersRequest.cursor:type_name -> core.v1.Cursor
+	5,  // 33: core.v1.ListMembersResponse.members:type_name -> core.v1.SheetMember
+	49, // 34: core.v1.ListMembersResponse.next_cursor:type_name -> core.v1.Cursor
+	47, // 35: core.v1.MenuItem.price:type_name -> core.v1.Money
+	29, // 36: core.v1.MenuItem.option_groups:type_name -> core.v1.MenuOptionGroup
+	30, // 37: core.v1.MenuOptionGroup.options:type_name -> core.v1.MenuOption
+	47, // 38: core.v1.MenuOption.price_delta:type_name -> core.v1.Money
+	28, // 39: core.v1.AttachMenuWithPayloadReq.items:type_name -> core.v1.MenuItem
+	28, // 40: core.v1.AttachMenuWithPayloadResp.items:type_name -> core.v1.MenuItem
+	4,  // 41: core.v1.AttachMenuWithPayloadResp.sheet:type_name -> core.v1.Sheet
+	2,  // 42: core.v1.ImportMenuReq.format:type_name -> core.v1.MenuImportFormat
+	28, // 43: core.v1.ImportMenuResp.items:type_name -> core.v1.MenuItem
+	34, // 44: core.v1.ImportMenuResp.errors:type_name -> core.v1.MenuImportError
+	4,  // 45: core.v1.ImportMenuResp.sheet:type_name -> core.v1.Sheet
+	28, // 46: core.v1.GetMenuResp.items:type_name -> core.v1.MenuItem
+	48, // 47: core.v1.MenuVersion.created_at:type_name -> google.protobuf.Timestamp
+	3,  // 48: core.v1.MenuItemChange.type:type_name -> core.v1.MenuChangeType
+	28, // 49: core.v1.MenuItemChange.before:type_name -> core.v1.MenuItem
+	28, // 50: core.v1.MenuItemChange.after:type_name -> core.v1.MenuItem
+	38, // 51: core.v1.ListMenuVersionsResp.versions:type_name -> core.v1.MenuVersion
+	38, // 52: core.v1.DiffMenuVersionsResp.from:type_name -> core.v1.MenuVersion
+	38, // 53: core.v1.DiffMenuVersionsResp.to:type_name -> core.v1.MenuVersion
+	39, // 54: core.v1.DiffMenuVersionsResp.changes:type_name -> core.v1.MenuItemChange
+	47, // 55: core.v1.SettlementLine.subtotal:type_name -> core.v1.Money
+	47, // 56: core.v1.SettlementLine.fee_share:type_name -> core.v1.Money
+	47, // 57: core.v1.SettlementLine.discount_share:type_name -> core.v1.Money
+	47, // 58: core.v1.SettlementLine.total:type_name -> core.v1.Money
+	44, // 59: core.v1.GetSheetSettlementResp.lines:type_name -> core.v1.SettlementLine
+	47, // 60: core.v1.GetSheetSettlementResp.subtotal:type_name -> core.v1.Money
+	47, // 61: core.v1.GetSheetSettlementResp.fee_total:type_name -> core.v1.Money
+	47, // 62: core.v1.GetSheetSettlementResp.discount_total:type_name -> core.v1.Money
+	47, // 63: core.v1.GetSheetSettlementResp.total:type_name -> core.v1.Money
+	47, // 64: core.v1.GetSheetSettlementResp.owed_to_host:type_name -> core.v1.Money
+	7,  // 65: core.v1.SheetsService.CreateSheet:input_type -> core.v1.CreateSheetReq
+	9,  // 66: core.v1.SheetsService.GetSheet:input_type -> core.v1.GetSheetReq
+	11, // 67: core.v1.SheetsService.UpdateSheet:input_type -> core.v1.UpdateSheetReq
+	13, // 68: core.v1.SheetsService.ListSheets:input_type -> core.v1.ListSheetsReq
+	15, // 69: core.v1.SheetsService.JoinSheet:input_type -> core.v1.JoinSheetRequest
+	17, // 70: core.v1.SheetsService.RemoveMember:input_type -> core.v1.RemoveMemberRequest
+	26, // 71: core.v1.SheetsService.ListMembers:input_type -> core.v1.ListMembersRequest
+	20, // 72: core.v1.SheetsService.CreateSheetInvite:input_type -> core.v1.CreateSheetInviteReq
+	22, // 73: core.v1.SheetsService.RedeemSheetInvite:input_type -> core.v1.RedeemSheetInviteReq
+	24, // 74: core.v1.SheetsService.RevokeSheetInvite:input_type -> core.v1.RevokeSheetInviteReq
+	31, // 75: core.v1.SheetsService.AttachMenuWithPayload:input_type -> core.v1.AttachMenuWithPayloadReq
+	33, // 76: core.v1.SheetsService.ImportMenu:input_type -> core.v1.ImportMenuReq
+	36, // 77: core.v1.SheetsService.GetMenu:input_type -> core.v1.GetMenuReq
+	40, // 78: core.v1.SheetsService.ListMenuVersions:input_type -> core.v1.ListMenuVersionsReq
+	42, // 79: core.v1.SheetsService.DiffMenuVersions:input_type -> core.v1.DiffMenuVersionsReq
+	45, // 80: core.v1.SheetsService.GetSheetSettlement:input_type -> core.v1.GetSheetSettlementReq
+	8,  // 81: core.v1.SheetsService.CreateSheet:output_type -> core.v1.CreateSheetResp
+	10, // 82: core.v1.SheetsService.GetSheet:output_type -> core.v1.GetSheetResp
+	12, // 83: core.v1.SheetsService.UpdateSheet:output_type -> core.v1.UpdateSheetResp
+	14, // 84: core.v1.SheetsService.ListSheets:output_type -> core.v1.ListSheetsResp
+	16, // 85: core.v1.SheetsService.JoinSheet:output_type -> core.v1.JoinSheetResponse
+	18, // 86: core.v1.SheetsService.RemoveMember:output_type -> core.v1.RemoveMemberResponse
+	27, // 87: core.v1.SheetsService.ListMembers:output_type -> core.v1.ListMembersResponse
+	21, // 88: core.v1.SheetsService.CreateSheetInvite:output_type -> core.v1.CreateSheetInviteResp
+	23, // 89: core.v1.SheetsService.RedeemSheetInvite:output_type -> core.v1.RedeemSheetInviteResp
+	25, // 90: core.v1.SheetsService.RevokeSheetInvite:output_type -> core.v1.RevokeSheetInviteResp
+	32, // 91: core.v1.SheetsService.AttachMenuWithPayload:output_type -> core.v1.AttachMenuWithPayloadResp
+	35, // 92: core.v1.SheetsService.ImportMenu:output_type -> core.v1.ImportMenuResp
+	37, // 93: core.v1.SheetsService.GetMenu:output_type -> core.v1.GetMenuResp
+	41, // 94: core.v1.SheetsService.ListMenuVersions:output_type -> core.v1.ListMenuVersionsResp
+	43, // 95: core.v1.SheetsService.DiffMenuVersions:output_type -> core.v1.DiffMenuVersionsResp
+	46, // 96: core.v1.SheetsService.GetSheetSettlement:output_type -> core.v1.GetSheetSettlementResp
+	81, // [81:97] is the sub-list for method output_type
+	65, // [65:81] is the sub-list for method input_type
+	65, // [65:65] is the sub-list for extension type_name
+	65, // [65:65] is the sub-list for extension extendee
+	0,  // [0:65] is the sub-list for field type_name
 }
 
 func init() { file_sheets_proto_init() }
@@ -1773,14 +3447,14 @@ func file_sheets_proto_init() {
 	file_common_proto_init()
 	file_sheets_proto_msgTypes[7].OneofWrappers = []any{}
 	file_sheets_proto_msgTypes[10].OneofWrappers = []any{}
-	file_sheets_proto_msgTypes[16].OneofWrappers = []any{}
+	file_sheets_proto_msgTypes[23].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sheets_proto_rawDesc), len(file_sheets_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   24,
+			NumEnums:      4,
+			NumMessages:   43,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -99,6 +99,66 @@ func (m *Sheet) validate(all bool) error {
 
 	// no validation rules for Status
 
+	// no validation rules for FeeSplitMode
+
+	if all {
+		switch v := interface{}(m.GetOpensAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SheetValidationError{
+					field:  "OpensAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SheetValidationError{
+					field:  "OpensAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOpensAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SheetValidationError{
+				field:  "OpensAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetClosesAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SheetValidationError{
+					field:  "ClosesAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SheetValidationError{
+					field:  "ClosesAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClosesAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SheetValidationError{
+				field:  "ClosesAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if all {
 		switch v := interface{}(m.GetCreatedAt()).(type) {
 		case interface{ ValidateAll() error }:
@@ -260,6 +320,8 @@ func (m *SheetMember) validate(all bool) error {
 
 	// no validation rules for SheetId
 
+	// no validation rules for Role
+
 	if all {
 		switch v := interface{}(m.GetJoinedAt()).(type) {
 		case interface{ ValidateAll() error }:
@@ -525,16 +587,7 @@ func (m *CreateSheetReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetHostUserId()) < 1 {
-		err := CreateSheetReqValidationError{
-			field:  "HostUserId",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for HostUserId
 
 	if all {
 		switch v := interface{}(m.GetDeliveryFee()).(type) {
@@ -565,10 +618,43 @@ func (m *CreateSheetReq) validate(all bool) error {
 		}
 	}
 
-	if m.GetDiscount() < 0 {
+	if val := m.GetDiscount(); val < 0 || val > 100 {
 		err := CreateSheetReqValidationError{
 			field:  "Discount",
-			reason: "value must be greater than or equal to 0",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetMemberIds()) > 100 {
+		err := CreateSheetReqValidationError{
+			field:  "MemberIds",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := FeeSplitMode_name[int32(m.GetFeeSplitMode())]; !ok {
+		err := CreateSheetReqValidationError{
+			field:  "FeeSplitMode",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetItems()) > 300 {
+		err := CreateSheetReqValidationError{
+			field:  "Items",
+			reason: "value must contain no more than 300 item(s)",
 		}
 		if !all {
 			return err
@@ -610,6 +696,64 @@ func (m *CreateSheetReq) validate(all bool) error {
 
 	}
 
+	if all {
+		switch v := interface{}(m.GetOpensAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSheetReqValidationError{
+					field:  "OpensAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSheetReqValidationError{
+					field:  "OpensAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOpensAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSheetReqValidationError{
+				field:  "OpensAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetClosesAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSheetReqValidationError{
+					field:  "ClosesAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSheetReqValidationError{
+					field:  "ClosesAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClosesAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSheetReqValidationError{
+				field:  "ClosesAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return CreateSheetReqMultiError(errors)
 	}
@@ -1088,6 +1232,64 @@ func (m *UpdateSheetReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if all {
+		switch v := interface{}(m.GetOpensAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateSheetReqValidationError{
+					field:  "OpensAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateSheetReqValidationError{
+					field:  "OpensAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOpensAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateSheetReqValidationError{
+				field:  "OpensAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetClosesAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateSheetReqValidationError{
+					field:  "ClosesAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateSheetReqValidationError{
+					field:  "ClosesAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClosesAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateSheetReqValidationError{
+				field:  "ClosesAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if m.Name != nil {
 
 		if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 255 {
@@ -1126,6 +1328,21 @@ func (m *UpdateSheetReq) validate(all bool) error {
 		// no validation rules for Status
 	}
 
+	if m.FeeSplitMode != nil {
+
+		if _, ok := FeeSplitMode_name[int32(m.GetFeeSplitMode())]; !ok {
+			err := UpdateSheetReqValidationError{
+				field:  "FeeSplitMode",
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return UpdateSheetReqMultiError(errors)
 	}
@@ -1713,16 +1930,7 @@ func (m *JoinSheetRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetUserId()) < 1 {
-		err := JoinSheetRequestValidationError{
-			field:  "UserId",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for UserId
 
 	if len(errors) > 0 {
 		return JoinSheetRequestMultiError(errors)
@@ -1966,16 +2174,7 @@ func (m *RemoveMemberRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetUserId()) < 1 {
-		err := RemoveMemberRequestValidationError{
-			field:  "UserId",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for UserId
 
 	if len(errors) > 0 {
 		return RemoveMemberRequestMultiError(errors)
@@ -2159,93 +2358,2686 @@ var _ interface {
 	ErrorName() string
 } = RemoveMemberResponseValidationError{}
 
-// Validate checks the field values on ListMembersRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListMembersRequest) Validate() error {
+// Validate checks the field values on SheetInvite with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SheetInvite) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListMembersRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListMembersRequestMultiError, or nil if none found.
-func (m *ListMembersRequest) ValidateAll() error {
+// ValidateAll checks the field values on SheetInvite with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SheetInviteMultiError, or
+// nil if none found.
+func (m *SheetInvite) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListMembersRequest) validate(all bool) error {
+func (m *SheetInvite) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetSheetId()) < 1 {
-		err := ListMembersRequestValidationError{
-			field:  "SheetId",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Code
 
-	if val := m.GetPageSize(); val < 1 || val > 100 {
-		err := ListMembersRequestValidationError{
-			field:  "PageSize",
-			reason: "value must be inside range [1, 100]",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for SheetId
+
+	// no validation rules for CreatedBy
+
+	// no validation rules for Role
+
+	// no validation rules for MaxUses
+
+	// no validation rules for Uses
 
 	if all {
-		switch v := interface{}(m.GetCursor()).(type) {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListMembersRequestValidationError{
-					field:  "Cursor",
+				errors = append(errors, SheetInviteValidationError{
+					field:  "ExpiresAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ListMembersRequestValidationError{
-					field:  "Cursor",
+				errors = append(errors, SheetInviteValidationError{
+					field:  "ExpiresAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetCursor()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ListMembersRequestValidationError{
-				field:  "Cursor",
+			return SheetInviteValidationError{
+				field:  "ExpiresAt",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if len(errors) > 0 {
-		return ListMembersRequestMultiError(errors)
-	}
-
-	return nil
+	if all {
+		switch v := interface{}(m.GetRevokedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SheetInviteValidationError{
+					field:  "RevokedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SheetInviteValidationError{
+					field:  "RevokedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRevokedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SheetInviteValidationError{
+				field:  "RevokedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SheetInviteValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SheetInviteValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SheetInviteValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SheetInviteMultiError(errors)
+	}
+
+	return nil
+}
+
+// SheetInviteMultiError is an error wrapping multiple validation errors
+// returned by SheetInvite.ValidateAll() if the designated constraints aren't met.
+type SheetInviteMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SheetInviteMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SheetInviteMultiError) AllErrors() []error { return m }
+
+// SheetInviteValidationError is the validation error returned by
+// SheetInvite.Validate if the designated constraints aren't met.
+type SheetInviteValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SheetInviteValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SheetInviteValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SheetInviteValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SheetInviteValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SheetInviteValidationError) ErrorName() string { return "SheetInviteValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SheetInviteValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSheetInvite.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SheetInviteValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SheetInviteValidationError{}
+
+// Validate checks the field values on CreateSheetInviteReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSheetInviteReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSheetInviteReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSheetInviteReqMultiError, or nil if none found.
+func (m *CreateSheetInviteReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSheetInviteReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetIdempotencyKey()) < 1 {
+		err := CreateSheetInviteReqValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSheetId()) < 1 {
+		err := CreateSheetInviteReqValidationError{
+			field:  "SheetId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSheetInviteReqValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSheetInviteReqValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSheetInviteReqValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetMaxUses() < 0 {
+		err := CreateSheetInviteReqValidationError{
+			field:  "MaxUses",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateSheetInviteReq_Role_InLookup[m.GetRole()]; !ok {
+		err := CreateSheetInviteReqValidationError{
+			field:  "Role",
+			reason: "value must be in list [ member]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateSheetInviteReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSheetInviteReqMultiError is an error wrapping multiple validation
+// errors returned by CreateSheetInviteReq.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSheetInviteReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSheetInviteReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSheetInviteReqMultiError) AllErrors() []error { return m }
+
+// CreateSheetInviteReqValidationError is the validation error returned by
+// CreateSheetInviteReq.Validate if the designated constraints aren't met.
+type CreateSheetInviteReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSheetInviteReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSheetInviteReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSheetInviteReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSheetInviteReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSheetInviteReqValidationError) ErrorName() string {
+	return "CreateSheetInviteReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSheetInviteReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSheetInviteReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSheetInviteReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSheetInviteReqValidationError{}
+
+var _CreateSheetInviteReq_Role_InLookup = map[string]struct{}{
+	"":       {},
+	"member": {},
+}
+
+// Validate checks the field values on CreateSheetInviteResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSheetInviteResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSheetInviteResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSheetInviteRespMultiError, or nil if none found.
+func (m *CreateSheetInviteResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSheetInviteResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetInvite()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSheetInviteRespValidationError{
+					field:  "Invite",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSheetInviteRespValidationError{
+					field:  "Invite",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInvite()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSheetInviteRespValidationError{
+				field:  "Invite",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateSheetInviteRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSheetInviteRespMultiError is an error wrapping multiple validation
+// errors returned by CreateSheetInviteResp.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSheetInviteRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSheetInviteRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSheetInviteRespMultiError) AllErrors() []error { return m }
+
+// CreateSheetInviteRespValidationError is the validation error returned by
+// CreateSheetInviteResp.Validate if the designated constraints aren't met.
+type CreateSheetInviteRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSheetInviteRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSheetInviteRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSheetInviteRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSheetInviteRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSheetInviteRespValidationError) ErrorName() string {
+	return "CreateSheetInviteRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSheetInviteRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSheetInviteResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSheetInviteRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSheetInviteRespValidationError{}
+
+// Validate checks the field values on RedeemSheetInviteReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RedeemSheetInviteReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RedeemSheetInviteReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RedeemSheetInviteReqMultiError, or nil if none found.
+func (m *RedeemSheetInviteReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RedeemSheetInviteReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetCode()); l < 1 || l > 32 {
+		err := RedeemSheetInviteReqValidationError{
+			field:  "Code",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RedeemSheetInviteReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// RedeemSheetInviteReqMultiError is an error wrapping multiple validation
+// errors returned by RedeemSheetInviteReq.ValidateAll() if the designated
+// constraints aren't met.
+type RedeemSheetInviteReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RedeemSheetInviteReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RedeemSheetInviteReqMultiError) AllErrors() []error { return m }
+
+// RedeemSheetInviteReqValidationError is the validation error returned by
+// RedeemSheetInviteReq.Validate if the designated constraints aren't met.
+type RedeemSheetInviteReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RedeemSheetInviteReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RedeemSheetInviteReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RedeemSheetInviteReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RedeemSheetInviteReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RedeemSheetInviteReqValidationError) ErrorName() string {
+	return "RedeemSheetInviteReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RedeemSheetInviteReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRedeemSheetInviteReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RedeemSheetInviteReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RedeemSheetInviteReqValidationError{}
+
+// Validate checks the field values on RedeemSheetInviteResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RedeemSheetInviteResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RedeemSheetInviteResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RedeemSheetInviteRespMultiError, or nil if none found.
+func (m *RedeemSheetInviteResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RedeemSheetInviteResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMember()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RedeemSheetInviteRespValidationError{
+					field:  "Member",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RedeemSheetInviteRespValidationError{
+					field:  "Member",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMember()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RedeemSheetInviteRespValidationError{
+				field:  "Member",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RedeemSheetInviteRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// RedeemSheetInviteRespMultiError is an error wrapping multiple validation
+// errors returned by RedeemSheetInviteResp.ValidateAll() if the designated
+// constraints aren't met.
+type RedeemSheetInviteRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RedeemSheetInviteRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RedeemSheetInviteRespMultiError) AllErrors() []error { return m }
+
+// RedeemSheetInviteRespValidationError is the validation error returned by
+// RedeemSheetInviteResp.Validate if the designated constraints aren't met.
+type RedeemSheetInviteRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RedeemSheetInviteRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RedeemSheetInviteRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RedeemSheetInviteRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RedeemSheetInviteRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RedeemSheetInviteRespValidationError) ErrorName() string {
+	return "RedeemSheetInviteRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RedeemSheetInviteRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRedeemSheetInviteResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RedeemSheetInviteRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RedeemSheetInviteRespValidationError{}
+
+// Validate checks the field values on RevokeSheetInviteReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeSheetInviteReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeSheetInviteReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeSheetInviteReqMultiError, or nil if none found.
+func (m *RevokeSheetInviteReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeSheetInviteReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetCode()); l < 1 || l > 32 {
+		err := RevokeSheetInviteReqValidationError{
+			field:  "Code",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RevokeSheetInviteReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeSheetInviteReqMultiError is an error wrapping multiple validation
+// errors returned by RevokeSheetInviteReq.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeSheetInviteReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeSheetInviteReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeSheetInviteReqMultiError) AllErrors() []error { return m }
+
+// RevokeSheetInviteReqValidationError is the validation error returned by
+// RevokeSheetInviteReq.Validate if the designated constraints aren't met.
+type RevokeSheetInviteReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeSheetInviteReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeSheetInviteReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeSheetInviteReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeSheetInviteReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeSheetInviteReqValidationError) ErrorName() string {
+	return "RevokeSheetInviteReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeSheetInviteReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeSheetInviteReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeSheetInviteReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeSheetInviteReqValidationError{}
+
+// Validate checks the field values on RevokeSheetInviteResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeSheetInviteResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeSheetInviteResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeSheetInviteRespMultiError, or nil if none found.
+func (m *RevokeSheetInviteResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeSheetInviteResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetInvite()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RevokeSheetInviteRespValidationError{
+					field:  "Invite",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RevokeSheetInviteRespValidationError{
+					field:  "Invite",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInvite()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RevokeSheetInviteRespValidationError{
+				field:  "Invite",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RevokeSheetInviteRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeSheetInviteRespMultiError is an error wrapping multiple validation
+// errors returned by RevokeSheetInviteResp.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeSheetInviteRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeSheetInviteRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeSheetInviteRespMultiError) AllErrors() []error { return m }
+
+// RevokeSheetInviteRespValidationError is the validation error returned by
+// RevokeSheetInviteResp.Validate if the designated constraints aren't met.
+type RevokeSheetInviteRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeSheetInviteRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeSheetInviteRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeSheetInviteRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeSheetInviteRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeSheetInviteRespValidationError) ErrorName() string {
+	return "RevokeSheetInviteRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeSheetInviteRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeSheetInviteResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeSheetInviteRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeSheetInviteRespValidationError{}
+
+// Validate checks the field values on ListMembersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMembersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMembersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMembersRequestMultiError, or nil if none found.
+func (m *ListMembersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMembersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetSheetId()) < 1 {
+		err := ListMembersRequestValidationError{
+			field:  "SheetId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 1 || val > 100 {
+		err := ListMembersRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCursor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListMembersRequestValidationError{
+					field:  "Cursor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListMembersRequestValidationError{
+					field:  "Cursor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCursor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListMembersRequestValidationError{
+				field:  "Cursor",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListMembersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMembersRequestMultiError is an error wrapping multiple validation errors
+// returned by ListMembersRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListMembersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMembersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMembersRequestMultiError) AllErrors() []error { return m }
+
+// ListMembersRequestValidationError is the validation error returned by
+// ListMembersRequest.Validate if the designated constraints aren't met.
+type ListMembersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMembersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMembersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMembersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMembersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMembersRequestValidationError) ErrorName() string {
+	return "ListMembersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMembersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMembersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMembersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMembersRequestValidationError{}
+
+// Validate checks the field values on ListMembersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMembersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMembersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMembersResponseMultiError, or nil if none found.
+func (m *ListMembersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMembersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMembersResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMembersResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMembersResponseValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.NextCursor != nil {
+
+		if all {
+			switch v := interface{}(m.GetNextCursor()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMembersResponseValidationError{
+						field:  "NextCursor",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMembersResponseValidationError{
+						field:  "NextCursor",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetNextCursor()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMembersResponseValidationError{
+					field:  "NextCursor",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListMembersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMembersResponseMultiError is an error wrapping multiple validation
+// errors returned by ListMembersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListMembersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMembersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMembersResponseMultiError) AllErrors() []error { return m }
+
+// ListMembersResponseValidationError is the validation error returned by
+// ListMembersResponse.Validate if the designated constraints aren't met.
+type ListMembersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMembersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMembersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMembersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMembersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMembersResponseValidationError) ErrorName() string {
+	return "ListMembersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMembersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMembersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMembersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMembersResponseValidationError{}
+
+// Validate checks the field values on MenuItem with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MenuItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MenuItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MenuItemMultiError, or nil
+// if none found.
+func (m *MenuItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MenuItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := MenuItemValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTitle()) < 1 {
+		err := MenuItemValidationError{
+			field:  "Title",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MenuItemValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MenuItemValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MenuItemValidationError{
+				field:  "Price",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 1000 {
+		err := MenuItemValidationError{
+			field:  "Description",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Available
+
+	for idx, item := range m.GetOptionGroups() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MenuItemValidationError{
+						field:  fmt.Sprintf("OptionGroups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MenuItemValidationError{
+						field:  fmt.Sprintf("OptionGroups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MenuItemValidationError{
+					field:  fmt.Sprintf("OptionGroups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MenuItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// MenuItemMultiError is an error wrapping multiple validation errors returned
+// by MenuItem.ValidateAll() if the designated constraints aren't met.
+type MenuItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MenuItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MenuItemMultiError) AllErrors() []error { return m }
+
+// MenuItemValidationError is the validation error returned by
+// MenuItem.Validate if the designated constraints aren't met.
+type MenuItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MenuItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MenuItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MenuItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MenuItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MenuItemValidationError) ErrorName() string { return "MenuItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MenuItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMenuItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MenuItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MenuItemValidationError{}
+
+// Validate checks the field values on MenuOptionGroup with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MenuOptionGroup) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MenuOptionGroup with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MenuOptionGroupMultiError, or nil if none found.
+func (m *MenuOptionGroup) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MenuOptionGroup) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := MenuOptionGroupValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTitle()) < 1 {
+		err := MenuOptionGroupValidationError{
+			field:  "Title",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Required
+
+	// no validation rules for MultiSelect
+
+	// no validation rules for MinSelect
+
+	// no validation rules for MaxSelect
+
+	for idx, item := range m.GetOptions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MenuOptionGroupValidationError{
+						field:  fmt.Sprintf("Options[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MenuOptionGroupValidationError{
+						field:  fmt.Sprintf("Options[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MenuOptionGroupValidationError{
+					field:  fmt.Sprintf("Options[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MenuOptionGroupMultiError(errors)
+	}
+
+	return nil
+}
+
+// MenuOptionGroupMultiError is an error wrapping multiple validation errors
+// returned by MenuOptionGroup.ValidateAll() if the designated constraints
+// aren't met.
+type MenuOptionGroupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MenuOptionGroupMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MenuOptionGroupMultiError) AllErrors() []error { return m }
+
+// MenuOptionGroupValidationError is the validation error returned by
+// MenuOptionGroup.Validate if the designated constraints aren't met.
+type MenuOptionGroupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MenuOptionGroupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MenuOptionGroupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MenuOptionGroupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MenuOptionGroupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MenuOptionGroupValidationError) ErrorName() string { return "MenuOptionGroupValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MenuOptionGroupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMenuOptionGroup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MenuOptionGroupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MenuOptionGroupValidationError{}
+
+// Validate checks the field values on MenuOption with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MenuOption) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MenuOption with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MenuOptionMultiError, or
+// nil if none found.
+func (m *MenuOption) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MenuOption) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := MenuOptionValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTitle()) < 1 {
+		err := MenuOptionValidationError{
+			field:  "Title",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPriceDelta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MenuOptionValidationError{
+					field:  "PriceDelta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MenuOptionValidationError{
+					field:  "PriceDelta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPriceDelta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MenuOptionValidationError{
+				field:  "PriceDelta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetMaxQuantity() < 1 {
+		err := MenuOptionValidationError{
+			field:  "MaxQuantity",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Available
+
+	// no validation rules for PerOrder
+
+	if len(errors) > 0 {
+		return MenuOptionMultiError(errors)
+	}
+
+	return nil
+}
+
+// MenuOptionMultiError is an error wrapping multiple validation errors
+// returned by MenuOption.ValidateAll() if the designated constraints aren't met.
+type MenuOptionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MenuOptionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MenuOptionMultiError) AllErrors() []error { return m }
+
+// MenuOptionValidationError is the validation error returned by
+// MenuOption.Validate if the designated constraints aren't met.
+type MenuOptionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MenuOptionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MenuOptionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MenuOptionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MenuOptionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MenuOptionValidationError) ErrorName() string { return "MenuOptionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MenuOptionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMenuOption.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MenuOptionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MenuOptionValidationError{}
+
+// Validate checks the field values on AttachMenuWithPayloadReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AttachMenuWithPayloadReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttachMenuWithPayloadReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttachMenuWithPayloadReqMultiError, or nil if none found.
+func (m *AttachMenuWithPayloadReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttachMenuWithPayloadReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetIdempotencyKey()) < 1 {
+		err := AttachMenuWithPayloadReqValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSheetId()) < 1 {
+		err := AttachMenuWithPayloadReqValidationError{
+			field:  "SheetId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetItems()); l < 1 || l > 300 {
+		err := AttachMenuWithPayloadReqValidationError{
+			field:  "Items",
+			reason: "value must contain between 1 and 300 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AttachMenuWithPayloadReqValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AttachMenuWithPayloadReqValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AttachMenuWithPayloadReqValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AttachMenuWithPayloadReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttachMenuWithPayloadReqMultiError is an error wrapping multiple validation
+// errors returned by AttachMenuWithPayloadReq.ValidateAll() if the designated
+// constraints aren't met.
+type AttachMenuWithPayloadReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttachMenuWithPayloadReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttachMenuWithPayloadReqMultiError) AllErrors() []error { return m }
+
+// AttachMenuWithPayloadReqValidationError is the validation error returned by
+// AttachMenuWithPayloadReq.Validate if the designated constraints aren't met.
+type AttachMenuWithPayloadReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttachMenuWithPayloadReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttachMenuWithPayloadReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttachMenuWithPayloadReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttachMenuWithPayloadReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttachMenuWithPayloadReqValidationError) ErrorName() string {
+	return "AttachMenuWithPayloadReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttachMenuWithPayloadReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttachMenuWithPayloadReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttachMenuWithPayloadReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttachMenuWithPayloadReqValidationError{}
+
+// Validate checks the field values on AttachMenuWithPayloadResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AttachMenuWithPayloadResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttachMenuWithPayloadResp with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttachMenuWithPayloadRespMultiError, or nil if none found.
+func (m *AttachMenuWithPayloadResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttachMenuWithPayloadResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AttachMenuWithPayloadRespValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AttachMenuWithPayloadRespValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AttachMenuWithPayloadRespValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetSheet()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AttachMenuWithPayloadRespValidationError{
+					field:  "Sheet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AttachMenuWithPayloadRespValidationError{
+					field:  "Sheet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSheet()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AttachMenuWithPayloadRespValidationError{
+				field:  "Sheet",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AttachMenuWithPayloadRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttachMenuWithPayloadRespMultiError is an error wrapping multiple validation
+// errors returned by AttachMenuWithPayloadResp.ValidateAll() if the
+// designated constraints aren't met.
+type AttachMenuWithPayloadRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttachMenuWithPayloadRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttachMenuWithPayloadRespMultiError) AllErrors() []error { return m }
+
+// AttachMenuWithPayloadRespValidationError is the validation error returned by
+// AttachMenuWithPayloadResp.Validate if the designated constraints aren't met.
+type AttachMenuWithPayloadRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttachMenuWithPayloadRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttachMenuWithPayloadRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttachMenuWithPayloadRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttachMenuWithPayloadRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttachMenuWithPayloadRespValidationError) ErrorName() string {
+	return "AttachMenuWithPayloadRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttachMenuWithPayloadRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttachMenuWithPayloadResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttachMenuWithPayloadRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttachMenuWithPayloadRespValidationError{}
+
+// Validate checks the field values on ImportMenuReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImportMenuReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportMenuReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ImportMenuReqMultiError, or
+// nil if none found.
+func (m *ImportMenuReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportMenuReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IdempotencyKey
+
+	if utf8.RuneCountInString(m.GetSheetId()) < 1 {
+		err := ImportMenuReqValidationError{
+			field:  "SheetId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ImportMenuReq_Format_NotInLookup[m.GetFormat()]; ok {
+		err := ImportMenuReqValidationError{
+			field:  "Format",
+			reason: "value must not be in list [MENU_IMPORT_FORMAT_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := MenuImportFormat_name[int32(m.GetFormat())]; !ok {
+		err := ImportMenuReqValidationError{
+			field:  "Format",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetPayload()); l < 1 || l > 1048576 {
+		err := ImportMenuReqValidationError{
+			field:  "Payload",
+			reason: "value length must be between 1 and 1048576 bytes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return ImportMenuReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportMenuReqMultiError is an error wrapping multiple validation errors
+// returned by ImportMenuReq.ValidateAll() if the designated constraints
+// aren't met.
+type ImportMenuReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportMenuReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportMenuReqMultiError) AllErrors() []error { return m }
+
+// ImportMenuReqValidationError is the validation error returned by
+// ImportMenuReq.Validate if the designated constraints aren't met.
+type ImportMenuReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportMenuReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportMenuReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportMenuReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportMenuReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportMenuReqValidationError) ErrorName() string { return "ImportMenuReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportMenuReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportMenuReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportMenuReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportMenuReqValidationError{}
+
+var _ImportMenuReq_Format_NotInLookup = map[MenuImportFormat]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on MenuImportError with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MenuImportError) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MenuImportError with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MenuImportErrorMultiError, or nil if none found.
+func (m *MenuImportError) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MenuImportError) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Row
+
+	// no validation rules for Field
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return MenuImportErrorMultiError(errors)
+	}
+
+	return nil
+}
+
+// MenuImportErrorMultiError is an error wrapping multiple validation errors
+// returned by MenuImportError.ValidateAll() if the designated constraints
+// aren't met.
+type MenuImportErrorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MenuImportErrorMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MenuImportErrorMultiError) AllErrors() []error { return m }
+
+// MenuImportErrorValidationError is the validation error returned by
+// MenuImportError.Validate if the designated constraints aren't met.
+type MenuImportErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MenuImportErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MenuImportErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MenuImportErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MenuImportErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MenuImportErrorValidationError) ErrorName() string { return "MenuImportErrorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MenuImportErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMenuImportError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MenuImportErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MenuImportErrorValidationError{}
+
+// Validate checks the field values on ImportMenuResp with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImportMenuResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportMenuResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ImportMenuRespMultiError,
+// or nil if none found.
+func (m *ImportMenuResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportMenuResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportMenuRespValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportMenuRespValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportMenuRespValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportMenuRespValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportMenuRespValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportMenuRespValidationError{
+					field:  fmt.Sprintf("Errors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetSheet()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImportMenuRespValidationError{
+					field:  "Sheet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImportMenuRespValidationError{
+					field:  "Sheet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSheet()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImportMenuRespValidationError{
+				field:  "Sheet",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ImportMenuRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportMenuRespMultiError is an error wrapping multiple validation errors
+// returned by ImportMenuResp.ValidateAll() if the designated constraints
+// aren't met.
+type ImportMenuRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportMenuRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportMenuRespMultiError) AllErrors() []error { return m }
+
+// ImportMenuRespValidationError is the validation error returned by
+// ImportMenuResp.Validate if the designated constraints aren't met.
+type ImportMenuRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportMenuRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportMenuRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportMenuRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportMenuRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportMenuRespValidationError) ErrorName() string { return "ImportMenuRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportMenuRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportMenuResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
 }
 
-// ListMembersRequestMultiError is an error wrapping multiple validation errors
-// returned by ListMembersRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ListMembersRequestMultiError []error
+var _ error = ImportMenuRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportMenuRespValidationError{}
+
+// Validate checks the field values on GetMenuReq with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetMenuReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMenuReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetMenuReqMultiError, or
+// nil if none found.
+func (m *GetMenuReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMenuReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetSheetId()) < 1 {
+		err := GetMenuReqValidationError{
+			field:  "SheetId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetMenuReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMenuReqMultiError is an error wrapping multiple validation errors
+// returned by GetMenuReq.ValidateAll() if the designated constraints aren't met.
+type GetMenuReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListMembersRequestMultiError) Error() string {
+func (m GetMenuReqMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2254,11 +5046,11 @@ func (m ListMembersRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListMembersRequestMultiError) AllErrors() []error { return m }
+func (m GetMenuReqMultiError) AllErrors() []error { return m }
 
-// ListMembersRequestValidationError is the validation error returned by
-// ListMembersRequest.Validate if the designated constraints aren't met.
-type ListMembersRequestValidationError struct {
+// GetMenuReqValidationError is the validation error returned by
+// GetMenuReq.Validate if the designated constraints aren't met.
+type GetMenuReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2266,24 +5058,22 @@ type ListMembersRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListMembersRequestValidationError) Field() string { return e.field }
+func (e GetMenuReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListMembersRequestValidationError) Reason() string { return e.reason }
+func (e GetMenuReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListMembersRequestValidationError) Cause() error { return e.cause }
+func (e GetMenuReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListMembersRequestValidationError) Key() bool { return e.key }
+func (e GetMenuReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListMembersRequestValidationError) ErrorName() string {
-	return "ListMembersRequestValidationError"
-}
+func (e GetMenuReqValidationError) ErrorName() string { return "GetMenuReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListMembersRequestValidationError) Error() string {
+func (e GetMenuReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2295,14 +5085,14 @@ func (e ListMembersRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListMembersRequest.%s: %s%s",
+		"invalid %sGetMenuReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListMembersRequestValidationError{}
+var _ error = GetMenuReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -2310,47 +5100,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListMembersRequestValidationError{}
+} = GetMenuReqValidationError{}
 
-// Validate checks the field values on ListMembersResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListMembersResponse) Validate() error {
+// Validate checks the field values on GetMenuResp with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetMenuResp) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListMembersResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListMembersResponseMultiError, or nil if none found.
-func (m *ListMembersResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetMenuResp with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetMenuRespMultiError, or
+// nil if none found.
+func (m *GetMenuResp) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListMembersResponse) validate(all bool) error {
+func (m *GetMenuResp) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetMembers() {
+	for idx, item := range m.GetItems() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListMembersResponseValidationError{
-						field:  fmt.Sprintf("Members[%v]", idx),
+					errors = append(errors, GetMenuRespValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListMembersResponseValidationError{
-						field:  fmt.Sprintf("Members[%v]", idx),
+					errors = append(errors, GetMenuRespValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -2358,8 +5148,8 @@ func (m *ListMembersResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListMembersResponseValidationError{
-					field:  fmt.Sprintf("Members[%v]", idx),
+				return GetMenuRespValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -2368,53 +5158,157 @@ func (m *ListMembersResponse) validate(all bool) error {
 
 	}
 
-	if m.NextCursor != nil {
+	if len(errors) > 0 {
+		return GetMenuRespMultiError(errors)
+	}
 
-		if all {
-			switch v := interface{}(m.GetNextCursor()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListMembersResponseValidationError{
-						field:  "NextCursor",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListMembersResponseValidationError{
-						field:  "NextCursor",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	return nil
+}
+
+// GetMenuRespMultiError is an error wrapping multiple validation errors
+// returned by GetMenuResp.ValidateAll() if the designated constraints aren't met.
+type GetMenuRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMenuRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMenuRespMultiError) AllErrors() []error { return m }
+
+// GetMenuRespValidationError is the validation error returned by
+// GetMenuResp.Validate if the designated constraints aren't met.
+type GetMenuRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMenuRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMenuRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMenuRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMenuRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMenuRespValidationError) ErrorName() string { return "GetMenuRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetMenuRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMenuResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMenuRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMenuRespValidationError{}
+
+// Validate checks the field values on MenuVersion with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MenuVersion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MenuVersion with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MenuVersionMultiError, or
+// nil if none found.
+func (m *MenuVersion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MenuVersion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for SheetId
+
+	// no validation rules for Version
+
+	// no validation rules for ItemCount
+
+	// no validation rules for Active
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MenuVersionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(m.GetNextCursor()).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListMembersResponseValidationError{
-					field:  "NextCursor",
+				errors = append(errors, MenuVersionValidationError{
+					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MenuVersionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return ListMembersResponseMultiError(errors)
+		return MenuVersionMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListMembersResponseMultiError is an error wrapping multiple validation
-// errors returned by ListMembersResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListMembersResponseMultiError []error
+// MenuVersionMultiError is an error wrapping multiple validation errors
+// returned by MenuVersion.ValidateAll() if the designated constraints aren't met.
+type MenuVersionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListMembersResponseMultiError) Error() string {
+func (m MenuVersionMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2423,11 +5317,11 @@ func (m ListMembersResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListMembersResponseMultiError) AllErrors() []error { return m }
+func (m MenuVersionMultiError) AllErrors() []error { return m }
 
-// ListMembersResponseValidationError is the validation error returned by
-// ListMembersResponse.Validate if the designated constraints aren't met.
-type ListMembersResponseValidationError struct {
+// MenuVersionValidationError is the validation error returned by
+// MenuVersion.Validate if the designated constraints aren't met.
+type MenuVersionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2435,24 +5329,22 @@ type ListMembersResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListMembersResponseValidationError) Field() string { return e.field }
+func (e MenuVersionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListMembersResponseValidationError) Reason() string { return e.reason }
+func (e MenuVersionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListMembersResponseValidationError) Cause() error { return e.cause }
+func (e MenuVersionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListMembersResponseValidationError) Key() bool { return e.key }
+func (e MenuVersionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListMembersResponseValidationError) ErrorName() string {
-	return "ListMembersResponseValidationError"
-}
+func (e MenuVersionValidationError) ErrorName() string { return "MenuVersionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListMembersResponseValidationError) Error() string {
+func (e MenuVersionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2464,14 +5356,14 @@ func (e ListMembersResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListMembersResponse.%s: %s%s",
+		"invalid %sMenuVersion.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListMembersResponseValidationError{}
+var _ error = MenuVersionValidationError{}
 
 var _ interface {
 	Field() string
@@ -2479,141 +5371,106 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListMembersResponseValidationError{}
+} = MenuVersionValidationError{}
 
-// Validate checks the field values on MenuItem with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on MenuItemChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *MenuItem) Validate() error {
+func (m *MenuItemChange) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MenuItem with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in MenuItemMultiError, or nil
-// if none found.
-func (m *MenuItem) ValidateAll() error {
+// ValidateAll checks the field values on MenuItemChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MenuItemChangeMultiError,
+// or nil if none found.
+func (m *MenuItemChange) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MenuItem) validate(all bool) error {
+func (m *MenuItemChange) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetId()) < 1 {
-		err := MenuItemValidationError{
-			field:  "Id",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Type
 
-	if utf8.RuneCountInString(m.GetTitle()) < 1 {
-		err := MenuItemValidationError{
-			field:  "Title",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for ItemId
 
 	if all {
-		switch v := interface{}(m.GetPrice()).(type) {
+		switch v := interface{}(m.GetBefore()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MenuItemValidationError{
-					field:  "Price",
+				errors = append(errors, MenuItemChangeValidationError{
+					field:  "Before",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MenuItemValidationError{
-					field:  "Price",
+				errors = append(errors, MenuItemChangeValidationError{
+					field:  "Before",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetPrice()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetBefore()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MenuItemValidationError{
-				field:  "Price",
+			return MenuItemChangeValidationError{
+				field:  "Before",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if utf8.RuneCountInString(m.GetDescription()) > 1000 {
-		err := MenuItemValidationError{
-			field:  "Description",
-			reason: "value length must be at most 1000 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for Available
-
-	for idx, item := range m.GetOptionGroups() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MenuItemValidationError{
-						field:  fmt.Sprintf("OptionGroups[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MenuItemValidationError{
-						field:  fmt.Sprintf("OptionGroups[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MenuItemChangeValidationError{
+					field:  "After",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return MenuItemValidationError{
-					field:  fmt.Sprintf("OptionGroups[%v]", idx),
+				errors = append(errors, MenuItemChangeValidationError{
+					field:  "After",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MenuItemChangeValidationError{
+				field:  "After",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return MenuItemMultiError(errors)
+		return MenuItemChangeMultiError(errors)
 	}
 
 	return nil
 }
 
-// MenuItemMultiError is an error wrapping multiple validation errors returned
-// by MenuItem.ValidateAll() if the designated constraints aren't met.
-type MenuItemMultiError []error
+// MenuItemChangeMultiError is an error wrapping multiple validation errors
+// returned by MenuItemChange.ValidateAll() if the designated constraints
+// aren't met.
+type MenuItemChangeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MenuItemMultiError) Error() string {
+func (m MenuItemChangeMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2622,11 +5479,11 @@ func (m MenuItemMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MenuItemMultiError) AllErrors() []error { return m }
+func (m MenuItemChangeMultiError) AllErrors() []error { return m }
 
-// MenuItemValidationError is the validation error returned by
-// MenuItem.Validate if the designated constraints aren't met.
-type MenuItemValidationError struct {
+// MenuItemChangeValidationError is the validation error returned by
+// MenuItemChange.Validate if the designated constraints aren't met.
+type MenuItemChangeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2634,22 +5491,22 @@ type MenuItemValidationError struct {
 }
 
 // Field function returns field value.
-func (e MenuItemValidationError) Field() string { return e.field }
+func (e MenuItemChangeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MenuItemValidationError) Reason() string { return e.reason }
+func (e MenuItemChangeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MenuItemValidationError) Cause() error { return e.cause }
+func (e MenuItemChangeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MenuItemValidationError) Key() bool { return e.key }
+func (e MenuItemChangeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MenuItemValidationError) ErrorName() string { return "MenuItemValidationError" }
+func (e MenuItemChangeValidationError) ErrorName() string { return "MenuItemChangeValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MenuItemValidationError) Error() string {
+func (e MenuItemChangeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2661,14 +5518,14 @@ func (e MenuItemValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMenuItem.%s: %s%s",
+		"invalid %sMenuItemChange.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MenuItemValidationError{}
+var _ error = MenuItemChangeValidationError{}
 
 var _ interface {
 	Field() string
@@ -2676,33 +5533,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MenuItemValidationError{}
+} = MenuItemChangeValidationError{}
 
-// Validate checks the field values on MenuOptionGroup with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *MenuOptionGroup) Validate() error {
+// Validate checks the field values on ListMenuVersionsReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMenuVersionsReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MenuOptionGroup with the rules
+// ValidateAll checks the field values on ListMenuVersionsReq with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MenuOptionGroupMultiError, or nil if none found.
-func (m *MenuOptionGroup) ValidateAll() error {
+// ListMenuVersionsReqMultiError, or nil if none found.
+func (m *ListMenuVersionsReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MenuOptionGroup) validate(all bool) error {
+func (m *ListMenuVersionsReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetId()) < 1 {
-		err := MenuOptionGroupValidationError{
-			field:  "Id",
+	if utf8.RuneCountInString(m.GetSheetId()) < 1 {
+		err := ListMenuVersionsReqValidationError{
+			field:  "SheetId",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -2711,42 +5568,125 @@ func (m *MenuOptionGroup) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetTitle()) < 1 {
-		err := MenuOptionGroupValidationError{
-			field:  "Title",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	if len(errors) > 0 {
+		return ListMenuVersionsReqMultiError(errors)
 	}
 
-	// no validation rules for Required
+	return nil
+}
 
-	// no validation rules for MultiSelect
+// ListMenuVersionsReqMultiError is an error wrapping multiple validation
+// errors returned by ListMenuVersionsReq.ValidateAll() if the designated
+// constraints aren't met.
+type ListMenuVersionsReqMultiError []error
 
-	// no validation rules for MinSelect
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMenuVersionsReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
 
-	// no validation rules for MaxSelect
+// AllErrors returns a list of validation violation errors.
+func (m ListMenuVersionsReqMultiError) AllErrors() []error { return m }
 
-	for idx, item := range m.GetOptions() {
+// ListMenuVersionsReqValidationError is the validation error returned by
+// ListMenuVersionsReq.Validate if the designated constraints aren't met.
+type ListMenuVersionsReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMenuVersionsReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMenuVersionsReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMenuVersionsReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMenuVersionsReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMenuVersionsReqValidationError) ErrorName() string {
+	return "ListMenuVersionsReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMenuVersionsReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMenuVersionsReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMenuVersionsReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMenuVersionsReqValidationError{}
+
+// Validate checks the field values on ListMenuVersionsResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMenuVersionsResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMenuVersionsResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMenuVersionsRespMultiError, or nil if none found.
+func (m *ListMenuVersionsResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMenuVersionsResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetVersions() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MenuOptionGroupValidationError{
-						field:  fmt.Sprintf("Options[%v]", idx),
+					errors = append(errors, ListMenuVersionsRespValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, MenuOptionGroupValidationError{
-						field:  fmt.Sprintf("Options[%v]", idx),
+					errors = append(errors, ListMenuVersionsRespValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -2754,8 +5694,8 @@ func (m *MenuOptionGroup) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return MenuOptionGroupValidationError{
-					field:  fmt.Sprintf("Options[%v]", idx),
+				return ListMenuVersionsRespValidationError{
+					field:  fmt.Sprintf("Versions[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -2765,19 +5705,19 @@ func (m *MenuOptionGroup) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return MenuOptionGroupMultiError(errors)
+		return ListMenuVersionsRespMultiError(errors)
 	}
 
 	return nil
 }
 
-// MenuOptionGroupMultiError is an error wrapping multiple validation errors
-// returned by MenuOptionGroup.ValidateAll() if the designated constraints
-// aren't met.
-type MenuOptionGroupMultiError []error
+// ListMenuVersionsRespMultiError is an error wrapping multiple validation
+// errors returned by ListMenuVersionsResp.ValidateAll() if the designated
+// constraints aren't met.
+type ListMenuVersionsRespMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MenuOptionGroupMultiError) Error() string {
+func (m ListMenuVersionsRespMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2786,11 +5726,11 @@ func (m MenuOptionGroupMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MenuOptionGroupMultiError) AllErrors() []error { return m }
+func (m ListMenuVersionsRespMultiError) AllErrors() []error { return m }
 
-// MenuOptionGroupValidationError is the validation error returned by
-// MenuOptionGroup.Validate if the designated constraints aren't met.
-type MenuOptionGroupValidationError struct {
+// ListMenuVersionsRespValidationError is the validation error returned by
+// ListMenuVersionsResp.Validate if the designated constraints aren't met.
+type ListMenuVersionsRespValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2798,22 +5738,24 @@ type MenuOptionGroupValidationError struct {
 }
 
 // Field function returns field value.
-func (e MenuOptionGroupValidationError) Field() string { return e.field }
+func (e ListMenuVersionsRespValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MenuOptionGroupValidationError) Reason() string { return e.reason }
+func (e ListMenuVersionsRespValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MenuOptionGroupValidationError) Cause() error { return e.cause }
+func (e ListMenuVersionsRespValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MenuOptionGroupValidationError) Key() bool { return e.key }
+func (e ListMenuVersionsRespValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MenuOptionGroupValidationError) ErrorName() string { return "MenuOptionGroupValidationError" }
+func (e ListMenuVersionsRespValidationError) ErrorName() string {
+	return "ListMenuVersionsRespValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e MenuOptionGroupValidationError) Error() string {
+func (e ListMenuVersionsRespValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2825,14 +5767,14 @@ func (e MenuOptionGroupValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMenuOptionGroup.%s: %s%s",
+		"invalid %sListMenuVersionsResp.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MenuOptionGroupValidationError{}
+var _ error = ListMenuVersionsRespValidationError{}
 
 var _ interface {
 	Field() string
@@ -2840,33 +5782,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MenuOptionGroupValidationError{}
+} = ListMenuVersionsRespValidationError{}
 
-// Validate checks the field values on MenuOption with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *MenuOption) Validate() error {
+// Validate checks the field values on DiffMenuVersionsReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DiffMenuVersionsReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MenuOption with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in MenuOptionMultiError, or
-// nil if none found.
-func (m *MenuOption) ValidateAll() error {
+// ValidateAll checks the field values on DiffMenuVersionsReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiffMenuVersionsReqMultiError, or nil if none found.
+func (m *DiffMenuVersionsReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MenuOption) validate(all bool) error {
+func (m *DiffMenuVersionsReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetId()) < 1 {
-		err := MenuOptionValidationError{
-			field:  "Id",
+	if utf8.RuneCountInString(m.GetSheetId()) < 1 {
+		err := DiffMenuVersionsReqValidationError{
+			field:  "SheetId",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -2875,9 +5817,9 @@ func (m *MenuOption) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetTitle()) < 1 {
-		err := MenuOptionValidationError{
-			field:  "Title",
+	if utf8.RuneCountInString(m.GetFromMenuId()) < 1 {
+		err := DiffMenuVersionsReqValidationError{
+			field:  "FromMenuId",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -2886,61 +5828,22 @@ func (m *MenuOption) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetPriceDelta()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MenuOptionValidationError{
-					field:  "PriceDelta",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, MenuOptionValidationError{
-					field:  "PriceDelta",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetPriceDelta()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return MenuOptionValidationError{
-				field:  "PriceDelta",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if m.GetMaxQuantity() < 1 {
-		err := MenuOptionValidationError{
-			field:  "MaxQuantity",
-			reason: "value must be greater than or equal to 1",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for Available
+	// no validation rules for ToMenuId
 
 	if len(errors) > 0 {
-		return MenuOptionMultiError(errors)
+		return DiffMenuVersionsReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// MenuOptionMultiError is an error wrapping multiple validation errors
-// returned by MenuOption.ValidateAll() if the designated constraints aren't met.
-type MenuOptionMultiError []error
+// DiffMenuVersionsReqMultiError is an error wrapping multiple validation
+// errors returned by DiffMenuVersionsReq.ValidateAll() if the designated
+// constraints aren't met.
+type DiffMenuVersionsReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MenuOptionMultiError) Error() string {
+func (m DiffMenuVersionsReqMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2949,11 +5852,11 @@ func (m MenuOptionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MenuOptionMultiError) AllErrors() []error { return m }
+func (m DiffMenuVersionsReqMultiError) AllErrors() []error { return m }
 
-// MenuOptionValidationError is the validation error returned by
-// MenuOption.Validate if the designated constraints aren't met.
-type MenuOptionValidationError struct {
+// DiffMenuVersionsReqValidationError is the validation error returned by
+// DiffMenuVersionsReq.Validate if the designated constraints aren't met.
+type DiffMenuVersionsReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2961,22 +5864,24 @@ type MenuOptionValidationError struct {
 }
 
 // Field function returns field value.
-func (e MenuOptionValidationError) Field() string { return e.field }
+func (e DiffMenuVersionsReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MenuOptionValidationError) Reason() string { return e.reason }
+func (e DiffMenuVersionsReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MenuOptionValidationError) Cause() error { return e.cause }
+func (e DiffMenuVersionsReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MenuOptionValidationError) Key() bool { return e.key }
+func (e DiffMenuVersionsReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MenuOptionValidationError) ErrorName() string { return "MenuOptionValidationError" }
+func (e DiffMenuVersionsReqValidationError) ErrorName() string {
+	return "DiffMenuVersionsReqValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e MenuOptionValidationError) Error() string {
+func (e DiffMenuVersionsReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2988,14 +5893,14 @@ func (e MenuOptionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMenuOption.%s: %s%s",
+		"invalid %sDiffMenuVersionsReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MenuOptionValidationError{}
+var _ error = DiffMenuVersionsReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -3003,80 +5908,105 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MenuOptionValidationError{}
+} = DiffMenuVersionsReqValidationError{}
 
-// Validate checks the field values on AttachMenuWithPayloadReq with the rules
+// Validate checks the field values on DiffMenuVersionsResp with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AttachMenuWithPayloadReq) Validate() error {
+func (m *DiffMenuVersionsResp) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AttachMenuWithPayloadReq with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DiffMenuVersionsResp with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AttachMenuWithPayloadReqMultiError, or nil if none found.
-func (m *AttachMenuWithPayloadReq) ValidateAll() error {
+// DiffMenuVersionsRespMultiError, or nil if none found.
+func (m *DiffMenuVersionsResp) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AttachMenuWithPayloadReq) validate(all bool) error {
+func (m *DiffMenuVersionsResp) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetIdempotencyKey()) < 1 {
-		err := AttachMenuWithPayloadReqValidationError{
-			field:  "IdempotencyKey",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetSheetId()) < 1 {
-		err := AttachMenuWithPayloadReqValidationError{
-			field:  "SheetId",
-			reason: "value length must be at least 1 runes",
+	if all {
+		switch v := interface{}(m.GetFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiffMenuVersionsRespValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiffMenuVersionsRespValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiffMenuVersionsRespValidationError{
+				field:  "From",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	if len(m.GetItems()) < 1 {
-		err := AttachMenuWithPayloadReqValidationError{
-			field:  "Items",
-			reason: "value must contain at least 1 item(s)",
+	if all {
+		switch v := interface{}(m.GetTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DiffMenuVersionsRespValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DiffMenuVersionsRespValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DiffMenuVersionsRespValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	for idx, item := range m.GetItems() {
+	for idx, item := range m.GetChanges() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AttachMenuWithPayloadReqValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
+					errors = append(errors, DiffMenuVersionsRespValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AttachMenuWithPayloadReqValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
+					errors = append(errors, DiffMenuVersionsRespValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3084,8 +6014,8 @@ func (m *AttachMenuWithPayloadReq) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AttachMenuWithPayloadReqValidationError{
-					field:  fmt.Sprintf("Items[%v]", idx),
+				return DiffMenuVersionsRespValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -3095,19 +6025,19 @@ func (m *AttachMenuWithPayloadReq) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return AttachMenuWithPayloadReqMultiError(errors)
+		return DiffMenuVersionsRespMultiError(errors)
 	}
 
 	return nil
 }
 
-// AttachMenuWithPayloadReqMultiError is an error wrapping multiple validation
-// errors returned by AttachMenuWithPayloadReq.ValidateAll() if the designated
+// DiffMenuVersionsRespMultiError is an error wrapping multiple validation
+// errors returned by DiffMenuVersionsResp.ValidateAll() if the designated
 // constraints aren't met.
-type AttachMenuWithPayloadReqMultiError []error
+type DiffMenuVersionsRespMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AttachMenuWithPayloadReqMultiError) Error() string {
+func (m DiffMenuVersionsRespMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3116,11 +6046,11 @@ func (m AttachMenuWithPayloadReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AttachMenuWithPayloadReqMultiError) AllErrors() []error { return m }
+func (m DiffMenuVersionsRespMultiError) AllErrors() []error { return m }
 
-// AttachMenuWithPayloadReqValidationError is the validation error returned by
-// AttachMenuWithPayloadReq.Validate if the designated constraints aren't met.
-type AttachMenuWithPayloadReqValidationError struct {
+// DiffMenuVersionsRespValidationError is the validation error returned by
+// DiffMenuVersionsResp.Validate if the designated constraints aren't met.
+type DiffMenuVersionsRespValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3128,24 +6058,24 @@ type AttachMenuWithPayloadReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e AttachMenuWithPayloadReqValidationError) Field() string { return e.field }
+func (e DiffMenuVersionsRespValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AttachMenuWithPayloadReqValidationError) Reason() string { return e.reason }
+func (e DiffMenuVersionsRespValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AttachMenuWithPayloadReqValidationError) Cause() error { return e.cause }
+func (e DiffMenuVersionsRespValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AttachMenuWithPayloadReqValidationError) Key() bool { return e.key }
+func (e DiffMenuVersionsRespValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AttachMenuWithPayloadReqValidationError) ErrorName() string {
-	return "AttachMenuWithPayloadReqValidationError"
+func (e DiffMenuVersionsRespValidationError) ErrorName() string {
+	return "DiffMenuVersionsRespValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AttachMenuWithPayloadReqValidationError) Error() string {
+func (e DiffMenuVersionsRespValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3157,14 +6087,14 @@ func (e AttachMenuWithPayloadReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAttachMenuWithPayloadReq.%s: %s%s",
+		"invalid %sDiffMenuVersionsResp.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AttachMenuWithPayloadReqValidationError{}
+var _ error = DiffMenuVersionsRespValidationError{}
 
 var _ interface {
 	Field() string
@@ -3172,87 +6102,146 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AttachMenuWithPayloadReqValidationError{}
+} = DiffMenuVersionsRespValidationError{}
 
-// Validate checks the field values on AttachMenuWithPayloadResp with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AttachMenuWithPayloadResp) Validate() error {
+// Validate checks the field values on SettlementLine with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SettlementLine) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AttachMenuWithPayloadResp with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AttachMenuWithPayloadRespMultiError, or nil if none found.
-func (m *AttachMenuWithPayloadResp) ValidateAll() error {
+// ValidateAll checks the field values on SettlementLine with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SettlementLineMultiError,
+// or nil if none found.
+func (m *SettlementLine) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AttachMenuWithPayloadResp) validate(all bool) error {
+func (m *SettlementLine) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetItems() {
-		_, _ = idx, item
+	// no validation rules for UserId
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AttachMenuWithPayloadRespValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AttachMenuWithPayloadRespValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	// no validation rules for IsHost
+
+	// no validation rules for OrderCount
+
+	if all {
+		switch v := interface{}(m.GetSubtotal()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SettlementLineValidationError{
+					field:  "Subtotal",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SettlementLineValidationError{
+					field:  "Subtotal",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubtotal()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SettlementLineValidationError{
+				field:  "Subtotal",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFeeShare()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SettlementLineValidationError{
+					field:  "FeeShare",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return AttachMenuWithPayloadRespValidationError{
-					field:  fmt.Sprintf("Items[%v]", idx),
+				errors = append(errors, SettlementLineValidationError{
+					field:  "FeeShare",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFeeShare()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SettlementLineValidationError{
+				field:  "FeeShare",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
+	}
 
+	if all {
+		switch v := interface{}(m.GetDiscountShare()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SettlementLineValidationError{
+					field:  "DiscountShare",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SettlementLineValidationError{
+					field:  "DiscountShare",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDiscountShare()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SettlementLineValidationError{
+				field:  "DiscountShare",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
 	if all {
-		switch v := interface{}(m.GetSheet()).(type) {
+		switch v := interface{}(m.GetTotal()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AttachMenuWithPayloadRespValidationError{
-					field:  "Sheet",
+				errors = append(errors, SettlementLineValidationError{
+					field:  "Total",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AttachMenuWithPayloadRespValidationError{
-					field:  "Sheet",
+				errors = append(errors, SettlementLineValidationError{
+					field:  "Total",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSheet()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetTotal()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return AttachMenuWithPayloadRespValidationError{
-				field:  "Sheet",
+			return SettlementLineValidationError{
+				field:  "Total",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -3260,19 +6249,19 @@ func (m *AttachMenuWithPayloadResp) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return AttachMenuWithPayloadRespMultiError(errors)
+		return SettlementLineMultiError(errors)
 	}
 
 	return nil
 }
 
-// AttachMenuWithPayloadRespMultiError is an error wrapping multiple validation
-// errors returned by AttachMenuWithPayloadResp.ValidateAll() if the
-// designated constraints aren't met.
-type AttachMenuWithPayloadRespMultiError []error
+// SettlementLineMultiError is an error wrapping multiple validation errors
+// returned by SettlementLine.ValidateAll() if the designated constraints
+// aren't met.
+type SettlementLineMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AttachMenuWithPayloadRespMultiError) Error() string {
+func (m SettlementLineMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3281,11 +6270,11 @@ func (m AttachMenuWithPayloadRespMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AttachMenuWithPayloadRespMultiError) AllErrors() []error { return m }
+func (m SettlementLineMultiError) AllErrors() []error { return m }
 
-// AttachMenuWithPayloadRespValidationError is the validation error returned by
-// AttachMenuWithPayloadResp.Validate if the designated constraints aren't met.
-type AttachMenuWithPayloadRespValidationError struct {
+// SettlementLineValidationError is the validation error returned by
+// SettlementLine.Validate if the designated constraints aren't met.
+type SettlementLineValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3293,24 +6282,22 @@ type AttachMenuWithPayloadRespValidationError struct {
 }
 
 // Field function returns field value.
-func (e AttachMenuWithPayloadRespValidationError) Field() string { return e.field }
+func (e SettlementLineValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AttachMenuWithPayloadRespValidationError) Reason() string { return e.reason }
+func (e SettlementLineValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AttachMenuWithPayloadRespValidationError) Cause() error { return e.cause }
+func (e SettlementLineValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AttachMenuWithPayloadRespValidationError) Key() bool { return e.key }
+func (e SettlementLineValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AttachMenuWithPayloadRespValidationError) ErrorName() string {
-	return "AttachMenuWithPayloadRespValidationError"
-}
+func (e SettlementLineValidationError) ErrorName() string { return "SettlementLineValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AttachMenuWithPayloadRespValidationError) Error() string {
+func (e SettlementLineValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3322,14 +6309,14 @@ func (e AttachMenuWithPayloadRespValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAttachMenuWithPayloadResp.%s: %s%s",
+		"invalid %sSettlementLine.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AttachMenuWithPayloadRespValidationError{}
+var _ error = SettlementLineValidationError{}
 
 var _ interface {
 	Field() string
@@ -3337,24 +6324,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AttachMenuWithPayloadRespValidationError{}
+} = SettlementLineValidationError{}
 
-// Validate checks the field values on GetMenuReq with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetMenuReq) Validate() error {
+// Validate checks the field values on GetSheetSettlementReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSheetSettlementReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetMenuReq with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetMenuReqMultiError, or
-// nil if none found.
-func (m *GetMenuReq) ValidateAll() error {
+// ValidateAll checks the field values on GetSheetSettlementReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSheetSettlementReqMultiError, or nil if none found.
+func (m *GetSheetSettlementReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetMenuReq) validate(all bool) error {
+func (m *GetSheetSettlementReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3362,7 +6349,7 @@ func (m *GetMenuReq) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetSheetId()) < 1 {
-		err := GetMenuReqValidationError{
+		err := GetSheetSettlementReqValidationError{
 			field:  "SheetId",
 			reason: "value length must be at least 1 runes",
 		}
@@ -3373,18 +6360,19 @@ func (m *GetMenuReq) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetMenuReqMultiError(errors)
+		return GetSheetSettlementReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetMenuReqMultiError is an error wrapping multiple validation errors
-// returned by GetMenuReq.ValidateAll() if the designated constraints aren't met.
-type GetMenuReqMultiError []error
+// GetSheetSettlementReqMultiError is an error wrapping multiple validation
+// errors returned by GetSheetSettlementReq.ValidateAll() if the designated
+// constraints aren't met.
+type GetSheetSettlementReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetMenuReqMultiError) Error() string {
+func (m GetSheetSettlementReqMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3393,11 +6381,11 @@ func (m GetMenuReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetMenuReqMultiError) AllErrors() []error { return m }
+func (m GetSheetSettlementReqMultiError) AllErrors() []error { return m }
 
-// GetMenuReqValidationError is the validation error returned by
-// GetMenuReq.Validate if the designated constraints aren't met.
-type GetMenuReqValidationError struct {
+// GetSheetSettlementReqValidationError is the validation error returned by
+// GetSheetSettlementReq.Validate if the designated constraints aren't met.
+type GetSheetSettlementReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3405,22 +6393,24 @@ type GetMenuReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetMenuReqValidationError) Field() string { return e.field }
+func (e GetSheetSettlementReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetMenuReqValidationError) Reason() string { return e.reason }
+func (e GetSheetSettlementReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetMenuReqValidationError) Cause() error { return e.cause }
+func (e GetSheetSettlementReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetMenuReqValidationError) Key() bool { return e.key }
+func (e GetSheetSettlementReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetMenuReqValidationError) ErrorName() string { return "GetMenuReqValidationError" }
+func (e GetSheetSettlementReqValidationError) ErrorName() string {
+	return "GetSheetSettlementReqValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetMenuReqValidationError) Error() string {
+func (e GetSheetSettlementReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3432,14 +6422,14 @@ func (e GetMenuReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetMenuReq.%s: %s%s",
+		"invalid %sGetSheetSettlementReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetMenuReqValidationError{}
+var _ error = GetSheetSettlementReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -3447,47 +6437,51 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetMenuReqValidationError{}
+} = GetSheetSettlementReqValidationError{}
 
-// Validate checks the field values on GetMenuResp with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetMenuResp) Validate() error {
+// Validate checks the field values on GetSheetSettlementResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSheetSettlementResp) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetMenuResp with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetMenuRespMultiError, or
-// nil if none found.
-func (m *GetMenuResp) ValidateAll() error {
+// ValidateAll checks the field values on GetSheetSettlementResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSheetSettlementRespMultiError, or nil if none found.
+func (m *GetSheetSettlementResp) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetMenuResp) validate(all bool) error {
+func (m *GetSheetSettlementResp) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetItems() {
+	// no validation rules for SheetId
+
+	// no validation rules for HostUserId
+
+	for idx, item := range m.GetLines() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetMenuRespValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
+					errors = append(errors, GetSheetSettlementRespValidationError{
+						field:  fmt.Sprintf("Lines[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetMenuRespValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
+					errors = append(errors, GetSheetSettlementRespValidationError{
+						field:  fmt.Sprintf("Lines[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3495,8 +6489,8 @@ func (m *GetMenuResp) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetMenuRespValidationError{
-					field:  fmt.Sprintf("Items[%v]", idx),
+				return GetSheetSettlementRespValidationError{
+					field:  fmt.Sprintf("Lines[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -3505,19 +6499,167 @@ func (m *GetMenuResp) validate(all bool) error {
 
 	}
 
+	if all {
+		switch v := interface{}(m.GetSubtotal()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSheetSettlementRespValidationError{
+					field:  "Subtotal",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSheetSettlementRespValidationError{
+					field:  "Subtotal",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubtotal()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSheetSettlementRespValidationError{
+				field:  "Subtotal",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFeeTotal()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSheetSettlementRespValidationError{
+					field:  "FeeTotal",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSheetSettlementRespValidationError{
+					field:  "FeeTotal",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFeeTotal()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSheetSettlementRespValidationError{
+				field:  "FeeTotal",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDiscountTotal()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSheetSettlementRespValidationError{
+					field:  "DiscountTotal",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSheetSettlementRespValidationError{
+					field:  "DiscountTotal",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDiscountTotal()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSheetSettlementRespValidationError{
+				field:  "DiscountTotal",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTotal()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSheetSettlementRespValidationError{
+					field:  "Total",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSheetSettlementRespValidationError{
+					field:  "Total",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTotal()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSheetSettlementRespValidationError{
+				field:  "Total",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetOwedToHost()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSheetSettlementRespValidationError{
+					field:  "OwedToHost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSheetSettlementRespValidationError{
+					field:  "OwedToHost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOwedToHost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSheetSettlementRespValidationError{
+				field:  "OwedToHost",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Provisional
+
 	if len(errors) > 0 {
-		return GetMenuRespMultiError(errors)
+		return GetSheetSettlementRespMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetMenuRespMultiError is an error wrapping multiple validation errors
-// returned by GetMenuResp.ValidateAll() if the designated constraints aren't met.
-type GetMenuRespMultiError []error
+// GetSheetSettlementRespMultiError is an error wrapping multiple validation
+// errors returned by GetSheetSettlementResp.ValidateAll() if the designated
+// constraints aren't met.
+type GetSheetSettlementRespMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetMenuRespMultiError) Error() string {
+func (m GetSheetSettlementRespMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3526,11 +6668,11 @@ func (m GetMenuRespMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetMenuRespMultiError) AllErrors() []error { return m }
+func (m GetSheetSettlementRespMultiError) AllErrors() []error { return m }
 
-// GetMenuRespValidationError is the validation error returned by
-// GetMenuResp.Validate if the designated constraints aren't met.
-type GetMenuRespValidationError struct {
+// GetSheetSettlementRespValidationError is the validation error returned by
+// GetSheetSettlementResp.Validate if the designated constraints aren't met.
+type GetSheetSettlementRespValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3538,22 +6680,24 @@ type GetMenuRespValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetMenuRespValidationError) Field() string { return e.field }
+func (e GetSheetSettlementRespValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetMenuRespValidationError) Reason() string { return e.reason }
+func (e GetSheetSettlementRespValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetMenuRespValidationError) Cause() error { return e.cause }
+func (e GetSheetSettlementRespValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetMenuRespValidationError) Key() bool { return e.key }
+func (e GetSheetSettlementRespValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetMenuRespValidationError) ErrorName() string { return "GetMenuRespValidationError" }
+func (e GetSheetSettlementRespValidationError) ErrorName() string {
+	return "GetSheetSettlementRespValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetMenuRespValidationError) Error() string {
+func (e GetSheetSettlementRespValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3565,14 +6709,14 @@ func (e GetMenuRespValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetMenuResp.%s: %s%s",
+		"invalid %sGetSheetSettlementResp.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetMenuRespValidationError{}
+var _ error = GetSheetSettlementRespValidationError{}
 
 var _ interface {
 	Field() string
@@ -3580,4 +6724,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetMenuRespValidationError{}
+} = GetSheetSettlementRespValidationError{}
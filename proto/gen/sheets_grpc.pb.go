@@ -26,8 +26,15 @@ const (
 	SheetsService_JoinSheet_FullMethodName             = "/core.v1.SheetsService/JoinSheet"
 	SheetsService_RemoveMember_FullMethodName          = "/core.v1.SheetsService/RemoveMember"
 	SheetsService_ListMembers_FullMethodName           = "/core.v1.SheetsService/ListMembers"
+	SheetsService_CreateSheetInvite_FullMethodName     = "/core.v1.SheetsService/CreateSheetInvite"
+	SheetsService_RedeemSheetInvite_FullMethodName     = "/core.v1.SheetsService/RedeemSheetInvite"
+	SheetsService_RevokeSheetInvite_FullMethodName     = "/core.v1.SheetsService/RevokeSheetInvite"
 	SheetsService_AttachMenuWithPayload_FullMethodName = "/core.v1.SheetsService/AttachMenuWithPayload"
+	SheetsService_ImportMenu_FullMethodName            = "/core.v1.SheetsService/ImportMenu"
 	SheetsService_GetMenu_FullMethodName               = "/core.v1.SheetsService/GetMenu"
+	SheetsService_ListMenuVersions_FullMethodName      = "/core.v1.SheetsService/ListMenuVersions"
+	SheetsService_DiffMenuVersions_FullMethodName      = "/core.v1.SheetsService/DiffMenuVersions"
+	SheetsService_GetSheetSettlement_FullMethodName    = "/core.v1.SheetsService/GetSheetSettlement"
 )
 
 // SheetsServiceClient is the client API for SheetsService service.
@@ -42,9 +49,21 @@ type SheetsServiceClient interface {
 	JoinSheet(ctx context.Context, in *JoinSheetRequest, opts ...grpc.CallOption) (*JoinSheetResponse, error)
 	RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...grpc.CallOption) (*RemoveMemberResponse, error)
 	ListMembers(ctx context.Context, in *ListMembersRequest, opts ...grpc.CallOption) (*ListMembersResponse, error)
+	// Invites: the host shares a join code, whoever redeems it joins the sheet.
+	CreateSheetInvite(ctx context.Context, in *CreateSheetInviteReq, opts ...grpc.CallOption) (*CreateSheetInviteResp, error)
+	RedeemSheetInvite(ctx context.Context, in *RedeemSheetInviteReq, opts ...grpc.CallOption) (*RedeemSheetInviteResp, error)
+	RevokeSheetInvite(ctx context.Context, in *RevokeSheetInviteReq, opts ...grpc.CallOption) (*RevokeSheetInviteResp, error)
 	// External menu attach/refresh (normalized snapshot in your DB).
 	AttachMenuWithPayload(ctx context.Context, in *AttachMenuWithPayloadReq, opts ...grpc.CallOption) (*AttachMenuWithPayloadResp, error)
+	// Normalizes a JSON or CSV menu from an external source. With dry_run the
+	// normalized menu and row errors are returned and nothing is stored.
+	ImportMenu(ctx context.Context, in *ImportMenuReq, opts ...grpc.CallOption) (*ImportMenuResp, error)
 	GetMenu(ctx context.Context, in *GetMenuReq, opts ...grpc.CallOption) (*GetMenuResp, error)
+	ListMenuVersions(ctx context.Context, in *ListMenuVersionsReq, opts ...grpc.CallOption) (*ListMenuVersionsResp, error)
+	DiffMenuVersions(ctx context.Context, in *DiffMenuVersionsReq, opts ...grpc.CallOption) (*DiffMenuVersionsResp, error)
+	// Per-member breakdown of what is owed to the host. Provisional until the
+	// sheet is closed.
+	GetSheetSettlement(ctx context.Context, in *GetSheetSettlementReq, opts ...grpc.CallOption) (*GetSheetSettlementResp, error)
 }
 
 type sheetsServiceClient struct {
@@ -125,6 +144,36 @@ func (c *sheetsServiceClient) ListMembers(ctx context.Context, in *ListMembersRe
 	return out, nil
 }
 
+func (c *sheetsServiceClient) CreateSheetInvite(ctx context.Context, in *CreateSheetInviteReq, opts ...grpc.CallOption) (*CreateSheetInviteResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSheetInviteResp)
+	err := c.cc.Invoke(ctx, SheetsService_CreateSheetInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sheetsServiceClient) RedeemSheetInvite(ctx context.Context, in *RedeemSheetInviteReq, opts ...grpc.CallOption) (*RedeemSheetInviteResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RedeemSheetInviteResp)
+	err := c.cc.Invoke(ctx, SheetsService_RedeemSheetInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sheetsServiceClient) RevokeSheetInvite(ctx context.Context, in *RevokeSheetInviteReq, opts ...grpc.CallOption) (*RevokeSheetInviteResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeSheetInviteResp)
+	err := c.cc.Invoke(ctx, SheetsService_RevokeSheetInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sheetsServiceClient) AttachMenuWithPayload(ctx context.Context, in *AttachMenuWithPayloadReq, opts ...grpc.CallOption) (*AttachMenuWithPayloadResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AttachMenuWithPayloadResp)
@@ -135,6 +184,16 @@ func (c *sheetsServiceClient) AttachMenuWithPayload(ctx context.Context, in *Att
 	return out, nil
 }
 
+func (c *sheetsServiceClient) ImportMenu(ctx context.Context, in *ImportMenuReq, opts ...grpc.CallOption) (*ImportMenuResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportMenuResp)
+	err := c.cc.Invoke(ctx, SheetsService_ImportMenu_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sheetsServiceClient) GetMenu(ctx context.Context, in *GetMenuReq, opts ...grpc.CallOption) (*GetMenuResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetMenuResp)
@@ -145,6 +204,36 @@ func (c *sheetsServiceClient) GetMenu(ctx context.Context, in *GetMenuReq, opts
 	return out, nil
 }
 
+func (c *sheetsServiceClient) ListMenuVersions(ctx context.Context, in *ListMenuVersionsReq, opts ...grpc.CallOption) (*ListMenuVersionsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMenuVersionsResp)
+	err := c.cc.Invoke(ctx, SheetsService_ListMenuVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sheetsServiceClient) DiffMenuVersions(ctx context.Context, in *DiffMenuVersionsReq, opts ...grpc.CallOption) (*DiffMenuVersionsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffMenuVersionsResp)
+	err := c.cc.Invoke(ctx, SheetsService_DiffMenuVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sheetsServiceClient) GetSheetSettlement(ctx context.Context, in *GetSheetSettlementReq, opts ...grpc.CallOption) (*GetSheetSettlementResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSheetSettlementResp)
+	err := c.cc.Invoke(ctx, SheetsService_GetSheetSettlement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SheetsServiceServer is the server API for SheetsService service.
 // All implementations must embed UnimplementedSheetsServiceServer
 // for forward compatibility.
@@ -157,9 +246,21 @@ type SheetsServiceServer interface {
 	JoinSheet(context.Context, *JoinSheetRequest) (*JoinSheetResponse, error)
 	RemoveMember(context.Context, *RemoveMemberRequest) (*RemoveMemberResponse, error)
 	ListMembers(context.Context, *ListMembersRequest) (*ListMembersResponse, error)
+	// Invites: the host shares a join code, whoever redeems it joins the sheet.
+	CreateSheetInvite(context.Context, *CreateSheetInviteReq) (*CreateSheetInviteResp, error)
+	RedeemSheetInvite(context.Context, *RedeemSheetInviteReq) (*RedeemSheetInviteResp, error)
+	RevokeSheetInvite(context.Context, *RevokeSheetInviteReq) (*RevokeSheetInviteResp, error)
 	// External menu attach/refresh (normalized snapshot in your DB).
 	AttachMenuWithPayload(context.Context, *AttachMenuWithPayloadReq) (*AttachMenuWithPayloadResp, error)
+	// Normalizes a JSON or CSV menu from an external source. With dry_run the
+	// normalized menu and row errors are returned and nothing is stored.
+	ImportMenu(context.Context, *ImportMenuReq) (*ImportMenuResp, error)
 	GetMenu(context.Context, *GetMenuReq) (*GetMenuResp, error)
+	ListMenuVersions(context.Context, *ListMenuVersionsReq) (*ListMenuVersionsResp, error)
+	DiffMenuVersions(context.Context, *DiffMenuVersionsReq) (*DiffMenuVersionsResp, error)
+	// Per-member breakdown of what is owed to the host. Provisional until the
+	// sheet is closed.
+	GetSheetSettlement(context.Context, *GetSheetSettlementReq) (*GetSheetSettlementResp, error)
 	mustEmbedUnimplementedSheetsServiceServer()
 }
 
@@ -191,12 +292,33 @@ func (UnimplementedSheetsServiceServer) RemoveMember(context.Context, *RemoveMem
 func (UnimplementedSheetsServiceServer) ListMembers(context.Context, *ListMembersRequest) (*ListMembersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMembers not implemented")
 }
+func (UnimplementedSheetsServiceServer) CreateSheetInvite(context.Context, *CreateSheetInviteReq) (*CreateSheetInviteResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSheetInvite not implemented")
+}
+func (UnimplementedSheetsServiceServer) RedeemSheetInvite(context.Context, *RedeemSheetInviteReq) (*RedeemSheetInviteResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemSheetInvite not implemented")
+}
+func (UnimplementedSheetsServiceServer) RevokeSheetInvite(context.Context, *RevokeSheetInviteReq) (*RevokeSheetInviteResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSheetInvite not implemented")
+}
 func (UnimplementedSheetsServiceServer) AttachMenuWithPayload(context.Context, *AttachMenuWithPayloadReq) (*AttachMenuWithPayloadResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AttachMenuWithPayload not implemented")
 }
+func (UnimplementedSheetsServiceServer) ImportMenu(context.Context, *ImportMenuReq) (*ImportMenuResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportMenu not implemented")
+}
 func (UnimplementedSheetsServiceServer) GetMenu(context.Context, *GetMenuReq) (*GetMenuResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMenu not implemented")
 }
+func (UnimplementedSheetsServiceServer) ListMenuVersions(context.Context, *ListMenuVersionsReq) (*ListMenuVersionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMenuVersions not implemented")
+}
+func (UnimplementedSheetsServiceServer) DiffMenuVersions(context.Context, *DiffMenuVersionsReq) (*DiffMenuVersionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffMenuVersions not implemented")
+}
+func (UnimplementedSheetsServiceServer) GetSheetSettlement(context.Context, *GetSheetSettlementReq) (*GetSheetSettlementResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSheetSettlement not implemented")
+}
 func (UnimplementedSheetsServiceServer) mustEmbedUnimplementedSheetsServiceServer() {}
 func (UnimplementedSheetsServiceServer) testEmbeddedByValue()                       {}
 
@@ -344,6 +466,60 @@ func _SheetsService_ListMembers_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SheetsService_CreateSheetInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSheetInviteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SheetsServiceServer).CreateSheetInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SheetsService_CreateSheetInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SheetsServiceServer).CreateSheetInvite(ctx, req.(*CreateSheetInviteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SheetsService_RedeemSheetInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemSheetInviteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SheetsServiceServer).RedeemSheetInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SheetsService_RedeemSheetInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SheetsServiceServer).RedeemSheetInvite(ctx, req.(*RedeemSheetInviteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SheetsService_RevokeSheetInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSheetInviteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SheetsServiceServer).RevokeSheetInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SheetsService_RevokeSheetInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SheetsServiceServer).RevokeSheetInvite(ctx, req.(*RevokeSheetInviteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SheetsService_AttachMenuWithPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AttachMenuWithPayloadReq)
 	if err := dec(in); err != nil {
@@ -362,6 +538,24 @@ func _SheetsService_AttachMenuWithPayload_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SheetsService_ImportMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportMenuReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SheetsServiceServer).ImportMenu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SheetsService_ImportMenu_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SheetsServiceServer).ImportMenu(ctx, req.(*ImportMenuReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SheetsService_GetMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetMenuReq)
 	if err := dec(in); err != nil {
@@ -380,6 +574,60 @@ func _SheetsService_GetMenu_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SheetsService_ListMenuVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMenuVersionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SheetsServiceServer).ListMenuVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SheetsService_ListMenuVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SheetsServiceServer).ListMenuVersions(ctx, req.(*ListMenuVersionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SheetsService_DiffMenuVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffMenuVersionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SheetsServiceServer).DiffMenuVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SheetsService_DiffMenuVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SheetsServiceServer).DiffMenuVersions(ctx, req.(*DiffMenuVersionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SheetsService_GetSheetSettlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSheetSettlementReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SheetsServiceServer).GetSheetSettlement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SheetsService_GetSheetSettlement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SheetsServiceServer).GetSheetSettlement(ctx, req.(*GetSheetSettlementReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SheetsService_ServiceDesc is the grpc.ServiceDesc for SheetsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -415,14 +663,42 @@ var SheetsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMembers",
 			Handler:    _SheetsService_ListMembers_Handler,
 		},
+		{
+			MethodName: "CreateSheetInvite",
+			Handler:    _SheetsService_CreateSheetInvite_Handler,
+		},
+		{
+			MethodName: "RedeemSheetInvite",
+			Handler:    _SheetsService_RedeemSheetInvite_Handler,
+		},
+		{
+			MethodName: "RevokeSheetInvite",
+			Handler:    _SheetsService_RevokeSheetInvite_Handler,
+		},
 		{
 			MethodName: "AttachMenuWithPayload",
 			Handler:    _SheetsService_AttachMenuWithPayload_Handler,
 		},
+		{
+			MethodName: "ImportMenu",
+			Handler:    _SheetsService_ImportMenu_Handler,
+		},
 		{
 			MethodName: "GetMenu",
 			Handler:    _SheetsService_GetMenu_Handler,
 		},
+		{
+			MethodName: "ListMenuVersions",
+			Handler:    _SheetsService_ListMenuVersions_Handler,
+		},
+		{
+			MethodName: "DiffMenuVersions",
+			Handler:    _SheetsService_DiffMenuVersions_Handler,
+		},
+		{
+			MethodName: "GetSheetSettlement",
+			Handler:    _SheetsService_GetSheetSettlement_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sheets.proto",
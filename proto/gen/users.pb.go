@@ -191,6 +191,7 @@ type User struct {
 	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	LastLoginAt     *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=last_login_at,json=lastLoginAt,proto3" json:"last_login_at,omitempty"`
+	DeletedAt       *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -316,6 +317,13 @@ func (x *User) GetLastLoginAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *User) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
 type ExternalIdentity struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                       // doc id = provider:subject
@@ -400,11 +408,15 @@ func (x *ExternalIdentity) GetLinkedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+// query matches word prefixes of the name, display name and email;
+// email_exact looks up one user and ignores the cursor
 type ListUsersFilter struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Query           string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
 	EmailExact      string                 `protobuf:"bytes,2,opt,name=email_exact,json=emailExact,proto3" json:"email_exact,omitempty"`
 	IncludeDisabled bool                   `protobuf:"varint,3,opt,name=include_disabled,json=includeDisabled,proto3" json:"include_disabled,omitempty"`
+	Role            UserRole               `protobuf:"varint,4,opt,name=role,proto3,enum=core.v1.UserRole" json:"role,omitempty"`
+	Status          UserStatus             `protobuf:"varint,5,opt,name=status,proto3,enum=core.v1.UserStatus" json:"status,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -460,6 +472,20 @@ func (x *ListUsersFilter) GetIncludeDisabled() bool {
 	return false
 }
 
+func (x *ListUsersFilter) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+func (x *ListUsersFilter) GetStatus() UserStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UserStatus_USER_STATUS_UNSPECIFIED
+}
+
 type AdminSetUserRolesReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1110,116 +1136,1253 @@ func (x *ListUsersResp) GetNextCursor() *Cursor {
 	return nil
 }
 
-var File_users_proto protoreflect.FileDescriptor
+type GetUserByIdentityReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      IdentityProvider       `protobuf:"varint,1,opt,name=provider,proto3,enum=core.v1.IdentityProvider" json:"provider,omitempty"`
+	Subject       string                 `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_users_proto_rawDesc = "" +
-	"\n" +
-	"\vusers.proto\x12\acore.v1\x1a\fcommon.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\xf4\x03\n" +
-	"\x04User\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
-	"\x05email\x18\x02 \x01(\tR\x05email\x12)\n" +
-	"\x10email_normalized\x18\x03 \x01(\tR\x0femailNormalized\x12%\n" +
-	"\x0eemail_verified\x18\x04 \x01(\bR\remailVerified\x12\x12\n" +
-	"\x04name\x18\x05 \x01(\tR\x04name\x12!\n" +
-	"\fdisplay_name\x18\x06 \x01(\tR\vdisplayName\x12\x1b\n" +
-	"\tphoto_url\x18\a \x01(\tR\bphotoUrl\x12\x14\n" +
-	"\x05phone\x18\b \x01(\tR\x05phone\x12'\n" +
-	"\x05roles\x18\t \x03(\x0e2\x11.core.v1.UserRoleR\x05roles\x12+\n" +
-	"\x06status\x18\n" +
-	" \x01(\x0e2\x13.core.v1.UserStatusR\x06status\x129\n" +
-	"\n" +
-	"created_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12>\n" +
-	"\rlast_login_at\x18\x16 \x01(\v2\x1a.google.protobuf.TimestampR\vlastLoginAt\"\xdb\x01\n" +
-	"\x10ExternalIdentity\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\x125\n" +
-	"\bprovider\x18\x03 \x01(\x0e2\x19.core.v1.IdentityProviderR\bprovider\x12\x18\n" +
-	"\asubject\x18\x04 \x01(\tR\asubject\x12\x14\n" +
-	"\x05email\x18\x05 \x01(\tR\x05email\x127\n" +
-	"\tlinked_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\blinkedAt\"s\n" +
-	"\x0fListUsersFilter\x12\x14\n" +
-	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1f\n" +
-	"\vemail_exact\x18\x02 \x01(\tR\n" +
-	"emailExact\x12)\n" +
-	"\x10include_disabled\x18\x03 \x01(\bR\x0fincludeDisabled\"a\n" +
-	"\x14AdminSetUserRolesReq\x12 \n" +
-	"\auser_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06userId\x12'\n" +
-	"\x05roles\x18\x02 \x03(\x0e2\x11.core.v1.UserRoleR\x05roles\":\n" +
-	"\x15AdminSetUserRolesResp\x12!\n" +
-	"\x04user\x18\x01 \x01(\v2\r.core.v1.UserR\x04user\"\\\n" +
-	"\x17AdminSetUserDisabledReq\x12 \n" +
-	"\auser_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06userId\x12\x1f\n" +
-	"\vis_disabled\x18\x02 \x01(\bR\n" +
-	"isDisabled\"=\n" +
-	"\x18AdminSetUserDisabledResp\x12!\n" +
-	"\x04user\x18\x01 \x01(\v2\r.core.v1.UserR\x04user\"\x95\x03\n" +
-	"\rCreateUserReq\x12\x1d\n" +
-	"\x05email\x18\x01 \x01(\tB\a\xfaB\x04r\x02`\x01R\x05email\x12\x1d\n" +
-	"\x04name\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\x04name\x121\n" +
-	"\fdisplay_name\x18\x03 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182H\x00R\vdisplayName\x88\x01\x01\x12*\n" +
-	"\tphoto_url\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x88\x01\x01H\x01R\bphotoUrl\x88\x01\x01\x12,\n" +
-	"\x05phone\x18\x05 \x01(\tB\x11\xfaB\x0er\f\x10\n" +
-	"2\b^[0-9]+$H\x02R\x05phone\x88\x01\x01\x12?\n" +
-	"\bprovider\x18\x06 \x01(\x0e2\x19.core.v1.IdentityProviderB\b\xfaB\x05\x82\x01\x02\x10\x01R\bprovider\x12\x18\n" +
-	"\asubject\x18\a \x01(\tR\asubject\x12(\n" +
-	"\bpassword\x18\b \x01(\tB\a\xfaB\x04r\x02\x10\x06H\x03R\bpassword\x88\x01\x01B\x0f\n" +
-	"\r_display_nameB\f\n" +
-	"\n" +
-	"_photo_urlB\b\n" +
-	"\x06_phoneB\v\n" +
-	"\t_password\"3\n" +
-	"\x0eCreateUserResp\x12!\n" +
-	"\x04user\x18\x01 \x01(\v2\r.core.v1.UserR\x04user\"%\n" +
-	"\n" +
-	"GetUserReq\x12\x17\n" +
-	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\"0\n" +
-	"\vGetUserResp\x12!\n" +
-	"\x04user\x18\x01 \x01(\v2\r.core.v1.UserR\x04user\"\xdf\x01\n" +
-	"\rUpdateUserReq\x12\x17\n" +
-	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\x121\n" +
-	"\fdisplay_name\x18\x03 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182H\x00R\vdisplayName\x88\x01\x01\x12,\n" +
-	"\n" +
-	"avatar_url\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x88\x01\x01H\x01R\tavatarUrl\x88\x01\x01\x12$\n" +
-	"\vis_disabled\x18\x05 \x01(\bH\x02R\n" +
-	"isDisabled\x88\x01\x01B\x0f\n" +
-	"\r_display_nameB\r\n" +
-	"\v_avatar_urlB\x0e\n" +
-	"\f_is_disabled\"3\n" +
-	"\x0eUpdateUserResp\x12!\n" +
-	"\x04user\x18\x01 \x01(\v2\r.core.v1.UserR\x04user\"\x86\x01\n" +
-	"\fListUsersReq\x12\x1b\n" +
-	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12'\n" +
-	"\x06cursor\x18\x02 \x01(\v2\x0f.core.v1.CursorR\x06cursor\x120\n" +
-	"\x06filter\x18\x03 \x01(\v2\x18.core.v1.ListUsersFilterR\x06filter\"{\n" +
-	"\rListUsersResp\x12#\n" +
-	"\x05users\x18\x01 \x03(\v2\r.core.v1.UserR\x05users\x125\n" +
-	"\vnext_cursor\x18\x02 \x01(\v2\x0f.core.v1.CursorH\x00R\n" +
-	"nextCursor\x88\x01\x01B\x0e\n" +
-	"\f_next_cursor*b\n" +
-	"\bUserRole\x12\x19\n" +
-	"\x15USER_ROLE_UNSPECIFIED\x10\x00\x12\x12\n" +
-	"\x0eUSER_ROLE_USER\x10\x01\x12\x13\n" +
-	"\x0fUSER_ROLE_ADMIN\x10\x02\x12\x12\n" +
-	"\x0eUSER_ROLE_HOST\x10\x03*u\n" +
-	"\n" +
-	"UserStatus\x12\x1b\n" +
-	"\x17USER_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
-	"\x12USER_STATUS_ACTIVE\x10\x01\x12\x19\n" +
-	"\x15USER_STATUS_SUSPENDED\x10\x02\x12\x17\n" +
-	"\x13USER_STATUS_DELETED\x10\x03*p\n" +
-	"\x10IdentityProvider\x12!\n" +
-	"\x1dIDENTITY_PROVIDER_UNSPECIFIED\x10\x00\x12\x1b\n" +
-	"\x17IDENTITY_PROVIDER_LOCAL\x10\x01\x12\x1c\n" +
-	"\x18IDENTITY_PROVIDER_GOOGLE\x10\x022\xaf\x03\n" +
-	"\fUsersService\x12=\n" +
-	"\n" +
-	"CreateUser\x12\x16.core.v1.CreateUserReq\x1a\x17.core.v1.CreateUserResp\x124\n" +
-	"\aGetUser\x12\x13.core.v1.GetUserReq\x1a\x14.core.v1.GetUserResp\x12=\n" +
-	"\n" +
-	"UpdateUser\x12\x16.core.v1.UpdateUserReq\x1a\x17.core.v1.UpdateUserResp\x12:\n" +
-	"\tListUsers\x12\x15.core.v1.ListUsersReq\x1a\x16.core.v1.ListUsersResp\x12R\n" +
+func (x *GetUserByIdentityReq) Reset() {
+	*x = GetUserByIdentityReq{}
+	mi := &file_users_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserByIdentityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserByIdentityReq) ProtoMessage() {}
+
+func (x *GetUserByIdentityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserByIdentityReq.ProtoReflect.Descriptor instead.
+func (*GetUserByIdentityReq) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetUserByIdentityReq) GetProvider() IdentityProvider {
+	if x != nil {
+		return x.Provider
+	}
+	return IdentityProvider_IDENTITY_PROVIDER_UNSPECIFIED
+}
+
+func (x *GetUserByIdentityReq) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+type GetUserByIdentityResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserByIdentityResp) Reset() {
+	*x = GetUserByIdentityResp{}
+	mi := &file_users_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserByIdentityResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserByIdentityResp) ProtoMessage() {}
+
+func (x *GetUserByIdentityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserByIdentityResp.ProtoReflect.Descriptor instead.
+func (*GetUserByIdentityResp) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetUserByIdentityResp) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type RecordLoginReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordLoginReq) Reset() {
+	*x = RecordLoginReq{}
+	mi := &file_users_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordLoginReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordLoginReq) ProtoMessage() {}
+
+func (x *RecordLoginReq) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordLoginReq.ProtoReflect.Descriptor instead.
+func (*RecordLoginReq) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RecordLoginReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RecordLoginResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordLoginResp) Reset() {
+	*x = RecordLoginResp{}
+	mi := &file_users_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordLoginResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordLoginResp) ProtoMessage() {}
+
+func (x *RecordLoginResp) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordLoginResp.ProtoReflect.Descriptor instead.
+func (*RecordLoginResp) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RecordLoginResp) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// Failed attempts are rate limited per email; repeated failures lock the
+// account out for a while and return RESOURCE_EXHAUSTED
+type AuthenticateLocalReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthenticateLocalReq) Reset() {
+	*x = AuthenticateLocalReq{}
+	mi := &file_users_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthenticateLocalReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthenticateLocalReq) ProtoMessage() {}
+
+func (x *AuthenticateLocalReq) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthenticateLocalReq.ProtoReflect.Descriptor instead.
+func (*AuthenticateLocalReq) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AuthenticateLocalReq) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AuthenticateLocalReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type AuthenticateLocalResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthenticateLocalResp) Reset() {
+	*x = AuthenticateLocalResp{}
+	mi := &file_users_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthenticateLocalResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthenticateLocalResp) ProtoMessage() {}
+
+func (x *AuthenticateLocalResp) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthenticateLocalResp.ProtoReflect.Descriptor instead.
+func (*AuthenticateLocalResp) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AuthenticateLocalResp) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type ChangePasswordReq struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CurrentPassword string                 `protobuf:"bytes,1,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"`
+	NewPassword     string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ChangePasswordReq) Reset() {
+	*x = ChangePasswordReq{}
+	mi := &file_users_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordReq) ProtoMessage() {}
+
+func (x *ChangePasswordReq) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordReq.ProtoReflect.Descriptor instead.
+func (*ChangePasswordReq) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ChangePasswordReq) GetCurrentPassword() string {
+	if x != nil {
+		return x.CurrentPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordReq) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ChangePasswordResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordResp) Reset() {
+	*x = ChangePasswordResp{}
+	mi := &file_users_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordResp) ProtoMessage() {}
+
+func (x *ChangePasswordResp) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordResp.ProtoReflect.Descriptor instead.
+func (*ChangePasswordResp) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{22}
+}
+
+type RequestPasswordResetReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetReq) Reset() {
+	*x = RequestPasswordResetReq{}
+	mi := &file_users_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetReq) ProtoMessage() {}
+
+func (x *RequestPasswordResetReq) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetReq.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetReq) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RequestPasswordResetReq) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// reset_token is empty when the email has no password login. The caller
+// delivers the token to the user; it is single use.
+type RequestPasswordResetResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResetToken    string                 `protobuf:"bytes,1,opt,name=reset_token,json=resetToken,proto3" json:"reset_token,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetResp) Reset() {
+	*x = RequestPasswordResetResp{}
+	mi := &file_users_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetResp) ProtoMessage() {}
+
+func (x *RequestPasswordResetResp) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetResp.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetResp) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RequestPasswordResetResp) GetResetToken() string {
+	if x != nil {
+		return x.ResetToken
+	}
+	return ""
+}
+
+func (x *RequestPasswordResetResp) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type ConfirmPasswordResetReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResetToken    string                 `protobuf:"bytes,1,opt,name=reset_token,json=resetToken,proto3" json:"reset_token,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmPasswordResetReq) Reset() {
+	*x = ConfirmPasswordResetReq{}
+	mi := &file_users_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmPasswordResetReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmPasswordResetReq) ProtoMessage() {}
+
+func (x *ConfirmPasswordResetReq) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmPasswordResetReq.ProtoReflect.Descriptor instead.
+func (*ConfirmPasswordResetReq) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ConfirmPasswordResetReq) GetResetToken() string {
+	if x != nil {
+		return x.ResetToken
+	}
+	return ""
+}
+
+func (x *ConfirmPasswordResetReq) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+// user_id names the account whose password changed, so the caller can end
+// its sessions
+type ConfirmPasswordResetResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmPasswordResetResp) Reset() {
+	*x = ConfirmPasswordResetResp{}
+	mi := &file_users_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmPasswordResetResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmPasswordResetResp) ProtoMessage() {}
+
+func (x *ConfirmPasswordResetResp) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmPasswordResetResp.ProtoReflect.Descriptor instead.
+func (*ConfirmPasswordResetResp) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ConfirmPasswordResetResp) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// For the local provider the subject is the account email and password is
+// required; for other providers it is the verified provider subject.
+type LinkIdentityReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Provider      IdentityProvider       `protobuf:"varint,2,opt,name=provider,proto3,enum=core.v1.IdentityProvider" json:"provider,omitempty"`
+	Subject       string                 `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Password      *string                `protobuf:"bytes,5,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkIdentityReq) Reset() {
+	*x = LinkIdentityReq{}
+	mi := &file_users_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkIdentityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkIdentityReq) ProtoMessage() {}
+
+func (x *LinkIdentityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkIdentityReq.ProtoReflect.Descriptor instead.
+func (*LinkIdentityReq) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *LinkIdentityReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LinkIdentityReq) GetProvider() IdentityProvider {
+	if x != nil {
+		return x.Provider
+	}
+	return IdentityProvider_IDENTITY_PROVIDER_UNSPECIFIED
+}
+
+func (x *LinkIdentityReq) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *LinkIdentityReq) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *LinkIdentityReq) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
+	}
+	return ""
+}
+
+type LinkIdentityResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identity      *ExternalIdentity      `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkIdentityResp) Reset() {
+	*x = LinkIdentityResp{}
+	mi := &file_users_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkIdentityResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkIdentityResp) ProtoMessage() {}
+
+func (x *LinkIdentityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkIdentityResp.ProtoReflect.Descriptor instead.
+func (*LinkIdentityResp) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *LinkIdentityResp) GetIdentity() *ExternalIdentity {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+// Refused with ABORTED when it would remove the user's last login method
+type UnlinkIdentityReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Provider      IdentityProvider       `protobuf:"varint,2,opt,name=provider,proto3,enum=core.v1.IdentityProvider" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkIdentityReq) Reset() {
+	*x = UnlinkIdentityReq{}
+	mi := &file_users_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkIdentityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkIdentityReq) ProtoMessage() {}
+
+func (x *UnlinkIdentityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkIdentityReq.ProtoReflect.Descriptor instead.
+func (*UnlinkIdentityReq) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UnlinkIdentityReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UnlinkIdentityReq) GetProvider() IdentityProvider {
+	if x != nil {
+		return x.Provider
+	}
+	return IdentityProvider_IDENTITY_PROVIDER_UNSPECIFIED
+}
+
+type UnlinkIdentityResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkIdentityResp) Reset() {
+	*x = UnlinkIdentityResp{}
+	mi := &file_users_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkIdentityResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkIdentityResp) ProtoMessage() {}
+
+func (x *UnlinkIdentityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkIdentityResp.ProtoReflect.Descriptor instead.
+func (*UnlinkIdentityResp) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{30}
+}
+
+type ListIdentitiesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIdentitiesReq) Reset() {
+	*x = ListIdentitiesReq{}
+	mi := &file_users_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIdentitiesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIdentitiesReq) ProtoMessage() {}
+
+func (x *ListIdentitiesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIdentitiesReq.ProtoReflect.Descriptor instead.
+func (*ListIdentitiesReq) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListIdentitiesReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListIdentitiesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identities    []*ExternalIdentity    `protobuf:"bytes,1,rep,name=identities,proto3" json:"identities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIdentitiesResp) Reset() {
+	*x = ListIdentitiesResp{}
+	mi := &file_users_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIdentitiesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIdentitiesResp) ProtoMessage() {}
+
+func (x *ListIdentitiesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIdentitiesResp.ProtoReflect.Descriptor instead.
+func (*ListIdentitiesResp) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListIdentitiesResp) GetIdentities() []*ExternalIdentity {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
+// Soft-deletes the account: the user can no longer sign in and the email is
+// free again. Personal data is erased by a background job after a grace period.
+type DeleteUserReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserReq) Reset() {
+	*x = DeleteUserReq{}
+	mi := &file_users_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserReq) ProtoMessage() {}
+
+func (x *DeleteUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserReq.ProtoReflect.Descriptor instead.
+func (*DeleteUserReq) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteUserReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteUserResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserResp) Reset() {
+	*x = DeleteUserResp{}
+	mi := &file_users_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserResp) ProtoMessage() {}
+
+func (x *DeleteUserResp) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserResp.ProtoReflect.Descriptor instead.
+func (*DeleteUserResp) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeleteUserResp) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// archive is a JSON document with the profile, identities, sheet memberships,
+// orders and payments of the user
+type ExportUserDataReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataReq) Reset() {
+	*x = ExportUserDataReq{}
+	mi := &file_users_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataReq) ProtoMessage() {}
+
+func (x *ExportUserDataReq) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataReq.ProtoReflect.Descriptor instead.
+func (*ExportUserDataReq) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ExportUserDataReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ExportUserDataResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Archive       []byte                 `protobuf:"bytes,1,opt,name=archive,proto3" json:"archive,omitempty"`
+	ExportedAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=exported_at,json=exportedAt,proto3" json:"exported_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataResp) Reset() {
+	*x = ExportUserDataResp{}
+	mi := &file_users_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataResp) ProtoMessage() {}
+
+func (x *ExportUserDataResp) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataResp.ProtoReflect.Descriptor instead.
+func (*ExportUserDataResp) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ExportUserDataResp) GetArchive() []byte {
+	if x != nil {
+		return x.Archive
+	}
+	return nil
+}
+
+func (x *ExportUserDataResp) GetExportedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExportedAt
+	}
+	return nil
+}
+
+var File_users_proto protoreflect.FileDescriptor
+
+const file_users_proto_rawDesc = "" +
+	"\n" +
+	"\vusers.proto\x12\acore.v1\x1a\fcommon.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\xaf\x04\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12)\n" +
+	"\x10email_normalized\x18\x03 \x01(\tR\x0femailNormalized\x12%\n" +
+	"\x0eemail_verified\x18\x04 \x01(\bR\remailVerified\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12!\n" +
+	"\fdisplay_name\x18\x06 \x01(\tR\vdisplayName\x12\x1b\n" +
+	"\tphoto_url\x18\a \x01(\tR\bphotoUrl\x12\x14\n" +
+	"\x05phone\x18\b \x01(\tR\x05phone\x12'\n" +
+	"\x05roles\x18\t \x03(\x0e2\x11.core.v1.UserRoleR\x05roles\x12+\n" +
+	"\x06status\x18\n" +
+	" \x01(\x0e2\x13.core.v1.UserStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12>\n" +
+	"\rlast_login_at\x18\x16 \x01(\v2\x1a.google.protobuf.TimestampR\vlastLoginAt\x129\n" +
+	"\n" +
+	"deleted_at\x18\x17 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"\xdb\x01\n" +
+	"\x10ExternalIdentity\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x125\n" +
+	"\bprovider\x18\x03 \x01(\x0e2\x19.core.v1.IdentityProviderR\bprovider\x12\x18\n" +
+	"\asubject\x18\x04 \x01(\tR\asubject\x12\x14\n" +
+	"\x05email\x18\x05 \x01(\tR\x05email\x127\n" +
+	"\tlinked_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\blinkedAt\"\xe4\x01\n" +
+	"\x0fListUsersFilter\x12\x1d\n" +
+	"\x05query\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x18dR\x05query\x12\x1f\n" +
+	"\vemail_exact\x18\x02 \x01(\tR\n" +
+	"emailExact\x12)\n" +
+	"\x10include_disabled\x18\x03 \x01(\bR\x0fincludeDisabled\x12/\n" +
+	"\x04role\x18\x04 \x01(\x0e2\x11.core.v1.UserRoleB\b\xfaB\x05\x82\x01\x02\x10\x01R\x04role\x125\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x13.core.v1.UserStatusB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06status\"a\n" +
+	"\x14AdminSetUserRolesReq\x12 \n" +
+	"\auser_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06userId\x12'\n" +
+	"\x05roles\x18\x02 \x03(\x0e2\x11.core.v1.UserRoleR\x05roles\":\n" +
+	"\x15AdminSetUserRolesResp\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.core.v1.UserR\x04user\"\\\n" +
+	"\x17AdminSetUserDisabledReq\x12 \n" +
+	"\auser_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06userId\x12\x1f\n" +
+	"\vis_disabled\x18\x02 \x01(\bR\n" +
+	"isDisabled\"=\n" +
+	"\x18AdminSetUserDisabledResp\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.core.v1.UserR\x04user\"\x98\x03\n" +
+	"\rCreateUserReq\x12\x1d\n" +
+	"\x05email\x18\x01 \x01(\tB\a\xfaB\x04r\x02`\x01R\x05email\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\x04name\x121\n" +
+	"\fdisplay_name\x18\x03 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182H\x00R\vdisplayName\x88\x01\x01\x12*\n" +
+	"\tphoto_url\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x88\x01\x01H\x01R\bphotoUrl\x88\x01\x01\x12,\n" +
+	"\x05phone\x18\x05 \x01(\tB\x11\xfaB\x0er\f\x10\n" +
+	"2\b^[0-9]+$H\x02R\x05phone\x88\x01\x01\x12?\n" +
+	"\bprovider\x18\x06 \x01(\x0e2\x19.core.v1.IdentityProviderB\b\xfaB\x05\x82\x01\x02\x10\x01R\bprovider\x12\x18\n" +
+	"\asubject\x18\a \x01(\tR\asubject\x12+\n" +
+	"\bpassword\x18\b \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\b\x18\x80\x01H\x03R\bpassword\x88\x01\x01B\x0f\n" +
+	"\r_display_nameB\f\n" +
+	"\n" +
+	"_photo_urlB\b\n" +
+	"\x06_phoneB\v\n" +
+	"\t_password\"3\n" +
+	"\x0eCreateUserResp\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.core.v1.UserR\x04user\"%\n" +
+	"\n" +
+	"GetUserReq\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\"0\n" +
+	"\vGetUserResp\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.core.v1.UserR\x04user\"\xdf\x01\n" +
+	"\rUpdateUserReq\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\x121\n" +
+	"\fdisplay_name\x18\x03 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182H\x00R\vdisplayName\x88\x01\x01\x12,\n" +
+	"\n" +
+	"avatar_url\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x88\x01\x01H\x01R\tavatarUrl\x88\x01\x01\x12$\n" +
+	"\vis_disabled\x18\x05 \x01(\bH\x02R\n" +
+	"isDisabled\x88\x01\x01B\x0f\n" +
+	"\r_display_nameB\r\n" +
+	"\v_avatar_urlB\x0e\n" +
+	"\f_is_disabled\"3\n" +
+	"\x0eUpdateUserResp\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.core.v1.UserR\x04user\"\x86\x01\n" +
+	"\fListUsersReq\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12'\n" +
+	"\x06cursor\x18\x02 \x01(\v2\x0f.core.v1.CursorR\x06cursor\x120\n" +
+	"\x06filter\x18\x03 \x01(\v2\x18.core.v1.ListUsersFilterR\x06filter\"{\n" +
+	"\rListUsersResp\x12#\n" +
+	"\x05users\x18\x01 \x03(\v2\r.core.v1.UserR\x05users\x125\n" +
+	"\vnext_cursor\x18\x02 \x01(\v2\x0f.core.v1.CursorH\x00R\n" +
+	"nextCursor\x88\x01\x01B\x0e\n" +
+	"\f_next_cursor\"|\n" +
+	"\x14GetUserByIdentityReq\x12A\n" +
+	"\bprovider\x18\x01 \x01(\x0e2\x19.core.v1.IdentityProviderB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\bprovider\x12!\n" +
+	"\asubject\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\asubject\":\n" +
+	"\x15GetUserByIdentityResp\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.core.v1.UserR\x04user\"2\n" +
+	"\x0eRecordLoginReq\x12 \n" +
+	"\auser_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06userId\"4\n" +
+	"\x0fRecordLoginResp\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.core.v1.UserR\x04user\"Z\n" +
+	"\x14AuthenticateLocalReq\x12\x1d\n" +
+	"\x05email\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x05email\x12#\n" +
+	"\bpassword\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bpassword\":\n" +
+	"\x15AuthenticateLocalResp\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.core.v1.UserR\x04user\"v\n" +
+	"\x11ChangePasswordReq\x122\n" +
+	"\x10current_password\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x0fcurrentPassword\x12-\n" +
+	"\fnew_password\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\b\x18\x80\x01R\vnewPassword\"\x14\n" +
+	"\x12ChangePasswordResp\"8\n" +
+	"\x17RequestPasswordResetReq\x12\x1d\n" +
+	"\x05email\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x05email\"v\n" +
+	"\x18RequestPasswordResetResp\x12\x1f\n" +
+	"\vreset_token\x18\x01 \x01(\tR\n" +
+	"resetToken\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"r\n" +
+	"\x17ConfirmPasswordResetReq\x12(\n" +
+	"\vreset_token\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"resetToken\x12-\n" +
+	"\fnew_password\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\b\x18\x80\x01R\vnewPassword\"3\n" +
+	"\x18ConfirmPasswordResetResp\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xe0\x01\n" +
+	"\x0fLinkIdentityReq\x12 \n" +
+	"\auser_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06userId\x12A\n" +
+	"\bprovider\x18\x02 \x01(\x0e2\x19.core.v1.IdentityProviderB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\bprovider\x12\x18\n" +
+	"\asubject\x18\x03 \x01(\tR\asubject\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12+\n" +
+	"\bpassword\x18\x05 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\b\x18\x80\x01H\x00R\bpassword\x88\x01\x01B\v\n" +
+	"\t_password\"I\n" +
+	"\x10LinkIdentityResp\x125\n" +
+	"\bidentity\x18\x01 \x01(\v2\x19.core.v1.ExternalIdentityR\bidentity\"x\n" +
+	"\x11UnlinkIdentityReq\x12 \n" +
+	"\auser_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06userId\x12A\n" +
+	"\bprovider\x18\x02 \x01(\x0e2\x19.core.v1.IdentityProviderB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\bprovider\"\x14\n" +
+	"\x12UnlinkIdentityResp\"5\n" +
+	"\x11ListIdentitiesReq\x12 \n" +
+	"\auser_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06userId\"O\n" +
+	"\x12ListIdentitiesResp\x129\n" +
+	"\n" +
+	"identities\x18\x01 \x03(\v2\x19.core.v1.ExternalIdentityR\n" +
+	"identities\"1\n" +
+	"\rDeleteUserReq\x12 \n" +
+	"\auser_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06userId\"3\n" +
+	"\x0eDeleteUserResp\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.core.v1.UserR\x04user\"5\n" +
+	"\x11ExportUserDataReq\x12 \n" +
+	"\auser_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06userId\"k\n" +
+	"\x12ExportUserDataResp\x12\x18\n" +
+	"\aarchive\x18\x01 \x01(\fR\aarchive\x12;\n" +
+	"\vexported_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"exportedAt*b\n" +
+	"\bUserRole\x12\x19\n" +
+	"\x15USER_ROLE_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eUSER_ROLE_USER\x10\x01\x12\x13\n" +
+	"\x0fUSER_ROLE_ADMIN\x10\x02\x12\x12\n" +
+	"\x0eUSER_ROLE_HOST\x10\x03*u\n" +
+	"\n" +
+	"UserStatus\x12\x1b\n" +
+	"\x17USER_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12USER_STATUS_ACTIVE\x10\x01\x12\x19\n" +
+	"\x15USER_STATUS_SUSPENDED\x10\x02\x12\x17\n" +
+	"\x13USER_STATUS_DELETED\x10\x03*p\n" +
+	"\x10IdentityProvider\x12!\n" +
+	"\x1dIDENTITY_PROVIDER_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17IDENTITY_PROVIDER_LOCAL\x10\x01\x12\x1c\n" +
+	"\x18IDENTITY_PROVIDER_GOOGLE\x10\x022\x83\n" +
+	"\n" +
+	"\fUsersService\x12=\n" +
+	"\n" +
+	"CreateUser\x12\x16.core.v1.CreateUserReq\x1a\x17.core.v1.CreateUserResp\x124\n" +
+	"\aGetUser\x12\x13.core.v1.GetUserReq\x1a\x14.core.v1.GetUserResp\x12=\n" +
+	"\n" +
+	"UpdateUser\x12\x16.core.v1.UpdateUserReq\x1a\x17.core.v1.UpdateUserResp\x12:\n" +
+	"\tListUsers\x12\x15.core.v1.ListUsersReq\x1a\x16.core.v1.ListUsersResp\x12R\n" +
+	"\x11GetUserByIdentity\x12\x1d.core.v1.GetUserByIdentityReq\x1a\x1e.core.v1.GetUserByIdentityResp\x12@\n" +
+	"\vRecordLogin\x12\x17.core.v1.RecordLoginReq\x1a\x18.core.v1.RecordLoginResp\x12R\n" +
+	"\x11AuthenticateLocal\x12\x1d.core.v1.AuthenticateLocalReq\x1a\x1e.core.v1.AuthenticateLocalResp\x12[\n" +
+	"\x14RequestPasswordReset\x12 .core.v1.RequestPasswordResetReq\x1a!.core.v1.RequestPasswordResetResp\x12[\n" +
+	"\x14ConfirmPasswordReset\x12 .core.v1.ConfirmPasswordResetReq\x1a!.core.v1.ConfirmPasswordResetResp\x12I\n" +
+	"\x0eChangePassword\x12\x1a.core.v1.ChangePasswordReq\x1a\x1b.core.v1.ChangePasswordResp\x12C\n" +
+	"\fLinkIdentity\x12\x18.core.v1.LinkIdentityReq\x1a\x19.core.v1.LinkIdentityResp\x12I\n" +
+	"\x0eUnlinkIdentity\x12\x1a.core.v1.UnlinkIdentityReq\x1a\x1b.core.v1.UnlinkIdentityResp\x12I\n" +
+	"\x0eListIdentities\x12\x1a.core.v1.ListIdentitiesReq\x1a\x1b.core.v1.ListIdentitiesResp\x12=\n" +
+	"\n" +
+	"DeleteUser\x12\x16.core.v1.DeleteUserReq\x1a\x17.core.v1.DeleteUserResp\x12I\n" +
+	"\x0eExportUserData\x12\x1a.core.v1.ExportUserDataReq\x1a\x1b.core.v1.ExportUserDataResp\x12R\n" +
 	"\x11AdminSetUserRoles\x12\x1d.core.v1.AdminSetUserRolesReq\x1a\x1e.core.v1.AdminSetUserRolesResp\x12[\n" +
 	"\x14AdminSetUserDisabled\x12 .core.v1.AdminSetUserDisabledReq\x1a!.core.v1.AdminSetUserDisabledRespB;Z9github.com/deni12345/dae-services/proto/gen/corev1;corev1b\x06proto3"
 
@@ -1236,7 +2399,7 @@ func file_users_proto_rawDescGZIP() []byte {
 }
 
 var file_users_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_users_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_users_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
 var file_users_proto_goTypes = []any{
 	(UserRole)(0),                    // 0: core.v1.UserRole
 	(UserStatus)(0),                  // 1: core.v1.UserStatus
@@ -1256,45 +2419,103 @@ var file_users_proto_goTypes = []any{
 	(*UpdateUserResp)(nil),           // 15: core.v1.UpdateUserResp
 	(*ListUsersReq)(nil),             // 16: core.v1.ListUsersReq
 	(*ListUsersResp)(nil),            // 17: core.v1.ListUsersResp
-	(*timestamppb.Timestamp)(nil),    // 18: google.protobuf.Timestamp
-	(*Cursor)(nil),                   // 19: core.v1.Cursor
+	(*GetUserByIdentityReq)(nil),     // 18: core.v1.GetUserByIdentityReq
+	(*GetUserByIdentityResp)(nil),    // 19: core.v1.GetUserByIdentityResp
+	(*RecordLoginReq)(nil),           // 20: core.v1.RecordLoginReq
+	(*RecordLoginResp)(nil),          // 21: core.v1.RecordLoginResp
+	(*AuthenticateLocalReq)(nil),     // 22: core.v1.AuthenticateLocalReq
+	(*AuthenticateLocalResp)(nil),    // 23: core.v1.AuthenticateLocalResp
+	(*ChangePasswordReq)(nil),        // 24: core.v1.ChangePasswordReq
+	(*ChangePasswordResp)(nil),       // 25: core.v1.ChangePasswordResp
+	(*RequestPasswordResetReq)(nil),  // 26: core.v1.RequestPasswordResetReq
+	(*RequestPasswordResetResp)(nil), // 27: core.v1.RequestPasswordResetResp
+	(*ConfirmPasswordResetReq)(nil),  // 28: core.v1.ConfirmPasswordResetReq
+	(*ConfirmPasswordResetResp)(nil), // 29: core.v1.ConfirmPasswordResetResp
+	(*LinkIdentityReq)(nil),          // 30: core.v1.LinkIdentityReq
+	(*LinkIdentityResp)(nil),         // 31: core.v1.LinkIdentityResp
+	(*UnlinkIdentityReq)(nil),        // 32: core.v1.UnlinkIdentityReq
+	(*UnlinkIdentityResp)(nil),       // 33: core.v1.UnlinkIdentityResp
+	(*ListIdentitiesReq)(nil),        // 34: core.v1.ListIdentitiesReq
+	(*ListIdentitiesResp)(nil),       // 35: core.v1.ListIdentitiesResp
+	(*DeleteUserReq)(nil),            // 36: core.v1.DeleteUserReq
+	(*DeleteUserResp)(nil),           // 37: core.v1.DeleteUserResp
+	(*ExportUserDataReq)(nil),        // 38: core.v1.ExportUserDataReq
+	(*ExportUserDataResp)(nil),       // 39: core.v1.ExportUserDataResp
+	(*timestamppb.Timestamp)(nil),    // 40: google.protobuf.Timestamp
+	(*Cursor)(nil),                   // 41: core.v1.Cursor
 }
 var file_users_proto_depIdxs = []int32{
 	0,  // 0: core.v1.User.roles:type_name -> core.v1.UserRole
 	1,  // 1: core.v1.User.status:type_name -> core.v1.UserStatus
-	18, // 2: core.v1.User.created_at:type_name -> google.protobuf.Timestamp
-	18, // 3: core.v1.User.updated_at:type_name -> google.protobuf.Timestamp
-	18, // 4: core.v1.User.last_login_at:type_name -> google.protobuf.Timestamp
-	2,  // 5: core.v1.ExternalIdentity.provider:type_name -> core.v1.IdentityProvider
-	18, // 6: core.v1.ExternalIdentity.linked_at:type_name -> google.protobuf.Timestamp
-	0,  // 7: core.v1.AdminSetUserRolesReq.roles:type_name -> core.v1.UserRole
-	3,  // 8: core.v1.AdminSetUserRolesResp.user:type_name -> core.v1.User
-	3,  // 9: core.v1.AdminSetUserDisabledResp.user:type_name -> core.v1.User
-	2,  // 10: core.v1.CreateUserReq.provider:type_name -> core.v1.IdentityProvider
-	3,  // 11: core.v1.CreateUserResp.user:type_name -> core.v1.User
-	3,  // 12: core.v1.GetUserResp.user:type_name -> core.v1.User
-	3,  // 13: core.v1.UpdateUserResp.user:type_name -> core.v1.User
-	19, // 14: core.v1.ListUsersReq.cursor:type_name -> core.v1.Cursor
-	5,  // 15: core.v1.ListUsersReq.filter:type_name -> core.v1.ListUsersFilter
-	3,  // 16: core.v1.ListUsersResp.users:type_name -> core.v1.User
-	19, // 17: core.v1.ListUsersResp.next_cursor:type_name -> core.v1.Cursor
-	10, // 18: core.v1.UsersService.CreateUser:input_type -> core.v1.CreateUserReq
-	12, // 19: core.v1.UsersService.GetUser:input_type -> core.v1.GetUserReq
-	14, // 20: core.v1.UsersService.UpdateUser:input_type -> core.v1.UpdateUserReq
-	16, // 21: core.v1.UsersService.ListUsers:input_type -> core.v1.ListUsersReq
-	6,  // 22: core.v1.UsersService.AdminSetUserRoles:input_type -> core.v1.AdminSetUserRolesReq
-	8,  // 23: core.v1.UsersService.AdminSetUserDisabled:input_type -> core.v1.AdminSetUserDisabledReq
-	11, // 24: core.v1.UsersService.CreateUser:output_type -> core.v1.CreateUserResp
-	13, // 25: core.v1.UsersService.GetUser:output_type -> core.v1.GetUserResp
-	15, // 26: core.v1.UsersService.UpdateUser:output_type -> core.v1.UpdateUserResp
-	17, // 27: core.v1.UsersService.ListUsers:output_type -> core.v1.ListUsersResp
-	7,  // 28: core.v1.UsersService.AdminSetUserRoles:output_type -> core.v1.AdminSetUserRolesResp
-	9,  // 29: core.v1.UsersService.AdminSetUserDisabled:output_type -> core.v1.AdminSetUserDisabledResp
-	24, // [24:30] is the sub-list for method output_type
-	18, // [18:24] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	40, // 2: core.v1.User.created_at:type_name -> google.protobuf.Timestamp
+	40, // 3: core.v1.User.updated_at:type_name -> google.protobuf.Timestamp
+	40, // 4: core.v1.User.last_login_at:type_name -> google.protobuf.Timestamp
+	40, // 5: core.v1.User.deleted_at:type_name -> google.protobuf.Timestamp
+	2,  // 6: core.v1.ExternalIdentity.provider:type_name -> core.v1.IdentityProvider
+	40, // 7: core.v1.ExternalIdentity.linked_at:type_name -> google.protobuf.Timestamp
+	0,  // 8: core.v1.ListUsersFilter.role:type_name -> core.v1.UserRole
+	1,  // 9: core.v1.ListUsersFilter.status:type_name -> core.v1.UserStatus
+	0,  // 10: core.v1.AdminSetUserRolesReq.roles:type_name -> core.v1.UserRole
+	3,  // 11: core.v1.AdminSetUserRolesResp.user:type_name -> core.v1.User
+	3,  // 12: core.v1.AdminSetUserDisabledResp.user:type_name -> core.v1.User
+	2,  // 13: core.v1.CreateUserReq.provider:type_name -> core.v1.IdentityProvider
+	3,  // 14: core.v1.CreateUserResp.user:type_name -> core.v1.User
+	3,  // 15: core.v1.GetUserResp.user:type_name -> core.v1.User
+	3,  // 16: core.v1.UpdateUserResp.user:type_name -> core.v1.User
+	41, // 17: core.v1.ListUsersReq.cursor:type_name -> core.v1.Cursor
+	5,  // 18: core.v1.ListUsersReq.filter:type_name -> core.v1.ListUsersFilter
+	3,  // 19: core.v1.ListUsersResp.users:type_name -> core.v1.User
+	41, // 20: core.v1.ListUsersResp.next_cursor:type_name -> core.v1.Cursor
+	2,  // 21: core.v1.GetUserByIdentityReq.provider:type_name -> core.v1.IdentityProvider
+	3,  // 22: core.v1.GetUserByIdentityResp.user:type_name -> core.v1.User
+	3,  // 23: core.v1.RecordLoginResp.user:type_name -> core.v1.User
+	3,  // 24: core.v1.AuthenticateLocalResp.user:type_name -> core.v1.User
+	40, // 25: core.v1.RequestPasswordResetResp.expires_at:type_name -> google.protobuf.Timestamp
+	2,  // 26: core.v1.LinkIdentityReq.provider:type_name -> core.v1.IdentityProvider
+	4,  // 27: core.v1.LinkIdentityResp.identity:type_name -> core.v1.ExternalIdentity
+	2,  // 28: core.v1.UnlinkIdentityReq.provider:type_name -> core.v1.IdentityProvider
+	4,  // 29: core.v1.ListIdentitiesResp.identities:type_name -> core.v1.ExternalIdentity
+	3,  // 30: core.v1.DeleteUserResp.user:type_name -> core.v1.User
+	40, // 31: core.v1.ExportUserDataResp.exported_at:type_name -> google.protobuf.Timestamp
+	10, // 32: core.v1.UsersService.CreateUser:input_type -> core.v1.CreateUserReq
+	12, // 33: core.v1.UsersService.GetUser:input_type -> core.v1.GetUserReq
+	14, // 34: core.v1.UsersService.UpdateUser:input_type -> core.v1.UpdateUserReq
+	16, // 35: core.v1.UsersService.ListUsers:input_type -> core.v1.ListUsersReq
+	18, // 36: core.v1.UsersService.GetUserByIdentity:input_type -> core.v1.GetUserByIdentityReq
+	20, // 37: core.v1.UsersService.RecordLogin:input_type -> core.v1.RecordLoginReq
+	22, // 38: core.v1.UsersService.AuthenticateLocal:input_type -> core.v1.AuthenticateLocalReq
+	26, // 39: core.v1.UsersService.RequestPasswordReset:input_type -> core.v1.RequestPasswordResetReq
+	28, // 40: core.v1.UsersService.ConfirmPasswordReset:input_type -> core.v1.ConfirmPasswordResetReq
+	24, // 41: core.v1.UsersService.ChangePassword:input_type -> core.v1.ChangePasswordReq
+	30, // 42: core.v1.UsersService.LinkIdentity:input_type -> core.v1.LinkIdentityReq
+	32, // 43: core.v1.UsersService.UnlinkIdentity:input_type -> core.v1.UnlinkIdentityReq
+	34, // 44: core.v1.UsersService.ListIdentities:input_type -> core.v1.ListIdentitiesReq
+	36, // 45: core.v1.UsersService.DeleteUser:input_type -> core.v1.DeleteUserReq
+	38, // 46: core.v1.UsersService.ExportUserData:input_type -> core.v1.ExportUserDataReq
+	6,  // 47: core.v1.UsersService.AdminSetUserRoles:input_type -> core.v1.AdminSetUserRolesReq
+	8,  // 48: core.v1.UsersService.AdminSetUserDisabled:input_type -> core.v1.AdminSetUserDisabledReq
+	11, // 49: core.v1.UsersService.CreateUser:output_type -> core.v1.CreateUserResp
+	13, // 50: core.v1.UsersService.GetUser:output_type -> core.v1.GetUserResp
+	15, // 51: core.v1.UsersService.UpdateUser:output_type -> core.v1.UpdateUserResp
+	17, // 52: core.v1.UsersService.ListUsers:output_type -> core.v1.ListUsersResp
+	19, // 53: core.v1.UsersService.GetUserByIdentity:output_type -> core.v1.GetUserByIdentityResp
+	21, // 54: core.v1.UsersService.RecordLogin:output_type -> core.v1.RecordLoginResp
+	23, // 55: core.v1.UsersService.AuthenticateLocal:output_type -> core.v1.AuthenticateLocalResp
+	27, // 56: core.v1.UsersService.RequestPasswordReset:output_type -> core.v1.RequestPasswordResetResp
+	29, // 57: core.v1.UsersService.ConfirmPasswordReset:output_type -> core.v1.ConfirmPasswordResetResp
+	25, // 58: core.v1.UsersService.ChangePassword:output_type -> core.v1.ChangePasswordResp
+	31, // 59: core.v1.UsersService.LinkIdentity:output_type -> core.v1.LinkIdentityResp
+	33, // 60: core.v1.UsersService.UnlinkIdentity:output_type -> core.v1.UnlinkIdentityResp
+	35, // 61: core.v1.UsersService.ListIdentities:output_type -> core.v1.ListIdentitiesResp
+	37, // 62: core.v1.UsersService.DeleteUser:output_type -> core.v1.DeleteUserResp
+	39, // 63: core.v1.UsersService.ExportUserData:output_type -> core.v1.ExportUserDataResp
+	7,  // 64: core.v1.UsersService.AdminSetUserRoles:output_type -> core.v1.AdminSetUserRolesResp
+	9,  // 65: core.v1.UsersService.AdminSetUserDisabled:output_type -> core.v1.AdminSetUserDisabledResp
+	49, // [49:66] is the sub-list for method output_type
+	32, // [32:49] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_users_proto_init() }
@@ -1306,13 +2527,14 @@ func file_users_proto_init() {
 	file_users_proto_msgTypes[7].OneofWrappers = []any{}
 	file_users_proto_msgTypes[11].OneofWrappers = []any{}
 	file_users_proto_msgTypes[14].OneofWrappers = []any{}
+	file_users_proto_msgTypes[27].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_users_proto_rawDesc), len(file_users_proto_rawDesc)),
 			NumEnums:      3,
-			NumMessages:   15,
+			NumMessages:   37,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -161,6 +161,35 @@ func (m *User) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetDeletedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeletedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserValidationError{
+				field:  "DeletedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return UserMultiError(errors)
 	}
@@ -399,12 +428,43 @@ func (m *ListUsersFilter) validate(all bool) error {
 
 	var errors []error
 
-	// no validation rules for Query
+	if utf8.RuneCountInString(m.GetQuery()) > 100 {
+		err := ListUsersFilterValidationError{
+			field:  "Query",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	// no validation rules for EmailExact
 
 	// no validation rules for IncludeDisabled
 
+	if _, ok := UserRole_name[int32(m.GetRole())]; !ok {
+		err := ListUsersFilterValidationError{
+			field:  "Role",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := UserStatus_name[int32(m.GetStatus())]; !ok {
+		err := ListUsersFilterValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return ListUsersFilterMultiError(errors)
 	}
@@ -1099,10 +1159,10 @@ func (m *CreateUserReq) validate(all bool) error {
 
 	if m.Password != nil {
 
-		if utf8.RuneCountInString(m.GetPassword()) < 6 {
+		if l := utf8.RuneCountInString(m.GetPassword()); l < 8 || l > 128 {
 			err := CreateUserReqValidationError{
 				field:  "Password",
-				reason: "value length must be at least 6 runes",
+				reason: "value length must be between 8 and 128 runes, inclusive",
 			}
 			if !all {
 				return err
@@ -2218,3 +2278,2729 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ListUsersRespValidationError{}
+
+// Validate checks the field values on GetUserByIdentityReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserByIdentityReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserByIdentityReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserByIdentityReqMultiError, or nil if none found.
+func (m *GetUserByIdentityReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserByIdentityReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _GetUserByIdentityReq_Provider_NotInLookup[m.GetProvider()]; ok {
+		err := GetUserByIdentityReqValidationError{
+			field:  "Provider",
+			reason: "value must not be in list [IDENTITY_PROVIDER_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := IdentityProvider_name[int32(m.GetProvider())]; !ok {
+		err := GetUserByIdentityReqValidationError{
+			field:  "Provider",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSubject()) < 1 {
+		err := GetUserByIdentityReqValidationError{
+			field:  "Subject",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetUserByIdentityReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserByIdentityReqMultiError is an error wrapping multiple validation
+// errors returned by GetUserByIdentityReq.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserByIdentityReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserByIdentityReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserByIdentityReqMultiError) AllErrors() []error { return m }
+
+// GetUserByIdentityReqValidationError is the validation error returned by
+// GetUserByIdentityReq.Validate if the designated constraints aren't met.
+type GetUserByIdentityReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserByIdentityReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserByIdentityReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserByIdentityReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserByIdentityReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserByIdentityReqValidationError) ErrorName() string {
+	return "GetUserByIdentityReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserByIdentityReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserByIdentityReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserByIdentityReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserByIdentityReqValidationError{}
+
+var _GetUserByIdentityReq_Provider_NotInLookup = map[IdentityProvider]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on GetUserByIdentityResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserByIdentityResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserByIdentityResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserByIdentityRespMultiError, or nil if none found.
+func (m *GetUserByIdentityResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserByIdentityResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserByIdentityRespValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUserByIdentityRespValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserByIdentityRespValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetUserByIdentityRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserByIdentityRespMultiError is an error wrapping multiple validation
+// errors returned by GetUserByIdentityResp.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserByIdentityRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserByIdentityRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserByIdentityRespMultiError) AllErrors() []error { return m }
+
+// GetUserByIdentityRespValidationError is the validation error returned by
+// GetUserByIdentityResp.Validate if the designated constraints aren't met.
+type GetUserByIdentityRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserByIdentityRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserByIdentityRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserByIdentityRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserByIdentityRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserByIdentityRespValidationError) ErrorName() string {
+	return "GetUserByIdentityRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserByIdentityRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserByIdentityResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserByIdentityRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserByIdentityRespValidationError{}
+
+// Validate checks the field values on RecordLoginReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RecordLoginReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecordLoginReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RecordLoginReqMultiError,
+// or nil if none found.
+func (m *RecordLoginReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecordLoginReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUserId()) < 1 {
+		err := RecordLoginReqValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RecordLoginReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecordLoginReqMultiError is an error wrapping multiple validation errors
+// returned by RecordLoginReq.ValidateAll() if the designated constraints
+// aren't met.
+type RecordLoginReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecordLoginReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecordLoginReqMultiError) AllErrors() []error { return m }
+
+// RecordLoginReqValidationError is the validation error returned by
+// RecordLoginReq.Validate if the designated constraints aren't met.
+type RecordLoginReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecordLoginReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecordLoginReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecordLoginReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecordLoginReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecordLoginReqValidationError) ErrorName() string { return "RecordLoginReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RecordLoginReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecordLoginReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecordLoginReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecordLoginReqValidationError{}
+
+// Validate checks the field values on RecordLoginResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RecordLoginResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecordLoginResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecordLoginRespMultiError, or nil if none found.
+func (m *RecordLoginResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecordLoginResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RecordLoginRespValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RecordLoginRespValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RecordLoginRespValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RecordLoginRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecordLoginRespMultiError is an error wrapping multiple validation errors
+// returned by RecordLoginResp.ValidateAll() if the designated constraints
+// aren't met.
+type RecordLoginRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecordLoginRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecordLoginRespMultiError) AllErrors() []error { return m }
+
+// RecordLoginRespValidationError is the validation error returned by
+// RecordLoginResp.Validate if the designated constraints aren't met.
+type RecordLoginRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecordLoginRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecordLoginRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecordLoginRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecordLoginRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecordLoginRespValidationError) ErrorName() string { return "RecordLoginRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RecordLoginRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecordLoginResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecordLoginRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecordLoginRespValidationError{}
+
+// Validate checks the field values on AuthenticateLocalReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuthenticateLocalReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthenticateLocalReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthenticateLocalReqMultiError, or nil if none found.
+func (m *AuthenticateLocalReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthenticateLocalReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetEmail()) < 1 {
+		err := AuthenticateLocalReqValidationError{
+			field:  "Email",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPassword()) < 1 {
+		err := AuthenticateLocalReqValidationError{
+			field:  "Password",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AuthenticateLocalReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthenticateLocalReqMultiError is an error wrapping multiple validation
+// errors returned by AuthenticateLocalReq.ValidateAll() if the designated
+// constraints aren't met.
+type AuthenticateLocalReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthenticateLocalReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthenticateLocalReqMultiError) AllErrors() []error { return m }
+
+// AuthenticateLocalReqValidationError is the validation error returned by
+// AuthenticateLocalReq.Validate if the designated constraints aren't met.
+type AuthenticateLocalReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthenticateLocalReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthenticateLocalReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthenticateLocalReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthenticateLocalReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthenticateLocalReqValidationError) ErrorName() string {
+	return "AuthenticateLocalReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuthenticateLocalReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthenticateLocalReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthenticateLocalReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthenticateLocalReqValidationError{}
+
+// Validate checks the field values on AuthenticateLocalResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuthenticateLocalResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthenticateLocalResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthenticateLocalRespMultiError, or nil if none found.
+func (m *AuthenticateLocalResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthenticateLocalResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuthenticateLocalRespValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuthenticateLocalRespValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuthenticateLocalRespValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AuthenticateLocalRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthenticateLocalRespMultiError is an error wrapping multiple validation
+// errors returned by AuthenticateLocalResp.ValidateAll() if the designated
+// constraints aren't met.
+type AuthenticateLocalRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthenticateLocalRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthenticateLocalRespMultiError) AllErrors() []error { return m }
+
+// AuthenticateLocalRespValidationError is the validation error returned by
+// AuthenticateLocalResp.Validate if the designated constraints aren't met.
+type AuthenticateLocalRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthenticateLocalRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthenticateLocalRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthenticateLocalRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthenticateLocalRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthenticateLocalRespValidationError) ErrorName() string {
+	return "AuthenticateLocalRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuthenticateLocalRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthenticateLocalResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthenticateLocalRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthenticateLocalRespValidationError{}
+
+// Validate checks the field values on ChangePasswordReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ChangePasswordReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChangePasswordReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChangePasswordReqMultiError, or nil if none found.
+func (m *ChangePasswordReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChangePasswordReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCurrentPassword()) < 1 {
+		err := ChangePasswordReqValidationError{
+			field:  "CurrentPassword",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetNewPassword()); l < 8 || l > 128 {
+		err := ChangePasswordReqValidationError{
+			field:  "NewPassword",
+			reason: "value length must be between 8 and 128 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ChangePasswordReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChangePasswordReqMultiError is an error wrapping multiple validation errors
+// returned by ChangePasswordReq.ValidateAll() if the designated constraints
+// aren't met.
+type ChangePasswordReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChangePasswordReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChangePasswordReqMultiError) AllErrors() []error { return m }
+
+// ChangePasswordReqValidationError is the validation error returned by
+// ChangePasswordReq.Validate if the designated constraints aren't met.
+type ChangePasswordReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangePasswordReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangePasswordReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangePasswordReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangePasswordReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangePasswordReqValidationError) ErrorName() string {
+	return "ChangePasswordReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChangePasswordReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChangePasswordReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChangePasswordReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangePasswordReqValidationError{}
+
+// Validate checks the field values on ChangePasswordResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ChangePasswordResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChangePasswordResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChangePasswordRespMultiError, or nil if none found.
+func (m *ChangePasswordResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChangePasswordResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ChangePasswordRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChangePasswordRespMultiError is an error wrapping multiple validation errors
+// returned by ChangePasswordResp.ValidateAll() if the designated constraints
+// aren't met.
+type ChangePasswordRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChangePasswordRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChangePasswordRespMultiError) AllErrors() []error { return m }
+
+// ChangePasswordRespValidationError is the validation error returned by
+// ChangePasswordResp.Validate if the designated constraints aren't met.
+type ChangePasswordRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangePasswordRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangePasswordRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangePasswordRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangePasswordRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangePasswordRespValidationError) ErrorName() string {
+	return "ChangePasswordRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChangePasswordRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChangePasswordResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChangePasswordRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangePasswordRespValidationError{}
+
+// Validate checks the field values on RequestPasswordResetReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestPasswordResetReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestPasswordResetReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestPasswordResetReqMultiError, or nil if none found.
+func (m *RequestPasswordResetReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestPasswordResetReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetEmail()) < 1 {
+		err := RequestPasswordResetReqValidationError{
+			field:  "Email",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RequestPasswordResetReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestPasswordResetReqMultiError is an error wrapping multiple validation
+// errors returned by RequestPasswordResetReq.ValidateAll() if the designated
+// constraints aren't met.
+type RequestPasswordResetReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestPasswordResetReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestPasswordResetReqMultiError) AllErrors() []error { return m }
+
+// RequestPasswordResetReqValidationError is the validation error returned by
+// RequestPasswordResetReq.Validate if the designated constraints aren't met.
+type RequestPasswordResetReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestPasswordResetReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestPasswordResetReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestPasswordResetReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestPasswordResetReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestPasswordResetReqValidationError) ErrorName() string {
+	return "RequestPasswordResetReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestPasswordResetReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestPasswordResetReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestPasswordResetReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestPasswordResetReqValidationError{}
+
+// Validate checks the field values on RequestPasswordResetResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestPasswordResetResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestPasswordResetResp with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestPasswordResetRespMultiError, or nil if none found.
+func (m *RequestPasswordResetResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestPasswordResetResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ResetToken
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RequestPasswordResetRespValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RequestPasswordResetRespValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RequestPasswordResetRespValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RequestPasswordResetRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestPasswordResetRespMultiError is an error wrapping multiple validation
+// errors returned by RequestPasswordResetResp.ValidateAll() if the designated
+// constraints aren't met.
+type RequestPasswordResetRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestPasswordResetRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestPasswordResetRespMultiError) AllErrors() []error { return m }
+
+// RequestPasswordResetRespValidationError is the validation error returned by
+// RequestPasswordResetResp.Validate if the designated constraints aren't met.
+type RequestPasswordResetRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestPasswordResetRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestPasswordResetRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestPasswordResetRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestPasswordResetRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestPasswordResetRespValidationError) ErrorName() string {
+	return "RequestPasswordResetRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestPasswordResetRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestPasswordResetResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestPasswordResetRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestPasswordResetRespValidationError{}
+
+// Validate checks the field values on ConfirmPasswordResetReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConfirmPasswordResetReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfirmPasswordResetReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfirmPasswordResetReqMultiError, or nil if none found.
+func (m *ConfirmPasswordResetReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfirmPasswordResetReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetResetToken()) < 1 {
+		err := ConfirmPasswordResetReqValidationError{
+			field:  "ResetToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetNewPassword()); l < 8 || l > 128 {
+		err := ConfirmPasswordResetReqValidationError{
+			field:  "NewPassword",
+			reason: "value length must be between 8 and 128 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ConfirmPasswordResetReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfirmPasswordResetReqMultiError is an error wrapping multiple validation
+// errors returned by ConfirmPasswordResetReq.ValidateAll() if the designated
+// constraints aren't met.
+type ConfirmPasswordResetReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfirmPasswordResetReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfirmPasswordResetReqMultiError) AllErrors() []error { return m }
+
+// ConfirmPasswordResetReqValidationError is the validation error returned by
+// ConfirmPasswordResetReq.Validate if the designated constraints aren't met.
+type ConfirmPasswordResetReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfirmPasswordResetReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfirmPasswordResetReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfirmPasswordResetReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfirmPasswordResetReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfirmPasswordResetReqValidationError) ErrorName() string {
+	return "ConfirmPasswordResetReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConfirmPasswordResetReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfirmPasswordResetReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfirmPasswordResetReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfirmPasswordResetReqValidationError{}
+
+// Validate checks the field values on ConfirmPasswordResetResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConfirmPasswordResetResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfirmPasswordResetResp with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfirmPasswordResetRespMultiError, or nil if none found.
+func (m *ConfirmPasswordResetResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfirmPasswordResetResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if len(errors) > 0 {
+		return ConfirmPasswordResetRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfirmPasswordResetRespMultiError is an error wrapping multiple validation
+// errors returned by ConfirmPasswordResetResp.ValidateAll() if the designated
+// constraints aren't met.
+type ConfirmPasswordResetRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfirmPasswordResetRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfirmPasswordResetRespMultiError) AllErrors() []error { return m }
+
+// ConfirmPasswordResetRespValidationError is the validation error returned by
+// ConfirmPasswordResetResp.Validate if the designated constraints aren't met.
+type ConfirmPasswordResetRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfirmPasswordResetRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfirmPasswordResetRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfirmPasswordResetRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfirmPasswordResetRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfirmPasswordResetRespValidationError) ErrorName() string {
+	return "ConfirmPasswordResetRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConfirmPasswordResetRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfirmPasswordResetResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfirmPasswordResetRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfirmPasswordResetRespValidationError{}
+
+// Validate checks the field values on LinkIdentityReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LinkIdentityReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LinkIdentityReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LinkIdentityReqMultiError, or nil if none found.
+func (m *LinkIdentityReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LinkIdentityReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUserId()) < 1 {
+		err := LinkIdentityReqValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _LinkIdentityReq_Provider_NotInLookup[m.GetProvider()]; ok {
+		err := LinkIdentityReqValidationError{
+			field:  "Provider",
+			reason: "value must not be in list [IDENTITY_PROVIDER_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := IdentityProvider_name[int32(m.GetProvider())]; !ok {
+		err := LinkIdentityReqValidationError{
+			field:  "Provider",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Subject
+
+	// no validation rules for Email
+
+	if m.Password != nil {
+
+		if l := utf8.RuneCountInString(m.GetPassword()); l < 8 || l > 128 {
+			err := LinkIdentityReqValidationError{
+				field:  "Password",
+				reason: "value length must be between 8 and 128 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return LinkIdentityReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// LinkIdentityReqMultiError is an error wrapping multiple validation errors
+// returned by LinkIdentityReq.ValidateAll() if the designated constraints
+// aren't met.
+type LinkIdentityReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LinkIdentityReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LinkIdentityReqMultiError) AllErrors() []error { return m }
+
+// LinkIdentityReqValidationError is the validation error returned by
+// LinkIdentityReq.Validate if the designated constraints aren't met.
+type LinkIdentityReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LinkIdentityReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LinkIdentityReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LinkIdentityReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LinkIdentityReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LinkIdentityReqValidationError) ErrorName() string { return "LinkIdentityReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LinkIdentityReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLinkIdentityReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LinkIdentityReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LinkIdentityReqValidationError{}
+
+var _LinkIdentityReq_Provider_NotInLookup = map[IdentityProvider]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on LinkIdentityResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LinkIdentityResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LinkIdentityResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LinkIdentityRespMultiError, or nil if none found.
+func (m *LinkIdentityResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LinkIdentityResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetIdentity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LinkIdentityRespValidationError{
+					field:  "Identity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LinkIdentityRespValidationError{
+					field:  "Identity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIdentity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LinkIdentityRespValidationError{
+				field:  "Identity",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return LinkIdentityRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// LinkIdentityRespMultiError is an error wrapping multiple validation errors
+// returned by LinkIdentityResp.ValidateAll() if the designated constraints
+// aren't met.
+type LinkIdentityRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LinkIdentityRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LinkIdentityRespMultiError) AllErrors() []error { return m }
+
+// LinkIdentityRespValidationError is the validation error returned by
+// LinkIdentityResp.Validate if the designated constraints aren't met.
+type LinkIdentityRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LinkIdentityRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LinkIdentityRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LinkIdentityRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LinkIdentityRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LinkIdentityRespValidationError) ErrorName() string { return "LinkIdentityRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LinkIdentityRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLinkIdentityResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LinkIdentityRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LinkIdentityRespValidationError{}
+
+// Validate checks the field values on UnlinkIdentityReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UnlinkIdentityReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnlinkIdentityReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnlinkIdentityReqMultiError, or nil if none found.
+func (m *UnlinkIdentityReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnlinkIdentityReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUserId()) < 1 {
+		err := UnlinkIdentityReqValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _UnlinkIdentityReq_Provider_NotInLookup[m.GetProvider()]; ok {
+		err := UnlinkIdentityReqValidationError{
+			field:  "Provider",
+			reason: "value must not be in list [IDENTITY_PROVIDER_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := IdentityProvider_name[int32(m.GetProvider())]; !ok {
+		err := UnlinkIdentityReqValidationError{
+			field:  "Provider",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UnlinkIdentityReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnlinkIdentityReqMultiError is an error wrapping multiple validation errors
+// returned by UnlinkIdentityReq.ValidateAll() if the designated constraints
+// aren't met.
+type UnlinkIdentityReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnlinkIdentityReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnlinkIdentityReqMultiError) AllErrors() []error { return m }
+
+// UnlinkIdentityReqValidationError is the validation error returned by
+// UnlinkIdentityReq.Validate if the designated constraints aren't met.
+type UnlinkIdentityReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnlinkIdentityReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnlinkIdentityReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnlinkIdentityReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnlinkIdentityReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnlinkIdentityReqValidationError) ErrorName() string {
+	return "UnlinkIdentityReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnlinkIdentityReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnlinkIdentityReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnlinkIdentityReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnlinkIdentityReqValidationError{}
+
+var _UnlinkIdentityReq_Provider_NotInLookup = map[IdentityProvider]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on UnlinkIdentityResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnlinkIdentityResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnlinkIdentityResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnlinkIdentityRespMultiError, or nil if none found.
+func (m *UnlinkIdentityResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnlinkIdentityResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UnlinkIdentityRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnlinkIdentityRespMultiError is an error wrapping multiple validation errors
+// returned by UnlinkIdentityResp.ValidateAll() if the designated constraints
+// aren't met.
+type UnlinkIdentityRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnlinkIdentityRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnlinkIdentityRespMultiError) AllErrors() []error { return m }
+
+// UnlinkIdentityRespValidationError is the validation error returned by
+// UnlinkIdentityResp.Validate if the designated constraints aren't met.
+type UnlinkIdentityRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnlinkIdentityRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnlinkIdentityRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnlinkIdentityRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnlinkIdentityRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnlinkIdentityRespValidationError) ErrorName() string {
+	return "UnlinkIdentityRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnlinkIdentityRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnlinkIdentityResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnlinkIdentityRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnlinkIdentityRespValidationError{}
+
+// Validate checks the field values on ListIdentitiesReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListIdentitiesReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListIdentitiesReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListIdentitiesReqMultiError, or nil if none found.
+func (m *ListIdentitiesReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListIdentitiesReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUserId()) < 1 {
+		err := ListIdentitiesReqValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListIdentitiesReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListIdentitiesReqMultiError is an error wrapping multiple validation errors
+// returned by ListIdentitiesReq.ValidateAll() if the designated constraints
+// aren't met.
+type ListIdentitiesReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListIdentitiesReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListIdentitiesReqMultiError) AllErrors() []error { return m }
+
+// ListIdentitiesReqValidationError is the validation error returned by
+// ListIdentitiesReq.Validate if the designated constraints aren't met.
+type ListIdentitiesReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListIdentitiesReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListIdentitiesReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListIdentitiesReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListIdentitiesReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListIdentitiesReqValidationError) ErrorName() string {
+	return "ListIdentitiesReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListIdentitiesReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListIdentitiesReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListIdentitiesReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListIdentitiesReqValidationError{}
+
+// Validate checks the field values on ListIdentitiesResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListIdentitiesResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListIdentitiesResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListIdentitiesRespMultiError, or nil if none found.
+func (m *ListIdentitiesResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListIdentitiesResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetIdentities() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListIdentitiesRespValidationError{
+						field:  fmt.Sprintf("Identities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListIdentitiesRespValidationError{
+						field:  fmt.Sprintf("Identities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListIdentitiesRespValidationError{
+					field:  fmt.Sprintf("Identities[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListIdentitiesRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListIdentitiesRespMultiError is an error wrapping multiple validation errors
+// returned by ListIdentitiesResp.ValidateAll() if the designated constraints
+// aren't met.
+type ListIdentitiesRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListIdentitiesRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListIdentitiesRespMultiError) AllErrors() []error { return m }
+
+// ListIdentitiesRespValidationError is the validation error returned by
+// ListIdentitiesResp.Validate if the designated constraints aren't met.
+type ListIdentitiesRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListIdentitiesRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListIdentitiesRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListIdentitiesRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListIdentitiesRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListIdentitiesRespValidationError) ErrorName() string {
+	return "ListIdentitiesRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListIdentitiesRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListIdentitiesResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListIdentitiesRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListIdentitiesRespValidationError{}
+
+// Validate checks the field values on DeleteUserReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DeleteUserReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteUserReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeleteUserReqMultiError, or
+// nil if none found.
+func (m *DeleteUserReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteUserReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUserId()) < 1 {
+		err := DeleteUserReqValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteUserReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteUserReqMultiError is an error wrapping multiple validation errors
+// returned by DeleteUserReq.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteUserReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteUserReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteUserReqMultiError) AllErrors() []error { return m }
+
+// DeleteUserReqValidationError is the validation error returned by
+// DeleteUserReq.Validate if the designated constraints aren't met.
+type DeleteUserReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteUserReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteUserReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteUserReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteUserReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteUserReqValidationError) ErrorName() string { return "DeleteUserReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeleteUserReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteUserReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteUserReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteUserReqValidationError{}
+
+// Validate checks the field values on DeleteUserResp with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DeleteUserResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteUserResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeleteUserRespMultiError,
+// or nil if none found.
+func (m *DeleteUserResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteUserResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteUserRespValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteUserRespValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteUserRespValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeleteUserRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteUserRespMultiError is an error wrapping multiple validation errors
+// returned by DeleteUserResp.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteUserRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteUserRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteUserRespMultiError) AllErrors() []error { return m }
+
+// DeleteUserRespValidationError is the validation error returned by
+// DeleteUserResp.Validate if the designated constraints aren't met.
+type DeleteUserRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteUserRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteUserRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteUserRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteUserRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteUserRespValidationError) ErrorName() string { return "DeleteUserRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeleteUserRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteUserResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteUserRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteUserRespValidationError{}
+
+// Validate checks the field values on ExportUserDataReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ExportUserDataReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportUserDataReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportUserDataReqMultiError, or nil if none found.
+func (m *ExportUserDataReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportUserDataReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUserId()) < 1 {
+		err := ExportUserDataReqValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ExportUserDataReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportUserDataReqMultiError is an error wrapping multiple validation errors
+// returned by ExportUserDataReq.ValidateAll() if the designated constraints
+// aren't met.
+type ExportUserDataReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportUserDataReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportUserDataReqMultiError) AllErrors() []error { return m }
+
+// ExportUserDataReqValidationError is the validation error returned by
+// ExportUserDataReq.Validate if the designated constraints aren't met.
+type ExportUserDataReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportUserDataReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportUserDataReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportUserDataReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportUserDataReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportUserDataReqValidationError) ErrorName() string {
+	return "ExportUserDataReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportUserDataReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportUserDataReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportUserDataReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportUserDataReqValidationError{}
+
+// Validate checks the field values on ExportUserDataResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportUserDataResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportUserDataResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportUserDataRespMultiError, or nil if none found.
+func (m *ExportUserDataResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportUserDataResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Archive
+
+	if all {
+		switch v := interface{}(m.GetExportedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportUserDataRespValidationError{
+					field:  "ExportedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportUserDataRespValidationError{
+					field:  "ExportedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExportedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportUserDataRespValidationError{
+				field:  "ExportedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExportUserDataRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportUserDataRespMultiError is an error wrapping multiple validation errors
+// returned by ExportUserDataResp.ValidateAll() if the designated constraints
+// aren't met.
+type ExportUserDataRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportUserDataRespMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportUserDataRespMultiError) AllErrors() []error { return m }
+
+// ExportUserDataRespValidationError is the validation error returned by
+// ExportUserDataResp.Validate if the designated constraints aren't met.
+type ExportUserDataRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportUserDataRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportUserDataRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportUserDataRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportUserDataRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportUserDataRespValidationError) ErrorName() string {
+	return "ExportUserDataRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportUserDataRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportUserDataResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportUserDataRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportUserDataRespValidationError{}
@@ -23,6 +23,17 @@ const (
 	UsersService_GetUser_FullMethodName              = "/core.v1.UsersService/GetUser"
 	UsersService_UpdateUser_FullMethodName           = "/core.v1.UsersService/UpdateUser"
 	UsersService_ListUsers_FullMethodName            = "/core.v1.UsersService/ListUsers"
+	UsersService_GetUserByIdentity_FullMethodName    = "/core.v1.UsersService/GetUserByIdentity"
+	UsersService_RecordLogin_FullMethodName          = "/core.v1.UsersService/RecordLogin"
+	UsersService_AuthenticateLocal_FullMethodName    = "/core.v1.UsersService/AuthenticateLocal"
+	UsersService_RequestPasswordReset_FullMethodName = "/core.v1.UsersService/RequestPasswordReset"
+	UsersService_ConfirmPasswordReset_FullMethodName = "/core.v1.UsersService/ConfirmPasswordReset"
+	UsersService_ChangePassword_FullMethodName       = "/core.v1.UsersService/ChangePassword"
+	UsersService_LinkIdentity_FullMethodName         = "/core.v1.UsersService/LinkIdentity"
+	UsersService_UnlinkIdentity_FullMethodName       = "/core.v1.UsersService/UnlinkIdentity"
+	UsersService_ListIdentities_FullMethodName       = "/core.v1.UsersService/ListIdentities"
+	UsersService_DeleteUser_FullMethodName           = "/core.v1.UsersService/DeleteUser"
+	UsersService_ExportUserData_FullMethodName       = "/core.v1.UsersService/ExportUserData"
 	UsersService_AdminSetUserRoles_FullMethodName    = "/core.v1.UsersService/AdminSetUserRoles"
 	UsersService_AdminSetUserDisabled_FullMethodName = "/core.v1.UsersService/AdminSetUserDisabled"
 )
@@ -36,6 +47,22 @@ type UsersServiceClient interface {
 	GetUser(ctx context.Context, in *GetUserReq, opts ...grpc.CallOption) (*GetUserResp, error)
 	UpdateUser(ctx context.Context, in *UpdateUserReq, opts ...grpc.CallOption) (*UpdateUserResp, error)
 	ListUsers(ctx context.Context, in *ListUsersReq, opts ...grpc.CallOption) (*ListUsersResp, error)
+	// Gateway login path, callable with a service token only
+	GetUserByIdentity(ctx context.Context, in *GetUserByIdentityReq, opts ...grpc.CallOption) (*GetUserByIdentityResp, error)
+	RecordLogin(ctx context.Context, in *RecordLoginReq, opts ...grpc.CallOption) (*RecordLoginResp, error)
+	AuthenticateLocal(ctx context.Context, in *AuthenticateLocalReq, opts ...grpc.CallOption) (*AuthenticateLocalResp, error)
+	RequestPasswordReset(ctx context.Context, in *RequestPasswordResetReq, opts ...grpc.CallOption) (*RequestPasswordResetResp, error)
+	ConfirmPasswordReset(ctx context.Context, in *ConfirmPasswordResetReq, opts ...grpc.CallOption) (*ConfirmPasswordResetResp, error)
+	// Caller's own password
+	ChangePassword(ctx context.Context, in *ChangePasswordReq, opts ...grpc.CallOption) (*ChangePasswordResp, error)
+	// Login methods linked to an account. Linking needs a service token: the
+	// gateway verifies the provider subject before calling it.
+	LinkIdentity(ctx context.Context, in *LinkIdentityReq, opts ...grpc.CallOption) (*LinkIdentityResp, error)
+	UnlinkIdentity(ctx context.Context, in *UnlinkIdentityReq, opts ...grpc.CallOption) (*UnlinkIdentityResp, error)
+	ListIdentities(ctx context.Context, in *ListIdentitiesReq, opts ...grpc.CallOption) (*ListIdentitiesResp, error)
+	// Account deletion and data access, for the user or an admin
+	DeleteUser(ctx context.Context, in *DeleteUserReq, opts ...grpc.CallOption) (*DeleteUserResp, error)
+	ExportUserData(ctx context.Context, in *ExportUserDataReq, opts ...grpc.CallOption) (*ExportUserDataResp, error)
 	// Admin only
 	AdminSetUserRoles(ctx context.Context, in *AdminSetUserRolesReq, opts ...grpc.CallOption) (*AdminSetUserRolesResp, error)
 	AdminSetUserDisabled(ctx context.Context, in *AdminSetUserDisabledReq, opts ...grpc.CallOption) (*AdminSetUserDisabledResp, error)
@@ -89,6 +116,116 @@ func (c *usersServiceClient) ListUsers(ctx context.Context, in *ListUsersReq, op
 	return out, nil
 }
 
+func (c *usersServiceClient) GetUserByIdentity(ctx context.Context, in *GetUserByIdentityReq, opts ...grpc.CallOption) (*GetUserByIdentityResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserByIdentityResp)
+	err := c.cc.Invoke(ctx, UsersService_GetUserByIdentity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) RecordLogin(ctx context.Context, in *RecordLoginReq, opts ...grpc.CallOption) (*RecordLoginResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordLoginResp)
+	err := c.cc.Invoke(ctx, UsersService_RecordLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) AuthenticateLocal(ctx context.Context, in *AuthenticateLocalReq, opts ...grpc.CallOption) (*AuthenticateLocalResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthenticateLocalResp)
+	err := c.cc.Invoke(ctx, UsersService_AuthenticateLocal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetReq, opts ...grpc.CallOption) (*RequestPasswordResetResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestPasswordResetResp)
+	err := c.cc.Invoke(ctx, UsersService_RequestPasswordReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) ConfirmPasswordReset(ctx context.Context, in *ConfirmPasswordResetReq, opts ...grpc.CallOption) (*ConfirmPasswordResetResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmPasswordResetResp)
+	err := c.cc.Invoke(ctx, UsersService_ConfirmPasswordReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) ChangePassword(ctx context.Context, in *ChangePasswordReq, opts ...grpc.CallOption) (*ChangePasswordResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangePasswordResp)
+	err := c.cc.Invoke(ctx, UsersService_ChangePassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) LinkIdentity(ctx context.Context, in *LinkIdentityReq, opts ...grpc.CallOption) (*LinkIdentityResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinkIdentityResp)
+	err := c.cc.Invoke(ctx, UsersService_LinkIdentity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) UnlinkIdentity(ctx context.Context, in *UnlinkIdentityReq, opts ...grpc.CallOption) (*UnlinkIdentityResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlinkIdentityResp)
+	err := c.cc.Invoke(ctx, UsersService_UnlinkIdentity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) ListIdentities(ctx context.Context, in *ListIdentitiesReq, opts ...grpc.CallOption) (*ListIdentitiesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIdentitiesResp)
+	err := c.cc.Invoke(ctx, UsersService_ListIdentities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) DeleteUser(ctx context.Context, in *DeleteUserReq, opts ...grpc.CallOption) (*DeleteUserResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteUserResp)
+	err := c.cc.Invoke(ctx, UsersService_DeleteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usersServiceClient) ExportUserData(ctx context.Context, in *ExportUserDataReq, opts ...grpc.CallOption) (*ExportUserDataResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportUserDataResp)
+	err := c.cc.Invoke(ctx, UsersService_ExportUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *usersServiceClient) AdminSetUserRoles(ctx context.Context, in *AdminSetUserRolesReq, opts ...grpc.CallOption) (*AdminSetUserRolesResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AdminSetUserRolesResp)
@@ -118,6 +255,22 @@ type UsersServiceServer interface {
 	GetUser(context.Context, *GetUserReq) (*GetUserResp, error)
 	UpdateUser(context.Context, *UpdateUserReq) (*UpdateUserResp, error)
 	ListUsers(context.Context, *ListUsersReq) (*ListUsersResp, error)
+	// Gateway login path, callable with a service token only
+	GetUserByIdentity(context.Context, *GetUserByIdentityReq) (*GetUserByIdentityResp, error)
+	RecordLogin(context.Context, *RecordLoginReq) (*RecordLoginResp, error)
+	AuthenticateLocal(context.Context, *AuthenticateLocalReq) (*AuthenticateLocalResp, error)
+	RequestPasswordReset(context.Context, *RequestPasswordResetReq) (*RequestPasswordResetResp, error)
+	ConfirmPasswordReset(context.Context, *ConfirmPasswordResetReq) (*ConfirmPasswordResetResp, error)
+	// Caller's own password
+	ChangePassword(context.Context, *ChangePasswordReq) (*ChangePasswordResp, error)
+	// Login methods linked to an account. Linking needs a service token: the
+	// gateway verifies the provider subject before calling it.
+	LinkIdentity(context.Context, *LinkIdentityReq) (*LinkIdentityResp, error)
+	UnlinkIdentity(context.Context, *UnlinkIdentityReq) (*UnlinkIdentityResp, error)
+	ListIdentities(context.Context, *ListIdentitiesReq) (*ListIdentitiesResp, error)
+	// Account deletion and data access, for the user or an admin
+	DeleteUser(context.Context, *DeleteUserReq) (*DeleteUserResp, error)
+	ExportUserData(context.Context, *ExportUserDataReq) (*ExportUserDataResp, error)
 	// Admin only
 	AdminSetUserRoles(context.Context, *AdminSetUserRolesReq) (*AdminSetUserRolesResp, error)
 	AdminSetUserDisabled(context.Context, *AdminSetUserDisabledReq) (*AdminSetUserDisabledResp, error)
@@ -143,6 +296,39 @@ func (UnimplementedUsersServiceServer) UpdateUser(context.Context, *UpdateUserRe
 func (UnimplementedUsersServiceServer) ListUsers(context.Context, *ListUsersReq) (*ListUsersResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
 }
+func (UnimplementedUsersServiceServer) GetUserByIdentity(context.Context, *GetUserByIdentityReq) (*GetUserByIdentityResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserByIdentity not implemented")
+}
+func (UnimplementedUsersServiceServer) RecordLogin(context.Context, *RecordLoginReq) (*RecordLoginResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordLogin not implemented")
+}
+func (UnimplementedUsersServiceServer) AuthenticateLocal(context.Context, *AuthenticateLocalReq) (*AuthenticateLocalResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthenticateLocal not implemented")
+}
+func (UnimplementedUsersServiceServer) RequestPasswordReset(context.Context, *RequestPasswordResetReq) (*RequestPasswordResetResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (UnimplementedUsersServiceServer) ConfirmPasswordReset(context.Context, *ConfirmPasswordResetReq) (*ConfirmPasswordResetResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmPasswordReset not implemented")
+}
+func (UnimplementedUsersServiceServer) ChangePassword(context.Context, *ChangePasswordReq) (*ChangePasswordResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (UnimplementedUsersServiceServer) LinkIdentity(context.Context, *LinkIdentityReq) (*LinkIdentityResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkIdentity not implemented")
+}
+func (UnimplementedUsersServiceServer) UnlinkIdentity(context.Context, *UnlinkIdentityReq) (*UnlinkIdentityResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkIdentity not implemented")
+}
+func (UnimplementedUsersServiceServer) ListIdentities(context.Context, *ListIdentitiesReq) (*ListIdentitiesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIdentities not implemented")
+}
+func (UnimplementedUsersServiceServer) DeleteUser(context.Context, *DeleteUserReq) (*DeleteUserResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedUsersServiceServer) ExportUserData(context.Context, *ExportUserDataReq) (*ExportUserDataResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
 func (UnimplementedUsersServiceServer) AdminSetUserRoles(context.Context, *AdminSetUserRolesReq) (*AdminSetUserRolesResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AdminSetUserRoles not implemented")
 }
@@ -242,6 +428,204 @@ func _UsersService_ListUsers_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UsersService_GetUserByIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserByIdentityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).GetUserByIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersService_GetUserByIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).GetUserByIdentity(ctx, req.(*GetUserByIdentityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_RecordLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordLoginReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).RecordLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersService_RecordLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).RecordLogin(ctx, req.(*RecordLoginReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_AuthenticateLocal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthenticateLocalReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).AuthenticateLocal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersService_AuthenticateLocal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).AuthenticateLocal(ctx, req.(*AuthenticateLocalReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPasswordResetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersService_RequestPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).RequestPasswordReset(ctx, req.(*RequestPasswordResetReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_ConfirmPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmPasswordResetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).ConfirmPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersService_ConfirmPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).ConfirmPasswordReset(ctx, req.(*ConfirmPasswordResetReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersService_ChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).ChangePassword(ctx, req.(*ChangePasswordReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_LinkIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkIdentityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).LinkIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersService_LinkIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).LinkIdentity(ctx, req.(*LinkIdentityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_UnlinkIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkIdentityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).UnlinkIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersService_UnlinkIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).UnlinkIdentity(ctx, req.(*UnlinkIdentityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_ListIdentities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIdentitiesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).ListIdentities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersService_ListIdentities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).ListIdentities(ctx, req.(*ListIdentitiesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersService_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).DeleteUser(ctx, req.(*DeleteUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsersService_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUserDataReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsersServiceServer).ExportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsersService_ExportUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsersServiceServer).ExportUserData(ctx, req.(*ExportUserDataReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UsersService_AdminSetUserRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AdminSetUserRolesReq)
 	if err := dec(in); err != nil {
@@ -301,6 +685,50 @@ var UsersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListUsers",
 			Handler:    _UsersService_ListUsers_Handler,
 		},
+		{
+			MethodName: "GetUserByIdentity",
+			Handler:    _UsersService_GetUserByIdentity_Handler,
+		},
+		{
+			MethodName: "RecordLogin",
+			Handler:    _UsersService_RecordLogin_Handler,
+		},
+		{
+			MethodName: "AuthenticateLocal",
+			Handler:    _UsersService_AuthenticateLocal_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _UsersService_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ConfirmPasswordReset",
+			Handler:    _UsersService_ConfirmPasswordReset_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _UsersService_ChangePassword_Handler,
+		},
+		{
+			MethodName: "LinkIdentity",
+			Handler:    _UsersService_LinkIdentity_Handler,
+		},
+		{
+			MethodName: "UnlinkIdentity",
+			Handler:    _UsersService_UnlinkIdentity_Handler,
+		},
+		{
+			MethodName: "ListIdentities",
+			Handler:    _UsersService_ListIdentities_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _UsersService_DeleteUser_Handler,
+		},
+		{
+			MethodName: "ExportUserData",
+			Handler:    _UsersService_ExportUserData_Handler,
+		},
 		{
 			MethodName: "AdminSetUserRoles",
 			Handler:    _UsersService_AdminSetUserRoles_Handler,
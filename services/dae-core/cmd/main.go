@@ -13,15 +13,23 @@ import (
 	"cloud.google.com/go/firestore"
 	libconfigs "github.com/deni12345/dae-services/libs/configs"
 	corev1 "github.com/deni12345/dae-services/proto/gen"
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/audit"
 	"github.com/deni12345/dae-services/services/dae-core/internal/app/health"
 	"github.com/deni12345/dae-services/services/dae-core/internal/app/order"
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/outbox"
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/payment"
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/scheduler"
 	"github.com/deni12345/dae-services/services/dae-core/internal/app/sheet"
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/sheettemplate"
 	"github.com/deni12345/dae-services/services/dae-core/internal/app/user"
+	auditrec "github.com/deni12345/dae-services/services/dae-core/internal/audit"
 	"github.com/deni12345/dae-services/services/dae-core/internal/configs"
 	grpchandler "github.com/deni12345/dae-services/services/dae-core/internal/grpc"
 	"github.com/deni12345/dae-services/services/dae-core/internal/grpc/interceptor"
 	frstore "github.com/deni12345/dae-services/services/dae-core/internal/infra/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/infra/menuimport"
 	"github.com/deni12345/dae-services/services/dae-core/internal/infra/observability"
+	"github.com/deni12345/dae-services/services/dae-core/internal/infra/password"
 	infraredis "github.com/deni12345/dae-services/services/dae-core/internal/infra/redis"
 	"github.com/deni12345/dae-services/services/dae-core/internal/port"
 	redisgo "github.com/redis/go-redis/v9"
@@ -82,17 +90,71 @@ func main() {
 		observability.Fatal(ctx, "failed to initialize observability", "error", err)
 	}
 
-	userUC := user.NewUsecase(userRepo)
-	orderUC := order.NewUsecase(orderRepo, sheetRepo, idemStore)
-	sheetUC := sheet.NewUsecase(sheetRepo, idemStore)
+	orderChanges := frstore.NewOrderChangeSource(fsClient)
+	paymentRepo := frstore.NewPaymentRepo(fsClient)
+
+	hasher, err := password.NewHasher(config.PasswordAlgo, password.DefaultArgon2Params)
+	if err != nil {
+		observability.Fatal(ctx, "failed to initialize password hasher", "error", err)
+	}
+	loginThrottle := infraredis.NewLoginThrottle(redisClient, infraredis.ThrottleConfig{
+		MaxAttempts:   config.LoginMaxAttempts,
+		AttemptWindow: time.Minute,
+		MaxFailures:   config.LoginMaxFailures,
+		FailureWindow: config.LoginFailureWindow,
+		Lockout:       config.LoginLockout,
+	})
+
+	userData := frstore.NewUserDataStore(fsClient)
+
+	userUC := user.NewUsecase(userRepo, hasher, loginThrottle, userData)
+	orderUC := order.NewUsecase(orderRepo, sheetRepo, idemStore, orderChanges)
+	menuParsers := []port.MenuParser{menuimport.NewJSONParser(), menuimport.NewCSVParser()}
+	sheetUC := sheet.NewUsecase(sheetRepo, frstore.NewSheetInviteRepo(fsClient), orderRepo, idemStore, menuParsers)
+	templateUC := sheettemplate.NewUsecase(frstore.NewSheetTemplateRepo(fsClient, config.PageSize), sheetRepo, userRepo, sheetUC)
+	paymentUC := payment.NewUsecase(paymentRepo, orderRepo, sheetRepo, idemStore)
 	healthUC := health.NewUsecase(fsClient, redisClient)
+	auditLog := frstore.NewAuditLog(fsClient, config.PageSize)
+	auditUC := audit.NewUsecase(auditLog)
+
+	verifier, err := initTokenVerifier(config)
+	if err != nil {
+		observability.Fatal(ctx, "failed to initialize token verifier", "error", err)
+	}
 
-	grpcServer := createGRPCServer(metrics, userUC, orderUC, sheetUC, healthUC)
+	grpcServer := createGRPCServer(metrics, verifier, userUC, orderUC, sheetUC, templateUC, paymentUC, healthUC, auditUC)
 	_, err = startGRPCServer(grpcServer, config.GRPCAddress)
 	if err != nil {
 		observability.Fatal(ctx, "failed to start gRPC server", "error", err)
 	}
 
+	if config.BackfillSearchPrefixes {
+		go runSearchBackfill(ctx, userUC)
+	}
+	if config.BackfillOrderStatuses {
+		go runStatusBackfill(ctx, orderUC)
+	}
+
+	eventPublisher := infraredis.NewEventPublisher(redisClient, infraredis.StreamConfig{
+		Stream:   config.EventStream,
+		MaxLen:   config.EventStreamMaxLen,
+		DedupTTL: config.EventDedupTTL,
+	})
+	relay := outbox.NewRelay(frstore.NewOutbox(fsClient), eventPublisher, outbox.Config{
+		BatchSize:   config.OutboxRelayBatch,
+		MaxAttempts: config.OutboxMaxAttempts,
+		Retention:   config.OutboxRetention,
+	})
+	go relay.Run(ctx, config.OutboxRelayInterval)
+
+	jobScheduler := scheduler.New(infraredis.NewLocker(redisClient), config.SchedulerLockTTL,
+		scheduler.Job{Name: "sheet-schedules", Run: sheetUC.ApplySchedules},
+		scheduler.Job{Name: "sheet-templates", Run: templateUC.MaterializeDue},
+		scheduler.Job{Name: "outbox-cleanup", Run: relay.Cleanup},
+		scheduler.Job{Name: "user-erasure", Run: eraseDeletedUsers(userUC, config.UserErasureGrace)},
+	)
+	go jobScheduler.Run(ctx, config.SchedulerInterval)
+
 	// Setup signal handler
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
@@ -176,30 +238,88 @@ func initObservability(ctx context.Context, cfg configs.Value) (func(context.Con
 
 func createGRPCServer(
 	metrics *observability.Metrics,
+	verifier *interceptor.TokenVerifier,
 	userUC user.Usecase,
 	orderUC order.Usecase,
 	sheetUC sheet.Usecase,
+	templateUC sheettemplate.Usecase,
+	paymentUC payment.Usecase,
 	healthUC health.Usecase,
+	auditUC audit.Usecase,
 ) *grpc.Server {
 
 	grpcServer := grpc.NewServer(
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 		grpc.ChainUnaryInterceptor(
+			interceptor.AuthInterceptor(verifier),
+			interceptor.AuthzInterceptor(),
 			interceptor.IdemInterceptor(),
+			interceptor.AuditInterceptor(),
 			interceptor.MetricsInterceptor(metrics),
 			interceptor.ValidateRequestInterceptor(metrics),
 			interceptor.LoggingInterceptor(),
 		),
+		grpc.ChainStreamInterceptor(
+			interceptor.AuthStreamInterceptor(verifier),
+			interceptor.AuthzStreamInterceptor(),
+			interceptor.MetricsStreamInterceptor(metrics),
+			interceptor.ValidateStreamInterceptor(metrics),
+			interceptor.LoggingStreamInterceptor(),
+		),
 	)
 
 	reflection.Register(grpcServer)
 	corev1.RegisterUsersServiceServer(grpcServer, grpchandler.NewUserHandler(userUC))
 	corev1.RegisterOrdersServiceServer(grpcServer, grpchandler.NewOrderHandler(orderUC))
 	corev1.RegisterSheetsServiceServer(grpcServer, grpchandler.NewSheetHandler(sheetUC))
+	corev1.RegisterSheetTemplatesServiceServer(grpcServer, grpchandler.NewSheetTemplateHandler(templateUC))
+	corev1.RegisterPaymentsServiceServer(grpcServer, grpchandler.NewPaymentHandler(paymentUC))
 	corev1.RegisterHealthServiceServer(grpcServer, grpchandler.NewHealthHandler(healthUC))
+	corev1.RegisterAuditServiceServer(grpcServer, grpchandler.NewAuditHandler(auditUC))
 	return grpcServer
 }
 
+// eraseDeletedUsers is the user-erasure job: it erases the accounts deleted
+// more than grace ago
+func eraseDeletedUsers(userUC user.Usecase, grace time.Duration) func(ctx context.Context, now time.Time) (int, error) {
+	return func(ctx context.Context, now time.Time) (int, error) {
+		return userUC.EraseDeletedUsers(ctx, now.Add(-grace))
+	}
+}
+
+// runSearchBackfill rebuilds the search prefixes of every user once
+func runSearchBackfill(ctx context.Context, userUC user.Usecase) {
+	ctx = auditrec.NewContext(ctx, auditrec.Job("search-backfill"))
+	updated, err := userUC.BackfillSearchPrefixes(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "search prefix backfill failed", "updated", updated, "error", err)
+		return
+	}
+	slog.InfoContext(ctx, "search prefix backfill done", "updated", updated)
+}
+
+// runStatusBackfill stores the status of every legacy order once
+func runStatusBackfill(ctx context.Context, orderUC order.Usecase) {
+	ctx = auditrec.NewContext(ctx, auditrec.Job("order-status-backfill"))
+	updated, err := orderUC.BackfillStatuses(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "order status backfill failed", "updated", updated, "error", err)
+		return
+	}
+	slog.InfoContext(ctx, "order status backfill done", "updated", updated)
+}
+
+func initTokenVerifier(config configs.Value) (*interceptor.TokenVerifier, error) {
+	if config.AuthJWKSFile == "" {
+		return nil, fmt.Errorf("auth_jwks_file is required")
+	}
+	jwks, err := os.ReadFile(config.AuthJWKSFile)
+	if err != nil {
+		return nil, fmt.Errorf("read jwks: %w", err)
+	}
+	return interceptor.NewTokenVerifier(jwks, config.AuthIssuer, config.AuthAudience)
+}
+
 func startGRPCServer(srv *grpc.Server, addr string) (net.Listener, error) {
 	lis, err := net.Listen("tcp", addr)
 	if err != nil {
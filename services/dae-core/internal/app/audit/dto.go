@@ -0,0 +1,16 @@
+package audit
+
+import "github.com/deni12345/dae-services/services/dae-core/internal/domain"
+
+type ListAuditEventsReq struct {
+	PageSize     int32
+	Cursor       string
+	ResourceType domain.AuditResourceType
+	ResourceID   string
+	ActorID      string
+}
+
+type ListAuditEventsResp struct {
+	Events     []*domain.AuditEvent
+	NextCursor string
+}
@@ -0,0 +1,8 @@
+package audit
+
+import "github.com/deni12345/dae-services/libs/apperror"
+
+var (
+	ErrInvalidCursor    = apperror.InvalidInput("invalid page cursor")
+	ErrResourceIDNoType = apperror.InvalidInput("resource_id requires resource_type")
+)
@@ -0,0 +1,39 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+)
+
+// ListAuditEvents pages through the audit log, newest first
+func (uc *usecase) ListAuditEvents(ctx context.Context, req *ListAuditEventsReq) (*ListAuditEventsResp, error) {
+	ctx, span := tracer.Start(ctx, "AuditUC.ListAuditEvents")
+	defer span.End()
+
+	query := port.ListAuditQuery{
+		Limit:        req.PageSize,
+		Cursor:       req.Cursor,
+		ResourceType: req.ResourceType,
+		ResourceID:   strings.TrimSpace(req.ResourceID),
+		ActorID:      strings.TrimSpace(req.ActorID),
+	}
+	if query.ResourceID != "" && query.ResourceType == "" {
+		err := ErrResourceIDNoType
+		span.RecordError(err)
+		return nil, err
+	}
+
+	resp, err := uc.auditLog.List(ctx, query)
+	if errors.Is(err, port.ErrInvalidAuditCursor) {
+		err = ErrInvalidCursor
+	}
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return &ListAuditEventsResp{Events: resp.Events, NextCursor: resp.NextCursor}, nil
+}
@@ -0,0 +1,25 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"go.opentelemetry.io/otel"
+)
+
+// Usecase reads the audit log. Events are written by the gRPC audit
+// interceptor, not through this usecase.
+type Usecase interface {
+	ListAuditEvents(ctx context.Context, req *ListAuditEventsReq) (*ListAuditEventsResp, error)
+}
+
+type usecase struct {
+	auditLog port.AuditLog
+}
+
+// NewUsecase creates a new audit usecase
+func NewUsecase(auditLog port.AuditLog) Usecase {
+	return &usecase{auditLog: auditLog}
+}
+
+var tracer = otel.Tracer("usecase/audit")
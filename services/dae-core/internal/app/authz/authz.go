@@ -0,0 +1,138 @@
+// Package authz holds the resource-level access checks usecases make once
+// the sheet, order or user a request touches has been loaded. Method-level
+// role checks live in the gRPC authz interceptor.
+package authz
+
+import (
+	"context"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/grpc/interceptor"
+	"github.com/deni12345/dae-services/libs/apperror"
+)
+
+var (
+	ErrNotAdmin        = apperror.Forbidden("admin role required")
+	ErrNotSuperAdmin   = apperror.Forbidden("superadmin role required")
+	ErrNotSelf         = apperror.Forbidden("only the user or an admin may do this")
+	ErrNotSheetHost    = apperror.Forbidden("only the sheet host or an admin may do this")
+	ErrNotSheetMember  = apperror.Forbidden("only sheet members or an admin may do this")
+	ErrNotOrderOwner   = apperror.Forbidden("only the order owner may do this")
+	ErrNotOrderParty   = apperror.Forbidden("only the order owner, sheet host or an admin may do this")
+	ErrNotTemplateHost = apperror.Forbidden("only the template host or an admin may do this")
+)
+
+// Caller returns the authenticated principal of the request
+func Caller(ctx context.Context) (*domain.Principal, error) {
+	p, ok := interceptor.PrincipalFromContext(ctx)
+	if !ok || p.UserID == "" {
+		return nil, apperror.Unauthorized("caller is not authenticated")
+	}
+	return p, nil
+}
+
+// IsAdmin reports whether the principal holds an admin role
+func IsAdmin(p *domain.Principal) bool {
+	return p.HasRole(domain.RoleAdmin) || p.HasRole(domain.RoleSuperAdmin)
+}
+
+// RequireAdmin allows admins and superadmins
+func RequireAdmin(ctx context.Context) error {
+	p, err := Caller(ctx)
+	if err != nil {
+		return err
+	}
+	if !IsAdmin(p) {
+		return ErrNotAdmin
+	}
+	return nil
+}
+
+// RequireSuperAdmin allows superadmins only
+func RequireSuperAdmin(ctx context.Context) error {
+	p, err := Caller(ctx)
+	if err != nil {
+		return err
+	}
+	if !p.HasRole(domain.RoleSuperAdmin) {
+		return ErrNotSuperAdmin
+	}
+	return nil
+}
+
+// RequireSelfOrAdmin allows userID itself and admins
+func RequireSelfOrAdmin(ctx context.Context, userID string) error {
+	p, err := Caller(ctx)
+	if err != nil {
+		return err
+	}
+	if p.UserID != userID && !IsAdmin(p) {
+		return ErrNotSelf
+	}
+	return nil
+}
+
+// RequireSheetHost allows the sheet's host and admins
+func RequireSheetHost(ctx context.Context, sheet *domain.Sheet) error {
+	p, err := Caller(ctx)
+	if err != nil {
+		return err
+	}
+	if sheet.HostUserID != p.UserID && !IsAdmin(p) {
+		return ErrNotSheetHost
+	}
+	return nil
+}
+
+// RequireSheetTemplateHost allows the template's host and admins
+func RequireSheetTemplateHost(ctx context.Context, template *domain.SheetTemplate) error {
+	p, err := Caller(ctx)
+	if err != nil {
+		return err
+	}
+	if template.HostUserID != p.UserID && !IsAdmin(p) {
+		return ErrNotTemplateHost
+	}
+	return nil
+}
+
+// RequireSheetMember allows the sheet's members, its host and admins
+func RequireSheetMember(ctx context.Context, sheet *domain.Sheet) error {
+	p, err := Caller(ctx)
+	if err != nil {
+		return err
+	}
+	if sheet.HostUserID == p.UserID || IsAdmin(p) {
+		return nil
+	}
+	for _, id := range sheet.MemberIDs {
+		if id == p.UserID {
+			return nil
+		}
+	}
+	return ErrNotSheetMember
+}
+
+// RequireOrderOwner allows only the user who placed the order
+func RequireOrderOwner(ctx context.Context, order *domain.Order) error {
+	p, err := Caller(ctx)
+	if err != nil {
+		return err
+	}
+	if order.UserID != p.UserID {
+		return ErrNotOrderOwner
+	}
+	return nil
+}
+
+// RequireOrderParty allows the order's owner, the host of its sheet and admins
+func RequireOrderParty(ctx context.Context, order *domain.Order, sheet *domain.Sheet) error {
+	p, err := Caller(ctx)
+	if err != nil {
+		return err
+	}
+	if order.UserID != p.UserID && sheet.HostUserID != p.UserID && !IsAdmin(p) {
+		return ErrNotOrderParty
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package authz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/grpc/interceptor"
+	"github.com/deni12345/dae-services/libs/apperror"
+)
+
+func asCaller(userID string, roles ...domain.Role) context.Context {
+	return interceptor.WithPrincipal(context.Background(), &domain.Principal{UserID: userID, Roles: roles})
+}
+
+func TestResourceChecks(t *testing.T) {
+	sheet := &domain.Sheet{ID: "s1", HostUserID: "host", MemberIDs: []string{"host", "member"}}
+	order := &domain.Order{ID: "o1", SheetID: "s1", UserID: "member"}
+
+	host := asCaller("host", domain.RoleUser)
+	member := asCaller("member", domain.RoleUser)
+	outsider := asCaller("outsider", domain.RoleUser)
+	admin := asCaller("root", domain.RoleAdmin)
+	superadmin := asCaller("god", domain.RoleSuperAdmin)
+	anonymous := context.Background()
+
+	checks := map[string]func(ctx context.Context) error{
+		"RequireAdmin":       RequireAdmin,
+		"RequireSuperAdmin":  RequireSuperAdmin,
+		"RequireSelfOrAdmin": func(ctx context.Context) error { return RequireSelfOrAdmin(ctx, "member") },
+		"RequireSheetHost":   func(ctx context.Context) error { return RequireSheetHost(ctx, sheet) },
+		"RequireSheetMember": func(ctx context.Context) error { return RequireSheetMember(ctx, sheet) },
+		"RequireOrderOwner":  func(ctx context.Context) error { return RequireOrderOwner(ctx, order) },
+		"RequireOrderParty":  func(ctx context.Context) error { return RequireOrderParty(ctx, order, sheet) },
+	}
+
+	// allowed lists the callers each check lets through; everyone else is forbidden
+	tests := map[string][]string{
+		"RequireAdmin":       {"admin", "superadmin"},
+		"RequireSuperAdmin":  {"superadmin"},
+		"RequireSelfOrAdmin": {"member", "admin", "superadmin"},
+		"RequireSheetHost":   {"host", "admin", "superadmin"},
+		"RequireSheetMember": {"host", "member", "admin", "superadmin"},
+		"RequireOrderOwner":  {"member"},
+		"RequireOrderParty":  {"host", "member", "admin", "superadmin"},
+	}
+
+	callers := map[string]context.Context{
+		"host":       host,
+		"member":     member,
+		"outsider":   outsider,
+		"admin":      admin,
+		"superadmin": superadmin,
+	}
+
+	for name, allowed := range tests {
+		check := checks[name]
+		ok := make(map[string]bool, len(allowed))
+		for _, c := range allowed {
+			ok[c] = true
+		}
+
+		for caller, ctx := range callers {
+			err := check(ctx)
+			if ok[caller] && err != nil {
+				t.Fatalf("%s as %s: error = %v, want nil", name, caller, err)
+			}
+			if !ok[caller] && apperror.GetCode(err) != apperror.CodeForbidden {
+				t.Fatalf("%s as %s: error = %v, want forbidden", name, caller, err)
+			}
+		}
+
+		if err := check(anonymous); apperror.GetCode(err) != apperror.CodeUnauthorized {
+			t.Fatalf("%s anonymously: error = %v, want unauthorized", name, err)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package order
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/authz"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/grpc/interceptor"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+)
+
+// storedOrder serves one order for reads as well as updates
+type storedOrder struct {
+	singleOrder
+}
+
+func (r *storedOrder) GetByID(context.Context, string) (*domain.Order, error) {
+	copied := *r.order
+	return &copied, nil
+}
+
+// passIdemStore runs every call; these tests never retry
+type passIdemStore struct {
+	port.IdempotencyStore
+}
+
+func (passIdemStore) Do(ctx context.Context, _ string, _ time.Duration, fn func(ctx context.Context) ([]byte, error)) ([]byte, error) {
+	return fn(ctx)
+}
+
+// TestOrderAccess covers the resource policies the authz interceptor leaves to
+// the usecase: confirming is for the host, editing for the owner, and a
+// signed-in user outside the sheet can neither place, read nor watch its
+// orders.
+func TestOrderAccess(t *testing.T) {
+	sheets := &cutoffSheets{sheet: &domain.Sheet{ID: "s1", HostUserID: "host", MemberIDs: []string{"host", "owner", "member"}, Status: domain.Status_OPEN}}
+	orders := &storedOrder{singleOrder{order: &domain.Order{ID: "o1", SheetID: "s1", UserID: "owner", Status: domain.OrderStatusPending}}}
+	uc := &usecase{sheetRepo: sheets, orderRepo: orders, idemStore: passIdemStore{}}
+	as := func(userID string) context.Context {
+		ctx := interceptor.WithPrincipal(context.Background(), &domain.Principal{UserID: userID})
+		return interceptor.WithIdempotencyKey(ctx, "ConfirmOrder", userID)
+	}
+
+	tests := map[string]struct {
+		caller string
+		call   func(ctx context.Context) error
+		want   error
+	}{
+		"CreateOrder as outsider": {"outsider", func(ctx context.Context) error {
+			_, err := uc.createOrderInternal(ctx, "outsider", &CreateOrderReq{SheetID: "s1", Lines: []OrderLineReq{{MenuItemID: "pho", Quantity: 1}}})
+			return err
+		}, authz.ErrNotSheetMember},
+		"ConfirmOrder as member": {"member", func(ctx context.Context) error {
+			_, err := uc.ConfirmOrder(ctx, "o1")
+			return err
+		}, authz.ErrNotSheetHost},
+		"ConfirmOrder as owner": {"owner", func(ctx context.Context) error {
+			_, err := uc.ConfirmOrder(ctx, "o1")
+			return err
+		}, authz.ErrNotSheetHost},
+		"UpdateOrder as host": {"host", func(ctx context.Context) error {
+			_, err := uc.UpdateOrder(ctx, &UpdateOrderReq{ID: "o1"})
+			return err
+		}, authz.ErrNotOrderOwner},
+		"CancelOrder as member": {"member", func(ctx context.Context) error {
+			_, err := uc.CancelOrder(ctx, "o1")
+			return err
+		}, authz.ErrNotOrderParty},
+		"GetOrder as member": {"member", func(ctx context.Context) error {
+			_, err := uc.GetOrderByID(ctx, "o1")
+			return err
+		}, authz.ErrNotOrderParty},
+		"ListOrders as outsider": {"outsider", func(ctx context.Context) error {
+			_, err := uc.ListOrders(ctx, &ListOrdersReq{SheetID: "s1"})
+			return err
+		}, authz.ErrNotSheetMember},
+		"StreamOrders as outsider": {"outsider", func(ctx context.Context) error {
+			return uc.StreamOrders(ctx, &StreamOrdersReq{SheetID: "s1"}, func(*domain.OrderEvent) error { return nil })
+		}, authz.ErrNotSheetMember},
+	}
+
+	for name, tc := range tests {
+		if err := tc.call(as(tc.caller)); err != tc.want {
+			t.Errorf("%s: err = %v, want %v", name, err, tc.want)
+		}
+	}
+	if orders.updated {
+		t.Fatal("a refused caller changed the order")
+	}
+}
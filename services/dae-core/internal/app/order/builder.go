@@ -2,68 +2,102 @@ package order
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"github.com/deni12345/dae-services/services/dae-core/internal/pricing"
 	"github.com/deni12345/dae-services/libs/apperror"
 )
 
-// buildOrderLine calculates price, options, and builds OrderLine with groupIDs
-func (u *usecase) buildOrderLine(ctx context.Context, sheetID string, lineReq OrderLineReq) (domain.OrderLine, error) {
-	// Fetch menu item
-	item, err := u.sheetRepo.GetMenuItemByID(ctx, sheetID, lineReq.MenuItemID)
+// buildOrderLines validates every requested line against the sheet's active
+// menu version and prices them. All selection problems are reported together
+// as field violations rather than stopping at the first one.
+func (u *usecase) buildOrderLines(ctx context.Context, sheet *domain.Sheet, lineReqs []OrderLineReq) ([]domain.OrderLine, error) {
+	lookup, err := u.menuLookup(ctx, sheet)
 	if err != nil {
-		return domain.OrderLine{}, err
+		return nil, err
 	}
 
-	// Calculate pricing
-	unitBase := item.Price
-	var unitOpts int64
-	var orderOptions []domain.OrderLineOption
-
-	// Process options with group information
-	if len(lineReq.Options) > 0 {
-		for _, optGroup := range item.OptionGroups {
-			for _, optReq := range lineReq.Options {
-				if optReq.Quantity <= 0 {
-					continue
-				}
+	items := make([]*domain.MenuItem, len(lineReqs))
+	var violations []apperror.FieldViolation
 
-				optItem, ok := optGroup.Options[optReq.OptionID]
-				if !ok {
-					continue
-				}
+	for i, lineReq := range lineReqs {
+		item, err := lookup(lineReq.MenuItemID)
+		if errors.Is(err, port.ErrMenuItemNotFound) {
+			violations = append(violations, apperror.FieldViolation{
+				Field:       fmt.Sprintf("lines[%d].menu_item_id", i),
+				Description: fmt.Sprintf("menu item %q does not exist", lineReq.MenuItemID),
+			})
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("get menu item for line %d: %w", i, err)
+		}
 
-				// Validate single vs multi select
-				if optReq.Quantity > 1 && optGroup.Type == domain.GroupSingle {
-					return domain.OrderLine{}, apperror.InvalidInput(fmt.Sprintf("cannot select multiple options for single-select group: %s", optGroup.ID))
-				}
+		items[i] = item
+		violations = append(violations, validateSelection(i, item, lineReq)...)
+	}
 
-				optionPrice := optItem.Price * int64(optReq.Quantity)
-				unitOpts += optionPrice
+	if len(violations) > 0 {
+		return nil, apperror.InvalidFields("invalid menu selection", violations)
+	}
 
-				orderOptions = append(orderOptions, domain.OrderLineOption{
-					GroupID:    optGroup.ID, // Include group ID
-					OptionID:   optReq.OptionID,
-					Title:      optItem.Name,
-					PriceDelta: domain.NewMoney(optionPrice, item.Currency),
-					Quantity:   int32(optReq.Quantity),
-				})
-			}
-		}
+	orderLines := make([]domain.OrderLine, 0, len(lineReqs))
+	for i, lineReq := range lineReqs {
+		orderLines = append(orderLines, buildOrderLine(items[i], lineReq))
 	}
+	return orderLines, nil
+}
 
-	unitTotal := unitBase + unitOpts
-	lineTotal := unitTotal * int64(lineReq.Quantity)
+// menuLookup resolves menu items from the sheet's active menu snapshot.
+// Sheets created before menus were versioned fall back to the live menu.
+func (u *usecase) menuLookup(ctx context.Context, sheet *domain.Sheet) (func(itemID string) (*domain.MenuItem, error), error) {
+	if sheet.ActiveMenuID == "" {
+		return func(itemID string) (*domain.MenuItem, error) {
+			return u.sheetRepo.GetMenuItemByID(ctx, sheet.ID, itemID)
+		}, nil
+	}
 
-	return domain.OrderLine{
-		MenuItemID:        lineReq.MenuItemID,
-		Name:              item.Name,
-		Quantity:          int32(lineReq.Quantity),
-		OrderBasePrice:    domain.NewMoney(unitBase, item.Currency),
-		OrderOptionsTotal: domain.NewMoney(unitOpts, item.Currency),
-		OrderTotal:        domain.NewMoney(lineTotal, item.Currency),
-		Options:           orderOptions,
-		Note:              lineReq.Note,
+	version, err := u.sheetRepo.GetMenuVersion(ctx, sheet.ID, sheet.ActiveMenuID)
+	if err != nil {
+		return nil, fmt.Errorf("get active menu: %w", err)
+	}
+	return func(itemID string) (*domain.MenuItem, error) {
+		if item, ok := version.Item(itemID); ok {
+			return item, nil
+		}
+		return nil, port.ErrMenuItemNotFound
 	}, nil
 }
+
+// buildOrderLine snapshots the item and option prices for a selection and
+// prices the line. The selection must already have passed validateSelection.
+func buildOrderLine(item *domain.MenuItem, lineReq OrderLineReq) domain.OrderLine {
+	var orderOptions []domain.OrderLineOption
+	for _, optReq := range lineReq.Options {
+		optGroup := item.OptionGroups[optReq.GroupID]
+		optItem := optGroup.Options[optReq.OptionID]
+
+		orderOptions = append(orderOptions, domain.OrderLineOption{
+			GroupID:    optGroup.ID,
+			OptionID:   optReq.OptionID,
+			Title:      optItem.Name,
+			PriceDelta: domain.NewMoney(optItem.Price, item.Currency),
+			Quantity:   int32(optReq.Quantity),
+			Per:        optItem.Per,
+		})
+	}
+
+	line := domain.OrderLine{
+		MenuItemID:     lineReq.MenuItemID,
+		Name:           item.Name,
+		Quantity:       int32(lineReq.Quantity),
+		OrderBasePrice: domain.NewMoney(item.Price, item.Currency),
+		Options:        orderOptions,
+		Note:           lineReq.Note,
+	}
+	pricing.Line(&line)
+	return line
+}
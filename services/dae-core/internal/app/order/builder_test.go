@@ -0,0 +1,36 @@
+package order
+
+import (
+	"testing"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+)
+
+func TestBuildOrderLineStoresUnitPrices(t *testing.T) {
+	item := testMenuItem()
+	item.Price = 30000
+	item.Currency = "VND"
+	boba := item.OptionGroups["topping"].Options["boba"]
+	boba.Price = 5000
+	item.OptionGroups["topping"].Options["boba"] = boba
+	size := item.OptionGroups["size"].Options["l"]
+	size.Price, size.Per = 8000, domain.PerOrder
+	item.OptionGroups["size"].Options["l"] = size
+
+	line := buildOrderLine(item, OrderLineReq{
+		MenuItemID: item.ID,
+		Quantity:   3,
+		Options: []OrderLineOptionReq{
+			{GroupID: "size", OptionID: "l", Quantity: 1},
+			{GroupID: "topping", OptionID: "boba", Quantity: 2},
+		},
+	})
+
+	if got := line.Options[1].PriceDelta.Amount; got != 5000 {
+		t.Fatalf("boba price delta = %d, want the unit price 5000", got)
+	}
+	// 3 x 30000 + 3 x 2 x 5000 + 8000 once for the line
+	if line.OrderOptionsTotal.Amount != 38000 || line.OrderTotal.Amount != 128000 {
+		t.Fatalf("options total = %d, line total = %d; want 38000, 128000", line.OrderOptionsTotal.Amount, line.OrderTotal.Amount)
+	}
+}
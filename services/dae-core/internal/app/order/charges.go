@@ -0,0 +1,145 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/pricing"
+)
+
+// maxCachedSheets bounds chargeCache; once full it starts over empty
+const maxCachedSheets = 1024
+
+// orderShares are the charges SheetCharges gave one order
+type orderShares struct {
+	fee      domain.Money
+	discount domain.Money
+}
+
+// sheetShares are the shares of every order of a sheet. They hold while the
+// sheet and its latest order change are the ones they were computed from.
+type sheetShares struct {
+	sheetUpdatedAt time.Time
+	cursor         string
+	orders         map[string]orderShares
+}
+
+// chargeCache keeps the last shares computed for each sheet, so reading an
+// order does not load every order of its sheet again. A nil cache keeps
+// nothing.
+type chargeCache struct {
+	mu     sync.Mutex
+	sheets map[string]*sheetShares
+}
+
+func newChargeCache() *chargeCache {
+	return &chargeCache{sheets: make(map[string]*sheetShares)}
+}
+
+func (c *chargeCache) get(sheet *domain.Sheet, cursor string) *sheetShares {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s, ok := c.sheets[sheet.ID]
+	if !ok || !s.sheetUpdatedAt.Equal(sheet.UpdatedAt) || s.cursor != cursor {
+		return nil
+	}
+	return s
+}
+
+func (c *chargeCache) put(sheetID string, s *sheetShares) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.sheets[sheetID]; !ok && len(c.sheets) >= maxCachedSheets {
+		c.sheets = make(map[string]*sheetShares)
+	}
+	c.sheets[sheetID] = s
+}
+
+// applySheetCharges fills in the delivery fee and discount shares of orders.
+// Shares depend on every order in a sheet, so each sheet's orders are split
+// together, once per request and only again after one of them changed.
+func (u *usecase) applySheetCharges(ctx context.Context, orders ...*domain.Order) error {
+	bySheet := make(map[string][]*domain.Order)
+	for _, o := range orders {
+		bySheet[o.SheetID] = append(bySheet[o.SheetID], o)
+	}
+
+	for sheetID, targets := range bySheet {
+		shares, err := u.sheetShares(ctx, sheetID, targets)
+		if err != nil {
+			return err
+		}
+		for _, o := range targets {
+			if s, ok := shares.orders[o.ID]; ok {
+				o.FeeShare = s.fee
+				o.DiscountShare = s.discount
+				pricing.Total(o)
+			}
+		}
+	}
+
+	return nil
+}
+
+// sheetShares returns the shares of the sheet's orders, computing them again
+// unless the cached ones are current and cover every target
+func (u *usecase) sheetShares(ctx context.Context, sheetID string, targets []*domain.Order) (*sheetShares, error) {
+	sheet, err := u.sheetRepo.GetByID(ctx, sheetID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The cursor is read before the orders, so a write in between changes it
+	// and the shares are computed again on the next read
+	var cursor string
+	if u.charges != nil {
+		if cursor, err = u.changes.LatestCursor(ctx, sheetID); err != nil {
+			return nil, err
+		}
+		if cached := u.charges.get(sheet, cursor); cached != nil && covers(cached, targets) {
+			return cached, nil
+		}
+	}
+
+	all, err := u.orderRepo.ListBySheet(ctx, sheetID)
+	if err != nil {
+		return nil, err
+	}
+	if err := pricing.SheetCharges(sheet, all); err != nil {
+		if errors.Is(err, domain.ErrCurrencyMismatch) {
+			return nil, ErrMixedCurrencies
+		}
+		return nil, err
+	}
+
+	shares := &sheetShares{
+		sheetUpdatedAt: sheet.UpdatedAt,
+		cursor:         cursor,
+		orders:         make(map[string]orderShares, len(all)),
+	}
+	for _, o := range all {
+		shares.orders[o.ID] = orderShares{fee: o.FeeShare, discount: o.DiscountShare}
+	}
+	u.charges.put(sheetID, shares)
+	return shares, nil
+}
+
+func covers(s *sheetShares, orders []*domain.Order) bool {
+	for _, o := range orders {
+		if _, ok := s.orders[o.ID]; !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,78 @@
+package order
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+)
+
+// sheetOrders serves every order of a sheet and counts the full loads
+type sheetOrders struct {
+	port.OrdersRepo
+	orders []*domain.Order
+	loads  int
+}
+
+func (r *sheetOrders) ListBySheet(context.Context, string) ([]*domain.Order, error) {
+	r.loads++
+	out := make([]*domain.Order, len(r.orders))
+	for i, o := range r.orders {
+		copied := *o
+		out[i] = &copied
+	}
+	return out, nil
+}
+
+// fixedCursor reports cursor as the sheet's latest order change
+type fixedCursor struct {
+	port.OrderChangeSource
+	cursor string
+}
+
+func (c *fixedCursor) LatestCursor(context.Context, string) (string, error) {
+	return c.cursor, nil
+}
+
+func TestApplySheetChargesCachesShares(t *testing.T) {
+	sheets := &cutoffSheets{sheet: &domain.Sheet{ID: "s1", DeliveryFee: domain.NewMoney(100, "VND"), UpdatedAt: time.Unix(1, 0)}}
+	orders := &sheetOrders{orders: []*domain.Order{
+		{ID: "a", SheetID: "s1", Subtotal: domain.NewMoney(300, "VND")},
+		{ID: "b", SheetID: "s1", Subtotal: domain.NewMoney(500, "VND")},
+	}}
+	changes := &fixedCursor{cursor: "1.c1"}
+	uc := &usecase{sheetRepo: sheets, orderRepo: orders, changes: changes, charges: newChargeCache()}
+
+	read := func() *domain.Order {
+		t.Helper()
+		o := &domain.Order{ID: "a", SheetID: "s1", Subtotal: domain.NewMoney(300, "VND")}
+		if err := uc.applySheetCharges(context.Background(), o); err != nil {
+			t.Fatalf("apply charges: %v", err)
+		}
+		return o
+	}
+
+	if o := read(); o.FeeShare.Amount != 50 || o.Total.Amount != 350 {
+		t.Fatalf("fee share %d, total %d, want 50 and 350", o.FeeShare.Amount, o.Total.Amount)
+	}
+	read()
+	if orders.loads != 1 {
+		t.Fatalf("unchanged sheet loaded its orders %d times, want 1", orders.loads)
+	}
+
+	// A new order on the sheet moves the latest change
+	orders.orders = append(orders.orders, &domain.Order{ID: "c", SheetID: "s1", Subtotal: domain.NewMoney(100, "VND")})
+	changes.cursor = "2.c2"
+	if o := read(); o.FeeShare.Amount != 34 || orders.loads != 2 {
+		t.Fatalf("after a new order: fee share %d after %d loads, want 34 after 2", o.FeeShare.Amount, orders.loads)
+	}
+
+	// So does a change to the sheet's charges
+	sheets.sheet.DeliveryFee = domain.NewMoney(0, "VND")
+	sheets.sheet.UpdatedAt = time.Unix(2, 0)
+	if o := read(); o.FeeShare.Amount != 0 || orders.loads != 3 {
+		t.Fatalf("after a sheet update: fee share %d after %d loads, want 0 after 3", o.FeeShare.Amount, orders.loads)
+	}
+}
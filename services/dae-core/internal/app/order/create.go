@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/authz"
 	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/pricing"
 	"github.com/deni12345/dae-services/services/dae-core/internal/grpc/interceptor"
 	"github.com/deni12345/dae-services/libs/apperror"
 	"github.com/google/uuid"
@@ -21,10 +23,16 @@ func (u *usecase) CreateOrder(ctx context.Context, req *CreateOrderReq) (*domain
 	ctx, span := tracer.Start(ctx, "OrderUC.CreateOrder")
 	defer span.End()
 
-	idemKey := interceptor.GetOrCreateIdempotencyKeyWithHash(ctx, req.SheetID, req.UserID)
+	userID, err := interceptor.ActingUserID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	idemKey := interceptor.GetOrCreateIdempotencyKeyWithHash(ctx, req.SheetID, userID)
 
 	result, err := u.idemStore.Do(ctx, idemKey, idempotencyTTL, func(ctx context.Context) ([]byte, error) {
-		order, err := u.createOrderInternal(ctx, req)
+		order, err := u.createOrderInternal(ctx, userID, req)
 		if err != nil {
 			span.RecordError(err)
 			return nil, err
@@ -43,6 +51,11 @@ func (u *usecase) CreateOrder(ctx context.Context, req *CreateOrderReq) (*domain
 		return nil, apperror.Internal(fmt.Sprintf("unmarshal order: %v", err))
 	}
 
+	if err := u.applySheetCharges(ctx, &order); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
 	return &order, nil
 }
 
@@ -50,25 +63,30 @@ func validateCreateRequest(req *CreateOrderReq) error {
 	if req.SheetID == "" {
 		return apperror.InvalidInput("sheet_id is required")
 	}
-	if req.UserID == "" {
-		return apperror.InvalidInput("user_id is required")
-	}
 	if len(req.Lines) == 0 {
 		return ErrInvalidOrderLines
 	}
 	return nil
 }
 
-func (u *usecase) createOrderInternal(ctx context.Context, req *CreateOrderReq) (*domain.Order, error) {
+func (u *usecase) createOrderInternal(ctx context.Context, userID string, req *CreateOrderReq) (*domain.Order, error) {
 	sheet, err := u.sheetRepo.GetByID(ctx, req.SheetID)
 	if err != nil {
 		return nil, err
 	}
+	// Orders count toward the sheet's settlement, so only members place them
+	if err := authz.RequireSheetMember(ctx, sheet); err != nil {
+		return nil, err
+	}
 	if !sheet.IsOpen() {
 		return nil, ErrSheetNotOpen
 	}
+	// The scheduler may not have closed the sheet yet
+	if sheet.PastCutoff(time.Now()) {
+		return nil, ErrCutoffPassed
+	}
 
-	orderLines, err := u.buildOrderLines(ctx, req.SheetID, req.Lines)
+	orderLines, err := u.buildOrderLines(ctx, sheet, req.Lines)
 	if err != nil {
 		return nil, err
 	}
@@ -77,14 +95,16 @@ func (u *usecase) createOrderInternal(ctx context.Context, req *CreateOrderReq)
 	order := &domain.Order{
 		ID:        uuid.New().String(),
 		SheetID:   req.SheetID,
-		UserID:    req.UserID,
+		UserID:    userID,
 		Lines:     orderLines,
 		Note:      req.Note,
+		Status:    domain.OrderStatusPending,
+		MenuID:    sheet.ActiveMenuID,
 		CreatedAt: now,
 		UpdatedAt: now,
 	}
 
-	domain.CalculateOrderTotals(order)
+	pricing.Order(order)
 
 	createdOrder, err := u.orderRepo.Create(ctx, order)
 	if err != nil {
@@ -93,15 +113,3 @@ func (u *usecase) createOrderInternal(ctx context.Context, req *CreateOrderReq)
 
 	return createdOrder, nil
 }
-
-func (u *usecase) buildOrderLines(ctx context.Context, sheetID string, lineReqs []OrderLineReq) ([]domain.OrderLine, error) {
-	orderLines := make([]domain.OrderLine, 0, len(lineReqs))
-	for i, lineReq := range lineReqs {
-		line, err := u.buildOrderLine(ctx, sheetID, lineReq)
-		if err != nil {
-			return nil, fmt.Errorf("build line %d: %w", i, err)
-		}
-		orderLines = append(orderLines, line)
-	}
-	return orderLines, nil
-}
@@ -0,0 +1,58 @@
+package order
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/grpc/interceptor"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+)
+
+// cutoffSheets serves one sheet that is open but past its cutoff, as it is
+// until the scheduler closes it
+type cutoffSheets struct {
+	port.SheetRepo
+	sheet *domain.Sheet
+}
+
+func (r *cutoffSheets) GetByID(context.Context, string) (*domain.Sheet, error) {
+	copied := *r.sheet
+	return &copied, nil
+}
+
+// singleOrder holds one order and applies Update's fn to a copy of it
+type singleOrder struct {
+	port.OrdersRepo
+	order   *domain.Order
+	updated bool
+}
+
+func (r *singleOrder) Update(_ context.Context, _ string, fn func(*domain.Order) error) (*domain.Order, error) {
+	copied := *r.order
+	if err := fn(&copied); err != nil {
+		return nil, err
+	}
+	r.updated = true
+	return &copied, nil
+}
+
+func TestOrdersRejectedPastCutoff(t *testing.T) {
+	cutoff := time.Now().Add(-time.Second)
+	sheets := &cutoffSheets{sheet: &domain.Sheet{ID: "s1", MemberIDs: []string{"u1"}, Status: domain.Status_OPEN, ClosesAt: &cutoff}}
+	orders := &singleOrder{order: &domain.Order{ID: "o1", SheetID: "s1", UserID: "u1", Status: domain.OrderStatusPending}}
+	uc := &usecase{sheetRepo: sheets, orderRepo: orders}
+	ctx := interceptor.WithPrincipal(context.Background(), &domain.Principal{UserID: "u1"})
+	lines := []OrderLineReq{{MenuItemID: "pho", Quantity: 1}}
+
+	if _, err := uc.createOrderInternal(ctx, "u1", &CreateOrderReq{SheetID: "s1", Lines: lines}); err != ErrCutoffPassed {
+		t.Fatalf("create: err = %v, want ErrCutoffPassed", err)
+	}
+	if _, err := uc.UpdateOrder(ctx, &UpdateOrderReq{ID: "o1", Lines: lines}); err != ErrCutoffPassed {
+		t.Fatalf("update: err = %v, want ErrCutoffPassed", err)
+	}
+	if orders.updated {
+		t.Fatal("order was updated past the cutoff")
+	}
+}
@@ -25,7 +25,6 @@ type CreateOrderReq struct {
 	SheetID string
 	Lines   []OrderLineReq
 	Note    string
-	UserID  string
 }
 
 type UpdateOrderReq struct {
@@ -40,6 +39,7 @@ type UpdateOrderReq struct {
 type ListFilter struct {
 	UserID *string
 	Since  *time.Time
+	Status domain.OrderStatus
 }
 
 type ListOrdersReq struct {
@@ -53,3 +53,8 @@ type ListOrdersResp struct {
 	Orders     []*domain.Order
 	NextCursor string
 }
+
+type StreamOrdersReq struct {
+	SheetID string
+	Cursor  string // resume after this event; empty to start live
+}
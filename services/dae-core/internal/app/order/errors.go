@@ -11,4 +11,9 @@ var (
 	ErrInvalidMenuItemID = apperror.InvalidInput("invalid menu item id")
 	ErrInvalidVariantID  = apperror.InvalidInput("invalid variant id")
 	ErrInvalidOptionID   = apperror.InvalidInput("invalid option id")
+	ErrInvalidCursor     = apperror.InvalidInput("invalid resume cursor")
+	ErrMixedCurrencies   = apperror.Conflict("sheet orders use different currencies")
+	ErrOrderNotEditable  = apperror.InvalidInput("only pending orders can be changed")
+	ErrSheetClosed       = apperror.InvalidInput("sheet is closed")
+	ErrCutoffPassed      = apperror.InvalidInput("sheet order cutoff has passed")
 )
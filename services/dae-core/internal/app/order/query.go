@@ -3,6 +3,7 @@ package order
 import (
 	"context"
 
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/authz"
 	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
 	"github.com/deni12345/dae-services/services/dae-core/internal/port"
 )
@@ -17,6 +18,15 @@ func (u *usecase) GetOrderByID(ctx context.Context, id string) (*domain.Order, e
 		span.RecordError(err)
 		return nil, err
 	}
+	if err := u.requireOrderParty(ctx, order); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if err := u.applySheetCharges(ctx, order); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
 
 	return order, nil
 }
@@ -33,11 +43,26 @@ func (u *usecase) ListOrders(ctx context.Context, req *ListOrdersReq) (*ListOrde
 		req.Limit = 100
 	}
 
+	// Members see their sheet's orders; listing across sheets is for admins
+	if err := u.requireListAccess(ctx, req.SheetID); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
 	// Fetch one extra to determine if there are more results
-	orders, err := u.orderRepo.List(ctx, port.ListOrdersQuery{
-		Limit:  req.Limit + 1,
-		Cursor: req.Cursor,
-	})
+	query := port.ListOrdersQuery{
+		Limit:   req.Limit + 1,
+		Cursor:  req.Cursor,
+		SheetID: req.SheetID,
+		Status:  req.Filter.Status,
+	}
+	if req.Filter.UserID != nil {
+		query.UserID = *req.Filter.UserID
+	}
+	if req.Filter.Since != nil {
+		query.UpdatedSince = *req.Filter.Since
+	}
+	orders, err := u.orderRepo.List(ctx, query)
 	if err != nil {
 		span.RecordError(err)
 		return nil, err
@@ -52,8 +77,60 @@ func (u *usecase) ListOrders(ctx context.Context, req *ListOrdersReq) (*ListOrde
 		nextCursor = orders[len(orders)-1].ID
 	}
 
+	if err := u.applySheetCharges(ctx, orders...); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
 	return &ListOrdersResp{
 		Orders:     orders,
 		NextCursor: nextCursor,
 	}, nil
 }
+
+func (u *usecase) requireListAccess(ctx context.Context, sheetID string) error {
+	if sheetID == "" {
+		return authz.RequireAdmin(ctx)
+	}
+	sheet, err := u.sheetRepo.GetByID(ctx, sheetID)
+	if err != nil {
+		return err
+	}
+	return authz.RequireSheetMember(ctx, sheet)
+}
+
+// requireOrderParty allows the order owner, its sheet's host and admins
+func (u *usecase) requireOrderParty(ctx context.Context, order *domain.Order) error {
+	sheet, err := u.sheetRepo.GetByID(ctx, order.SheetID)
+	if err != nil {
+		return err
+	}
+	return authz.RequireOrderParty(ctx, order, sheet)
+}
+
+// statusBackfillBatch is how many orders BackfillStatuses reads per
+// transaction
+const statusBackfillBatch = 200
+
+// BackfillStatuses stores the pending status on every order written before
+// statuses existed, so status filters find them, and returns how many it
+// rewrote. Running it again rewrites nothing.
+func (u *usecase) BackfillStatuses(ctx context.Context) (int, error) {
+	ctx, span := tracer.Start(ctx, "OrderUC.BackfillStatuses")
+	defer span.End()
+
+	total := 0
+	after := ""
+	for {
+		last, updated, err := u.orderRepo.BackfillStatuses(ctx, after, statusBackfillBatch)
+		total += updated
+		if err != nil {
+			span.RecordError(err)
+			return total, err
+		}
+		if last == "" {
+			return total, nil
+		}
+		after = last
+	}
+}
@@ -0,0 +1,42 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+)
+
+// pagedOrders hands out a fixed number of backfill pages and records where
+// each one started
+type pagedOrders struct {
+	port.OrdersRepo
+	pages    int
+	backfill []string
+}
+
+func (r *pagedOrders) BackfillStatuses(_ context.Context, afterID string, limit int) (string, int, error) {
+	r.backfill = append(r.backfill, afterID)
+	if r.pages == 0 {
+		return "", 0, nil
+	}
+	r.pages--
+	return fmt.Sprintf("after-%d", len(r.backfill)), limit / 2, nil
+}
+
+func TestBackfillStatuses(t *testing.T) {
+	repo := &pagedOrders{pages: 2}
+	uc := &usecase{orderRepo: repo}
+
+	updated, err := uc.BackfillStatuses(context.Background())
+	if err != nil {
+		t.Fatalf("backfill: %v", err)
+	}
+	if updated != statusBackfillBatch {
+		t.Fatalf("updated = %d, want %d", updated, statusBackfillBatch)
+	}
+	if got := fmt.Sprint(repo.backfill); got != "[ after-1 after-2]" {
+		t.Fatalf("pages started after %s, want [ after-1 after-2]", got)
+	}
+}
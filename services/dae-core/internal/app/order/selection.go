@@ -0,0 +1,99 @@
+package order
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/libs/apperror"
+)
+
+// validateSelection checks one order line against its menu item and returns
+// every rule the chosen options break. Fields are addressed relative to the
+// request, e.g. lines[0].options[1].option_id for a single choice and
+// lines[0].option_groups[size] for a rule that spans a whole group.
+func validateSelection(line int, item *domain.MenuItem, req OrderLineReq) []apperror.FieldViolation {
+	var violations []apperror.FieldViolation
+	add := func(field, description string) {
+		violations = append(violations, apperror.FieldViolation{Field: field, Description: description})
+	}
+
+	prefix := fmt.Sprintf("lines[%d]", line)
+	if !item.Active {
+		add(prefix+".menu_item_id", fmt.Sprintf("%s is not available", item.Name))
+	}
+	if req.Quantity <= 0 {
+		add(prefix+".quantity", "must be at least 1")
+	}
+
+	selected := make(map[string]int) // distinct options chosen per group
+	seen := make(map[string]bool)
+	for i, optReq := range req.Options {
+		field := fmt.Sprintf("%s.options[%d]", prefix, i)
+
+		group, ok := item.OptionGroups[optReq.GroupID]
+		if !ok {
+			add(field+".group_id", fmt.Sprintf("%s has no option group %q", item.Name, optReq.GroupID))
+			continue
+		}
+		opt, ok := group.Options[optReq.OptionID]
+		if !ok {
+			add(field+".option_id", fmt.Sprintf("%s has no option %q", group.Name, optReq.OptionID))
+			continue
+		}
+
+		key := optReq.GroupID + "/" + optReq.OptionID
+		if seen[key] {
+			add(field+".option_id", fmt.Sprintf("%s is selected more than once", opt.Name))
+			continue
+		}
+		seen[key] = true
+		selected[optReq.GroupID]++
+
+		if !opt.Active {
+			add(field+".option_id", fmt.Sprintf("%s is not available", opt.Name))
+		}
+
+		switch {
+		case optReq.Quantity <= 0:
+			add(field+".quantity", "must be at least 1")
+		case group.Type == domain.GroupSingle && optReq.Quantity > 1:
+			add(field+".quantity", fmt.Sprintf("%s allows a single choice", group.Name))
+		case opt.MaxQuantity > 0 && optReq.Quantity > int(opt.MaxQuantity):
+			add(field+".quantity", fmt.Sprintf("at most %d of %s allowed", opt.MaxQuantity, opt.Name))
+		}
+	}
+
+	// Group rules, in a stable order so responses don't shuffle between calls
+	groupIDs := make([]string, 0, len(item.OptionGroups))
+	for id := range item.OptionGroups {
+		groupIDs = append(groupIDs, id)
+	}
+	sort.Strings(groupIDs)
+
+	for _, id := range groupIDs {
+		group := item.OptionGroups[id]
+		field := fmt.Sprintf("%s.option_groups[%s]", prefix, id)
+		n := selected[id]
+
+		minSelect := group.MinSelect
+		if group.Required && minSelect < 1 {
+			minSelect = 1
+		}
+		maxSelect := group.MaxSelect
+		if group.Type == domain.GroupSingle {
+			maxSelect = 1
+		}
+
+		switch {
+		case n < minSelect && minSelect == 1:
+			add(field, fmt.Sprintf("choose an option for %s", group.Name))
+		case n < minSelect:
+			add(field, fmt.Sprintf("choose at least %d options for %s", minSelect, group.Name))
+		case maxSelect > 0 && n > maxSelect:
+			add(field, fmt.Sprintf("choose at most %d options for %s", maxSelect, group.Name))
+		}
+	}
+
+	return violations
+}
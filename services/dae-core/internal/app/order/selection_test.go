@@ -0,0 +1,73 @@
+package order
+
+import (
+	"testing"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+)
+
+func testMenuItem() *domain.MenuItem {
+	return &domain.MenuItem{
+		ID:     "tea",
+		Name:   "Milk tea",
+		Active: true,
+		OptionGroups: map[string]domain.OptionGroup{
+			"size": {
+				ID: "size", Name: "Size", Type: domain.GroupSingle, Required: true,
+				Options: map[string]domain.Option{
+					"m": {ID: "m", Name: "M", Active: true},
+					"l": {ID: "l", Name: "L", Active: true},
+				},
+			},
+			"topping": {
+				ID: "topping", Name: "Topping", Type: domain.GroupMulti, MaxSelect: 2,
+				Options: map[string]domain.Option{
+					"boba":    {ID: "boba", Name: "Boba", Active: true, MaxQuantity: 2},
+					"jelly":   {ID: "jelly", Name: "Jelly", Active: true},
+					"pudding": {ID: "pudding", Name: "Pudding", Active: false},
+				},
+			},
+		},
+	}
+}
+
+func TestValidateSelection(t *testing.T) {
+	opt := func(group, option string, qty int) OrderLineOptionReq {
+		return OrderLineOptionReq{GroupID: group, OptionID: option, Quantity: qty}
+	}
+
+	tests := map[string]struct {
+		inactive bool
+		options  []OrderLineOptionReq
+		want     []string
+	}{
+		"valid":             {options: []OrderLineOptionReq{opt("size", "m", 1), opt("topping", "boba", 2)}},
+		"required missing":  {options: []OrderLineOptionReq{opt("topping", "jelly", 1)}, want: []string{"lines[0].option_groups[size]"}},
+		"two single":        {options: []OrderLineOptionReq{opt("size", "m", 1), opt("size", "l", 1)}, want: []string{"lines[0].option_groups[size]"}},
+		"single repeated":   {options: []OrderLineOptionReq{opt("size", "m", 2)}, want: []string{"lines[0].options[0].quantity"}},
+		"over max select":   {options: []OrderLineOptionReq{opt("size", "m", 1), opt("topping", "boba", 1), opt("topping", "jelly", 1), opt("topping", "pudding", 1)}, want: []string{"lines[0].options[3].option_id", "lines[0].option_groups[topping]"}},
+		"over max quantity": {options: []OrderLineOptionReq{opt("size", "m", 1), opt("topping", "boba", 3)}, want: []string{"lines[0].options[1].quantity"}},
+		"unknown option":    {options: []OrderLineOptionReq{opt("size", "xl", 1)}, want: []string{"lines[0].options[0].option_id", "lines[0].option_groups[size]"}},
+		"unknown group":     {options: []OrderLineOptionReq{opt("size", "m", 1), opt("ice", "less", 1)}, want: []string{"lines[0].options[1].group_id"}},
+		"duplicate option":  {options: []OrderLineOptionReq{opt("size", "m", 1), opt("size", "m", 1)}, want: []string{"lines[0].options[1].option_id"}},
+		"inactive item":     {inactive: true, options: []OrderLineOptionReq{opt("size", "m", 1)}, want: []string{"lines[0].menu_item_id"}},
+	}
+
+	for name, tc := range tests {
+		item := testMenuItem()
+		item.Active = !tc.inactive
+
+		got := validateSelection(0, item, OrderLineReq{MenuItemID: item.ID, Quantity: 1, Options: tc.options})
+		if len(got) != len(tc.want) {
+			t.Fatalf("%s: got violations %+v, want fields %v", name, got, tc.want)
+		}
+		for i, v := range got {
+			if v.Field != tc.want[i] {
+				t.Fatalf("%s: violation %d field = %s, want %s", name, i, v.Field, tc.want[i])
+			}
+			if v.Description == "" {
+				t.Fatalf("%s: violation %d has no description", name, i)
+			}
+		}
+	}
+}
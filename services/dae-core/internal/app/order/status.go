@@ -0,0 +1,117 @@
+package order
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/authz"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/grpc/interceptor"
+	"github.com/deni12345/dae-services/libs/apperror"
+)
+
+// CancelOrder cancels a pending or confirmed order while its sheet is not closed
+func (u *usecase) CancelOrder(ctx context.Context, id string) (*domain.Order, error) {
+	ctx, span := tracer.Start(ctx, "OrderUC.CancelOrder")
+	defer span.End()
+
+	order, err := u.transitionOrder(ctx, id, domain.OrderStatusCancelled)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	return order, nil
+}
+
+// ConfirmOrder confirms a pending order
+func (u *usecase) ConfirmOrder(ctx context.Context, id string) (*domain.Order, error) {
+	ctx, span := tracer.Start(ctx, "OrderUC.ConfirmOrder")
+	defer span.End()
+
+	order, err := u.transitionOrder(ctx, id, domain.OrderStatusConfirmed)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	return order, nil
+}
+
+// transitionOrder moves an order to status, deduplicating client retries
+func (u *usecase) transitionOrder(ctx context.Context, id string, status domain.OrderStatus) (*domain.Order, error) {
+	if id == "" {
+		return nil, apperror.InvalidInput("order_id is required")
+	}
+
+	idemKey := interceptor.GetOrCreateIdempotencyKeyWithHash(ctx, string(status), id)
+
+	result, err := u.idemStore.Do(ctx, idemKey, idempotencyTTL, func(ctx context.Context) ([]byte, error) {
+		order, err := u.orderRepo.Update(ctx, id, func(order *domain.Order) error {
+			sheet, err := u.sheetRepo.GetByID(ctx, order.SheetID)
+			if err != nil {
+				return err
+			}
+
+			// Owners may cancel their own orders; confirming is up to the host
+			if status == domain.OrderStatusCancelled {
+				if err := authz.RequireOrderParty(ctx, order, sheet); err != nil {
+					return err
+				}
+				if sheet.Status == domain.Status_CLOSED {
+					return ErrSheetClosed
+				}
+			} else if err := authz.RequireSheetHost(ctx, sheet); err != nil {
+				return err
+			}
+
+			if err := validateStatusTransition(order.CurrentStatus(), status); err != nil {
+				return err
+			}
+			order.Status = status
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(order)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var order domain.Order
+	if err := json.Unmarshal(result, &order); err != nil {
+		return nil, apperror.Internal(fmt.Sprintf("unmarshal order: %v", err))
+	}
+
+	if err := u.applySheetCharges(ctx, &order); err != nil {
+		return nil, err
+	}
+
+	return &order, nil
+}
+
+// validateStatusTransition validates order status transitions
+func validateStatusTransition(from, to domain.OrderStatus) error {
+	// Define allowed transitions
+	allowedTransitions := map[domain.OrderStatus][]domain.OrderStatus{
+		domain.OrderStatusPending:   {domain.OrderStatusConfirmed, domain.OrderStatusCancelled},
+		domain.OrderStatusConfirmed: {domain.OrderStatusCompleted, domain.OrderStatusCancelled},
+		domain.OrderStatusCancelled: {}, // No transitions from cancelled
+		domain.OrderStatusCompleted: {}, // No transitions from completed
+	}
+
+	allowed, ok := allowedTransitions[from]
+	if !ok {
+		return apperror.InvalidInput(fmt.Sprintf("unknown order status: %s", from))
+	}
+
+	for _, allowedStatus := range allowed {
+		if to == allowedStatus {
+			return nil
+		}
+	}
+
+	return apperror.InvalidInput(fmt.Sprintf("cannot transition order from %s to %s", from, to))
+}
@@ -0,0 +1,55 @@
+package order
+
+import (
+	"context"
+	"errors"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/authz"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"github.com/deni12345/dae-services/libs/apperror"
+)
+
+// StreamOrders delivers the order events of a sheet to fn until ctx is done.
+// A client going away ends the stream without error.
+func (u *usecase) StreamOrders(ctx context.Context, req *StreamOrdersReq, fn func(ev *domain.OrderEvent) error) error {
+	ctx, span := tracer.Start(ctx, "OrderUC.StreamOrders")
+	defer span.End()
+
+	if req.SheetID == "" {
+		err := apperror.InvalidInput("sheet_id is required")
+		span.RecordError(err)
+		return err
+	}
+
+	sheet, err := u.sheetRepo.GetByID(ctx, req.SheetID)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+	if err := authz.RequireSheetMember(ctx, sheet); err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	// Events carry the stored order; its shares are derived as on any read
+	err = u.changes.Watch(ctx, req.SheetID, req.Cursor, func(ev *domain.OrderEvent) error {
+		if err := u.applySheetCharges(ctx, ev.Order); err != nil {
+			return err
+		}
+		return fn(ev)
+	})
+	if err != nil && ctx.Err() != nil {
+		return nil
+	}
+	if errors.Is(err, port.ErrInvalidCursor) {
+		span.RecordError(err)
+		return ErrInvalidCursor
+	}
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
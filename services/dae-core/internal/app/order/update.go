@@ -2,9 +2,11 @@ package order
 
 import (
 	"context"
-	"fmt"
+	"time"
 
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/authz"
 	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/pricing"
 )
 
 // UpdateOrder updates an existing order with re-pricing
@@ -14,6 +16,10 @@ func (u *usecase) UpdateOrder(ctx context.Context, req *UpdateOrderReq) (*domain
 
 	// Use callback pattern to fetch, validate, and update
 	updatedOrder, err := u.orderRepo.Update(ctx, req.ID, func(order *domain.Order) error {
+		if err := authz.RequireOrderOwner(ctx, order); err != nil {
+			return err
+		}
+
 		// Verify sheet is still open for updates
 		sheet, err := u.sheetRepo.GetByID(ctx, order.SheetID)
 		if err != nil {
@@ -23,21 +29,25 @@ func (u *usecase) UpdateOrder(ctx context.Context, req *UpdateOrderReq) (*domain
 		if !sheet.IsOpen() {
 			return ErrSheetNotOpen
 		}
+		if sheet.PastCutoff(time.Now()) {
+			return ErrCutoffPassed
+		}
+
+		if order.CurrentStatus() != domain.OrderStatusPending {
+			return ErrOrderNotEditable
+		}
 
-		// Re-price all lines with correct sheetID
-		newLines := make([]domain.OrderLine, 0, len(req.Lines))
-		for _, lineReq := range req.Lines {
-			line, err := u.buildOrderLine(ctx, order.SheetID, lineReq)
-			if err != nil {
-				return fmt.Errorf("build order line: %w", err)
-			}
-			newLines = append(newLines, line)
+		// Re-price all lines against the sheet's current menu version
+		newLines, err := u.buildOrderLines(ctx, sheet, req.Lines)
+		if err != nil {
+			return err
 		}
 
 		// Apply changes to the current order
 		order.Lines = newLines
 		order.Note = req.Note
-		domain.CalculateOrderTotals(order)
+		order.MenuID = sheet.ActiveMenuID
+		pricing.Order(order)
 
 		return nil
 	})
@@ -47,5 +57,10 @@ func (u *usecase) UpdateOrder(ctx context.Context, req *UpdateOrderReq) (*domain
 		return nil, err
 	}
 
+	if err := u.applySheetCharges(ctx, updatedOrder); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
 	return updatedOrder, nil
 }
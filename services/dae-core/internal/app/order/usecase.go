@@ -13,24 +13,37 @@ type Usecase interface {
 	// Commands
 	CreateOrder(ctx context.Context, req *CreateOrderReq) (*domain.Order, error)
 	UpdateOrder(ctx context.Context, req *UpdateOrderReq) (*domain.Order, error)
+	CancelOrder(ctx context.Context, id string) (*domain.Order, error)
+	ConfirmOrder(ctx context.Context, id string) (*domain.Order, error)
 
 	// Queries
 	GetOrderByID(ctx context.Context, id string) (*domain.Order, error)
 	ListOrders(ctx context.Context, req *ListOrdersReq) (*ListOrdersResp, error)
+
+	// Streams
+	StreamOrders(ctx context.Context, req *StreamOrdersReq, fn func(ev *domain.OrderEvent) error) error
+
+	// BackfillStatuses is a migration run at startup when enabled, not
+	// exposed over gRPC
+	BackfillStatuses(ctx context.Context) (int, error)
 }
 
 type usecase struct {
 	orderRepo port.OrdersRepo
 	sheetRepo port.SheetRepo
 	idemStore port.IdempotencyStore
+	changes   port.OrderChangeSource
+	charges   *chargeCache
 }
 
 // NewUsecase creates a new order usecase
-func NewUsecase(orderRepo port.OrdersRepo, sheetRepo port.SheetRepo, idemStore port.IdempotencyStore) Usecase {
+func NewUsecase(orderRepo port.OrdersRepo, sheetRepo port.SheetRepo, idemStore port.IdempotencyStore, changes port.OrderChangeSource) Usecase {
 	return &usecase{
 		orderRepo: orderRepo,
 		sheetRepo: sheetRepo,
 		idemStore: idemStore,
+		changes:   changes,
+		charges:   newChargeCache(),
 	}
 }
 
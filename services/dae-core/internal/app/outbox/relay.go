@@ -0,0 +1,119 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("usecase/outbox")
+
+// Config tunes the relay
+type Config struct {
+	BatchSize   int           // events published, or deleted, per run
+	MaxAttempts int           // failed publishes before an event is dead-lettered
+	Retention   time.Duration // how long published events are kept
+}
+
+// Relay moves events from the outbox to the publisher. An event is marked
+// published only after Publish succeeds, so a crash in between publishes it
+// again on the next run (at-least-once).
+type Relay struct {
+	outbox    port.Outbox
+	publisher port.EventPublisher
+	config    Config
+}
+
+func NewRelay(outbox port.Outbox, publisher port.EventPublisher, config Config) *Relay {
+	return &Relay{outbox: outbox, publisher: publisher, config: config}
+}
+
+// RunOnce publishes one batch of pending events, oldest first, and returns how
+// many were published. It stops at the first failure so later events are not
+// delivered ahead of it, unless that failure was the event's last attempt: the
+// event is then dead-lettered and the batch goes on without it.
+func (r *Relay) RunOnce(ctx context.Context) (int, error) {
+	ctx, span := tracer.Start(ctx, "Relay.RunOnce")
+	defer span.End()
+
+	events, err := r.outbox.Pending(ctx, r.config.BatchSize)
+	if err != nil {
+		span.RecordError(err)
+		return 0, err
+	}
+
+	published := 0
+	for _, event := range events {
+		if err := r.publisher.Publish(ctx, event); err != nil {
+			span.RecordError(err)
+			if attempts := event.Attempts + 1; attempts >= r.config.MaxAttempts {
+				if dlErr := r.outbox.DeadLetter(ctx, event.ID, err); dlErr != nil {
+					return published, dlErr
+				}
+				slog.WarnContext(ctx, "outbox event dead-lettered", "event_id", event.ID, "type", event.Type, "attempts", attempts, "error", err)
+				continue
+			}
+			if markErr := r.outbox.MarkFailed(ctx, event.ID, err); markErr != nil {
+				slog.ErrorContext(ctx, "failed to record publish failure", "event_id", event.ID, "error", markErr)
+			}
+			return published, fmt.Errorf("publish event %s: %w", event.ID, err)
+		}
+		if err := r.outbox.MarkPublished(ctx, event.ID); err != nil {
+			span.RecordError(err)
+			return published, err
+		}
+		published++
+	}
+	return published, nil
+}
+
+// Cleanup deletes the events published longer than the retention ago and
+// returns how many it deleted. It has the signature of a scheduler job.
+func (r *Relay) Cleanup(ctx context.Context, now time.Time) (int, error) {
+	ctx, span := tracer.Start(ctx, "Relay.Cleanup")
+	defer span.End()
+
+	before := now.Add(-r.config.Retention)
+	deleted := 0
+	for {
+		n, err := r.outbox.DeletePublished(ctx, before, r.config.BatchSize)
+		deleted += n
+		if err != nil {
+			span.RecordError(err)
+			return deleted, err
+		}
+		if n < r.config.BatchSize {
+			return deleted, nil
+		}
+	}
+}
+
+// Run calls RunOnce every interval until ctx is cancelled. A full batch is
+// followed straight away by the next one.
+func (r *Relay) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		published, err := r.RunOnce(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "outbox relay failed", "published", published, "error", err)
+		}
+		if err == nil && published == r.config.BatchSize {
+			if ctx.Err() != nil {
+				return
+			}
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
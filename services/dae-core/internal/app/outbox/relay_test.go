@@ -0,0 +1,213 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/infra/memory"
+)
+
+// fakeOutbox holds events in occurrence order
+type fakeOutbox struct {
+	events      []*domain.Event
+	deadLetters []*domain.Event
+}
+
+func (o *fakeOutbox) Pending(_ context.Context, limit int) ([]*domain.Event, error) {
+	var out []*domain.Event
+	for _, e := range o.events {
+		if !e.Published && len(out) < limit {
+			out = append(out, e)
+		}
+	}
+	return out, nil
+}
+
+func (o *fakeOutbox) MarkPublished(_ context.Context, id string) error {
+	e := o.find(id)
+	now := time.Now()
+	e.Published, e.PublishedAt = true, &now
+	return nil
+}
+
+func (o *fakeOutbox) MarkFailed(_ context.Context, id string, cause error) error {
+	e := o.find(id)
+	e.Attempts++
+	e.LastError = cause.Error()
+	return nil
+}
+
+func (o *fakeOutbox) DeadLetter(ctx context.Context, id string, cause error) error {
+	if err := o.MarkFailed(ctx, id, cause); err != nil {
+		return err
+	}
+	o.deadLetters = append(o.deadLetters, o.find(id))
+	o.events = slices.DeleteFunc(o.events, func(e *domain.Event) bool { return e.ID == id })
+	return nil
+}
+
+func (o *fakeOutbox) DeletePublished(_ context.Context, before time.Time, limit int) (int, error) {
+	deleted := 0
+	o.events = slices.DeleteFunc(o.events, func(e *domain.Event) bool {
+		if deleted < limit && e.Published && e.PublishedAt.Before(before) {
+			deleted++
+			return true
+		}
+		return false
+	})
+	return deleted, nil
+}
+
+func (o *fakeOutbox) find(id string) *domain.Event {
+	for _, e := range o.events {
+		if e.ID == id {
+			return e
+		}
+	}
+	panic("unknown event " + id)
+}
+
+// flakyPublisher fails the events in failing, then hands off to next
+type flakyPublisher struct {
+	next    *memory.EventPublisher
+	failing map[string]bool
+}
+
+func (p *flakyPublisher) Publish(ctx context.Context, event *domain.Event) error {
+	if p.failing[event.ID] {
+		return errors.New("stream unavailable")
+	}
+	return p.next.Publish(ctx, event)
+}
+
+func newOutbox(n int) *fakeOutbox {
+	o := &fakeOutbox{}
+	for i := 1; i <= n; i++ {
+		o.events = append(o.events, &domain.Event{ID: fmt.Sprintf("e%d", i), Type: domain.EventOrderCreated})
+	}
+	return o
+}
+
+func publishedIDs(p *memory.EventPublisher) []string {
+	var ids []string
+	for _, e := range p.Events() {
+		ids = append(ids, e.ID)
+	}
+	return ids
+}
+
+func TestRelayStopsAtFirstFailureAndRetries(t *testing.T) {
+	ctx := context.Background()
+	box := newOutbox(3)
+	mem := memory.NewEventPublisher()
+	pub := &flakyPublisher{next: mem, failing: map[string]bool{"e2": true}}
+	relay := NewRelay(box, pub, Config{BatchSize: 10, MaxAttempts: 3})
+
+	published, err := relay.RunOnce(ctx)
+	if err == nil {
+		t.Fatal("RunOnce error = nil, want publish failure")
+	}
+	if published != 1 {
+		t.Fatalf("published = %d, want 1", published)
+	}
+	if got := box.find("e2"); got.Attempts != 1 || got.LastError == "" {
+		t.Fatalf("e2 attempts = %d, last error = %q", got.Attempts, got.LastError)
+	}
+	if box.find("e3").Published {
+		t.Fatal("e3 published ahead of e2")
+	}
+
+	delete(pub.failing, "e2")
+	if published, err = relay.RunOnce(ctx); err != nil || published != 2 {
+		t.Fatalf("RunOnce = %d, %v; want 2, nil", published, err)
+	}
+	if got := fmt.Sprint(publishedIDs(mem)); got != "[e1 e2 e3]" {
+		t.Fatalf("published %s, want [e1 e2 e3]", got)
+	}
+}
+
+func TestRelayRedeliveryIsDeduplicated(t *testing.T) {
+	ctx := context.Background()
+	box := newOutbox(2)
+	mem := memory.NewEventPublisher()
+
+	// e1 reached the publisher but the relay died before marking it
+	if err := mem.Publish(ctx, box.events[0]); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewRelay(box, mem, Config{BatchSize: 10, MaxAttempts: 3}).RunOnce(ctx); err != nil {
+		t.Fatalf("RunOnce error = %v", err)
+	}
+	if got := fmt.Sprint(publishedIDs(mem)); got != "[e1 e2]" {
+		t.Fatalf("published %s, want [e1 e2]", got)
+	}
+	for _, e := range box.events {
+		if !e.Published {
+			t.Fatalf("%s not marked published", e.ID)
+		}
+	}
+}
+
+func TestRelayDeadLettersAfterMaxAttempts(t *testing.T) {
+	ctx := context.Background()
+	box := newOutbox(2)
+	mem := memory.NewEventPublisher()
+	pub := &flakyPublisher{next: mem, failing: map[string]bool{"e1": true}}
+	relay := NewRelay(box, pub, Config{BatchSize: 10, MaxAttempts: 3})
+
+	// e1 holds back e2 until its last attempt
+	for attempt := 1; attempt < 3; attempt++ {
+		if published, err := relay.RunOnce(ctx); err == nil || published != 0 {
+			t.Fatalf("attempt %d: RunOnce = %d, %v; want 0 and the publish failure", attempt, published, err)
+		}
+	}
+	published, err := relay.RunOnce(ctx)
+	if err != nil || published != 1 {
+		t.Fatalf("last attempt: RunOnce = %d, %v; want 1, nil", published, err)
+	}
+
+	if len(box.deadLetters) != 1 || box.deadLetters[0].ID != "e1" || box.deadLetters[0].Attempts != 3 {
+		t.Fatalf("dead letters = %+v, want e1 after 3 attempts", box.deadLetters)
+	}
+	if got := fmt.Sprint(publishedIDs(mem)); got != "[e2]" {
+		t.Fatalf("published %s, want [e2]", got)
+	}
+	if pending, _ := box.Pending(ctx, 10); len(pending) != 0 {
+		t.Fatalf("pending = %+v, want none", pending)
+	}
+}
+
+func TestRelayCleanup(t *testing.T) {
+	ctx := context.Background()
+	box := newOutbox(5)
+	relay := NewRelay(box, memory.NewEventPublisher(), Config{BatchSize: 2, MaxAttempts: 3, Retention: time.Hour})
+
+	for _, e := range box.events {
+		if err := box.MarkPublished(ctx, e.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	old := time.Now().Add(-2 * time.Hour)
+	for _, e := range box.events[:4] {
+		e.PublishedAt = &old
+	}
+	box.events = append(box.events, &domain.Event{ID: "pending"})
+
+	deleted, err := relay.Cleanup(ctx, time.Now())
+	if err != nil || deleted != 4 {
+		t.Fatalf("Cleanup = %d, %v; want 4, nil", deleted, err)
+	}
+	var left []string
+	for _, e := range box.events {
+		left = append(left, e.ID)
+	}
+	if got := fmt.Sprint(left); got != "[e5 pending]" {
+		t.Fatalf("left %s, want [e5 pending]", got)
+	}
+}
@@ -0,0 +1,152 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/authz"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/pricing"
+	"github.com/deni12345/dae-services/libs/apperror"
+)
+
+// ListSheetBalances returns what every member of a sheet owes the host and
+// how much of it has been paid
+func (u *usecase) ListSheetBalances(ctx context.Context, sheetID string) ([]domain.Balance, error) {
+	ctx, span := tracer.Start(ctx, "PaymentUC.ListSheetBalances")
+	defer span.End()
+
+	if sheetID == "" {
+		err := apperror.InvalidInput("sheet_id is required")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	sheet, err := u.sheetRepo.GetByID(ctx, sheetID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if err := authz.RequireSheetMember(ctx, sheet); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	settlement, err := u.settle(ctx, sheet)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	payments, err := u.paymentRepo.ListBySheet(ctx, sheetID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return domain.BuildBalances(settlement, payments), nil
+}
+
+// ListUserDebts returns the user's unsettled balances across all closed sheets
+func (u *usecase) ListUserDebts(ctx context.Context, userID string) (*ListUserDebtsResp, error) {
+	ctx, span := tracer.Start(ctx, "PaymentUC.ListUserDebts")
+	defer span.End()
+
+	if userID == "" {
+		err := apperror.InvalidInput("user_id is required")
+		span.RecordError(err)
+		return nil, err
+	}
+	if err := authz.RequireSelfOrAdmin(ctx, userID); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	orders, err := u.orderRepo.ListByUser(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	payments, err := u.paymentRepo.ListByUser(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	resp := &ListUserDebtsResp{Balances: []domain.Balance{}, Totals: []domain.Money{}}
+	totals := make(map[string]int64)
+	for _, o := range orders {
+		if seen[o.SheetID] {
+			continue
+		}
+		seen[o.SheetID] = true
+
+		sheet, err := u.sheetRepo.GetByID(ctx, o.SheetID)
+		if err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+		if sheet.Status != domain.Status_CLOSED || sheet.HostUserID == userID {
+			continue
+		}
+
+		balance, err := u.memberBalance(ctx, sheet, userID, payments)
+		if err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+		if balance == nil || balance.IsSettled() {
+			continue
+		}
+
+		resp.Balances = append(resp.Balances, *balance)
+		totals[balance.Outstanding.CurrencyCode] += balance.Outstanding.Amount
+	}
+
+	sort.Slice(resp.Balances, func(i, j int) bool { return resp.Balances[i].SheetID < resp.Balances[j].SheetID })
+	for currency, amount := range totals {
+		resp.Totals = append(resp.Totals, domain.NewMoney(amount, currency))
+	}
+	sort.Slice(resp.Totals, func(i, j int) bool { return resp.Totals[i].CurrencyCode < resp.Totals[j].CurrencyCode })
+
+	return resp, nil
+}
+
+// memberBalance returns the user's balance on sheet, or nil when the user has
+// no orders there. Payments are loaded from the sheet when nil.
+func (u *usecase) memberBalance(ctx context.Context, sheet *domain.Sheet, userID string, payments []*domain.Payment) (*domain.Balance, error) {
+	settlement, err := u.settle(ctx, sheet)
+	if err != nil {
+		return nil, err
+	}
+
+	if payments == nil {
+		payments, err = u.paymentRepo.ListBySheet(ctx, sheet.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, b := range domain.BuildBalances(settlement, payments) {
+		if b.UserID == userID {
+			return &b, nil
+		}
+	}
+	return nil, nil
+}
+
+func (u *usecase) settle(ctx context.Context, sheet *domain.Sheet) (*domain.Settlement, error) {
+	orders, err := u.orderRepo.ListBySheet(ctx, sheet.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	settlement, err := pricing.Settlement(sheet, orders)
+	if errors.Is(err, domain.ErrCurrencyMismatch) {
+		return nil, ErrMixedCurrencies
+	}
+	return settlement, err
+}
@@ -0,0 +1,20 @@
+package payment
+
+import "github.com/deni12345/dae-services/services/dae-core/internal/domain"
+
+// Command DTOs - for write operations
+
+type SetPaymentStatusReq struct {
+	SheetID    string
+	UserID     string
+	Status     domain.PaymentStatus
+	AmountPaid domain.Money // required for partial, ignored for paid and unpaid
+	Note       string
+}
+
+// Query DTOs - for read operations
+
+type ListUserDebtsResp struct {
+	Balances []domain.Balance
+	Totals   []domain.Money // outstanding sum per currency
+}
@@ -0,0 +1,13 @@
+package payment
+
+import "github.com/deni12345/dae-services/libs/apperror"
+
+// Domain errors using apperror for better gRPC mapping
+var (
+	ErrInvalidStatus   = apperror.InvalidInput("invalid payment status")
+	ErrInvalidAmount   = apperror.InvalidInput("amount paid must be above zero and below the amount owed")
+	ErrSheetNotClosed  = apperror.InvalidInput("payments can only be recorded on closed sheets")
+	ErrHostPayment     = apperror.InvalidInput("the host does not owe payment on their own sheet")
+	ErrNoBalance       = apperror.NotFound("user has no orders on this sheet")
+	ErrMixedCurrencies = apperror.Conflict("sheet orders use different currencies")
+)
@@ -0,0 +1,122 @@
+package payment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/authz"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/grpc/interceptor"
+	"github.com/deni12345/dae-services/libs/apperror"
+)
+
+const (
+	idempotencyTTL = 24 * time.Hour
+)
+
+// SetPaymentStatus marks a member of a closed sheet as unpaid, partially paid,
+// paid or waived
+func (u *usecase) SetPaymentStatus(ctx context.Context, req *SetPaymentStatusReq) (*domain.Payment, error) {
+	ctx, span := tracer.Start(ctx, "PaymentUC.SetPaymentStatus")
+	defer span.End()
+
+	if err := validateSetStatusRequest(req); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	idemKey := interceptor.GetOrCreateIdempotencyKeyWithHash(ctx, string(req.Status), req.SheetID, req.UserID)
+
+	result, err := u.idemStore.Do(ctx, idemKey, idempotencyTTL, func(ctx context.Context) ([]byte, error) {
+		payment, err := u.setPaymentStatusInternal(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(payment)
+	})
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	var payment domain.Payment
+	if err := json.Unmarshal(result, &payment); err != nil {
+		span.RecordError(err)
+		return nil, apperror.Internal(fmt.Sprintf("unmarshal payment: %v", err))
+	}
+
+	return &payment, nil
+}
+
+func validateSetStatusRequest(req *SetPaymentStatusReq) error {
+	if req.SheetID == "" {
+		return apperror.InvalidInput("sheet_id is required")
+	}
+	if req.UserID == "" {
+		return apperror.InvalidInput("user_id is required")
+	}
+	switch req.Status {
+	case domain.PaymentStatusUnpaid, domain.PaymentStatusPartial, domain.PaymentStatusPaid, domain.PaymentStatusWaived:
+		return nil
+	default:
+		return ErrInvalidStatus
+	}
+}
+
+func (u *usecase) setPaymentStatusInternal(ctx context.Context, req *SetPaymentStatusReq) (*domain.Payment, error) {
+	sheet, err := u.sheetRepo.GetByID(ctx, req.SheetID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authz.RequireSheetHost(ctx, sheet); err != nil {
+		return nil, err
+	}
+	if sheet.Status != domain.Status_CLOSED {
+		return nil, ErrSheetNotClosed
+	}
+	if sheet.HostUserID == req.UserID {
+		return nil, ErrHostPayment
+	}
+
+	balance, err := u.memberBalance(ctx, sheet, req.UserID, nil)
+	if err != nil {
+		return nil, err
+	}
+	if balance == nil {
+		return nil, ErrNoBalance
+	}
+
+	amount, err := paidAmount(req, balance.Owed)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.paymentRepo.Save(ctx, &domain.Payment{
+		SheetID:    req.SheetID,
+		UserID:     req.UserID,
+		Status:     req.Status,
+		AmountPaid: amount,
+		Note:       req.Note,
+	})
+}
+
+// paidAmount returns what the member has paid once the status is applied
+func paidAmount(req *SetPaymentStatusReq, owed domain.Money) (domain.Money, error) {
+	switch req.Status {
+	case domain.PaymentStatusPaid:
+		return owed, nil
+	case domain.PaymentStatusPartial:
+		if c := req.AmountPaid.CurrencyCode; c != "" && c != owed.CurrencyCode {
+			return domain.Money{}, apperror.InvalidInput(fmt.Sprintf("amount paid must be in %s", owed.CurrencyCode))
+		}
+		if req.AmountPaid.Amount <= 0 || req.AmountPaid.Amount >= owed.Amount {
+			return domain.Money{}, ErrInvalidAmount
+		}
+		return domain.NewMoney(req.AmountPaid.Amount, owed.CurrencyCode), nil
+	default:
+		return domain.NewMoney(0, owed.CurrencyCode), nil
+	}
+}
@@ -0,0 +1,202 @@
+package payment
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/authz"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/grpc/interceptor"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+)
+
+// fakePaymentRepo appends records the way the Firestore repository does
+type fakePaymentRepo struct {
+	port.PaymentsRepo
+	records []*domain.Payment
+}
+
+func (r *fakePaymentRepo) Save(_ context.Context, payment *domain.Payment) (*domain.Payment, error) {
+	out := *payment
+	if cur := domain.LatestPayments(r.records)[out.SheetID][out.UserID]; cur != nil {
+		out.Sequence = cur.Sequence
+	}
+	out.Sequence++
+	out.ID = domain.PaymentID(out.SheetID, out.UserID, out.Sequence)
+	r.records = append(r.records, &out)
+	return &out, nil
+}
+
+func (r *fakePaymentRepo) ListBySheet(_ context.Context, sheetID string) ([]*domain.Payment, error) {
+	var out []*domain.Payment
+	for _, p := range r.records {
+		if p.SheetID == sheetID {
+			out = append(out, p)
+		}
+	}
+	return out, nil
+}
+
+func (r *fakePaymentRepo) ListByUser(_ context.Context, userID string) ([]*domain.Payment, error) {
+	var out []*domain.Payment
+	for _, p := range r.records {
+		if p.UserID == userID {
+			out = append(out, p)
+		}
+	}
+	return out, nil
+}
+
+type fakeSheetRepo struct {
+	port.SheetRepo
+	byID map[string]*domain.Sheet
+}
+
+func (r *fakeSheetRepo) GetByID(_ context.Context, id string) (*domain.Sheet, error) {
+	return r.byID[id], nil
+}
+
+type fakeOrderRepo struct {
+	port.OrdersRepo
+	orders []*domain.Order
+}
+
+func (r *fakeOrderRepo) ListBySheet(_ context.Context, sheetID string) ([]*domain.Order, error) {
+	var out []*domain.Order
+	for _, o := range r.orders {
+		if o.SheetID == sheetID {
+			copied := *o
+			out = append(out, &copied)
+		}
+	}
+	return out, nil
+}
+
+func (r *fakeOrderRepo) ListByUser(_ context.Context, userID string) ([]*domain.Order, error) {
+	var out []*domain.Order
+	for _, o := range r.orders {
+		if o.UserID == userID {
+			copied := *o
+			out = append(out, &copied)
+		}
+	}
+	return out, nil
+}
+
+// fakeIdemStore runs fn once per key and replays its result
+type fakeIdemStore struct {
+	results map[string][]byte
+}
+
+func (s *fakeIdemStore) Do(ctx context.Context, key string, _ time.Duration, fn func(ctx context.Context) ([]byte, error)) ([]byte, error) {
+	if res, ok := s.results[key]; ok {
+		return res, nil
+	}
+	res, err := fn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.results[key] = res
+	return res, nil
+}
+
+func newTestUsecase() (*usecase, *fakePaymentRepo) {
+	payments := &fakePaymentRepo{}
+	sheets := &fakeSheetRepo{byID: map[string]*domain.Sheet{
+		"closed": {ID: "closed", HostUserID: "host", Status: domain.Status_CLOSED, MemberIDs: []string{"host", "ann"}},
+		"open":   {ID: "open", HostUserID: "host", Status: domain.Status_OPEN, MemberIDs: []string{"host", "ann"}},
+	}}
+	orders := &fakeOrderRepo{orders: []*domain.Order{
+		{ID: "o1", SheetID: "closed", UserID: "ann", Subtotal: domain.NewMoney(300, "VND")},
+		{ID: "o2", SheetID: "closed", UserID: "host", Subtotal: domain.NewMoney(100, "VND")},
+		{ID: "o3", SheetID: "open", UserID: "ann", Subtotal: domain.NewMoney(50, "VND")},
+	}}
+	uc := &usecase{paymentRepo: payments, orderRepo: orders, sheetRepo: sheets, idemStore: &fakeIdemStore{results: map[string][]byte{}}}
+	return uc, payments
+}
+
+func asHost(key string) context.Context {
+	ctx := interceptor.WithPrincipal(context.Background(), &domain.Principal{UserID: "host"})
+	return interceptor.WithIdempotencyKey(ctx, "SetPaymentStatus", key)
+}
+
+func TestSetPaymentStatusKeepsHistory(t *testing.T) {
+	uc, payments := newTestUsecase()
+
+	partial := &SetPaymentStatusReq{SheetID: "closed", UserID: "ann", Status: domain.PaymentStatusPartial, AmountPaid: domain.NewMoney(100, "VND")}
+	first, err := uc.SetPaymentStatus(asHost("k1"), partial)
+	if err != nil {
+		t.Fatalf("partial: %v", err)
+	}
+	paid, err := uc.SetPaymentStatus(asHost("k2"), &SetPaymentStatusReq{SheetID: "closed", UserID: "ann", Status: domain.PaymentStatusPaid})
+	if err != nil {
+		t.Fatalf("paid: %v", err)
+	}
+
+	// A retry replays the first write instead of recording it again
+	again, err := uc.SetPaymentStatus(asHost("k1"), partial)
+	if err != nil || again.ID != first.ID {
+		t.Fatalf("retry = %+v, %v; want %s", again, err, first.ID)
+	}
+
+	if len(payments.records) != 2 || first.ID == paid.ID || paid.Sequence != first.Sequence+1 {
+		t.Fatalf("records = %d, ids %s and %s, want two distinct records in sequence", len(payments.records), first.ID, paid.ID)
+	}
+	if payments.records[0].AmountPaid.Amount != 100 {
+		t.Fatalf("first record = %+v, overwritten by the second", payments.records[0])
+	}
+
+	balances, err := uc.ListSheetBalances(asHost(""), "closed")
+	if err != nil {
+		t.Fatalf("balances: %v", err)
+	}
+	if len(balances) != 1 || balances[0].Status != domain.PaymentStatusPaid || !balances[0].IsSettled() {
+		t.Fatalf("balances = %+v, want ann settled by the latest record", balances)
+	}
+}
+
+func TestSetPaymentStatusRejects(t *testing.T) {
+	uc, payments := newTestUsecase()
+
+	tests := map[string]struct {
+		req  *SetPaymentStatusReq
+		want error
+	}{
+		"open sheet":     {&SetPaymentStatusReq{SheetID: "open", UserID: "ann", Status: domain.PaymentStatusPaid}, ErrSheetNotClosed},
+		"host":           {&SetPaymentStatusReq{SheetID: "closed", UserID: "host", Status: domain.PaymentStatusPaid}, ErrHostPayment},
+		"no orders":      {&SetPaymentStatusReq{SheetID: "closed", UserID: "bob", Status: domain.PaymentStatusPaid}, ErrNoBalance},
+		"unknown status": {&SetPaymentStatusReq{SheetID: "closed", UserID: "ann", Status: "refunded"}, ErrInvalidStatus},
+		"partial of all": {&SetPaymentStatusReq{SheetID: "closed", UserID: "ann", Status: domain.PaymentStatusPartial, AmountPaid: domain.NewMoney(300, "VND")}, ErrInvalidAmount},
+	}
+	for name, tc := range tests {
+		if _, err := uc.SetPaymentStatus(asHost(name), tc.req); err != tc.want {
+			t.Errorf("%s: err = %v, want %v", name, err, tc.want)
+		}
+	}
+	if len(payments.records) != 0 {
+		t.Fatalf("rejected writes stored %d records", len(payments.records))
+	}
+}
+
+func TestPaymentAccess(t *testing.T) {
+	uc, payments := newTestUsecase()
+	as := func(userID string) context.Context {
+		ctx := interceptor.WithPrincipal(context.Background(), &domain.Principal{UserID: userID})
+		return interceptor.WithIdempotencyKey(ctx, "SetPaymentStatus", userID)
+	}
+
+	// Only the host records payments, not even the member who owes them
+	for _, caller := range []string{"ann", "bob"} {
+		req := &SetPaymentStatusReq{SheetID: "closed", UserID: "ann", Status: domain.PaymentStatusPaid}
+		if _, err := uc.SetPaymentStatus(as(caller), req); err != authz.ErrNotSheetHost {
+			t.Errorf("SetPaymentStatus as %s: err = %v, want ErrNotSheetHost", caller, err)
+		}
+	}
+	if _, err := uc.ListSheetBalances(as("bob"), "closed"); err != authz.ErrNotSheetMember {
+		t.Errorf("ListSheetBalances as outsider: err = %v, want ErrNotSheetMember", err)
+	}
+	if len(payments.records) != 0 {
+		t.Fatalf("refused callers stored %d records", len(payments.records))
+	}
+}
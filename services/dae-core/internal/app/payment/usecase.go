@@ -0,0 +1,38 @@
+package payment
+
+import (
+	"context"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"go.opentelemetry.io/otel"
+)
+
+// Usecase defines the payment ledger operations
+type Usecase interface {
+	// Commands
+	SetPaymentStatus(ctx context.Context, req *SetPaymentStatusReq) (*domain.Payment, error)
+
+	// Queries
+	ListSheetBalances(ctx context.Context, sheetID string) ([]domain.Balance, error)
+	ListUserDebts(ctx context.Context, userID string) (*ListUserDebtsResp, error)
+}
+
+type usecase struct {
+	paymentRepo port.PaymentsRepo
+	orderRepo   port.OrdersRepo
+	sheetRepo   port.SheetRepo
+	idemStore   port.IdempotencyStore
+}
+
+// NewUsecase creates a new payment usecase
+func NewUsecase(paymentRepo port.PaymentsRepo, orderRepo port.OrdersRepo, sheetRepo port.SheetRepo, idemStore port.IdempotencyStore) Usecase {
+	return &usecase{
+		paymentRepo: paymentRepo,
+		orderRepo:   orderRepo,
+		sheetRepo:   sheetRepo,
+		idemStore:   idemStore,
+	}
+}
+
+var tracer = otel.Tracer("usecase/payment")
@@ -0,0 +1,74 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/audit"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+)
+
+// Job is periodic work that must run on one replica at a time. Run returns
+// how many items it handled.
+type Job struct {
+	Name string
+	Run  func(ctx context.Context, now time.Time) (int, error)
+}
+
+// Scheduler runs its jobs on every tick. Each job holds a lock named after it
+// while it runs, so replicas that tick at the same time skip it.
+type Scheduler struct {
+	locker  port.Locker
+	lockTTL time.Duration
+	jobs    []Job
+	now     func() time.Time
+}
+
+func New(locker port.Locker, lockTTL time.Duration, jobs ...Job) *Scheduler {
+	return &Scheduler{
+		locker:  locker,
+		lockTTL: lockTTL,
+		jobs:    jobs,
+		now:     time.Now,
+	}
+}
+
+// RunOnce runs every job not already running elsewhere. A failing job does
+// not stop the others.
+func (s *Scheduler) RunOnce(ctx context.Context) error {
+	var errs []error
+	for _, job := range s.jobs {
+		_, err := s.locker.TryDo(ctx, "scheduler:"+job.Name, s.lockTTL, func(ctx context.Context) error {
+			handled, err := job.Run(audit.NewContext(ctx, audit.Job(job.Name)), s.now().UTC())
+			if handled > 0 {
+				slog.InfoContext(ctx, "scheduled job ran", "job", job.Name, "handled", handled)
+			}
+			return err
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", job.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Run calls RunOnce every interval until ctx is cancelled
+func (s *Scheduler) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := s.RunOnce(ctx); err != nil {
+			slog.ErrorContext(ctx, "scheduler run failed", "error", err)
+		}
+	}
+}
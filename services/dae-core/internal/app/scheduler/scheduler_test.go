@@ -0,0 +1,61 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeLocker refuses the keys in held, as if another replica had them
+type fakeLocker struct {
+	mu   sync.Mutex
+	held map[string]bool
+}
+
+func (l *fakeLocker) TryDo(ctx context.Context, key string, _ time.Duration, fn func(ctx context.Context) error) (bool, error) {
+	l.mu.Lock()
+	if l.held[key] {
+		l.mu.Unlock()
+		return false, nil
+	}
+	l.held[key] = true
+	l.mu.Unlock()
+
+	defer func() {
+		l.mu.Lock()
+		delete(l.held, key)
+		l.mu.Unlock()
+	}()
+	return true, fn(ctx)
+}
+
+func TestRunOnceSkipsLockedJobsAndKeepsGoing(t *testing.T) {
+	now := time.Date(2026, 3, 6, 11, 30, 0, 0, time.UTC)
+	ran := map[string]time.Time{}
+	job := func(name string, err error) Job {
+		return Job{Name: name, Run: func(_ context.Context, at time.Time) (int, error) {
+			ran[name] = at
+			return 1, err
+		}}
+	}
+
+	locker := &fakeLocker{held: map[string]bool{"scheduler:locked": true}}
+	s := New(locker, time.Minute, job("failing", errors.New("boom")), job("locked", nil), job("ok", nil))
+	s.now = func() time.Time { return now }
+
+	err := s.RunOnce(context.Background())
+	if err == nil || err.Error() != "failing: boom" {
+		t.Fatalf("RunOnce error = %v, want failing: boom", err)
+	}
+	if _, ok := ran["locked"]; ok {
+		t.Fatal("job ran while another replica held its lock")
+	}
+	if !ran["failing"].Equal(now) || !ran["ok"].Equal(now) {
+		t.Fatalf("ran = %v, want failing and ok at %v", ran, now)
+	}
+	if len(locker.held) != 1 {
+		t.Fatalf("locks left held: %v", locker.held)
+	}
+}
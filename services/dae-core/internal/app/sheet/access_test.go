@@ -0,0 +1,96 @@
+package sheet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/authz"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+)
+
+// TestSheetAccess covers the resource policies the authz interceptor leaves to
+// the usecase: a member who is not the host is refused host-only RPCs, and a
+// signed-in user who is not a member is refused member RPCs as well.
+func TestSheetAccess(t *testing.T) {
+	uc, _, invites := newInviteTestUsecase(&domain.Sheet{ID: "open", HostUserID: "host", MemberIDs: []string{"host", "member", "other"}, Status: domain.Status_OPEN})
+	uc.menuParsers = map[domain.MenuImportFormat]port.MenuParser{domain.MenuImportCSV: fakeParser{}}
+	invite, err := uc.CreateSheetInvite(withKey(asUser("host"), "invite"), &CreateSheetInviteReq{SheetID: "open"})
+	if err != nil {
+		t.Fatalf("create invite: %v", err)
+	}
+
+	name := "renamed"
+	hostOnly := map[string]func(ctx context.Context) error{
+		"UpdateSheet": func(ctx context.Context) error {
+			_, err := uc.UpdateSheet(ctx, &UpdateSheetReq{ID: "open", Name: &name})
+			return err
+		},
+		"JoinSheet": func(ctx context.Context) error {
+			_, err := uc.JoinSheet(ctx, &JoinSheetReq{SheetID: "open", UserID: "friend"})
+			return err
+		},
+		"RemoveMember": func(ctx context.Context) error {
+			return uc.LeaveSheet(ctx, &LeaveSheetReq{SheetID: "open", UserID: "other"})
+		},
+		"CreateSheetInvite": func(ctx context.Context) error {
+			_, err := uc.CreateSheetInvite(ctx, &CreateSheetInviteReq{SheetID: "open"})
+			return err
+		},
+		"RevokeSheetInvite": func(ctx context.Context) error {
+			_, err := uc.RevokeSheetInvite(ctx, invite.Code)
+			return err
+		},
+		"AttachMenuWithPayload": func(ctx context.Context) error {
+			_, _, err := uc.AttachMenu(ctx, &AttachMenuReq{SheetID: "open", Items: []MenuItemReq{{Name: "Pho", Price: 45000, Currency: "VND"}}})
+			return err
+		},
+		"ImportMenu": func(ctx context.Context) error {
+			_, err := uc.ImportMenu(ctx, &ImportMenuReq{SheetID: "open", Format: domain.MenuImportCSV, Payload: []byte("pho,45000"), DryRun: true})
+			return err
+		},
+	}
+	memberOnly := map[string]func(ctx context.Context) error{
+		"GetSheet": func(ctx context.Context) error {
+			_, err := uc.GetSheet(ctx, "open")
+			return err
+		},
+		"GetMenu": func(ctx context.Context) error {
+			_, err := uc.GetMenu(ctx, "open")
+			return err
+		},
+		"ListMenuVersions": func(ctx context.Context) error {
+			_, err := uc.ListMenuVersions(ctx, "open")
+			return err
+		},
+		"DiffMenuVersions": func(ctx context.Context) error {
+			_, err := uc.DiffMenuVersions(ctx, &DiffMenuVersionsReq{SheetID: "open", FromMenuID: "m1"})
+			return err
+		},
+		"ListMembers": func(ctx context.Context) error {
+			_, err := uc.ListMembers(ctx, &ListMembersReq{SheetID: "open"})
+			return err
+		},
+		"GetSheetSettlement": func(ctx context.Context) error {
+			_, err := uc.GetSheetSettlement(ctx, "open")
+			return err
+		},
+	}
+
+	for rpc, call := range hostOnly {
+		for _, caller := range []string{"member", "outsider"} {
+			if err := call(withKey(asUser(caller), rpc+caller)); err != authz.ErrNotSheetHost {
+				t.Errorf("%s as %s: err = %v, want ErrNotSheetHost", rpc, caller, err)
+			}
+		}
+	}
+	for rpc, call := range memberOnly {
+		if err := call(asUser("outsider")); err != authz.ErrNotSheetMember {
+			t.Errorf("%s as outsider: err = %v, want ErrNotSheetMember", rpc, err)
+		}
+	}
+
+	if len(invites.byCode) != 1 {
+		t.Fatalf("refused callers created %d invites", len(invites.byCode)-1)
+	}
+}
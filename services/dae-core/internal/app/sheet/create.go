@@ -14,6 +14,20 @@ import (
 
 const (
 	idempotencyTTL = 24 * time.Hour
+
+	// maxTransactionWrites is Firestore's limit on the writes of one
+	// transaction
+	maxTransactionWrites = 500
+
+	// maxMenuItems matches the item limit of CreateSheetReq. With the working
+	// copy of every item and the snapshot, ReplaceMenu stays within
+	// maxTransactionWrites.
+	maxMenuItems = 300
+
+	// maxMenuBytes bounds the encoded items of a menu version. The snapshot
+	// is a single document, which Firestore caps at 1 MiB; the rest is left
+	// for field names and the version fields.
+	maxMenuBytes = 900 << 10
 )
 
 // CreateSheet creates a new sheet with idempotency protection
@@ -26,10 +40,16 @@ func (u *usecase) CreateSheet(ctx context.Context, req *CreateSheetReq) (*domain
 		return nil, err
 	}
 
-	idemKey := interceptor.GetOrCreateIdempotencyKeyWithHash(ctx, req.HostUserID)
+	hostUserID, err := interceptor.ActingUserID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	idemKey := interceptor.GetOrCreateIdempotencyKeyWithHash(ctx, hostUserID)
 
 	result, err := u.idemStore.Do(ctx, idemKey, idempotencyTTL, func(ctx context.Context) ([]byte, error) {
-		sheet, err := u.createSheetInternal(ctx, req)
+		sheet, err := u.createSheetInternal(ctx, hostUserID, req)
 		if err != nil {
 			return nil, err
 		}
@@ -53,17 +73,25 @@ func (u *usecase) CreateSheet(ctx context.Context, req *CreateSheetReq) (*domain
 
 // validateCreateRequest validates the create sheet request
 func validateCreateRequest(req *CreateSheetReq) error {
-	if req.HostUserID == "" {
-		return apperror.InvalidInput("host_user_id is required")
-	}
 	if req.Name == "" {
 		return apperror.InvalidInput("name is required")
 	}
+	if req.ClosesAt != nil && !req.ClosesAt.After(time.Now()) {
+		return ErrCutoffInPast
+	}
+	return validateSchedule(req.OpensAt, req.ClosesAt)
+}
+
+// validateSchedule checks that a sheet closes after it opens
+func validateSchedule(opensAt, closesAt *time.Time) error {
+	if opensAt != nil && closesAt != nil && !closesAt.After(*opensAt) {
+		return ErrInvalidSchedule
+	}
 	return nil
 }
 
 // createSheetInternal is the core sheet creation logic
-func (u *usecase) createSheetInternal(ctx context.Context, req *CreateSheetReq) (*domain.Sheet, error) {
+func (u *usecase) createSheetInternal(ctx context.Context, hostUserID string, req *CreateSheetReq) (*domain.Sheet, error) {
 	now := time.Now().UTC()
 
 	// Validate menu items BEFORE conversion
@@ -74,10 +102,10 @@ func (u *usecase) createSheetInternal(ctx context.Context, req *CreateSheetReq)
 	}
 
 	// Initialize member list with host as first member
-	memberIDs := []string{req.HostUserID}
+	memberIDs := []string{hostUserID}
 	if req.MemberIDs != nil {
 		// Add additional members, avoiding duplicates
-		seen := map[string]bool{req.HostUserID: true}
+		seen := map[string]bool{hostUserID: true}
 		for _, mid := range req.MemberIDs {
 			if !seen[mid] {
 				memberIDs = append(memberIDs, mid)
@@ -86,43 +114,72 @@ func (u *usecase) createSheetInternal(ctx context.Context, req *CreateSheetReq)
 		}
 	}
 
+	if createWrites(len(memberIDs), len(req.MenuItems)) > maxTransactionWrites {
+		return nil, ErrSheetTooLarge
+	}
+
+	// Sheets that open later wait as pending for the scheduler
+	status := domain.Status_OPEN
+	if req.OpensAt != nil && req.OpensAt.After(now) {
+		status = domain.Status_PENDING
+	}
+
 	sheet := &domain.Sheet{
 		ID:          fmt.Sprintf("%s-%s", req.Name, uuid.New().String()),
 		Name:        req.Name,
 		Description: req.Description,
-		HostUserID:  req.HostUserID,
-		Status:      domain.Status_OPEN, // Default to open
+		HostUserID:  hostUserID,
+		Status:      status,
 		DeliveryFee: *req.DeliveryFee,
 		Discount:    req.Discount,
+		FeeSplit:    req.FeeSplit,
 		MemberIDs:   memberIDs,
+		OpensAt:     utcTime(req.OpensAt),
+		ClosesAt:    utcTime(req.ClosesAt),
 
 		CreatedAt: now,
 		UpdatedAt: now,
 	}
 
-	createdSheet, err := u.sheetRepo.Create(ctx, sheet)
-	if err != nil {
-		return nil, err
-	}
-
-	// Add members to subcollection (dual-write pattern)
-	for _, memberID := range memberIDs {
-		if err := u.sheetRepo.AddMember(ctx, sheet.ID, memberID); err != nil {
+	// The sheet, its members and its first menu version are written together
+	var menuID string
+	var menuItems []*domain.MenuItem
+	if len(req.MenuItems) > 0 {
+		menuID = uuid.New().String()
+		menuItems = convertMenuItemsToDomain(req.MenuItems, now.Unix())
+		if err := checkMenuSize(menuItems); err != nil {
 			return nil, err
 		}
 	}
 
-	// Attach menu items if provided (already validated)
-	if len(req.MenuItems) > 0 {
-		menuItems := convertMenuItemsToDomain(req.MenuItems, now.Unix())
-		if err := u.sheetRepo.AttachMenuItems(ctx, sheet.ID, menuItems); err != nil {
-			return nil, err
-		}
+	createdSheet, err := u.sheetRepo.Create(ctx, sheet, menuID, menuItems)
+	if err != nil {
+		return nil, err
 	}
 
 	return createdSheet, nil
 }
 
+// createWrites is the number of documents SheetRepo.Create writes: the
+// sheet with its audit and created events, a member document and joined
+// event per member, and the menu items with their menu version
+func createWrites(members, items int) int {
+	writes := 3 + 2*members
+	if items > 0 {
+		writes += items + 1
+	}
+	return writes
+}
+
+// utcTime returns t in UTC, or nil
+func utcTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	u := t.UTC()
+	return &u
+}
+
 // validateMenuItems validates all menu items and their nested structures
 // Returns early on first validation error
 func validateMenuItems(items []MenuItemReq) error {
@@ -131,6 +188,7 @@ func validateMenuItems(items []MenuItemReq) error {
 	}
 
 	seen := make(map[string]bool, len(items))
+	ids := make(map[string]bool, len(items))
 	for _, item := range items {
 		// Item name required
 		if item.Name == "" {
@@ -142,6 +200,9 @@ func validateMenuItems(items []MenuItemReq) error {
 			return ErrDuplicateMenuItemName
 		}
 		seen[item.Name] = true
+		if !uniqueID(ids, item.ID) {
+			return ErrDuplicateMenuID
+		}
 
 		// Price & currency validation
 		if item.Price < 0 {
@@ -165,6 +226,7 @@ func validateOptionGroups(groups []MenuOptionGroupReq) error {
 	}
 
 	seen := make(map[string]bool, len(groups))
+	ids := make(map[string]bool, len(groups))
 	for _, grp := range groups {
 		if grp.Name == "" {
 			return ErrOptionGroupNameRequired
@@ -173,6 +235,9 @@ func validateOptionGroups(groups []MenuOptionGroupReq) error {
 			return ErrDuplicateOptionGroupName
 		}
 		seen[grp.Name] = true
+		if !uniqueID(ids, grp.ID) {
+			return ErrDuplicateMenuID
+		}
 
 		// MaxSelect validation for multi-select groups
 		if grp.MultiSelect && grp.MaxSelect <= 0 {
@@ -193,6 +258,7 @@ func validateOptions(options []MenuOptionReq) error {
 	}
 
 	seen := make(map[string]bool, len(options))
+	ids := make(map[string]bool, len(options))
 	for _, opt := range options {
 		if opt.Name == "" {
 			return ErrOptionNameRequired
@@ -201,6 +267,9 @@ func validateOptions(options []MenuOptionReq) error {
 			return ErrDuplicateOptionName
 		}
 		seen[opt.Name] = true
+		if !uniqueID(ids, opt.ID) {
+			return ErrDuplicateMenuID
+		}
 
 		if opt.Price < 0 {
 			return ErrOptionInvalidPrice
@@ -209,6 +278,27 @@ func validateOptions(options []MenuOptionReq) error {
 	return nil
 }
 
+// uniqueID records a caller-supplied ID and reports whether it was new.
+// Empty IDs are generated later and always unique.
+func uniqueID(seen map[string]bool, id string) bool {
+	if id == "" {
+		return true
+	}
+	if seen[id] {
+		return false
+	}
+	seen[id] = true
+	return true
+}
+
+// menuID returns the caller-supplied ID or generates one from the name
+func menuID(id, name string) string {
+	if id != "" {
+		return id
+	}
+	return fmt.Sprintf("%s-%s", name, uuid.New().String())
+}
+
 // convertMenuItemsToDomain converts validated request DTOs to domain entities
 // Assumes items are already validated by validateMenuItems
 func convertMenuItemsToDomain(reqItems []MenuItemReq, timestamp int64) []*domain.MenuItem {
@@ -216,7 +306,7 @@ func convertMenuItemsToDomain(reqItems []MenuItemReq, timestamp int64) []*domain
 
 	for i, req := range reqItems {
 		domainItems[i] = &domain.MenuItem{
-			ID:           fmt.Sprintf("%s-%s", req.Name, uuid.New().String()),
+			ID:           menuID(req.ID, req.Name),
 			Name:         req.Name,
 			Active:       req.Active,
 			Price:        req.Price,
@@ -236,7 +326,7 @@ func convertOptionGroups(reqGroups []MenuOptionGroupReq) map[string]domain.Optio
 
 	optionGroups := make(map[string]domain.OptionGroup, len(reqGroups))
 	for _, grpReq := range reqGroups {
-		id := fmt.Sprintf("%s-%s", grpReq.Name, uuid.New().String())
+		id := menuID(grpReq.ID, grpReq.Name)
 
 		// Convert MultiSelect bool to domain.OptionGroupType
 		var groupType domain.OptionGroupType
@@ -251,6 +341,7 @@ func convertOptionGroups(reqGroups []MenuOptionGroupReq) map[string]domain.Optio
 			Name:      grpReq.Name,
 			Type:      groupType,
 			Required:  grpReq.Required,
+			MinSelect: int(grpReq.MinSelect),
 			MaxSelect: int(grpReq.MaxSelect),
 			Options:   convertOptions(grpReq.Options),
 		}
@@ -266,13 +357,20 @@ func convertOptions(reqOptions []MenuOptionReq) map[string]domain.Option {
 
 	options := make(map[string]domain.Option, len(reqOptions))
 	for _, optReq := range reqOptions {
-		id := fmt.Sprintf("%s-%s", optReq.Name, uuid.New().String())
+		per := domain.PerUnit
+		if optReq.PerOrder {
+			per = domain.PerOrder
+		}
+
+		id := menuID(optReq.ID, optReq.Name)
 		options[id] = domain.Option{
 			ID:     id,
 			Name:   optReq.Name,
 			Price:  optReq.Price,
-			Per:    domain.PerUnit, // Default to per-unit pricing
+			Per:    per,
 			Active: optReq.Active,
+
+			MaxQuantity: optReq.MaxQuantity,
 		}
 	}
 	return options
@@ -0,0 +1,31 @@
+package sheet
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func TestCreateSheetWriteLimit(t *testing.T) {
+	uc := &usecase{sheetRepo: newFakeSheetRepo()}
+
+	// The host, 99 members and 300 items need 3 + 2*100 + 301 writes
+	req := &CreateSheetReq{Name: "Lunch"}
+	for i := 0; i < 99; i++ {
+		req.MemberIDs = append(req.MemberIDs, fmt.Sprintf("member-%d", i))
+	}
+	for i := 0; i < 300; i++ {
+		req.MenuItems = append(req.MenuItems, MenuItemReq{Name: fmt.Sprintf("item-%d", i), Price: 1000, Currency: "VND"})
+	}
+
+	if _, err := uc.createSheetInternal(context.Background(), "host", req); err != ErrSheetTooLarge {
+		t.Fatalf("err = %v, want ErrSheetTooLarge", err)
+	}
+
+	if got := createWrites(100, 295); got != maxTransactionWrites-1 {
+		t.Fatalf("createWrites(100, 295) = %d, want %d", got, maxTransactionWrites-1)
+	}
+	if got := createWrites(1, 0); got != 5 {
+		t.Fatalf("createWrites(1, 0) = %d, want 5", got)
+	}
+}
@@ -1,14 +1,20 @@
 package sheet
 
-import "github.com/deni12345/dae-services/services/dae-core/internal/domain"
+import (
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+)
 
 // Request DTOs for nested structures
 
 type MenuOptionReq struct {
-	ID     string
-	Name   string
-	Price  int64
-	Active bool
+	ID          string
+	Name        string
+	Price       int64
+	Active      bool
+	MaxQuantity int32
+	PerOrder    bool // charged once per order line instead of once per item
 }
 
 type MenuOptionGroupReq struct {
@@ -36,12 +42,14 @@ type MenuItemReq struct {
 type CreateSheetReq struct {
 	IdempotencyKey string
 	Name           string
-	HostUserID     string
 	DeliveryFee    *domain.Money
 	Discount       int32
+	FeeSplit       domain.FeeSplitMode
 	Description    string
 	MemberIDs      []string
 	MenuItems      []MenuItemReq // Clean request, not domain entities
+	OpensAt        *time.Time
+	ClosesAt       *time.Time
 }
 
 type UpdateSheetReq struct {
@@ -51,6 +59,9 @@ type UpdateSheetReq struct {
 	Status      *domain.Status
 	DeliveryFee *domain.Money
 	Discount    *int32
+	FeeSplit    *domain.FeeSplitMode
+	OpensAt     *time.Time
+	ClosesAt    *time.Time
 }
 
 // Query DTOs
@@ -78,24 +89,76 @@ type ListUserSheetsResp struct {
 	NextCursor string
 }
 
+type ListMembersReq struct {
+	SheetID string
+	Limit   int32
+	Cursor  string
+}
+
+type ListMembersResp struct {
+	Members    []*domain.SheetMember
+	NextCursor string
+}
+
+type ListMenuVersionsResp struct {
+	Versions     []*domain.MenuVersion
+	ActiveMenuID string
+}
+
+type DiffMenuVersionsReq struct {
+	SheetID    string
+	FromMenuID string
+	ToMenuID   string // empty means the sheet's active menu
+}
+
+type DiffMenuVersionsResp struct {
+	From         *domain.MenuVersion
+	To           *domain.MenuVersion
+	ActiveMenuID string
+	Changes      []domain.MenuItemChange
+}
+
 // Command DTOs
 
 type JoinSheetReq struct {
 	SheetID string
-	UserID  string
+	UserID  string // defaults to the caller
+}
+
+type CreateSheetInviteReq struct {
+	SheetID   string
+	ExpiresAt *time.Time // nil never expires
+	MaxUses   int32      // 0 = unlimited
+	Role      string     // role of members who join with it, member when empty
+}
+
+type AttachMenuReq struct {
+	SheetID string
+	Items   []MenuItemReq
+}
+
+type ImportMenuReq struct {
+	SheetID string
+	Format  domain.MenuImportFormat
+	Payload []byte
+	DryRun  bool // parse and validate only, nothing is stored
+}
+
+type ImportMenuResp struct {
+	Items  []*domain.MenuItem
+	Errors []domain.MenuImportError
+	Sheet  *domain.Sheet // nil on dry runs
 }
 
 type LeaveSheetReq struct {
 	SheetID string
-	UserID  string
+	UserID  string // member to remove, defaults to the caller
 }
 
 type CloseSheetReq struct {
-	SheetID     string
-	ActorUserID string
+	SheetID string
 }
 
 type ReopenSheetReq struct {
-	SheetID     string
-	ActorUserID string
+	SheetID string
 }
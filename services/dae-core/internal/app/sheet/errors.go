@@ -10,10 +10,34 @@ var (
 	ErrAlreadyExists     = apperror.AlreadyExists("sheet already exists")
 	ErrUnauthorized      = apperror.Unauthorized("unauthorized")
 	ErrInvalidTransition = apperror.InvalidInput("invalid status transition")
+	ErrMixedCurrencies   = apperror.Conflict("sheet orders use different currencies")
+	ErrSheetClosed       = apperror.InvalidInput("sheet is closed")
+	ErrMenuNotFound      = apperror.NotFound("menu version not found")
+	ErrNoActiveMenu      = apperror.InvalidInput("sheet has no active menu")
+	ErrUnsupportedFormat = apperror.InvalidInput("unsupported menu import format")
+	ErrEmptyMenu         = apperror.InvalidInput("menu must have at least one item")
+	ErrTooManyMenuItems  = apperror.InvalidInput("menu must have at most 300 items")
+	ErrMenuTooLarge      = apperror.InvalidInput("menu is too large to store as one version")
+	ErrInvalidSchedule   = apperror.InvalidInput("closes_at must be after opens_at")
+	ErrCutoffInPast      = apperror.InvalidInput("closes_at must be in the future")
+	ErrSheetTooLarge     = apperror.InvalidInput("too many members and menu items to create the sheet at once")
+
+	ErrTooManyPendingOrders = apperror.Conflict("sheet has too many pending orders to close at once")
+
+	// Invite errors
+	ErrInviteNotFound     = apperror.NotFound("invite not found")
+	ErrInviteRevoked      = apperror.InvalidInput("invite has been revoked")
+	ErrInviteExpired      = apperror.InvalidInput("invite has expired")
+	ErrInviteUsedUp       = apperror.InvalidInput("invite has no uses left")
+	ErrInviteRole         = apperror.InvalidInput("invite role must be member")
+	ErrInviteMaxUses      = apperror.InvalidInput("max_uses must not be negative")
+	ErrInviteExpiryInPast = apperror.InvalidInput("expires_at must be in the future")
+	ErrSheetNotJoinable   = apperror.InvalidInput("sheet is not open for joining")
 
 	// Menu validation errors
 	ErrMenuItemNameRequired        = apperror.InvalidInput("menu item name required")
 	ErrDuplicateMenuItemName       = apperror.AlreadyExists("duplicate menu item name")
+	ErrDuplicateMenuID             = apperror.AlreadyExists("duplicate menu item, option group or option id")
 	ErrMenuItemInvalidPrice        = apperror.InvalidInput("menu item invalid price")
 	ErrMenuItemInvalidCurrency     = apperror.InvalidInput("menu item invalid currency")
 	ErrOptionGroupNameRequired     = apperror.InvalidInput("option group name required")
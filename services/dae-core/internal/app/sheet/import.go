@@ -0,0 +1,135 @@
+package sheet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/authz"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/grpc/interceptor"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"github.com/deni12345/dae-services/libs/apperror"
+)
+
+// ImportMenu normalizes an external menu payload with the parser for its
+// format. A dry run returns the normalized items and row errors without
+// touching the sheet; otherwise a payload with any row error is rejected and
+// a clean one becomes the sheet's new menu version. Closed sheets refuse both,
+// and retries of an import with the same idempotency key return its result.
+func (u *usecase) ImportMenu(ctx context.Context, req *ImportMenuReq) (*ImportMenuResp, error) {
+	ctx, span := tracer.Start(ctx, "SheetUC.ImportMenu")
+	defer span.End()
+
+	if req.SheetID == "" {
+		err := apperror.InvalidInput("sheet_id is required")
+		span.RecordError(err)
+		return nil, err
+	}
+	parser, ok := u.menuParsers[req.Format]
+	if !ok {
+		err := ErrUnsupportedFormat
+		span.RecordError(err)
+		return nil, err
+	}
+	callerID, err := interceptor.ActingUserID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	sheet, err := u.sheetRepo.GetByID(ctx, req.SheetID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if err := authz.RequireSheetHost(ctx, sheet); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if sheet.Status == domain.Status_CLOSED {
+		span.RecordError(ErrSheetClosed)
+		return nil, ErrSheetClosed
+	}
+
+	if req.DryRun {
+		resp, err := parseMenu(parser, req)
+		if err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+		return resp, nil
+	}
+
+	idemKey := interceptor.GetOrCreateIdempotencyKeyWithHash(ctx, "", callerID, req.SheetID)
+	result, err := u.idemStore.Do(ctx, idemKey, idempotencyTTL, func(ctx context.Context) ([]byte, error) {
+		resp, err := u.importMenu(ctx, parser, req)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(resp)
+	})
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	var resp ImportMenuResp
+	if err := json.Unmarshal(result, &resp); err != nil {
+		span.RecordError(err)
+		return nil, apperror.Internal(fmt.Sprintf("unmarshal menu import: %v", err))
+	}
+	return &resp, nil
+}
+
+// parseMenu normalizes the payload of req, stamping the items with the time
+// of the import
+func parseMenu(parser port.MenuParser, req *ImportMenuReq) (*ImportMenuResp, error) {
+	imported, err := parser.Parse(req.Payload)
+	if err != nil {
+		return nil, apperror.InvalidInput(fmt.Sprintf("parse %s menu: %v", req.Format, err))
+	}
+
+	if err := checkMenuSize(imported.Items); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC().Unix()
+	for _, item := range imported.Items {
+		item.UpdatedAt = now
+	}
+	return &ImportMenuResp{Items: imported.Items, Errors: imported.Errors}, nil
+}
+
+// importMenu stores a clean payload as the sheet's new menu version
+func (u *usecase) importMenu(ctx context.Context, parser port.MenuParser, req *ImportMenuReq) (*ImportMenuResp, error) {
+	resp, err := parseMenu(parser, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Errors) > 0 {
+		return nil, apperror.InvalidFields("invalid menu import", importViolations(resp.Errors))
+	}
+	if len(resp.Items) == 0 {
+		return nil, ErrEmptyMenu
+	}
+
+	updated, err := u.sheetRepo.ReplaceMenu(ctx, req.SheetID, menuVersionID(ctx, req.SheetID), resp.Items)
+	if err != nil {
+		return nil, err
+	}
+	resp.Sheet = updated
+	return resp, nil
+}
+
+func importViolations(errs []domain.MenuImportError) []apperror.FieldViolation {
+	violations := make([]apperror.FieldViolation, len(errs))
+	for i, e := range errs {
+		violations[i] = apperror.FieldViolation{
+			Field:       fmt.Sprintf("rows[%d].%s", e.Row, e.Field),
+			Description: e.Message,
+		}
+	}
+	return violations
+}
@@ -0,0 +1,122 @@
+package sheet
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/grpc/interceptor"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+)
+
+// fakeMenuRepo records the menu versions ReplaceMenu stores
+type fakeMenuRepo struct {
+	*fakeSheetRepo
+	replaced []string
+}
+
+func (r *fakeMenuRepo) ReplaceMenu(_ context.Context, sheetID, menuID string, _ []*domain.MenuItem) (*domain.Sheet, error) {
+	r.replaced = append(r.replaced, menuID)
+	s := r.byID[sheetID]
+	s.ActiveMenuID = menuID
+	copied := *s
+	return &copied, nil
+}
+
+// fakeParser returns one item for any payload
+type fakeParser struct{}
+
+func (fakeParser) Format() domain.MenuImportFormat { return domain.MenuImportCSV }
+
+func (fakeParser) Parse([]byte) (*domain.MenuImport, error) {
+	return &domain.MenuImport{Items: []*domain.MenuItem{{ID: "pho", Name: "Pho", Price: 45000, Currency: "VND"}}}, nil
+}
+
+func TestImportMenu(t *testing.T) {
+	repo := &fakeMenuRepo{fakeSheetRepo: newFakeSheetRepo(
+		&domain.Sheet{ID: "open", HostUserID: "host", Status: domain.Status_OPEN},
+		&domain.Sheet{ID: "closed", HostUserID: "host", Status: domain.Status_CLOSED},
+	)}
+	uc := &usecase{
+		sheetRepo:   repo,
+		idemStore:   &fakeIdemStore{results: map[string][]byte{}},
+		menuParsers: map[domain.MenuImportFormat]port.MenuParser{domain.MenuImportCSV: fakeParser{}},
+	}
+	ctx := interceptor.WithIdempotencyKey(asUser("host"), "ImportMenu", "k1")
+	req := func(sheetID string, dryRun bool) *ImportMenuReq {
+		return &ImportMenuReq{SheetID: sheetID, Format: domain.MenuImportCSV, Payload: []byte("pho,45000"), DryRun: dryRun}
+	}
+
+	// A closed sheet refuses even a dry run
+	for _, dryRun := range []bool{true, false} {
+		if _, err := uc.ImportMenu(ctx, req("closed", dryRun)); err != ErrSheetClosed {
+			t.Fatalf("closed sheet, dry run %v: err = %v, want ErrSheetClosed", dryRun, err)
+		}
+	}
+
+	dry, err := uc.ImportMenu(ctx, req("open", true))
+	if err != nil || len(dry.Items) != 1 || dry.Sheet != nil || len(repo.replaced) != 0 {
+		t.Fatalf("dry run = %+v, %v with %d menus stored", dry, err, len(repo.replaced))
+	}
+
+	first, err := uc.ImportMenu(ctx, req("open", false))
+	if err != nil || first.Sheet == nil || len(repo.replaced) != 1 {
+		t.Fatalf("import = %+v, %v with %d menus stored", first, err, len(repo.replaced))
+	}
+
+	// A retry returns the stored version instead of adding another
+	again, err := uc.ImportMenu(ctx, req("open", false))
+	if err != nil || again.Sheet.ActiveMenuID != first.Sheet.ActiveMenuID || len(repo.replaced) != 1 {
+		t.Fatalf("retry = %+v, %v with %d menus stored, want the first import", again, err, len(repo.replaced))
+	}
+}
+
+func TestAttachMenuRetryNamesSameVersion(t *testing.T) {
+	repo := &fakeMenuRepo{fakeSheetRepo: newFakeSheetRepo(
+		&domain.Sheet{ID: "open", HostUserID: "host", Status: domain.Status_OPEN},
+	)}
+	uc := &usecase{sheetRepo: repo}
+	req := &AttachMenuReq{SheetID: "open", Items: []MenuItemReq{{Name: "Pho", Price: 45000, Currency: "VND"}}}
+
+	keyed := interceptor.WithIdempotencyKey(asUser("host"), "AttachMenuWithPayload", "k1")
+	for i := 0; i < 2; i++ {
+		if _, _, err := uc.AttachMenu(keyed, req); err != nil {
+			t.Fatalf("attach %d: %v", i, err)
+		}
+	}
+	if _, _, err := uc.AttachMenu(asUser("host"), req); err != nil {
+		t.Fatalf("attach without key: %v", err)
+	}
+
+	if len(repo.replaced) != 3 || repo.replaced[0] != repo.replaced[1] || repo.replaced[2] == repo.replaced[0] {
+		t.Fatalf("menu versions = %v, want a retry to reuse the keyed version only", repo.replaced)
+	}
+}
+
+// Every menu version is stored as one snapshot document, so menus are capped
+// by item count and by encoded size
+func TestCheckMenuSize(t *testing.T) {
+	menu := func(n, nameLen int) []*domain.MenuItem {
+		items := make([]*domain.MenuItem, n)
+		for i := range items {
+			items[i] = &domain.MenuItem{ID: fmt.Sprintf("item-%d", i), Name: strings.Repeat("x", nameLen), Price: 45000, Currency: "VND"}
+		}
+		return items
+	}
+
+	tests := map[string]struct {
+		items []*domain.MenuItem
+		want  error
+	}{
+		"at the item limit": {menu(maxMenuItems, 100), nil},
+		"too many items":    {menu(maxMenuItems+1, 1), ErrTooManyMenuItems},
+		"too many bytes":    {menu(maxMenuItems, 4<<10), ErrMenuTooLarge},
+	}
+	for name, tc := range tests {
+		if err := checkMenuSize(tc.items); err != tc.want {
+			t.Errorf("%s: err = %v, want %v", name, err, tc.want)
+		}
+	}
+}
@@ -0,0 +1,219 @@
+package sheet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/authz"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/grpc/interceptor"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"github.com/deni12345/dae-services/libs/apperror"
+)
+
+// inviteCodeAttempts bounds the codes tried when generated codes collide
+const inviteCodeAttempts = 5
+
+// CreateSheetInvite creates a join code for a sheet. Retries with the same
+// idempotency key return the same invite.
+func (u *usecase) CreateSheetInvite(ctx context.Context, req *CreateSheetInviteReq) (*domain.SheetInvite, error) {
+	ctx, span := tracer.Start(ctx, "SheetUC.CreateSheetInvite")
+	defer span.End()
+
+	if err := validateInviteRequest(req); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	callerID, err := interceptor.ActingUserID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	sheet, err := u.sheetRepo.GetByID(ctx, req.SheetID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if err := authz.RequireSheetHost(ctx, sheet); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if sheet.Status == domain.Status_CLOSED {
+		span.RecordError(ErrSheetClosed)
+		return nil, ErrSheetClosed
+	}
+
+	idemKey := interceptor.GetOrCreateIdempotencyKeyWithHash(ctx, "", callerID, req.SheetID)
+	result, err := u.idemStore.Do(ctx, idemKey, idempotencyTTL, func(ctx context.Context) ([]byte, error) {
+		invite, err := u.createInvite(ctx, callerID, req)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(invite)
+	})
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	var invite domain.SheetInvite
+	if err := json.Unmarshal(result, &invite); err != nil {
+		span.RecordError(err)
+		return nil, apperror.Internal(fmt.Sprintf("unmarshal invite: %v", err))
+	}
+	return &invite, nil
+}
+
+func validateInviteRequest(req *CreateSheetInviteReq) error {
+	if req.SheetID == "" {
+		return apperror.InvalidInput("sheet_id is required")
+	}
+	if req.MaxUses < 0 {
+		return ErrInviteMaxUses
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return ErrInviteExpiryInPast
+	}
+	// Hosting is not shared, so invites only make members
+	switch req.Role {
+	case "", domain.MemberRoleMember:
+		return nil
+	}
+	return ErrInviteRole
+}
+
+// createInvite stores a new invite under a fresh code
+func (u *usecase) createInvite(ctx context.Context, callerID string, req *CreateSheetInviteReq) (*domain.SheetInvite, error) {
+	role := req.Role
+	if role == "" {
+		role = domain.MemberRoleMember
+	}
+
+	now := time.Now().UTC()
+	invite := &domain.SheetInvite{
+		SheetID:   req.SheetID,
+		CreatedBy: callerID,
+		Role:      role,
+		MaxUses:   req.MaxUses,
+		ExpiresAt: utcTime(req.ExpiresAt),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	for attempt := 0; attempt < inviteCodeAttempts; attempt++ {
+		code, err := domain.NewInviteCode()
+		if err != nil {
+			return nil, err
+		}
+		invite.Code = code
+
+		created, err := u.inviteRepo.Create(ctx, invite)
+		if errors.Is(err, port.ErrInviteCodeTaken) {
+			continue
+		}
+		return created, err
+	}
+	return nil, apperror.Internal("no free invite code found")
+}
+
+// RedeemSheetInvite adds the caller to the invite's sheet. Redeeming again
+// as a member returns the membership and does not use the invite up.
+func (u *usecase) RedeemSheetInvite(ctx context.Context, code string) (*domain.SheetMember, error) {
+	ctx, span := tracer.Start(ctx, "SheetUC.RedeemSheetInvite")
+	defer span.End()
+
+	code = domain.NormalizeInviteCode(code)
+	if code == "" {
+		err := apperror.InvalidInput("code is required")
+		span.RecordError(err)
+		return nil, err
+	}
+	userID, err := interceptor.ActingUserID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	now := time.Now()
+	member, _, err := u.inviteRepo.Redeem(ctx, code, userID, func(invite *domain.SheetInvite, sheet *domain.Sheet) error {
+		switch {
+		case invite.RevokedAt != nil:
+			return ErrInviteRevoked
+		case invite.Expired(now):
+			return ErrInviteExpired
+		case invite.UsedUp():
+			return ErrInviteUsedUp
+		case !sheet.IsOpen():
+			return ErrSheetNotJoinable
+		}
+		return nil
+	})
+	if errors.Is(err, port.ErrSheetInviteNotFound) {
+		err = ErrInviteNotFound
+	}
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return member, nil
+}
+
+// RevokeSheetInvite stops an invite from being redeemed. Members who already
+// joined with it stay.
+func (u *usecase) RevokeSheetInvite(ctx context.Context, code string) (*domain.SheetInvite, error) {
+	ctx, span := tracer.Start(ctx, "SheetUC.RevokeSheetInvite")
+	defer span.End()
+
+	code = domain.NormalizeInviteCode(code)
+	if code == "" {
+		err := apperror.InvalidInput("code is required")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	// An invite never moves to another sheet, so its host is checked once
+	// before the transaction
+	invite, err := u.inviteRepo.GetByCode(ctx, code)
+	if errors.Is(err, port.ErrSheetInviteNotFound) {
+		err = ErrInviteNotFound
+	}
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	sheet, err := u.sheetRepo.GetByID(ctx, invite.SheetID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if err := authz.RequireSheetHost(ctx, sheet); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if invite.RevokedAt != nil {
+		return invite, nil
+	}
+
+	// A concurrent revoke leaves the invite unchanged, so nothing is written
+	invite, err = u.inviteRepo.Update(ctx, code, func(invite *domain.SheetInvite) error {
+		if invite.RevokedAt == nil {
+			now := time.Now().UTC()
+			invite.RevokedAt = &now
+		}
+		return nil
+	})
+	if errors.Is(err, port.ErrSheetInviteNotFound) {
+		err = ErrInviteNotFound
+	}
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return invite, nil
+}
@@ -0,0 +1,242 @@
+package sheet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/authz"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/grpc/interceptor"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+)
+
+// fakeInviteRepo keeps invites in memory and redeems them against the
+// sheets of a fakeSheetRepo the way the Firestore transaction does
+type fakeInviteRepo struct {
+	port.SheetInviteRepo
+	byCode  map[string]*domain.SheetInvite
+	sheets  *fakeSheetRepo
+	updates int
+}
+
+func newFakeInviteRepo(sheets *fakeSheetRepo) *fakeInviteRepo {
+	return &fakeInviteRepo{byCode: map[string]*domain.SheetInvite{}, sheets: sheets}
+}
+
+func (r *fakeInviteRepo) Create(_ context.Context, invite *domain.SheetInvite) (*domain.SheetInvite, error) {
+	if _, ok := r.byCode[invite.Code]; ok {
+		return nil, port.ErrInviteCodeTaken
+	}
+	copied := *invite
+	r.byCode[invite.Code] = &copied
+	return invite, nil
+}
+
+func (r *fakeInviteRepo) GetByCode(_ context.Context, code string) (*domain.SheetInvite, error) {
+	invite, ok := r.byCode[code]
+	if !ok {
+		return nil, port.ErrSheetInviteNotFound
+	}
+	copied := *invite
+	return &copied, nil
+}
+
+func (r *fakeInviteRepo) Update(_ context.Context, code string, fn func(*domain.SheetInvite) error) (*domain.SheetInvite, error) {
+	invite, ok := r.byCode[code]
+	if !ok {
+		return nil, port.ErrSheetInviteNotFound
+	}
+	copied := *invite
+	if err := fn(&copied); err != nil {
+		return nil, err
+	}
+	r.updates++
+	r.byCode[code] = &copied
+	return &copied, nil
+}
+
+func (r *fakeInviteRepo) Redeem(_ context.Context, code, userID string, check func(*domain.SheetInvite, *domain.Sheet) error) (*domain.SheetMember, bool, error) {
+	invite, ok := r.byCode[code]
+	if !ok {
+		return nil, false, port.ErrSheetInviteNotFound
+	}
+	sheet := r.sheets.byID[invite.SheetID]
+	for _, id := range sheet.MemberIDs {
+		if id == userID {
+			return &domain.SheetMember{SheetID: sheet.ID, UserID: userID, Role: domain.MemberRoleMember}, false, nil
+		}
+	}
+	if err := check(invite, sheet); err != nil {
+		return nil, false, err
+	}
+	invite.Uses++
+	sheet.MemberIDs = append(sheet.MemberIDs, userID)
+	return &domain.SheetMember{SheetID: sheet.ID, UserID: userID, Role: invite.Role}, true, nil
+}
+
+// fakeIdemStore runs fn once per key and replays its result
+type fakeIdemStore struct {
+	results map[string][]byte
+}
+
+func (s *fakeIdemStore) Do(ctx context.Context, key string, _ time.Duration, fn func(ctx context.Context) ([]byte, error)) ([]byte, error) {
+	if res, ok := s.results[key]; ok {
+		return res, nil
+	}
+	res, err := fn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.results[key] = res
+	return res, nil
+}
+
+func newInviteTestUsecase(sheets ...*domain.Sheet) (*usecase, *fakeSheetRepo, *fakeInviteRepo) {
+	sheetRepo := newFakeSheetRepo(sheets...)
+	inviteRepo := newFakeInviteRepo(sheetRepo)
+	uc := &usecase{sheetRepo: sheetRepo, inviteRepo: inviteRepo, idemStore: &fakeIdemStore{results: map[string][]byte{}}}
+	return uc, sheetRepo, inviteRepo
+}
+
+func withKey(ctx context.Context, key string) context.Context {
+	return interceptor.WithIdempotencyKey(ctx, "CreateSheetInvite", key)
+}
+
+func TestCreateSheetInvite(t *testing.T) {
+	uc, _, invites := newInviteTestUsecase(
+		&domain.Sheet{ID: "open", HostUserID: "host", Status: domain.Status_OPEN},
+		&domain.Sheet{ID: "closed", HostUserID: "host", Status: domain.Status_CLOSED},
+	)
+
+	refusals := map[string]struct {
+		ctx  context.Context
+		req  *CreateSheetInviteReq
+		want error
+	}{
+		"non-host":      {asUser("guest"), &CreateSheetInviteReq{SheetID: "open"}, authz.ErrNotSheetHost},
+		"closed sheet":  {asUser("host"), &CreateSheetInviteReq{SheetID: "closed"}, ErrSheetClosed},
+		"host role":     {asUser("host"), &CreateSheetInviteReq{SheetID: "open", Role: domain.MemberRoleHost}, ErrInviteRole},
+		"negative uses": {asUser("host"), &CreateSheetInviteReq{SheetID: "open", MaxUses: -1}, ErrInviteMaxUses},
+	}
+	for name, tc := range refusals {
+		if _, err := uc.CreateSheetInvite(withKey(tc.ctx, name), tc.req); err != tc.want {
+			t.Errorf("%s: err = %v, want %v", name, err, tc.want)
+		}
+	}
+	if len(invites.byCode) != 0 {
+		t.Fatalf("refused requests stored invites: %v", invites.byCode)
+	}
+
+	ctx := withKey(asUser("host"), "k1")
+	invite, err := uc.CreateSheetInvite(ctx, &CreateSheetInviteReq{SheetID: "open", MaxUses: 3})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if len(invite.Code) != 8 || invite.Role != domain.MemberRoleMember || invite.CreatedBy != "host" || invite.MaxUses != 3 {
+		t.Fatalf("invite = %+v", invite)
+	}
+
+	// A retry returns the same invite instead of minting another code
+	again, err := uc.CreateSheetInvite(ctx, &CreateSheetInviteReq{SheetID: "open", MaxUses: 3})
+	if err != nil || again.Code != invite.Code || len(invites.byCode) != 1 {
+		t.Fatalf("retry = %+v, %v with %d invites, want the same invite", again, err, len(invites.byCode))
+	}
+}
+
+func TestRedeemSheetInvite(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	uc, sheets, invites := newInviteTestUsecase(
+		&domain.Sheet{ID: "open", HostUserID: "host", Status: domain.Status_OPEN, MemberIDs: []string{"host"}},
+		&domain.Sheet{ID: "closed", HostUserID: "host", Status: domain.Status_CLOSED, MemberIDs: []string{"host"}},
+	)
+	invites.byCode = map[string]*domain.SheetInvite{
+		"REVOKED1": {Code: "REVOKED1", SheetID: "open", Role: domain.MemberRoleMember, RevokedAt: &past},
+		"EXPIRED1": {Code: "EXPIRED1", SheetID: "open", Role: domain.MemberRoleMember, ExpiresAt: &past},
+		"USEDUP11": {Code: "USEDUP11", SheetID: "open", Role: domain.MemberRoleMember, MaxUses: 1, Uses: 1},
+		"CLOSED11": {Code: "CLOSED11", SheetID: "closed", Role: domain.MemberRoleMember},
+		"VALID111": {Code: "VALID111", SheetID: "open", Role: domain.MemberRoleMember, MaxUses: 1},
+	}
+
+	refusals := map[string]error{
+		"REVOKED1": ErrInviteRevoked,
+		"EXPIRED1": ErrInviteExpired,
+		"USEDUP11": ErrInviteUsedUp,
+		"CLOSED11": ErrSheetNotJoinable,
+		"MISSING1": ErrInviteNotFound,
+	}
+	for code, want := range refusals {
+		if _, err := uc.RedeemSheetInvite(asUser("guest"), code); err != want {
+			t.Errorf("%s: err = %v, want %v", code, err, want)
+		}
+	}
+	if got := sheets.byID["open"].MemberIDs; len(got) != 1 {
+		t.Fatalf("members = %v after refusals, want only the host", got)
+	}
+
+	// Codes are accepted as displayed
+	member, err := uc.RedeemSheetInvite(asUser("guest"), "valid-111")
+	if err != nil {
+		t.Fatalf("redeem: %v", err)
+	}
+	if member.UserID != "guest" || member.Role != domain.MemberRoleMember {
+		t.Fatalf("member = %+v", member)
+	}
+
+	// Redeeming again as a member uses nothing, so the single use is not spent
+	if _, err := uc.RedeemSheetInvite(asUser("guest"), "VALID111"); err != nil {
+		t.Fatalf("redeem again: %v", err)
+	}
+	if uses := invites.byCode["VALID111"].Uses; uses != 1 {
+		t.Fatalf("uses = %d, want 1", uses)
+	}
+	if _, err := uc.RedeemSheetInvite(asUser("other"), "VALID111"); err != ErrInviteUsedUp {
+		t.Fatalf("second user: err = %v, want ErrInviteUsedUp", err)
+	}
+}
+
+func TestRevokeSheetInvite(t *testing.T) {
+	uc, _, invites := newInviteTestUsecase(&domain.Sheet{ID: "open", HostUserID: "host", Status: domain.Status_OPEN})
+	invites.byCode["CODE2345"] = &domain.SheetInvite{Code: "CODE2345", SheetID: "open", Role: domain.MemberRoleMember}
+
+	if _, err := uc.RevokeSheetInvite(asUser("guest"), "CODE2345"); err != authz.ErrNotSheetHost {
+		t.Fatalf("non-host: err = %v, want ErrNotSheetHost", err)
+	}
+	if invites.byCode["CODE2345"].RevokedAt != nil || invites.updates != 0 {
+		t.Fatal("non-host revoked the invite")
+	}
+
+	revoked, err := uc.RevokeSheetInvite(asUser("host"), "code-2345")
+	if err != nil || revoked.RevokedAt == nil {
+		t.Fatalf("revoke = %+v, %v", revoked, err)
+	}
+	if _, err := uc.RedeemSheetInvite(asUser("guest"), "CODE2345"); err != ErrInviteRevoked {
+		t.Fatalf("redeem revoked: err = %v, want ErrInviteRevoked", err)
+	}
+
+	// Revoking again returns the invite as it is, without writing
+	again, err := uc.RevokeSheetInvite(asUser("host"), "CODE2345")
+	if err != nil || !again.RevokedAt.Equal(*revoked.RevokedAt) || invites.updates != 1 {
+		t.Fatalf("revoke again = %+v, %v after %d updates, want the first revocation and 1 update", again, err, invites.updates)
+	}
+	if _, err := uc.RevokeSheetInvite(asUser("host"), "MISSING1"); err != ErrInviteNotFound {
+		t.Fatalf("missing: err = %v, want ErrInviteNotFound", err)
+	}
+}
+
+func TestJoinSheetRequiresHost(t *testing.T) {
+	repo := newFakeSheetRepo(&domain.Sheet{ID: "open", HostUserID: "host", Status: domain.Status_OPEN})
+	uc := &usecase{sheetRepo: repo}
+
+	if _, err := uc.JoinSheet(asUser("guest"), &JoinSheetReq{SheetID: "open"}); err != authz.ErrNotSheetHost {
+		t.Fatalf("self join: err = %v, want ErrNotSheetHost", err)
+	}
+	if _, err := uc.JoinSheet(asUser("guest"), &JoinSheetReq{SheetID: "open", UserID: "friend"}); err != authz.ErrNotSheetHost {
+		t.Fatalf("adding others: err = %v, want ErrNotSheetHost", err)
+	}
+
+	member, err := uc.JoinSheet(asUser("host"), &JoinSheetReq{SheetID: "open", UserID: "friend"})
+	if err != nil || member.UserID != "friend" {
+		t.Fatalf("host adds friend = %+v, %v", member, err)
+	}
+}
@@ -3,13 +3,19 @@ package sheet
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/authz"
 	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/grpc/interceptor"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
 	"github.com/deni12345/dae-services/libs/apperror"
 )
 
-// JoinSheet adds a user to a sheet's member list
-func (u *usecase) JoinSheet(ctx context.Context, req *JoinSheetReq) error {
+// JoinSheet adds a member to a sheet, the caller when req.UserID is empty.
+// Only the host and admins add members directly; everyone else redeems an
+// invite.
+func (u *usecase) JoinSheet(ctx context.Context, req *JoinSheetReq) (*domain.SheetMember, error) {
 	ctx, span := tracer.Start(ctx, "SheetUC.JoinSheet")
 	defer span.End()
 
@@ -17,34 +23,43 @@ func (u *usecase) JoinSheet(ctx context.Context, req *JoinSheetReq) error {
 	if req.SheetID == "" {
 		err := apperror.InvalidInput("sheet_id is required")
 		span.RecordError(err)
-		return err
+		return nil, err
 	}
-	if req.UserID == "" {
-		err := apperror.InvalidInput("user_id is required")
-		span.RecordError(err)
-		return err
+	userID := req.UserID
+	if userID == "" {
+		actorID, err := interceptor.ActingUserID(ctx)
+		if err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+		userID = actorID
 	}
 
-	// Verify sheet exists and is open
+	// Verify sheet exists, the caller hosts it and it is open
 	sheet, err := u.sheetRepo.GetByID(ctx, req.SheetID)
 	if err != nil {
 		span.RecordError(err)
-		return err
+		return nil, err
+	}
+	if err := authz.RequireSheetHost(ctx, sheet); err != nil {
+		span.RecordError(err)
+		return nil, err
 	}
 
 	if !sheet.IsOpen() {
 		err := apperror.InvalidInput(fmt.Sprintf("sheet %s is not open for joining", req.SheetID))
 		span.RecordError(err)
-		return err
+		return nil, err
 	}
 
 	// Add member (idempotent operation)
-	if err := u.sheetRepo.AddMember(ctx, req.SheetID, req.UserID); err != nil {
+	member, err := u.sheetRepo.AddMember(ctx, req.SheetID, userID)
+	if err != nil {
 		span.RecordError(err)
-		return err
+		return nil, err
 	}
 
-	return nil
+	return member, nil
 }
 
 // LeaveSheet removes a user from a sheet's member list
@@ -58,11 +73,15 @@ func (u *usecase) LeaveSheet(ctx context.Context, req *LeaveSheetReq) error {
 		span.RecordError(err)
 		return err
 	}
-	if req.UserID == "" {
-		err := apperror.InvalidInput("user_id is required")
+	actorID, err := interceptor.ActingUserID(ctx)
+	if err != nil {
 		span.RecordError(err)
 		return err
 	}
+	userID := req.UserID
+	if userID == "" {
+		userID = actorID
+	}
 
 	// Verify user is not the host
 	sheet, err := u.sheetRepo.GetByID(ctx, req.SheetID)
@@ -71,14 +90,20 @@ func (u *usecase) LeaveSheet(ctx context.Context, req *LeaveSheetReq) error {
 		return err
 	}
 
-	if sheet.HostUserID == req.UserID {
+	if sheet.HostUserID == userID {
 		err := apperror.Forbidden("host cannot leave sheet")
 		span.RecordError(err)
 		return err
 	}
+	if userID != actorID {
+		if err := authz.RequireSheetHost(ctx, sheet); err != nil {
+			span.RecordError(err)
+			return err
+		}
+	}
 
 	// Remove member (idempotent operation)
-	if err := u.sheetRepo.RemoveMember(ctx, req.SheetID, req.UserID); err != nil {
+	if err := u.sheetRepo.RemoveMember(ctx, req.SheetID, userID); err != nil {
 		span.RecordError(err)
 		return err
 	}
@@ -97,28 +122,59 @@ func (u *usecase) GetSheetMembers(ctx context.Context, sheetID string) ([]string
 	return memberIDs, nil
 }
 
-// CloseSheet closes a sheet (sets status to CLOSED)
-func (u *usecase) CloseSheet(ctx context.Context, req *CloseSheetReq) (*domain.Sheet, error) {
-	ctx, span := tracer.Start(ctx, "SheetUC.CloseSheet")
+// ListMembers returns a page of a sheet's members with their role and join time
+func (u *usecase) ListMembers(ctx context.Context, req *ListMembersReq) (*ListMembersResp, error) {
+	ctx, span := tracer.Start(ctx, "SheetUC.ListMembers")
 	defer span.End()
 
-	// Validate request
 	if req.SheetID == "" {
 		err := apperror.InvalidInput("sheet_id is required")
 		span.RecordError(err)
 		return nil, err
 	}
-	if req.ActorUserID == "" {
-		err := apperror.InvalidInput("actor_user_id is required")
+
+	sheet, err := u.sheetRepo.GetByID(ctx, req.SheetID)
+	if err != nil {
 		span.RecordError(err)
 		return nil, err
 	}
+	if err := authz.RequireSheetMember(ctx, sheet); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	resp, err := u.sheetRepo.ListMembers(ctx, port.ListMembersQuery{
+		SheetID: req.SheetID,
+		Limit:   req.Limit,
+		Cursor:  req.Cursor,
+	})
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return &ListMembersResp{
+		Members:    resp.Members,
+		NextCursor: resp.NextCursor,
+	}, nil
+}
 
+// CloseSheet closes a sheet (sets status to CLOSED)
+func (u *usecase) CloseSheet(ctx context.Context, req *CloseSheetReq) (*domain.Sheet, error) {
+	ctx, span := tracer.Start(ctx, "SheetUC.CloseSheet")
+	defer span.End()
+
+	// Validate request
+	if req.SheetID == "" {
+		err := apperror.InvalidInput("sheet_id is required")
+		span.RecordError(err)
+		return nil, err
+	}
 	// Use patch-in-transaction pattern
 	updatedSheet, err := u.sheetRepo.Update(ctx, req.SheetID, func(sheet *domain.Sheet) error {
 		// Business rule: only host can close
-		if sheet.HostUserID != req.ActorUserID {
-			return apperror.Forbidden("only host can close sheet")
+		if err := authz.RequireSheetHost(ctx, sheet); err != nil {
+			return err
 		}
 
 		// Business rule: cannot close already closed sheet
@@ -150,17 +206,11 @@ func (u *usecase) ReopenSheet(ctx context.Context, req *ReopenSheetReq) (*domain
 		span.RecordError(err)
 		return nil, err
 	}
-	if req.ActorUserID == "" {
-		err := apperror.InvalidInput("actor_user_id is required")
-		span.RecordError(err)
-		return nil, err
-	}
-
 	// Use patch-in-transaction pattern
 	updatedSheet, err := u.sheetRepo.Update(ctx, req.SheetID, func(sheet *domain.Sheet) error {
 		// Business rule: only host can reopen
-		if sheet.HostUserID != req.ActorUserID {
-			return apperror.Forbidden("only host can reopen sheet")
+		if err := authz.RequireSheetHost(ctx, sheet); err != nil {
+			return err
 		}
 
 		// Business rule: can only reopen closed sheets
@@ -168,8 +218,11 @@ func (u *usecase) ReopenSheet(ctx context.Context, req *ReopenSheetReq) (*domain
 			return apperror.InvalidInput(fmt.Sprintf("can only reopen closed sheets, current status: %s", domain.Status_name[domain.Status(sheet.Status)]))
 		}
 
-		// Apply change
+		// Apply change; a cutoff that has passed would close it again at once
 		sheet.Status = domain.Status_OPEN
+		if sheet.PastCutoff(time.Now()) {
+			sheet.ClosesAt = nil
+		}
 		return nil
 	})
 
@@ -0,0 +1,226 @@
+package sheet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/authz"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/grpc/interceptor"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"github.com/deni12345/dae-services/libs/apperror"
+	"github.com/google/uuid"
+)
+
+// AttachMenu stores the given items as a new immutable menu version and makes
+// it the sheet's active menu. Orders keep the menu version they were priced with.
+func (u *usecase) AttachMenu(ctx context.Context, req *AttachMenuReq) (*domain.Sheet, []*domain.MenuItem, error) {
+	ctx, span := tracer.Start(ctx, "SheetUC.AttachMenu")
+	defer span.End()
+
+	if req.SheetID == "" {
+		err := apperror.InvalidInput("sheet_id is required")
+		span.RecordError(err)
+		return nil, nil, err
+	}
+	if len(req.Items) == 0 {
+		err := ErrEmptyMenu
+		span.RecordError(err)
+		return nil, nil, err
+	}
+	if err := validateMenuItems(req.Items); err != nil {
+		span.RecordError(err)
+		return nil, nil, err
+	}
+
+	sheet, err := u.sheetRepo.GetByID(ctx, req.SheetID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, nil, err
+	}
+	if err := authz.RequireSheetHost(ctx, sheet); err != nil {
+		span.RecordError(err)
+		return nil, nil, err
+	}
+	if sheet.Status == domain.Status_CLOSED {
+		err := ErrSheetClosed
+		span.RecordError(err)
+		return nil, nil, err
+	}
+
+	items := convertMenuItemsToDomain(req.Items, time.Now().UTC().Unix())
+	if err := checkMenuSize(items); err != nil {
+		span.RecordError(err)
+		return nil, nil, err
+	}
+	updated, err := u.sheetRepo.ReplaceMenu(ctx, req.SheetID, menuVersionID(ctx, req.SheetID), items)
+	if err != nil {
+		span.RecordError(err)
+		return nil, nil, err
+	}
+
+	return updated, items, nil
+}
+
+// checkMenuSize refuses menus whose version snapshot would not fit in one
+// document. The JSON encoding stands in for the stored size; it is no
+// smaller than Firestore's for the same fields.
+func checkMenuSize(items []*domain.MenuItem) error {
+	if len(items) > maxMenuItems {
+		return ErrTooManyMenuItems
+	}
+	encoded, err := json.Marshal(items)
+	if err != nil {
+		return fmt.Errorf("encode menu: %w", err)
+	}
+	if len(encoded) > maxMenuBytes {
+		return ErrMenuTooLarge
+	}
+	return nil
+}
+
+// menuVersionID names the menu version a write stores. With an idempotency
+// key a retry names the same version, which ReplaceMenu then leaves as is;
+// without one every call gets a fresh ID.
+func menuVersionID(ctx context.Context, sheetID string) string {
+	key := interceptor.GetOrCreateIdempotencyKeyWithHash(ctx, uuid.New().String(), sheetID)
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte(key)).String()
+}
+
+// GetMenu returns the sheet's current menu items ordered by name
+func (u *usecase) GetMenu(ctx context.Context, sheetID string) ([]*domain.MenuItem, error) {
+	ctx, span := tracer.Start(ctx, "SheetUC.GetMenu")
+	defer span.End()
+
+	if sheetID == "" {
+		err := apperror.InvalidInput("sheet_id is required")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	sheet, err := u.sheetRepo.GetByID(ctx, sheetID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if err := authz.RequireSheetMember(ctx, sheet); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	items, err := u.sheetRepo.GetMenuItems(ctx, sheetID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Name != items[j].Name {
+			return items[i].Name < items[j].Name
+		}
+		return items[i].ID < items[j].ID
+	})
+
+	return items, nil
+}
+
+// ListMenuVersions returns the sheet's menu snapshots, newest first
+func (u *usecase) ListMenuVersions(ctx context.Context, sheetID string) (*ListMenuVersionsResp, error) {
+	ctx, span := tracer.Start(ctx, "SheetUC.ListMenuVersions")
+	defer span.End()
+
+	if sheetID == "" {
+		err := apperror.InvalidInput("sheet_id is required")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	sheet, err := u.sheetRepo.GetByID(ctx, sheetID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if err := authz.RequireSheetMember(ctx, sheet); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	versions, err := u.sheetRepo.ListMenuVersions(ctx, sheetID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return &ListMenuVersionsResp{
+		Versions:     versions,
+		ActiveMenuID: sheet.ActiveMenuID,
+	}, nil
+}
+
+// DiffMenuVersions reports the item changes between two menu snapshots of a
+// sheet. The target defaults to the active menu.
+func (u *usecase) DiffMenuVersions(ctx context.Context, req *DiffMenuVersionsReq) (*DiffMenuVersionsResp, error) {
+	ctx, span := tracer.Start(ctx, "SheetUC.DiffMenuVersions")
+	defer span.End()
+
+	if req.SheetID == "" {
+		err := apperror.InvalidInput("sheet_id is required")
+		span.RecordError(err)
+		return nil, err
+	}
+	if req.FromMenuID == "" {
+		err := apperror.InvalidInput("from_menu_id is required")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	sheet, err := u.sheetRepo.GetByID(ctx, req.SheetID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if err := authz.RequireSheetMember(ctx, sheet); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	toMenuID := req.ToMenuID
+	if toMenuID == "" {
+		toMenuID = sheet.ActiveMenuID
+	}
+	if toMenuID == "" {
+		err := ErrNoActiveMenu
+		span.RecordError(err)
+		return nil, err
+	}
+
+	from, err := u.getMenuVersion(ctx, req.SheetID, req.FromMenuID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	to, err := u.getMenuVersion(ctx, req.SheetID, toMenuID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return &DiffMenuVersionsResp{
+		From:         from,
+		To:           to,
+		ActiveMenuID: sheet.ActiveMenuID,
+		Changes:      domain.DiffMenus(from.Items, to.Items),
+	}, nil
+}
+
+func (u *usecase) getMenuVersion(ctx context.Context, sheetID, menuID string) (*domain.MenuVersion, error) {
+	version, err := u.sheetRepo.GetMenuVersion(ctx, sheetID, menuID)
+	if errors.Is(err, port.ErrMenuVersionNotFound) {
+		return nil, ErrMenuNotFound
+	}
+	return version, err
+}
@@ -3,6 +3,7 @@ package sheet
 import (
 	"context"
 
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/authz"
 	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
 	"github.com/deni12345/dae-services/services/dae-core/internal/port"
 	"github.com/deni12345/dae-services/libs/apperror"
@@ -24,6 +25,10 @@ func (u *usecase) GetSheet(ctx context.Context, id string) (*domain.Sheet, error
 		span.RecordError(err)
 		return nil, err
 	}
+	if err := authz.RequireSheetMember(ctx, sheet); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
 
 	return sheet, nil
 }
@@ -0,0 +1,56 @@
+package sheet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+)
+
+// scheduleBatch bounds the sheets transitioned per ApplySchedules call
+const scheduleBatch = 100
+
+// ApplySchedules opens pending sheets whose opening time has passed and
+// closes sheets past their cutoff; the close confirms their pending orders
+// in the same transaction, like a manual close. It returns how many sheets changed status; a failing sheet
+// does not stop the others.
+func (u *usecase) ApplySchedules(ctx context.Context, now time.Time) (int, error) {
+	ctx, span := tracer.Start(ctx, "SheetUC.ApplySchedules")
+	defer span.End()
+
+	due, err := u.sheetRepo.ListScheduleDue(ctx, now, scheduleBatch)
+	if err != nil {
+		span.RecordError(err)
+		return 0, err
+	}
+
+	var errs []error
+	changed := 0
+	for _, s := range due {
+		moved := false
+		_, err := u.sheetRepo.Update(ctx, s.ID, func(sheet *domain.Sheet) error {
+			// The host may have changed the sheet since it was listed
+			status, ok := sheet.ScheduledStatus(now)
+			if ok {
+				sheet.Status = status
+				moved = true
+			}
+			return nil
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("apply schedule of sheet %s: %w", s.ID, err))
+			continue
+		}
+		if moved {
+			changed++
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		span.RecordError(err)
+		return changed, err
+	}
+	return changed, nil
+}
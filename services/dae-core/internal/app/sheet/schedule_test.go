@@ -0,0 +1,173 @@
+package sheet
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/grpc/interceptor"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+)
+
+// fakeSheetRepo keeps sheets in memory. Update fails for the IDs in failing;
+// stale holds the copies ListScheduleDue returns instead of the current
+// sheet, as if the sheet changed after it was listed.
+type fakeSheetRepo struct {
+	port.SheetRepo
+	byID    map[string]*domain.Sheet
+	stale   map[string]*domain.Sheet
+	failing map[string]bool
+	updates int
+}
+
+func newFakeSheetRepo(sheets ...*domain.Sheet) *fakeSheetRepo {
+	r := &fakeSheetRepo{byID: map[string]*domain.Sheet{}, stale: map[string]*domain.Sheet{}, failing: map[string]bool{}}
+	for _, s := range sheets {
+		r.byID[s.ID] = s
+	}
+	return r
+}
+
+func (r *fakeSheetRepo) GetByID(_ context.Context, id string) (*domain.Sheet, error) {
+	s, ok := r.byID[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	copied := *s
+	return &copied, nil
+}
+
+func (r *fakeSheetRepo) Update(_ context.Context, id string, fn func(*domain.Sheet) error) (*domain.Sheet, error) {
+	if r.failing[id] {
+		return nil, errors.New("update aborted")
+	}
+	s, ok := r.byID[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	copied := *s
+	if err := fn(&copied); err != nil {
+		return nil, err
+	}
+	r.updates++
+	r.byID[id] = &copied
+	return &copied, nil
+}
+
+func (r *fakeSheetRepo) ListScheduleDue(_ context.Context, now time.Time, limit int) ([]*domain.Sheet, error) {
+	var due []*domain.Sheet
+	for id, s := range r.byID {
+		listed := s
+		if stale, ok := r.stale[id]; ok {
+			listed = stale
+		}
+		if _, ok := listed.ScheduledStatus(now); ok && len(due) < limit {
+			copied := *listed
+			due = append(due, &copied)
+		}
+	}
+	return due, nil
+}
+
+func (r *fakeSheetRepo) AddMember(_ context.Context, sheetID, userID string) (*domain.SheetMember, error) {
+	s, ok := r.byID[sheetID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	s.MemberIDs = append(s.MemberIDs, userID)
+	return &domain.SheetMember{SheetID: sheetID, UserID: userID, Role: domain.MemberRoleMember}, nil
+}
+
+func asUser(userID string) context.Context {
+	return interceptor.WithPrincipal(context.Background(), &domain.Principal{UserID: userID})
+}
+
+func TestApplySchedules(t *testing.T) {
+	now := time.Date(2026, 3, 2, 11, 0, 0, 0, time.UTC)
+	past, future := now.Add(-time.Minute), now.Add(time.Hour)
+
+	repo := newFakeSheetRepo(
+		&domain.Sheet{ID: "opens", Status: domain.Status_PENDING, OpensAt: &past, ClosesAt: &future},
+		&domain.Sheet{ID: "closes", Status: domain.Status_OPEN, ClosesAt: &past},
+		&domain.Sheet{ID: "paused-and-past", Status: domain.Status_PENDING, OpensAt: &past, ClosesAt: &past},
+		&domain.Sheet{ID: "not-yet", Status: domain.Status_PENDING, OpensAt: &future},
+		&domain.Sheet{ID: "closed", Status: domain.Status_CLOSED, ClosesAt: &past},
+		// The host moved the cutoff after the sheet was listed
+		&domain.Sheet{ID: "moved", Status: domain.Status_OPEN, ClosesAt: &future},
+	)
+	repo.stale["moved"] = &domain.Sheet{ID: "moved", Status: domain.Status_OPEN, ClosesAt: &past}
+	uc := &usecase{sheetRepo: repo}
+
+	changed, err := uc.ApplySchedules(context.Background(), now)
+	if err != nil {
+		t.Fatalf("apply schedules: %v", err)
+	}
+	if changed != 3 {
+		t.Fatalf("changed = %d, want 3", changed)
+	}
+
+	want := map[string]domain.Status{
+		"opens":           domain.Status_OPEN,
+		"closes":          domain.Status_CLOSED,
+		"paused-and-past": domain.Status_CLOSED,
+		"not-yet":         domain.Status_PENDING,
+		"closed":          domain.Status_CLOSED,
+		"moved":           domain.Status_OPEN,
+	}
+	for id, status := range want {
+		if got := repo.byID[id].Status; got != status {
+			t.Errorf("sheet %s status = %v, want %v", id, got, status)
+		}
+	}
+
+	// Nothing is left due, so a second run changes nothing
+	changed, err = uc.ApplySchedules(context.Background(), now)
+	if err != nil || changed != 0 {
+		t.Fatalf("second run = %d, %v, want 0 and no error", changed, err)
+	}
+}
+
+func TestApplySchedulesContinuesPastFailures(t *testing.T) {
+	now := time.Date(2026, 3, 2, 11, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Minute)
+
+	repo := newFakeSheetRepo(
+		&domain.Sheet{ID: "a", Status: domain.Status_OPEN, ClosesAt: &past},
+		&domain.Sheet{ID: "b", Status: domain.Status_OPEN, ClosesAt: &past},
+	)
+	repo.failing["a"] = true
+	uc := &usecase{sheetRepo: repo}
+
+	changed, err := uc.ApplySchedules(context.Background(), now)
+	if err == nil {
+		t.Fatal("want the failing sheet reported")
+	}
+	if changed != 1 || repo.byID["b"].Status != domain.Status_CLOSED {
+		t.Fatalf("changed = %d, b = %v, want b closed", changed, repo.byID["b"].Status)
+	}
+
+	// The failed sheet is still due and is closed on the next run
+	delete(repo.failing, "a")
+	changed, err = uc.ApplySchedules(context.Background(), now)
+	if err != nil || changed != 1 || repo.byID["a"].Status != domain.Status_CLOSED {
+		t.Fatalf("retry = %d, %v, a = %v, want a closed", changed, err, repo.byID["a"].Status)
+	}
+}
+
+func TestCutoffInPastRejected(t *testing.T) {
+	past := time.Now().Add(-time.Minute)
+	repo := newFakeSheetRepo(&domain.Sheet{ID: "s1", HostUserID: "host", Status: domain.Status_OPEN})
+	uc := &usecase{sheetRepo: repo}
+
+	if _, err := uc.CreateSheet(asUser("host"), &CreateSheetReq{Name: "Lunch", ClosesAt: &past}); err != ErrCutoffInPast {
+		t.Fatalf("create: err = %v, want ErrCutoffInPast", err)
+	}
+	if _, err := uc.UpdateSheet(asUser("host"), &UpdateSheetReq{ID: "s1", ClosesAt: &past}); err != ErrCutoffInPast {
+		t.Fatalf("update: err = %v, want ErrCutoffInPast", err)
+	}
+	if repo.updates != 0 || repo.byID["s1"].ClosesAt != nil {
+		t.Fatalf("sheet changed: %+v", repo.byID["s1"])
+	}
+}
@@ -0,0 +1,51 @@
+package sheet
+
+import (
+	"context"
+	"errors"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/authz"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/pricing"
+	"github.com/deni12345/dae-services/libs/apperror"
+)
+
+// GetSheetSettlement aggregates the sheet's orders into what each member owes
+// the host. On a sheet that is not closed the result is marked provisional.
+func (u *usecase) GetSheetSettlement(ctx context.Context, sheetID string) (*domain.Settlement, error) {
+	ctx, span := tracer.Start(ctx, "SheetUC.GetSheetSettlement")
+	defer span.End()
+
+	if sheetID == "" {
+		err := apperror.InvalidInput("sheet_id is required")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	sheet, err := u.sheetRepo.GetByID(ctx, sheetID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if err := authz.RequireSheetMember(ctx, sheet); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	orders, err := u.orderRepo.ListBySheet(ctx, sheetID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	settlement, err := pricing.Settlement(sheet, orders)
+	if err != nil {
+		span.RecordError(err)
+		if errors.Is(err, domain.ErrCurrencyMismatch) {
+			return nil, ErrMixedCurrencies
+		}
+		return nil, err
+	}
+
+	return settlement, nil
+}
@@ -2,9 +2,13 @@ package sheet
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/authz"
 	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
 	"github.com/deni12345/dae-services/libs/apperror"
 )
 
@@ -21,14 +25,36 @@ func (u *usecase) UpdateSheet(ctx context.Context, req *UpdateSheetReq) (*domain
 
 	// Use callback pattern with validation
 	updatedSheet, err := u.sheetRepo.Update(ctx, req.ID, func(sheet *domain.Sheet) error {
+		if err := authz.RequireSheetHost(ctx, sheet); err != nil {
+			return err
+		}
+
 		// Apply updates
 		if req.Status != nil {
 			if err := validateStatusTransition(sheet.Status, *req.Status); err != nil {
 				return err
 			}
+			// A sheet paused after its opening time stays paused: drop the
+			// opening time so the scheduler does not reopen it
+			if *req.Status == domain.Status_PENDING && sheet.OpensAt != nil && !time.Now().Before(*sheet.OpensAt) {
+				sheet.OpensAt = nil
+			}
 			sheet.Status = *req.Status
 		}
 
+		if req.OpensAt != nil {
+			sheet.OpensAt = utcTime(req.OpensAt)
+		}
+		if req.ClosesAt != nil {
+			if !req.ClosesAt.After(time.Now()) {
+				return ErrCutoffInPast
+			}
+			sheet.ClosesAt = utcTime(req.ClosesAt)
+		}
+		if err := validateSchedule(sheet.OpensAt, sheet.ClosesAt); err != nil {
+			return err
+		}
+
 		if req.DeliveryFee != nil {
 			sheet.DeliveryFee = *req.DeliveryFee
 		}
@@ -40,6 +66,10 @@ func (u *usecase) UpdateSheet(ctx context.Context, req *UpdateSheetReq) (*domain
 			sheet.Discount = *req.Discount
 		}
 
+		if req.FeeSplit != nil {
+			sheet.FeeSplit = *req.FeeSplit
+		}
+
 		if req.Description != nil {
 			sheet.Description = *req.Description
 		}
@@ -47,6 +77,9 @@ func (u *usecase) UpdateSheet(ctx context.Context, req *UpdateSheetReq) (*domain
 		return nil
 	})
 
+	if errors.Is(err, port.ErrTooManyPendingOrders) {
+		err = ErrTooManyPendingOrders
+	}
 	if err != nil {
 		span.RecordError(err)
 		return nil, err
@@ -2,6 +2,7 @@ package sheet
 
 import (
 	"context"
+	"time"
 
 	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
 	"github.com/deni12345/dae-services/services/dae-core/internal/port"
@@ -13,28 +14,52 @@ type Usecase interface {
 	// Commands
 	CreateSheet(ctx context.Context, req *CreateSheetReq) (*domain.Sheet, error)
 	UpdateSheet(ctx context.Context, req *UpdateSheetReq) (*domain.Sheet, error)
-	JoinSheet(ctx context.Context, req *JoinSheetReq) error
+	JoinSheet(ctx context.Context, req *JoinSheetReq) (*domain.SheetMember, error)
 	LeaveSheet(ctx context.Context, req *LeaveSheetReq) error
 	CloseSheet(ctx context.Context, req *CloseSheetReq) (*domain.Sheet, error)
 	ReopenSheet(ctx context.Context, req *ReopenSheetReq) (*domain.Sheet, error)
+	CreateSheetInvite(ctx context.Context, req *CreateSheetInviteReq) (*domain.SheetInvite, error)
+	RedeemSheetInvite(ctx context.Context, code string) (*domain.SheetMember, error)
+	RevokeSheetInvite(ctx context.Context, code string) (*domain.SheetInvite, error)
+	AttachMenu(ctx context.Context, req *AttachMenuReq) (*domain.Sheet, []*domain.MenuItem, error)
+	ImportMenu(ctx context.Context, req *ImportMenuReq) (*ImportMenuResp, error)
+	// ApplySchedules makes the status changes sheet schedules call for at now
+	ApplySchedules(ctx context.Context, now time.Time) (int, error)
 
 	// Queries
 	GetSheet(ctx context.Context, id string) (*domain.Sheet, error)
 	ListSheets(ctx context.Context, req *ListSheetsReq) (*ListSheetsResp, error)
 	ListUserSheets(ctx context.Context, req *ListUserSheetsReq) (*ListUserSheetsResp, error)
 	GetSheetMembers(ctx context.Context, sheetID string) ([]string, error)
+	ListMembers(ctx context.Context, req *ListMembersReq) (*ListMembersResp, error)
+	GetMenu(ctx context.Context, sheetID string) ([]*domain.MenuItem, error)
+	ListMenuVersions(ctx context.Context, sheetID string) (*ListMenuVersionsResp, error)
+	DiffMenuVersions(ctx context.Context, req *DiffMenuVersionsReq) (*DiffMenuVersionsResp, error)
+	GetSheetSettlement(ctx context.Context, sheetID string) (*domain.Settlement, error)
 }
 
 type usecase struct {
-	sheetRepo port.SheetRepo
-	idemStore port.IdempotencyStore
+	sheetRepo   port.SheetRepo
+	inviteRepo  port.SheetInviteRepo
+	orderRepo   port.OrdersRepo
+	idemStore   port.IdempotencyStore
+	menuParsers map[domain.MenuImportFormat]port.MenuParser
 }
 
-// NewUsecase creates a new sheet usecase
-func NewUsecase(sheetRepo port.SheetRepo, idemStore port.IdempotencyStore) Usecase {
+// NewUsecase creates a new sheet usecase. menuParsers are the menu import
+// formats ImportMenu accepts.
+func NewUsecase(sheetRepo port.SheetRepo, inviteRepo port.SheetInviteRepo, orderRepo port.OrdersRepo, idemStore port.IdempotencyStore, menuParsers []port.MenuParser) Usecase {
+	parsers := make(map[domain.MenuImportFormat]port.MenuParser, len(menuParsers))
+	for _, p := range menuParsers {
+		parsers[p.Format()] = p
+	}
+
 	return &usecase{
-		sheetRepo: sheetRepo,
-		idemStore: idemStore,
+		sheetRepo:   sheetRepo,
+		inviteRepo:  inviteRepo,
+		orderRepo:   orderRepo,
+		idemStore:   idemStore,
+		menuParsers: parsers,
 	}
 }
 
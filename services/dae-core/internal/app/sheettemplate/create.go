@@ -0,0 +1,110 @@
+package sheettemplate
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/authz"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"github.com/deni12345/dae-services/libs/apperror"
+	"github.com/google/uuid"
+)
+
+// CreateSheetTemplate saves a sheet, with its current menu, as a template
+// that recurs on req.Recurrence
+func (u *usecase) CreateSheetTemplate(ctx context.Context, req *CreateSheetTemplateReq) (*domain.SheetTemplate, error) {
+	ctx, span := tracer.Start(ctx, "SheetTemplateUC.CreateSheetTemplate")
+	defer span.End()
+
+	if req.SheetID == "" {
+		err := apperror.InvalidInput("sheet_id is required")
+		span.RecordError(err)
+		return nil, err
+	}
+	if err := validateSchedule(req.Recurrence, req.CloseAfterMinutes); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	sheet, err := u.sheetRepo.GetByID(ctx, req.SheetID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if err := authz.RequireSheetHost(ctx, sheet); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	items, err := u.sheetRepo.GetMenuItems(ctx, sheet.ID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		name = sheet.Name
+	}
+
+	now := time.Now().UTC()
+	template := &domain.SheetTemplate{
+		ID:                uuid.New().String(),
+		Name:              name,
+		Description:       sheet.Description,
+		HostUserID:        sheet.HostUserID,
+		MemberIDs:         sheet.MemberIDs,
+		DeliveryFee:       sheet.DeliveryFee,
+		Discount:          sheet.Discount,
+		FeeSplit:          sheet.FeeSplit,
+		MenuItems:         make([]domain.MenuItem, 0, len(items)),
+		Recurrence:        req.Recurrence,
+		CloseAfterMinutes: req.CloseAfterMinutes,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+	for _, item := range items {
+		template.MenuItems = append(template.MenuItems, *item)
+	}
+	if err := template.Reschedule(now); err != nil {
+		span.RecordError(err)
+		return nil, recurrenceError(err)
+	}
+
+	created, err := u.templates.Create(ctx, template)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	return created, nil
+}
+
+// validateSchedule checks a recurrence and the cutoff of the sheets it creates
+func validateSchedule(recurrence domain.Recurrence, closeAfterMinutes int32) error {
+	if closeAfterMinutes < 0 {
+		return ErrInvalidCloseAfter
+	}
+	if err := recurrence.Validate(); err != nil {
+		return recurrenceError(err)
+	}
+	return nil
+}
+
+// recurrenceError maps invalid recurrence rules to an input error
+func recurrenceError(err error) error {
+	if errors.Is(err, domain.ErrInvalidRecurrence) {
+		return apperror.InvalidInput(err.Error())
+	}
+	return err
+}
+
+// mapNotFound maps repository lookups of missing templates to ErrNotFound
+func mapNotFound(err error) error {
+	if errors.Is(err, port.ErrSheetTemplateNotFound) {
+		return ErrNotFound
+	}
+	return err
+}
@@ -0,0 +1,36 @@
+package sheettemplate
+
+import "github.com/deni12345/dae-services/services/dae-core/internal/domain"
+
+// Command DTOs
+
+type CreateSheetTemplateReq struct {
+	SheetID           string // the sheet saved as a template
+	Name              string // defaults to the sheet's name
+	Recurrence        domain.Recurrence
+	CloseAfterMinutes int32
+}
+
+type UpdateSheetTemplateReq struct {
+	ID                string
+	Name              *string
+	Description       *string
+	DeliveryFee       *domain.Money
+	MemberIDs         []string // nil keeps the current members
+	Recurrence        *domain.Recurrence
+	CloseAfterMinutes *int32
+	Paused            *bool
+}
+
+// Query DTOs
+
+type ListSheetTemplatesReq struct {
+	HostUserID string // empty means the caller
+	Limit      int32
+	Cursor     string
+}
+
+type ListSheetTemplatesResp struct {
+	Templates  []*domain.SheetTemplate
+	NextCursor string
+}
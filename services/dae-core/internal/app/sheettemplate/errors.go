@@ -0,0 +1,9 @@
+package sheettemplate
+
+import "github.com/deni12345/dae-services/libs/apperror"
+
+var (
+	ErrNotFound          = apperror.NotFound("sheet template not found")
+	ErrInvalidCursor     = apperror.InvalidInput("invalid page cursor")
+	ErrInvalidCloseAfter = apperror.InvalidInput("close_after_minutes must not be negative")
+)
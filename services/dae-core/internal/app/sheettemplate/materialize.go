@@ -0,0 +1,184 @@
+package sheettemplate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/sheet"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/grpc/interceptor"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+)
+
+// materializeBatch bounds the templates materialized by one MaterializeDue call
+const materializeBatch = 50
+
+// MaterializeDue creates a sheet for every template whose next occurrence is
+// at or before now and moves the template on to its following occurrence.
+// Sheets are created as the template's host with the occurrence's
+// idempotency key, so an occurrence retried after a failure creates its
+// sheet once. Occurrences missed by more than their cutoff, or due while the
+// host cannot sign in, are skipped; a template whose host was deleted is
+// paused.
+func (u *usecase) MaterializeDue(ctx context.Context, now time.Time) (int, error) {
+	ctx, span := tracer.Start(ctx, "SheetTemplateUC.MaterializeDue")
+	defer span.End()
+
+	due, err := u.templates.ListDue(ctx, now, materializeBatch)
+	if err != nil {
+		span.RecordError(err)
+		return 0, err
+	}
+
+	var errs []error
+	created := 0
+	for _, template := range due {
+		ok, err := u.materialize(ctx, template, now)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("materialize template %s: %w", template.ID, err))
+			continue
+		}
+		if ok {
+			created++
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		span.RecordError(err)
+		return created, err
+	}
+	return created, nil
+}
+
+// materialize creates the sheet of template's due occurrence and reports
+// whether one was created
+func (u *usecase) materialize(ctx context.Context, template *domain.SheetTemplate, now time.Time) (bool, error) {
+	if template.NextRunAt == nil {
+		return false, nil
+	}
+	occurrence := *template.NextRunAt
+
+	host, err := u.users.GetByID(ctx, template.HostUserID)
+	if err != nil && !errors.Is(err, port.ErrUserNotFound) {
+		return false, err
+	}
+	if host == nil || host.IsDeleted() || host.ErasedAt != nil {
+		return false, u.pause(ctx, template.ID)
+	}
+
+	var closesAt *time.Time
+	if template.CloseAfterMinutes > 0 {
+		cutoff := occurrence.Add(time.Duration(template.CloseAfterMinutes) * time.Minute)
+		closesAt = &cutoff
+	}
+
+	var sheetID string
+	if host.CanLogin() && (closesAt == nil || closesAt.After(now)) {
+		req, err := sheetRequest(template, occurrence, closesAt)
+		if err != nil {
+			return false, err
+		}
+
+		hostCtx := interceptor.WithPrincipal(ctx, &domain.Principal{UserID: template.HostUserID})
+		hostCtx = interceptor.WithIdempotencyKey(hostCtx, "CreateSheet", template.OccurrenceKey(occurrence))
+		created, err := u.sheets.CreateSheet(hostCtx, req)
+		if err != nil {
+			return false, err
+		}
+		sheetID = created.ID
+	}
+
+	// Only the run that saw this occurrence may move the template on
+	_, err = u.templates.Update(ctx, template.ID, func(cur *domain.SheetTemplate) error {
+		if cur.NextRunAt == nil || !cur.NextRunAt.Equal(occurrence) {
+			return nil
+		}
+		if sheetID != "" {
+			cur.LastSheetID = sheetID
+		}
+		after := now
+		if occurrence.After(after) {
+			after = occurrence
+		}
+		return cur.Reschedule(after)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return sheetID != "", nil
+}
+
+// pause stops a template whose host is gone from creating sheets as them
+func (u *usecase) pause(ctx context.Context, id string) error {
+	_, err := u.templates.Update(ctx, id, func(cur *domain.SheetTemplate) error {
+		cur.Paused = true
+		return cur.Reschedule(time.Time{})
+	})
+	return err
+}
+
+// sheetRequest builds the CreateSheet request of template's occurrence at
+func sheetRequest(template *domain.SheetTemplate, at time.Time, closesAt *time.Time) (*sheet.CreateSheetReq, error) {
+	loc, err := template.Recurrence.Location()
+	if err != nil {
+		return nil, err
+	}
+
+	fee := template.DeliveryFee
+	req := &sheet.CreateSheetReq{
+		Name:        fmt.Sprintf("%s %s", template.Name, at.In(loc).Format("2006-01-02")),
+		Description: template.Description,
+		DeliveryFee: &fee,
+		Discount:    template.Discount,
+		FeeSplit:    template.FeeSplit,
+		MemberIDs:   template.MemberIDs,
+		MenuItems:   make([]sheet.MenuItemReq, 0, len(template.MenuItems)),
+		ClosesAt:    closesAt,
+	}
+	for _, item := range template.MenuItems {
+		req.MenuItems = append(req.MenuItems, menuItemReq(item))
+	}
+	return req, nil
+}
+
+// menuItemReq turns a saved menu item back into a request, keeping its IDs
+// so orders on successive sheets refer to the same items
+func menuItemReq(item domain.MenuItem) sheet.MenuItemReq {
+	req := sheet.MenuItemReq{
+		ID:       item.ID,
+		Name:     item.Name,
+		Active:   item.Active,
+		Price:    item.Price,
+		Currency: item.Currency,
+	}
+
+	for _, grp := range item.OptionGroups {
+		grpReq := sheet.MenuOptionGroupReq{
+			ID:          grp.ID,
+			Name:        grp.Name,
+			Required:    grp.Required,
+			MultiSelect: grp.Type == domain.GroupMulti,
+			MinSelect:   int32(grp.MinSelect),
+			MaxSelect:   int32(grp.MaxSelect),
+		}
+		for _, opt := range grp.Options {
+			grpReq.Options = append(grpReq.Options, sheet.MenuOptionReq{
+				ID:          opt.ID,
+				Name:        opt.Name,
+				Price:       opt.Price,
+				Active:      opt.Active,
+				MaxQuantity: opt.MaxQuantity,
+				PerOrder:    opt.Per == domain.PerOrder,
+			})
+		}
+		sort.Slice(grpReq.Options, func(i, j int) bool { return grpReq.Options[i].ID < grpReq.Options[j].ID })
+		req.OptionGroups = append(req.OptionGroups, grpReq)
+	}
+	sort.Slice(req.OptionGroups, func(i, j int) bool { return req.OptionGroups[i].ID < req.OptionGroups[j].ID })
+
+	return req
+}
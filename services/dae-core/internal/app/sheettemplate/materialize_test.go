@@ -0,0 +1,225 @@
+package sheettemplate
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/sheet"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/grpc/interceptor"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+)
+
+// fakeTemplates keeps templates in memory. failUpdates fails that many
+// Update calls before succeeding.
+type fakeTemplates struct {
+	port.SheetTemplateRepo
+	byID        map[string]*domain.SheetTemplate
+	failUpdates int
+}
+
+func (r *fakeTemplates) ListDue(_ context.Context, now time.Time, limit int) ([]*domain.SheetTemplate, error) {
+	var due []*domain.SheetTemplate
+	for _, t := range r.byID {
+		if t.NextRunAt != nil && !t.NextRunAt.After(now) && len(due) < limit {
+			copied := *t
+			due = append(due, &copied)
+		}
+	}
+	return due, nil
+}
+
+func (r *fakeTemplates) Update(_ context.Context, id string, fn func(*domain.SheetTemplate) error) (*domain.SheetTemplate, error) {
+	if r.failUpdates > 0 {
+		r.failUpdates--
+		return nil, errors.New("update aborted")
+	}
+	copied := *r.byID[id]
+	if err := fn(&copied); err != nil {
+		return nil, err
+	}
+	r.byID[id] = &copied
+	return &copied, nil
+}
+
+// fakeSheets creates one sheet per idempotency key, as the sheet usecase does
+type fakeSheets struct {
+	sheet.Usecase
+	byKey map[string]*domain.Sheet
+	calls []*sheet.CreateSheetReq
+}
+
+func (s *fakeSheets) CreateSheet(ctx context.Context, req *sheet.CreateSheetReq) (*domain.Sheet, error) {
+	s.calls = append(s.calls, req)
+	hostUserID, err := interceptor.ActingUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	key := interceptor.GetOrCreateIdempotencyKeyWithHash(ctx, hostUserID)
+	if created, ok := s.byKey[key]; ok {
+		return created, nil
+	}
+	created := &domain.Sheet{ID: key, Name: req.Name, HostUserID: hostUserID, ClosesAt: req.ClosesAt}
+	s.byKey[key] = created
+	return created, nil
+}
+
+// fakeUsers serves the users in byID and reports the rest as not found
+type fakeUsers struct {
+	port.UsersRepo
+	byID map[string]*domain.User
+}
+
+func (r *fakeUsers) GetByID(_ context.Context, id string) (*domain.User, error) {
+	u, ok := r.byID[id]
+	if !ok {
+		return nil, port.ErrUserNotFound
+	}
+	return u, nil
+}
+
+func newFixture(t *testing.T, nextRunAt time.Time) (*usecase, *fakeTemplates, *fakeSheets) {
+	t.Helper()
+	template := &domain.SheetTemplate{
+		ID:          "friday-lunch",
+		Name:        "Team lunch",
+		HostUserID:  "host-1",
+		MemberIDs:   []string{"host-1", "user-2"},
+		DeliveryFee: domain.Money{CurrencyCode: "VND", Amount: 15000},
+		MenuItems: []domain.MenuItem{{
+			ID: "pho", Name: "Pho", Active: true, Price: 50000, Currency: "VND",
+			OptionGroups: map[string]domain.OptionGroup{
+				"size": {ID: "size", Name: "Size", Type: domain.GroupSingle, Options: map[string]domain.Option{
+					"large": {ID: "large", Name: "Large", Price: 10000, Per: domain.PerUnit, Active: true},
+					"box":   {ID: "box", Name: "Box", Price: 2000, Per: domain.PerOrder, Active: true},
+				}},
+			},
+		}},
+		Recurrence:        domain.Recurrence{Frequency: domain.RecurWeekly, TimeOfDay: "11:00", Weekdays: []time.Weekday{time.Friday}, TimeZone: "Asia/Ho_Chi_Minh"},
+		CloseAfterMinutes: 90,
+		NextRunAt:         &nextRunAt,
+	}
+
+	templates := &fakeTemplates{byID: map[string]*domain.SheetTemplate{template.ID: template}}
+	sheets := &fakeSheets{byKey: map[string]*domain.Sheet{}}
+	users := &fakeUsers{byID: map[string]*domain.User{"host-1": {ID: "host-1", Status: domain.UserStatusActive}}}
+	return NewUsecase(templates, nil, users, sheets).(*usecase), templates, sheets
+}
+
+func TestMaterializeDueCreatesOneSheetPerOccurrence(t *testing.T) {
+	// Friday 2026-03-06 11:00 in Ho Chi Minh City
+	occurrence := time.Date(2026, 3, 6, 4, 0, 0, 0, time.UTC)
+	uc, templates, sheets := newFixture(t, occurrence)
+	now := occurrence.Add(30 * time.Second)
+
+	// The first attempt creates the sheet but fails to move the template on
+	templates.failUpdates = 1
+	if n, err := uc.MaterializeDue(context.Background(), now); err == nil || n != 0 {
+		t.Fatalf("MaterializeDue = %d, %v; want the update failure", n, err)
+	}
+	if n, err := uc.MaterializeDue(context.Background(), now); err != nil || n != 1 {
+		t.Fatalf("MaterializeDue = %d, %v; want 1 sheet", n, err)
+	}
+	if n, err := uc.MaterializeDue(context.Background(), now.Add(time.Minute)); err != nil || n != 0 {
+		t.Fatalf("MaterializeDue = %d, %v; want nothing due", n, err)
+	}
+
+	if len(sheets.calls) != 2 || len(sheets.byKey) != 1 {
+		t.Fatalf("CreateSheet calls = %d, sheets = %d; want the retry to reuse one sheet", len(sheets.calls), len(sheets.byKey))
+	}
+	// Stored keys are scoped to the method and the host acting for the template
+	created := sheets.byKey["CreateSheet:host-1:"+templates.byID["friday-lunch"].OccurrenceKey(occurrence)]
+	if created == nil {
+		t.Fatalf("sheets = %v, want one keyed by the occurrence", sheets.byKey)
+	}
+	if created.Name != "Team lunch 2026-03-06" || created.HostUserID != "host-1" {
+		t.Fatalf("sheet = %+v, want the host's Team lunch 2026-03-06", created)
+	}
+	if want := occurrence.Add(90 * time.Minute); !created.ClosesAt.Equal(want) {
+		t.Fatalf("ClosesAt = %v, want %v", created.ClosesAt, want)
+	}
+
+	req := sheets.calls[0]
+	if len(req.MenuItems) != 1 || len(req.MenuItems[0].OptionGroups) != 1 {
+		t.Fatalf("menu = %+v, want the template's item", req.MenuItems)
+	}
+	if opts := req.MenuItems[0].OptionGroups[0].Options; len(opts) != 2 || opts[0].ID != "box" || !opts[0].PerOrder || opts[1].PerOrder {
+		t.Fatalf("options = %+v, want box per order and large per unit", opts)
+	}
+
+	template := templates.byID["friday-lunch"]
+	if want := occurrence.AddDate(0, 0, 7); template.NextRunAt == nil || !template.NextRunAt.Equal(want) {
+		t.Fatalf("NextRunAt = %v, want %v", template.NextRunAt, want)
+	}
+	if template.LastSheetID != created.ID {
+		t.Fatalf("LastSheetID = %q, want %q", template.LastSheetID, created.ID)
+	}
+}
+
+func TestMaterializeDueSkipsOccurrencesPastTheirCutoff(t *testing.T) {
+	occurrence := time.Date(2026, 3, 6, 4, 0, 0, 0, time.UTC)
+	uc, templates, sheets := newFixture(t, occurrence)
+
+	// The service was down for two weeks and a half
+	now := occurrence.AddDate(0, 0, 17)
+	if n, err := uc.MaterializeDue(context.Background(), now); err != nil || n != 0 {
+		t.Fatalf("MaterializeDue = %d, %v; want the stale occurrence skipped", n, err)
+	}
+	if len(sheets.calls) != 0 {
+		t.Fatalf("CreateSheet called %d times, want none", len(sheets.calls))
+	}
+
+	// Missed occurrences are not replayed one by one
+	template := templates.byID["friday-lunch"]
+	if want := occurrence.AddDate(0, 0, 21); template.NextRunAt == nil || !template.NextRunAt.Equal(want) {
+		t.Fatalf("NextRunAt = %v, want %v", template.NextRunAt, want)
+	}
+}
+
+func TestMaterializeDueChecksTheHost(t *testing.T) {
+	occurrence := time.Date(2026, 3, 6, 4, 0, 0, 0, time.UTC)
+	now := occurrence.Add(30 * time.Second)
+	erasedAt := occurrence.AddDate(0, -1, 0)
+
+	tests := map[string]struct {
+		host       *domain.User // nil when the host no longer exists
+		wantPaused bool
+	}{
+		"deleted":   {host: &domain.User{ID: "host-1", Status: domain.UserStatusDeleted}, wantPaused: true},
+		"erased":    {host: &domain.User{ID: "host-1", Status: domain.UserStatusDeleted, ErasedAt: &erasedAt}, wantPaused: true},
+		"missing":   {wantPaused: true},
+		"suspended": {host: &domain.User{ID: "host-1", Status: domain.UserStatusSuspended}},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			uc, templates, sheets := newFixture(t, occurrence)
+			users := uc.users.(*fakeUsers)
+			delete(users.byID, "host-1")
+			if tc.host != nil {
+				users.byID["host-1"] = tc.host
+			}
+
+			if n, err := uc.MaterializeDue(context.Background(), now); err != nil || n != 0 {
+				t.Fatalf("MaterializeDue = %d, %v; want no sheet", n, err)
+			}
+			if len(sheets.calls) != 0 {
+				t.Fatalf("CreateSheet called %d times as a host who cannot sign in", len(sheets.calls))
+			}
+
+			template := templates.byID["friday-lunch"]
+			if template.Paused != tc.wantPaused {
+				t.Fatalf("Paused = %v, want %v", template.Paused, tc.wantPaused)
+			}
+			if tc.wantPaused && template.NextRunAt != nil {
+				t.Fatalf("NextRunAt = %v, want nil on a paused template", template.NextRunAt)
+			}
+			// A suspended host skips the occurrence and keeps the schedule
+			if !tc.wantPaused && (template.NextRunAt == nil || !template.NextRunAt.Equal(occurrence.AddDate(0, 0, 7))) {
+				t.Fatalf("NextRunAt = %v, want the next Friday", template.NextRunAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package sheettemplate
+
+import (
+	"context"
+	"errors"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/authz"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"github.com/deni12345/dae-services/libs/apperror"
+)
+
+// GetSheetTemplate returns a template to its host or an admin
+func (u *usecase) GetSheetTemplate(ctx context.Context, id string) (*domain.SheetTemplate, error) {
+	ctx, span := tracer.Start(ctx, "SheetTemplateUC.GetSheetTemplate")
+	defer span.End()
+
+	if id == "" {
+		err := apperror.InvalidInput("template_id is required")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	template, err := u.templates.GetByID(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		return nil, mapNotFound(err)
+	}
+	if err := authz.RequireSheetTemplateHost(ctx, template); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	return template, nil
+}
+
+// ListSheetTemplates pages through the templates a user hosts
+func (u *usecase) ListSheetTemplates(ctx context.Context, req *ListSheetTemplatesReq) (*ListSheetTemplatesResp, error) {
+	ctx, span := tracer.Start(ctx, "SheetTemplateUC.ListSheetTemplates")
+	defer span.End()
+
+	caller, err := authz.Caller(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	hostUserID := req.HostUserID
+	if hostUserID == "" {
+		hostUserID = caller.UserID
+	}
+	if err := authz.RequireSelfOrAdmin(ctx, hostUserID); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	resp, err := u.templates.List(ctx, port.ListSheetTemplatesQuery{
+		HostUserID: hostUserID,
+		Limit:      req.Limit,
+		Cursor:     req.Cursor,
+	})
+	if errors.Is(err, port.ErrInvalidSheetTemplateCursor) {
+		err = ErrInvalidCursor
+	}
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return &ListSheetTemplatesResp{Templates: resp.Templates, NextCursor: resp.NextCursor}, nil
+}
@@ -0,0 +1,133 @@
+package sheettemplate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/authz"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/grpc/interceptor"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"github.com/deni12345/dae-services/libs/apperror"
+)
+
+func (r *fakeTemplates) Create(_ context.Context, t *domain.SheetTemplate) (*domain.SheetTemplate, error) {
+	copied := *t
+	r.byID[t.ID] = &copied
+	return t, nil
+}
+
+// templateSheets serves one sheet and its menu to CreateSheetTemplate
+type templateSheets struct {
+	port.SheetRepo
+	sheet *domain.Sheet
+	items []*domain.MenuItem
+}
+
+func (r *templateSheets) GetByID(_ context.Context, id string) (*domain.Sheet, error) {
+	if id != r.sheet.ID {
+		return nil, ErrNotFound
+	}
+	return r.sheet, nil
+}
+
+func (r *templateSheets) GetMenuItems(context.Context, string) ([]*domain.MenuItem, error) {
+	return r.items, nil
+}
+
+func asUser(userID string) context.Context {
+	return interceptor.WithPrincipal(context.Background(), &domain.Principal{UserID: userID})
+}
+
+var dailyLunch = domain.Recurrence{Frequency: domain.RecurDaily, TimeOfDay: "11:00", TimeZone: "Asia/Ho_Chi_Minh"}
+
+func TestCreateSheetTemplate(t *testing.T) {
+	templates := &fakeTemplates{byID: map[string]*domain.SheetTemplate{}}
+	sheets := &templateSheets{
+		sheet: &domain.Sheet{ID: "s1", Name: "Lunch", HostUserID: "host", MemberIDs: []string{"host", "u2"}},
+		items: []*domain.MenuItem{{ID: "pho", Name: "Pho", Price: 50000, Currency: "VND", Active: true}},
+	}
+	uc := NewUsecase(templates, sheets, nil, nil)
+
+	refusals := map[string]struct {
+		ctx  context.Context
+		req  *CreateSheetTemplateReq
+		code apperror.Code
+	}{
+		"non-host":           {asUser("guest"), &CreateSheetTemplateReq{SheetID: "s1", Recurrence: dailyLunch}, apperror.CodeForbidden},
+		"invalid recurrence": {asUser("host"), &CreateSheetTemplateReq{SheetID: "s1", Recurrence: domain.Recurrence{Frequency: domain.RecurWeekly, TimeOfDay: "11:00"}}, apperror.CodeInvalidInput},
+		"negative cutoff":    {asUser("host"), &CreateSheetTemplateReq{SheetID: "s1", Recurrence: dailyLunch, CloseAfterMinutes: -1}, apperror.CodeInvalidInput},
+	}
+	for name, tc := range refusals {
+		if _, err := uc.CreateSheetTemplate(tc.ctx, tc.req); apperror.GetCode(err) != tc.code {
+			t.Errorf("%s: err = %v, want code %v", name, err, tc.code)
+		}
+	}
+	if len(templates.byID) != 0 {
+		t.Fatalf("refused requests stored templates: %v", templates.byID)
+	}
+
+	before := time.Now()
+	created, err := uc.CreateSheetTemplate(asUser("host"), &CreateSheetTemplateReq{SheetID: "s1", Recurrence: dailyLunch, CloseAfterMinutes: 90})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if created.Name != "Lunch" || created.HostUserID != "host" || len(created.MemberIDs) != 2 || len(created.MenuItems) != 1 {
+		t.Fatalf("template = %+v, want a copy of the sheet", created)
+	}
+	if created.NextRunAt == nil || !created.NextRunAt.After(before) || created.NextRunAt.After(before.Add(24*time.Hour)) {
+		t.Fatalf("NextRunAt = %v, want the next 11:00", created.NextRunAt)
+	}
+}
+
+func TestUpdateSheetTemplate(t *testing.T) {
+	next := time.Now().Add(time.Hour).UTC()
+	templates := &fakeTemplates{byID: map[string]*domain.SheetTemplate{
+		"t1": {ID: "t1", Name: "Lunch", HostUserID: "host", Recurrence: dailyLunch, NextRunAt: &next},
+	}}
+	uc := NewUsecase(templates, nil, nil, nil)
+	boolPtr := func(b bool) *bool { return &b }
+	strPtr := func(s string) *string { return &s }
+
+	if _, err := uc.UpdateSheetTemplate(asUser("guest"), &UpdateSheetTemplateReq{ID: "t1", Paused: boolPtr(true)}); err != authz.ErrNotTemplateHost {
+		t.Fatalf("non-host: err = %v, want ErrNotTemplateHost", err)
+	}
+	if templates.byID["t1"].Paused {
+		t.Fatal("non-host paused the template")
+	}
+
+	// Other fields leave the schedule alone
+	renamed, err := uc.UpdateSheetTemplate(asUser("host"), &UpdateSheetTemplateReq{ID: "t1", Name: strPtr("Team lunch")})
+	if err != nil || renamed.Name != "Team lunch" || !renamed.NextRunAt.Equal(next) {
+		t.Fatalf("rename = %+v, %v, want the same NextRunAt", renamed, err)
+	}
+
+	paused, err := uc.UpdateSheetTemplate(asUser("host"), &UpdateSheetTemplateReq{ID: "t1", Paused: boolPtr(true)})
+	if err != nil || !paused.Paused || paused.NextRunAt != nil {
+		t.Fatalf("pause = %+v, %v, want no next run", paused, err)
+	}
+
+	before := time.Now()
+	resumed, err := uc.UpdateSheetTemplate(asUser("host"), &UpdateSheetTemplateReq{ID: "t1", Paused: boolPtr(false)})
+	if err != nil || resumed.Paused || resumed.NextRunAt == nil || !resumed.NextRunAt.After(before) {
+		t.Fatalf("resume = %+v, %v, want the next run after now", resumed, err)
+	}
+
+	weekly := domain.Recurrence{Frequency: domain.RecurWeekly, TimeOfDay: "11:00", Weekdays: []time.Weekday{time.Friday}, TimeZone: "Asia/Ho_Chi_Minh"}
+	moved, err := uc.UpdateSheetTemplate(asUser("host"), &UpdateSheetTemplateReq{ID: "t1", Recurrence: &weekly})
+	if err != nil {
+		t.Fatalf("change recurrence: %v", err)
+	}
+	loc, _ := time.LoadLocation("Asia/Ho_Chi_Minh")
+	if at := moved.NextRunAt.In(loc); at.Weekday() != time.Friday || at.Hour() != 11 {
+		t.Fatalf("NextRunAt = %v, want a Friday at 11:00", at)
+	}
+
+	if _, err := uc.UpdateSheetTemplate(asUser("host"), &UpdateSheetTemplateReq{ID: "t1", Recurrence: &domain.Recurrence{Frequency: domain.RecurCron, Cron: "nonsense"}}); apperror.GetCode(err) != apperror.CodeInvalidInput {
+		t.Fatalf("invalid recurrence: err = %v, want invalid input", err)
+	}
+	if !templates.byID["t1"].NextRunAt.Equal(*moved.NextRunAt) {
+		t.Fatal("invalid recurrence changed the stored schedule")
+	}
+}
@@ -0,0 +1,91 @@
+package sheettemplate
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/authz"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/libs/apperror"
+)
+
+// UpdateSheetTemplate changes a template. Changing the recurrence or
+// resuming a paused template schedules the next occurrence from now.
+func (u *usecase) UpdateSheetTemplate(ctx context.Context, req *UpdateSheetTemplateReq) (*domain.SheetTemplate, error) {
+	ctx, span := tracer.Start(ctx, "SheetTemplateUC.UpdateSheetTemplate")
+	defer span.End()
+
+	if req.ID == "" {
+		err := apperror.InvalidInput("template_id is required")
+		span.RecordError(err)
+		return nil, err
+	}
+	if req.Name != nil && strings.TrimSpace(*req.Name) == "" {
+		err := apperror.InvalidInput("name must not be empty")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	updated, err := u.templates.Update(ctx, req.ID, func(template *domain.SheetTemplate) error {
+		if err := authz.RequireSheetTemplateHost(ctx, template); err != nil {
+			return err
+		}
+
+		reschedule := false
+		if req.Name != nil {
+			template.Name = strings.TrimSpace(*req.Name)
+		}
+		if req.Description != nil {
+			template.Description = *req.Description
+		}
+		if req.DeliveryFee != nil {
+			template.DeliveryFee = *req.DeliveryFee
+		}
+		if req.MemberIDs != nil {
+			template.MemberIDs = req.MemberIDs
+		}
+		if req.Recurrence != nil {
+			template.Recurrence = *req.Recurrence
+			reschedule = true
+		}
+		if req.CloseAfterMinutes != nil {
+			template.CloseAfterMinutes = *req.CloseAfterMinutes
+		}
+		if req.Paused != nil && *req.Paused != template.Paused {
+			template.Paused = *req.Paused
+			reschedule = true
+		}
+
+		if err := validateSchedule(template.Recurrence, template.CloseAfterMinutes); err != nil {
+			return err
+		}
+		if reschedule {
+			return recurrenceError(template.Reschedule(time.Now()))
+		}
+		return nil
+	})
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, mapNotFound(err)
+	}
+	return updated, nil
+}
+
+// DeleteSheetTemplate deletes a template. Sheets it already created stay.
+func (u *usecase) DeleteSheetTemplate(ctx context.Context, id string) error {
+	ctx, span := tracer.Start(ctx, "SheetTemplateUC.DeleteSheetTemplate")
+	defer span.End()
+
+	if _, err := u.GetSheetTemplate(ctx, id); err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	if err := u.templates.Delete(ctx, id); err != nil {
+		span.RecordError(err)
+		return mapNotFound(err)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package sheettemplate
+
+import (
+	"context"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/sheet"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"go.opentelemetry.io/otel"
+)
+
+// Usecase manages recurring sheet templates
+type Usecase interface {
+	// Commands
+	CreateSheetTemplate(ctx context.Context, req *CreateSheetTemplateReq) (*domain.SheetTemplate, error)
+	UpdateSheetTemplate(ctx context.Context, req *UpdateSheetTemplateReq) (*domain.SheetTemplate, error)
+	DeleteSheetTemplate(ctx context.Context, id string) error
+	// MaterializeDue creates the sheets of templates due at now
+	MaterializeDue(ctx context.Context, now time.Time) (int, error)
+
+	// Queries
+	GetSheetTemplate(ctx context.Context, id string) (*domain.SheetTemplate, error)
+	ListSheetTemplates(ctx context.Context, req *ListSheetTemplatesReq) (*ListSheetTemplatesResp, error)
+}
+
+type usecase struct {
+	templates port.SheetTemplateRepo
+	sheetRepo port.SheetRepo
+	users     port.UsersRepo
+	sheets    sheet.Usecase
+}
+
+// NewUsecase creates a new sheet template usecase. Sheets are materialized
+// through sheets so they go through the same checks as any other sheet;
+// users is where the host acting for a template is looked up.
+func NewUsecase(templates port.SheetTemplateRepo, sheetRepo port.SheetRepo, users port.UsersRepo, sheets sheet.Usecase) Usecase {
+	return &usecase{
+		templates: templates,
+		sheetRepo: sheetRepo,
+		users:     users,
+		sheets:    sheets,
+	}
+}
+
+var tracer = otel.Tracer("usecase/sheettemplate")
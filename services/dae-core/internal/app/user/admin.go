@@ -3,6 +3,7 @@ package user
 import (
 	"context"
 
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/authz"
 	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
 	"github.com/deni12345/dae-services/libs/apperror"
 )
@@ -26,6 +27,17 @@ func (u *usecase) AdminSetUserRoles(ctx context.Context, req *AdminSetUserRolesR
 		}
 	}
 
+	// Only superadmins hand out admin rights
+	for _, r := range req.Roles {
+		if r == domain.RoleAdmin || r == domain.RoleSuperAdmin {
+			if err := authz.RequireSuperAdmin(ctx); err != nil {
+				span.RecordError(err)
+				return nil, err
+			}
+			break
+		}
+	}
+
 	user, err := u.userRepo.SetRoles(ctx, req.UserID, req.Roles)
 	if err != nil {
 		span.RecordError(err)
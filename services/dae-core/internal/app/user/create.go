@@ -39,6 +39,25 @@ func (uc *usecase) CreateUser(ctx context.Context, req *CreateUserReq) (*domain.
 		return nil, err
 	}
 
+	// Local accounts sign in with a password, hashed with the preferred algorithm
+	var passwordHash, passwordAlgo string
+	if req.Provider == domain.IdentityProviderLocal {
+		if req.Password == "" {
+			err := ErrPasswordRequired
+			span.RecordError(err)
+			return nil, err
+		}
+		if err := validatePassword(req.Password); err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+		passwordHash, passwordAlgo, err = uc.hasher.Hash(req.Password)
+		if err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+	}
+
 	// Create user
 	createReq := port.CreateUserRequest{
 		Email:       req.Email,
@@ -48,7 +67,9 @@ func (uc *usecase) CreateUser(ctx context.Context, req *CreateUserReq) (*domain.
 		Phone:       req.Phone,
 		Provider:    req.Provider,
 		Subject:     req.Subject,
-		Password:    req.Password,
+
+		PasswordHash: passwordHash,
+		PasswordAlgo: passwordAlgo,
 	}
 
 	user, err := uc.userRepo.Create(ctx, createReq)
@@ -0,0 +1,109 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/authz"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"github.com/deni12345/dae-services/libs/apperror"
+)
+
+// erasureBatch bounds the users erased by one EraseDeletedUsers call
+const erasureBatch = 50
+
+// DeleteUser soft-deletes an account. The user can no longer sign in and
+// their email is free for a new signup; personal data stays until the
+// erasure job runs.
+func (u *usecase) DeleteUser(ctx context.Context, userID string) (*domain.User, error) {
+	ctx, span := tracer.Start(ctx, "UserUC.DeleteUser")
+	defer span.End()
+
+	if userID == "" {
+		err := apperror.InvalidInput("user_id is required")
+		span.RecordError(err)
+		return nil, err
+	}
+	if err := authz.RequireSelfOrAdmin(ctx, userID); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	user, err := u.userRepo.SoftDelete(ctx, userID)
+	if errors.Is(err, port.ErrUserNotFound) {
+		err = ErrNotFound
+	}
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// ExportUserData returns everything stored about the user as a JSON document
+func (u *usecase) ExportUserData(ctx context.Context, userID string) (*domain.UserDataExport, []byte, error) {
+	ctx, span := tracer.Start(ctx, "UserUC.ExportUserData")
+	defer span.End()
+
+	if userID == "" {
+		err := apperror.InvalidInput("user_id is required")
+		span.RecordError(err)
+		return nil, nil, err
+	}
+	if err := authz.RequireSelfOrAdmin(ctx, userID); err != nil {
+		span.RecordError(err)
+		return nil, nil, err
+	}
+
+	export, err := u.userData.Export(ctx, userID)
+	if errors.Is(err, port.ErrUserNotFound) {
+		err = ErrNotFound
+	}
+	if err != nil {
+		span.RecordError(err)
+		return nil, nil, err
+	}
+
+	archive, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		span.RecordError(err)
+		return nil, nil, fmt.Errorf("marshal user data: %w", err)
+	}
+
+	return export, archive, nil
+}
+
+// EraseDeletedUsers erases users soft-deleted before deletedBefore and
+// returns how many were erased. A failed user does not stop the others; it
+// is picked up again by the next call.
+func (u *usecase) EraseDeletedUsers(ctx context.Context, deletedBefore time.Time) (int, error) {
+	ctx, span := tracer.Start(ctx, "UserUC.EraseDeletedUsers")
+	defer span.End()
+
+	ids, err := u.userData.ListErasable(ctx, deletedBefore, erasureBatch)
+	if err != nil {
+		span.RecordError(err)
+		return 0, err
+	}
+
+	var errs []error
+	erased := 0
+	for _, id := range ids {
+		if err := u.userData.Erase(ctx, id); err != nil {
+			errs = append(errs, fmt.Errorf("erase user %s: %w", id, err))
+			continue
+		}
+		erased++
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		span.RecordError(err)
+		return erased, err
+	}
+	return erased, nil
+}
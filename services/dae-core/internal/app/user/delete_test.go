@@ -0,0 +1,96 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/grpc/interceptor"
+	"github.com/deni12345/dae-services/libs/apperror"
+)
+
+// fakeUserData exports the fake repo's users and fails to erase the IDs in
+// failing
+type fakeUserData struct {
+	users    *fakeUserRepo
+	erasable []string
+	failing  map[string]bool
+	erased   []string
+}
+
+func (d *fakeUserData) Export(ctx context.Context, userID string) (*domain.UserDataExport, error) {
+	user, err := d.users.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.UserDataExport{
+		ExportedAt: time.Now(),
+		User:       user,
+		Orders:     []*domain.Order{{ID: "o1", UserID: userID, Note: "no onions"}},
+	}, nil
+}
+
+func (d *fakeUserData) Erase(_ context.Context, userID string) error {
+	if d.failing[userID] {
+		return errors.New("erase failed")
+	}
+	d.erased = append(d.erased, userID)
+	return nil
+}
+
+func (d *fakeUserData) ListErasable(context.Context, time.Time, int) ([]string, error) {
+	return d.erasable, nil
+}
+
+func TestExportUserData(t *testing.T) {
+	uc, users, _ := newPasswordTestUsecase(t)
+	uc.userData = &fakeUserData{users: users}
+
+	other := interceptor.WithPrincipal(context.Background(), &domain.Principal{UserID: "u2"})
+	if _, _, err := uc.ExportUserData(other, "u1"); apperror.GetCode(err) != apperror.CodeForbidden {
+		t.Fatalf("other user: code = %v, want Forbidden", apperror.GetCode(err))
+	}
+
+	self := interceptor.WithPrincipal(context.Background(), &domain.Principal{UserID: "u1"})
+	_, archive, err := uc.ExportUserData(self, "u1")
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
+
+	var got map[string]any
+	if err := json.Unmarshal(archive, &got); err != nil {
+		t.Fatalf("archive is not JSON: %v", err)
+	}
+	user := got["user"].(map[string]any)
+	if user["email_normalized"] != "ann@example.com" {
+		t.Fatalf("user = %v", user)
+	}
+	if _, ok := user["password_hash"]; ok {
+		t.Fatal("archive contains the password hash")
+	}
+	if orders := got["orders"].([]any); len(orders) != 1 {
+		t.Fatalf("orders = %v", orders)
+	}
+
+	admin := interceptor.WithPrincipal(context.Background(), &domain.Principal{UserID: "a1", Roles: []domain.Role{domain.RoleAdmin}})
+	if _, _, err := uc.ExportUserData(admin, "missing"); err != ErrNotFound {
+		t.Fatalf("missing user: err = %v, want ErrNotFound", err)
+	}
+}
+
+func TestEraseDeletedUsersContinuesPastFailures(t *testing.T) {
+	uc, users, _ := newPasswordTestUsecase(t)
+	data := &fakeUserData{users: users, erasable: []string{"u1", "u2", "u3"}, failing: map[string]bool{"u2": true}}
+	uc.userData = data
+
+	erased, err := uc.EraseDeletedUsers(context.Background(), time.Now())
+	if err == nil {
+		t.Fatal("err = nil, want the failure for u2")
+	}
+	if erased != 2 || len(data.erased) != 2 || data.erased[1] != "u3" {
+		t.Fatalf("erased = %d %v, want u1 and u3", erased, data.erased)
+	}
+}
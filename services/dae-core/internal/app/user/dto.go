@@ -1,6 +1,8 @@
 package user
 
 import (
+	"time"
+
 	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
 )
 
@@ -36,14 +38,67 @@ type AdminSetUserDisabledReq struct {
 	IsDisabled bool
 }
 
+type RecordLoginReq struct {
+	UserID string
+}
+
+// LinkIdentityReq links a login method to UserID. Subject is ignored for the
+// local provider, which uses the account email and needs Password.
+type LinkIdentityReq struct {
+	UserID   string
+	Provider domain.IdentityProvider
+	Subject  string
+	Email    string
+	Password string
+}
+
+type UnlinkIdentityReq struct {
+	UserID   string
+	Provider domain.IdentityProvider
+}
+
+type AuthenticateLocalReq struct {
+	Email    string
+	Password string
+}
+
+type ChangePasswordReq struct {
+	CurrentPassword string
+	NewPassword     string
+}
+
+type RequestPasswordResetReq struct {
+	Email string
+}
+
+// RequestPasswordResetResp carries the token for the caller to deliver. Token
+// is empty when the email has no password login, so callers cannot tell
+// whether an account exists.
+type RequestPasswordResetResp struct {
+	Token     string
+	ExpiresAt time.Time
+}
+
+type ConfirmPasswordResetReq struct {
+	Token       string
+	NewPassword string
+}
+
 // Query DTOs - for read operations
 
+type GetUserByIdentityReq struct {
+	Provider domain.IdentityProvider
+	Subject  string
+}
+
 type ListUsersReq struct {
 	PageSize        int32
 	Cursor          string
 	IncludeDisabled bool
 	Query           string
 	EmailExact      string
+	Role            domain.Role
+	Status          domain.UserStatus
 }
 
 type ListUsersResp struct {
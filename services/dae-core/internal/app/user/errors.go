@@ -7,6 +7,7 @@ var (
 	ErrInvalidArgument = apperror.InvalidInput("invalid argument")
 	ErrNotFound        = apperror.NotFound("user not found")
 	ErrInvalidRole     = apperror.InvalidInput("invalid role")
+	ErrInvalidCursor   = apperror.InvalidInput("invalid page cursor")
 
 	// Create user errors
 	ErrEmailRequired         = apperror.InvalidInput("email is required")
@@ -17,4 +18,19 @@ var (
 	ErrEmailAlreadyExists    = apperror.AlreadyExists("email already exists")
 	ErrIdentityAlreadyLinked = apperror.AlreadyExists("identity already linked to another user")
 	ErrInvalidInput          = apperror.InvalidInput("invalid input")
+
+	// Login errors
+	ErrIdentityNotFound = apperror.NotFound("identity is not linked to any user")
+	ErrLoginNotAllowed  = apperror.Forbidden("user is disabled or inactive")
+
+	// Identity link errors
+	ErrProviderAlreadyLinked = apperror.AlreadyExists("user already has a login for this provider")
+	ErrIdentityNotLinked     = apperror.NotFound("provider is not linked to this user")
+	ErrLastLoginMethod       = apperror.Conflict("cannot unlink the last login method")
+
+	// Password errors
+	ErrInvalidCredentials = apperror.Unauthorized("invalid email or password")
+	ErrWrongPassword      = apperror.Unauthorized("current password is incorrect")
+	ErrNoPassword         = apperror.InvalidInput("account has no password login")
+	ErrInvalidResetToken  = apperror.InvalidInput("password reset token is invalid or expired")
 )
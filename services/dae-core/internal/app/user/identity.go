@@ -0,0 +1,154 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/authz"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"github.com/deni12345/dae-services/libs/apperror"
+)
+
+// LinkIdentity adds a login method to an existing user. Only the gateway may
+// call it, after verifying the provider subject.
+func (uc *usecase) LinkIdentity(ctx context.Context, req *LinkIdentityReq) (*domain.UserIdentity, error) {
+	ctx, span := tracer.Start(ctx, "UserUC.LinkIdentity")
+	defer span.End()
+
+	if req.UserID == "" {
+		err := apperror.InvalidInput("user_id is required")
+		span.RecordError(err)
+		return nil, err
+	}
+	if req.Provider == "" {
+		err := ErrProviderRequired
+		span.RecordError(err)
+		return nil, err
+	}
+
+	linkReq := port.LinkIdentityRequest{
+		UserID:   req.UserID,
+		Provider: req.Provider,
+		Subject:  req.Subject,
+		Email:    req.Email,
+	}
+
+	// Local logins are keyed by the account email and need a password
+	if req.Provider == domain.IdentityProviderLocal {
+		user, err := uc.userRepo.GetByID(ctx, req.UserID)
+		if errors.Is(err, port.ErrUserNotFound) {
+			err = ErrNotFound
+		}
+		if err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+		if req.Password == "" {
+			err := ErrPasswordRequired
+			span.RecordError(err)
+			return nil, err
+		}
+		if err := validatePassword(req.Password); err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+		linkReq.PasswordHash, linkReq.PasswordAlgo, err = uc.hasher.Hash(req.Password)
+		if err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+		linkReq.Subject = user.EmailNormalized
+		linkReq.Email = user.Email
+	}
+	if linkReq.Subject == "" {
+		err := ErrSubjectRequired
+		span.RecordError(err)
+		return nil, err
+	}
+
+	identity, err := uc.userRepo.LinkIdentity(ctx, linkReq)
+	if err != nil {
+		err = identityError(err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return identity, nil
+}
+
+// UnlinkIdentity removes a login method from the user, keeping at least one
+func (uc *usecase) UnlinkIdentity(ctx context.Context, req *UnlinkIdentityReq) error {
+	ctx, span := tracer.Start(ctx, "UserUC.UnlinkIdentity")
+	defer span.End()
+
+	if req.UserID == "" {
+		err := apperror.InvalidInput("user_id is required")
+		span.RecordError(err)
+		return err
+	}
+	if req.Provider == "" {
+		err := ErrProviderRequired
+		span.RecordError(err)
+		return err
+	}
+	if err := authz.RequireSelfOrAdmin(ctx, req.UserID); err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	if err := uc.userRepo.UnlinkIdentity(ctx, req.UserID, req.Provider); err != nil {
+		err = identityError(err)
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
+
+// ListIdentities returns the login methods linked to the user
+func (uc *usecase) ListIdentities(ctx context.Context, userID string) ([]*domain.UserIdentity, error) {
+	ctx, span := tracer.Start(ctx, "UserUC.ListIdentities")
+	defer span.End()
+
+	if userID == "" {
+		err := apperror.InvalidInput("user_id is required")
+		span.RecordError(err)
+		return nil, err
+	}
+	if err := authz.RequireSelfOrAdmin(ctx, userID); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if _, err := uc.userRepo.GetByID(ctx, userID); err != nil {
+		err = identityError(err)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	identities, err := uc.userRepo.ListIdentities(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return identities, nil
+}
+
+// identityError maps repository identity errors to app errors
+func identityError(err error) error {
+	switch {
+	case errors.Is(err, port.ErrUserNotFound):
+		return ErrNotFound
+	case errors.Is(err, port.ErrIdentityTaken):
+		return ErrIdentityAlreadyLinked
+	case errors.Is(err, port.ErrProviderLinked):
+		return ErrProviderAlreadyLinked
+	case errors.Is(err, port.ErrIdentityNotLinked):
+		return ErrIdentityNotLinked
+	case errors.Is(err, port.ErrLastLoginMethod):
+		return ErrLastLoginMethod
+	}
+	return err
+}
@@ -0,0 +1,79 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/grpc/interceptor"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"github.com/deni12345/dae-services/libs/apperror"
+)
+
+// identityRepo records link requests and replays a canned unlink error
+type identityRepo struct {
+	*fakeUserRepo
+	linked    []port.LinkIdentityRequest
+	unlinkErr error
+}
+
+func (r *identityRepo) LinkIdentity(_ context.Context, req port.LinkIdentityRequest) (*domain.UserIdentity, error) {
+	r.linked = append(r.linked, req)
+	return &domain.UserIdentity{UserID: req.UserID, Provider: req.Provider, Subject: req.Subject}, nil
+}
+
+func (r *identityRepo) UnlinkIdentity(context.Context, string, domain.IdentityProvider) error {
+	return r.unlinkErr
+}
+
+func TestLinkLocalIdentityUsesAccountEmail(t *testing.T) {
+	uc, users, _ := newPasswordTestUsecase(t)
+	repo := &identityRepo{fakeUserRepo: users}
+	uc.userRepo = repo
+
+	_, err := uc.LinkIdentity(context.Background(), &LinkIdentityReq{UserID: "u1", Provider: domain.IdentityProviderLocal})
+	if err != ErrPasswordRequired {
+		t.Fatalf("err = %v, want ErrPasswordRequired", err)
+	}
+
+	identity, err := uc.LinkIdentity(context.Background(), &LinkIdentityReq{
+		UserID: "u1", Provider: domain.IdentityProviderLocal, Subject: "ignored", Password: "new-password",
+	})
+	if err != nil {
+		t.Fatalf("link: %v", err)
+	}
+	if identity.Subject != "ann@example.com" {
+		t.Fatalf("subject = %q, want the account email", identity.Subject)
+	}
+	if req := repo.linked[0]; req.PasswordHash == "" || req.PasswordAlgo != domain.PasswordAlgoArgon2id {
+		t.Fatalf("password not hashed: %+v", req)
+	}
+}
+
+func TestUnlinkIdentity(t *testing.T) {
+	uc, users, _ := newPasswordTestUsecase(t)
+	repo := &identityRepo{fakeUserRepo: users}
+	uc.userRepo = repo
+	self := interceptor.WithPrincipal(context.Background(), &domain.Principal{UserID: "u1"})
+	req := &UnlinkIdentityReq{UserID: "u1", Provider: domain.IdentityProviderGoogle}
+
+	other := interceptor.WithPrincipal(context.Background(), &domain.Principal{UserID: "u2"})
+	if err := uc.UnlinkIdentity(other, req); apperror.GetCode(err) != apperror.CodeForbidden {
+		t.Fatalf("other user: code = %v, want Forbidden", apperror.GetCode(err))
+	}
+
+	repo.unlinkErr = port.ErrLastLoginMethod
+	if err := uc.UnlinkIdentity(self, req); err != ErrLastLoginMethod {
+		t.Fatalf("last method: err = %v, want ErrLastLoginMethod", err)
+	}
+
+	repo.unlinkErr = port.ErrIdentityNotLinked
+	if err := uc.UnlinkIdentity(self, req); apperror.GetCode(err) != apperror.CodeNotFound {
+		t.Fatalf("not linked: code = %v, want NotFound", apperror.GetCode(err))
+	}
+
+	repo.unlinkErr = nil
+	if err := uc.UnlinkIdentity(self, req); err != nil {
+		t.Fatalf("unlink: %v", err)
+	}
+}
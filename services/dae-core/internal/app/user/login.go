@@ -0,0 +1,72 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"github.com/deni12345/dae-services/libs/apperror"
+)
+
+// GetUserByIdentity returns the user linked to a provider subject
+func (uc *usecase) GetUserByIdentity(ctx context.Context, req *GetUserByIdentityReq) (*domain.User, error) {
+	ctx, span := tracer.Start(ctx, "UserUC.GetUserByIdentity")
+	defer span.End()
+
+	if req.Provider == "" {
+		err := ErrProviderRequired
+		span.RecordError(err)
+		return nil, err
+	}
+	if req.Subject == "" {
+		err := ErrSubjectRequired
+		span.RecordError(err)
+		return nil, err
+	}
+
+	userID, err := uc.userRepo.GetUserIDByIdentity(ctx, req.Provider, req.Subject)
+	if errors.Is(err, port.ErrIdentityNotFound) {
+		err = ErrIdentityNotFound
+	}
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// RecordLogin stamps last_login_at, refusing users who may not log in
+func (uc *usecase) RecordLogin(ctx context.Context, req *RecordLoginReq) (*domain.User, error) {
+	ctx, span := tracer.Start(ctx, "UserUC.RecordLogin")
+	defer span.End()
+
+	if req.UserID == "" {
+		err := apperror.InvalidInput("user_id is required")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	user, err := uc.userRepo.Update(ctx, req.UserID, func(u *domain.User) error {
+		if !u.CanLogin() {
+			return ErrLoginNotAllowed
+		}
+		u.LastLoginAt = &now
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return user, nil
+}
@@ -0,0 +1,322 @@
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/grpc/interceptor"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"github.com/deni12345/dae-services/libs/apperror"
+	"github.com/deni12345/dae-services/libs/utils"
+)
+
+const passwordResetTTL = 30 * time.Minute
+
+// AuthenticateLocal checks an email and password, upgrading the stored hash
+// when it uses an outdated algorithm, and records the login
+func (uc *usecase) AuthenticateLocal(ctx context.Context, req *AuthenticateLocalReq) (*domain.User, error) {
+	ctx, span := tracer.Start(ctx, "UserUC.AuthenticateLocal")
+	defer span.End()
+
+	email := utils.NormalizeString(req.Email)
+	if email == "" || req.Password == "" {
+		err := ErrInvalidCredentials
+		span.RecordError(err)
+		return nil, err
+	}
+
+	// Throttle by email whether or not the account exists, so lockouts do not
+	// reveal which emails are registered
+	key := "email:" + email
+	if err := uc.allowAttempt(ctx, key); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	user, err := uc.userRepo.GetByEmail(ctx, email)
+	if errors.Is(err, port.ErrUserNotFound) {
+		err = uc.failAttempt(ctx, key, ErrInvalidCredentials)
+	}
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	ok, rehash, err := uc.verifyPassword(user, req.Password)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if !ok {
+		err := uc.failAttempt(ctx, key, ErrInvalidCredentials)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	var newHash, newAlgo string
+	if rehash {
+		newHash, newAlgo, err = uc.hasher.Hash(req.Password)
+		if err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+	}
+
+	now := time.Now().UTC()
+	user, err = uc.userRepo.Update(ctx, user.ID, func(u *domain.User) error {
+		if !u.CanLogin() {
+			return ErrLoginNotAllowed
+		}
+		if newHash != "" {
+			setPassword(u, newHash, newAlgo, now)
+		}
+		u.LastLoginAt = &now
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if err := uc.throttle.Reset(ctx, key); err != nil {
+		span.RecordError(err)
+	}
+	return user, nil
+}
+
+// ChangePassword replaces the caller's password after checking the current one
+func (uc *usecase) ChangePassword(ctx context.Context, req *ChangePasswordReq) error {
+	ctx, span := tracer.Start(ctx, "UserUC.ChangePassword")
+	defer span.End()
+
+	userID, err := interceptor.ActingUserID(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+	if err := validatePassword(req.NewPassword); err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	key := "user:" + userID
+	if err := uc.allowAttempt(ctx, key); err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+	ok, _, err := uc.verifyPassword(user, req.CurrentPassword)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+	if !ok {
+		err := uc.failAttempt(ctx, key, ErrWrongPassword)
+		span.RecordError(err)
+		return err
+	}
+
+	if err := uc.replacePassword(ctx, userID, req.NewPassword, nil); err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	if err := uc.throttle.Reset(ctx, key); err != nil {
+		span.RecordError(err)
+	}
+	return nil
+}
+
+// RequestPasswordReset issues a single-use reset token for a password account.
+// Unknown emails get an empty token rather than an error.
+func (uc *usecase) RequestPasswordReset(ctx context.Context, req *RequestPasswordResetReq) (*RequestPasswordResetResp, error) {
+	ctx, span := tracer.Start(ctx, "UserUC.RequestPasswordReset")
+	defer span.End()
+
+	email := utils.NormalizeString(req.Email)
+	if email == "" {
+		err := ErrEmailRequired
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if err := uc.allowAttempt(ctx, "reset:"+email); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	user, err := uc.userRepo.GetByEmail(ctx, email)
+	if errors.Is(err, port.ErrUserNotFound) {
+		return &RequestPasswordResetResp{}, nil
+	}
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if user.PasswordHash == nil || !user.CanLogin() {
+		return &RequestPasswordResetResp{}, nil
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("generate reset token: %w", err)
+	}
+	// The user ID lets the confirmation find the account without a lookup
+	// by token; only the hash of the whole token is stored
+	token := user.ID + "." + base64.RawURLEncoding.EncodeToString(raw)
+	hash := hashResetToken(token)
+	expiresAt := time.Now().UTC().Add(passwordResetTTL)
+
+	// A new token replaces any earlier one
+	_, err = uc.userRepo.Update(ctx, user.ID, func(u *domain.User) error {
+		u.PasswordResetHash = &hash
+		u.PasswordResetExpiresAt = &expiresAt
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return &RequestPasswordResetResp{
+		Token:     token,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// ConfirmPasswordReset sets a new password with a reset token and lifts any
+// lockout on the account. The token is checked and cleared in the same write
+// that sets the password, so it can be used once only. It returns the ID of
+// the user whose password changed.
+func (uc *usecase) ConfirmPasswordReset(ctx context.Context, req *ConfirmPasswordResetReq) (string, error) {
+	ctx, span := tracer.Start(ctx, "UserUC.ConfirmPasswordReset")
+	defer span.End()
+
+	userID, _, ok := strings.Cut(req.Token, ".")
+	if !ok || userID == "" {
+		err := ErrInvalidResetToken
+		span.RecordError(err)
+		return "", err
+	}
+	if err := validatePassword(req.NewPassword); err != nil {
+		span.RecordError(err)
+		return "", err
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if errors.Is(err, port.ErrUserNotFound) {
+		err = ErrInvalidResetToken
+	}
+	if err != nil {
+		span.RecordError(err)
+		return "", err
+	}
+
+	hash := hashResetToken(req.Token)
+	err = uc.replacePassword(ctx, userID, req.NewPassword, func(u *domain.User) error {
+		if u.PasswordResetHash == nil || u.PasswordResetExpiresAt == nil ||
+			subtle.ConstantTimeCompare([]byte(*u.PasswordResetHash), []byte(hash)) != 1 ||
+			!time.Now().Before(*u.PasswordResetExpiresAt) {
+			return ErrInvalidResetToken
+		}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return "", err
+	}
+
+	if err := uc.throttle.Reset(ctx, "email:"+user.EmailNormalized); err != nil {
+		span.RecordError(err)
+	}
+	return userID, nil
+}
+
+func (uc *usecase) verifyPassword(user *domain.User, password string) (bool, bool, error) {
+	if user.PasswordHash == nil || user.PasswordAlgo == nil {
+		return false, false, nil
+	}
+	return uc.hasher.Verify(*user.PasswordHash, *user.PasswordAlgo, password)
+}
+
+// replacePassword sets the user's password once check, when given, accepts
+// the stored user. Any outstanding reset token is cleared with it.
+func (uc *usecase) replacePassword(ctx context.Context, userID, password string, check func(u *domain.User) error) error {
+	hash, algo, err := uc.hasher.Hash(password)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	_, err = uc.userRepo.Update(ctx, userID, func(u *domain.User) error {
+		if check != nil {
+			if err := check(u); err != nil {
+				return err
+			}
+		}
+		if u.PasswordHash == nil {
+			return ErrNoPassword
+		}
+		setPassword(u, hash, algo, now)
+		u.PasswordResetHash = nil
+		u.PasswordResetExpiresAt = nil
+		return nil
+	})
+	return err
+}
+
+// allowAttempt turns a throttled key into a TooManyRequests error
+func (uc *usecase) allowAttempt(ctx context.Context, key string) error {
+	wait, err := uc.throttle.Allow(ctx, key)
+	if err != nil {
+		return err
+	}
+	if wait > 0 {
+		return tooManyAttempts(wait)
+	}
+	return nil
+}
+
+// failAttempt records a failure and returns cause, or the lockout it triggered
+func (uc *usecase) failAttempt(ctx context.Context, key string, cause error) error {
+	lockout, err := uc.throttle.Fail(ctx, key)
+	if err != nil {
+		return err
+	}
+	if lockout > 0 {
+		return tooManyAttempts(lockout)
+	}
+	return cause
+}
+
+func tooManyAttempts(wait time.Duration) error {
+	seconds := int(math.Ceil(wait.Seconds()))
+	return apperror.TooManyRequests(fmt.Sprintf("too many attempts, retry in %d seconds", seconds))
+}
+
+func setPassword(u *domain.User, hash, algo string, at time.Time) {
+	u.PasswordHash = &hash
+	u.PasswordAlgo = &algo
+	u.PasswordUpdatedAt = &at
+}
+
+func hashResetToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,249 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/grpc/interceptor"
+	"github.com/deni12345/dae-services/services/dae-core/internal/infra/password"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"github.com/deni12345/dae-services/libs/apperror"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// fakeUserRepo implements the UsersRepo methods the password flows use
+type fakeUserRepo struct {
+	port.UsersRepo
+	users map[string]*domain.User
+}
+
+func (r *fakeUserRepo) GetByID(_ context.Context, id string) (*domain.User, error) {
+	u, ok := r.users[id]
+	if !ok {
+		return nil, port.ErrUserNotFound
+	}
+	cp := *u
+	return &cp, nil
+}
+
+func (r *fakeUserRepo) GetByEmail(_ context.Context, email string) (*domain.User, error) {
+	for _, u := range r.users {
+		if u.EmailNormalized == email {
+			cp := *u
+			return &cp, nil
+		}
+	}
+	return nil, port.ErrUserNotFound
+}
+
+func (r *fakeUserRepo) Update(_ context.Context, id string, fn func(u *domain.User) error) (*domain.User, error) {
+	u, ok := r.users[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	cp := *u
+	if err := fn(&cp); err != nil {
+		return nil, err
+	}
+	r.users[id] = &cp
+	return &cp, nil
+}
+
+// fakeThrottle locks a key after maxFailures failures
+type fakeThrottle struct {
+	maxFailures int
+	failures    map[string]int
+	locked      map[string]bool
+}
+
+func newFakeThrottle(maxFailures int) *fakeThrottle {
+	return &fakeThrottle{maxFailures: maxFailures, failures: map[string]int{}, locked: map[string]bool{}}
+}
+
+func (t *fakeThrottle) Allow(_ context.Context, key string) (time.Duration, error) {
+	if t.locked[key] {
+		return time.Minute, nil
+	}
+	return 0, nil
+}
+
+func (t *fakeThrottle) Fail(_ context.Context, key string) (time.Duration, error) {
+	t.failures[key]++
+	if t.failures[key] >= t.maxFailures {
+		t.locked[key] = true
+		return time.Minute, nil
+	}
+	return 0, nil
+}
+
+func (t *fakeThrottle) Reset(_ context.Context, key string) error {
+	delete(t.failures, key)
+	delete(t.locked, key)
+	return nil
+}
+
+func newPasswordTestUsecase(t *testing.T) (*usecase, *fakeUserRepo, *fakeThrottle) {
+	t.Helper()
+	hasher, err := password.NewHasher(domain.PasswordAlgoArgon2id, password.Argon2Params{Memory: 1024, Time: 1, Threads: 1, SaltLen: 16, KeyLen: 32})
+	if err != nil {
+		t.Fatalf("new hasher: %v", err)
+	}
+
+	legacy, err := bcrypt.GenerateFromPassword([]byte("old-password"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("bcrypt: %v", err)
+	}
+	hash, algo := string(legacy), domain.PasswordAlgoBcrypt
+
+	repo := &fakeUserRepo{users: map[string]*domain.User{
+		"u1": {ID: "u1", EmailNormalized: "ann@example.com", Status: domain.UserStatusActive, PasswordHash: &hash, PasswordAlgo: &algo},
+	}}
+	throttle := newFakeThrottle(3)
+	return &usecase{userRepo: repo, hasher: hasher, throttle: throttle}, repo, throttle
+}
+
+func TestAuthenticateLocalUpgradesLegacyHash(t *testing.T) {
+	uc, repo, _ := newPasswordTestUsecase(t)
+
+	u, err := uc.AuthenticateLocal(context.Background(), &AuthenticateLocalReq{Email: " Ann@Example.com ", Password: "old-password"})
+	if err != nil {
+		t.Fatalf("authenticate: %v", err)
+	}
+	if u.LastLoginAt == nil {
+		t.Fatalf("last_login_at not recorded")
+	}
+	stored := repo.users["u1"]
+	if *stored.PasswordAlgo != domain.PasswordAlgoArgon2id {
+		t.Fatalf("password algo = %q, want argon2id after login", *stored.PasswordAlgo)
+	}
+
+	// The upgraded hash still accepts the same password
+	if _, err := uc.AuthenticateLocal(context.Background(), &AuthenticateLocalReq{Email: "ann@example.com", Password: "old-password"}); err != nil {
+		t.Fatalf("authenticate after upgrade: %v", err)
+	}
+}
+
+func TestAuthenticateLocalLocksOut(t *testing.T) {
+	uc, _, throttle := newPasswordTestUsecase(t)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		_, err := uc.AuthenticateLocal(ctx, &AuthenticateLocalReq{Email: "ann@example.com", Password: "wrong"})
+		if apperror.GetCode(err) != apperror.CodeUnauthorized {
+			t.Fatalf("attempt %d: code = %v, want Unauthorized", i, apperror.GetCode(err))
+		}
+	}
+	_, err := uc.AuthenticateLocal(ctx, &AuthenticateLocalReq{Email: "ann@example.com", Password: "wrong"})
+	if apperror.GetCode(err) != apperror.CodeTooMany {
+		t.Fatalf("third failure: code = %v, want TooMany", apperror.GetCode(err))
+	}
+
+	// Locked out even with the right password
+	_, err = uc.AuthenticateLocal(ctx, &AuthenticateLocalReq{Email: "ann@example.com", Password: "old-password"})
+	if apperror.GetCode(err) != apperror.CodeTooMany {
+		t.Fatalf("locked login: code = %v, want TooMany", apperror.GetCode(err))
+	}
+
+	// Unknown emails count failures too, without revealing they do not exist
+	_, err = uc.AuthenticateLocal(ctx, &AuthenticateLocalReq{Email: "nobody@example.com", Password: "x"})
+	if apperror.GetCode(err) != apperror.CodeUnauthorized || throttle.failures["email:nobody@example.com"] != 1 {
+		t.Fatalf("unknown email: code = %v, failures = %d", apperror.GetCode(err), throttle.failures["email:nobody@example.com"])
+	}
+}
+
+func TestAuthenticateLocalRejectsDisabledUser(t *testing.T) {
+	uc, repo, _ := newPasswordTestUsecase(t)
+	repo.users["u1"].IsDisabled = true
+
+	_, err := uc.AuthenticateLocal(context.Background(), &AuthenticateLocalReq{Email: "ann@example.com", Password: "old-password"})
+	if apperror.GetCode(err) != apperror.CodeForbidden {
+		t.Fatalf("code = %v, want Forbidden", apperror.GetCode(err))
+	}
+}
+
+func TestPasswordResetFlow(t *testing.T) {
+	uc, repo, throttle := newPasswordTestUsecase(t)
+	ctx := context.Background()
+	throttle.locked["email:ann@example.com"] = true
+
+	resp, err := uc.RequestPasswordReset(ctx, &RequestPasswordResetReq{Email: "ann@example.com"})
+	if err != nil || resp.Token == "" {
+		t.Fatalf("request reset = %+v, %v", resp, err)
+	}
+
+	unknown, err := uc.RequestPasswordReset(ctx, &RequestPasswordResetReq{Email: "nobody@example.com"})
+	if err != nil || unknown.Token != "" {
+		t.Fatalf("unknown email reset = %+v, %v; want empty token", unknown, err)
+	}
+
+	if _, err := uc.ConfirmPasswordReset(ctx, &ConfirmPasswordResetReq{Token: resp.Token, NewPassword: "short"}); apperror.GetCode(err) != apperror.CodeInvalidInput {
+		t.Fatalf("short password: code = %v, want InvalidInput", apperror.GetCode(err))
+	}
+	for _, forged := range []string{"u1.forged", "nobody." + resp.Token, "no-user-id"} {
+		if _, err := uc.ConfirmPasswordReset(ctx, &ConfirmPasswordResetReq{Token: forged, NewPassword: "new-password"}); err != ErrInvalidResetToken {
+			t.Fatalf("token %q: err = %v, want ErrInvalidResetToken", forged, err)
+		}
+	}
+
+	userID, err := uc.ConfirmPasswordReset(ctx, &ConfirmPasswordResetReq{Token: resp.Token, NewPassword: "new-password"})
+	if err != nil || userID != "u1" {
+		t.Fatalf("confirm reset = %q, %v; want u1", userID, err)
+	}
+	if throttle.locked["email:ann@example.com"] {
+		t.Fatalf("reset did not lift the lockout")
+	}
+	if repo.users["u1"].PasswordResetHash != nil {
+		t.Fatalf("token kept after the password was set")
+	}
+
+	// Tokens are single use
+	_, err = uc.ConfirmPasswordReset(ctx, &ConfirmPasswordResetReq{Token: resp.Token, NewPassword: "another-password"})
+	if apperror.GetCode(err) != apperror.CodeInvalidInput {
+		t.Fatalf("reused token: code = %v, want InvalidInput", apperror.GetCode(err))
+	}
+
+	if _, err := uc.AuthenticateLocal(ctx, &AuthenticateLocalReq{Email: "ann@example.com", Password: "new-password"}); err != nil {
+		t.Fatalf("login with new password: %v", err)
+	}
+}
+
+func TestChangePassword(t *testing.T) {
+	uc, _, _ := newPasswordTestUsecase(t)
+	ctx := interceptor.WithPrincipal(context.Background(), &domain.Principal{UserID: "u1"})
+
+	err := uc.ChangePassword(ctx, &ChangePasswordReq{CurrentPassword: "wrong", NewPassword: "new-password"})
+	if apperror.GetCode(err) != apperror.CodeUnauthorized {
+		t.Fatalf("wrong current password: code = %v, want Unauthorized", apperror.GetCode(err))
+	}
+	if err := uc.ChangePassword(ctx, &ChangePasswordReq{CurrentPassword: "old-password", NewPassword: "new-password"}); err != nil {
+		t.Fatalf("change password: %v", err)
+	}
+	if _, err := uc.AuthenticateLocal(context.Background(), &AuthenticateLocalReq{Email: "ann@example.com", Password: "new-password"}); err != nil {
+		t.Fatalf("login with changed password: %v", err)
+	}
+}
+
+func TestPasswordResetTokenReplacedAndExpires(t *testing.T) {
+	uc, repo, _ := newPasswordTestUsecase(t)
+	ctx := context.Background()
+
+	first, err := uc.RequestPasswordReset(ctx, &RequestPasswordResetReq{Email: "ann@example.com"})
+	if err != nil {
+		t.Fatalf("first request: %v", err)
+	}
+	second, err := uc.RequestPasswordReset(ctx, &RequestPasswordResetReq{Email: "ann@example.com"})
+	if err != nil {
+		t.Fatalf("second request: %v", err)
+	}
+	if _, err := uc.ConfirmPasswordReset(ctx, &ConfirmPasswordResetReq{Token: first.Token, NewPassword: "new-password"}); err != ErrInvalidResetToken {
+		t.Fatalf("replaced token: err = %v, want ErrInvalidResetToken", err)
+	}
+
+	past := time.Now().Add(-time.Second)
+	repo.users["u1"].PasswordResetExpiresAt = &past
+	if _, err := uc.ConfirmPasswordReset(ctx, &ConfirmPasswordResetReq{Token: second.Token, NewPassword: "new-password"}); err != ErrInvalidResetToken {
+		t.Fatalf("expired token: err = %v, want ErrInvalidResetToken", err)
+	}
+}
@@ -2,10 +2,12 @@ package user
 
 import (
 	"context"
+	"errors"
 
 	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
 	"github.com/deni12345/dae-services/services/dae-core/internal/port"
 	"github.com/deni12345/dae-services/libs/apperror"
+	"github.com/deni12345/dae-services/libs/utils"
 )
 
 // GetUser retrieves a user by ID
@@ -20,6 +22,9 @@ func (u *usecase) GetUser(ctx context.Context, id string) (*domain.User, error)
 	}
 
 	user, err := u.userRepo.GetByID(ctx, id)
+	if errors.Is(err, port.ErrUserNotFound) {
+		err = ErrNotFound
+	}
 	if err != nil {
 		span.RecordError(err)
 		return nil, err
@@ -41,28 +46,52 @@ func (u *usecase) ListUsers(ctx context.Context, req *ListUsersReq) (*ListUsersR
 		req.PageSize = 100
 	}
 
-	// Fetch one extra to determine if there are more results
-	users, err := u.userRepo.List(ctx, port.ListUserQuery{
-		Limit:           req.PageSize + 1,
+	resp, err := u.userRepo.List(ctx, port.ListUserQuery{
+		Limit:           req.PageSize,
 		Cursor:          req.Cursor,
 		IncludeDisabled: req.IncludeDisabled,
+		EmailExact:      utils.NormalizeString(req.EmailExact),
+		Search:          utils.NormalizeString(req.Query),
+		Role:            req.Role,
+		Status:          req.Status,
 	})
+	if errors.Is(err, port.ErrInvalidUserCursor) {
+		err = ErrInvalidCursor
+	}
 	if err != nil {
 		span.RecordError(err)
 		return nil, err
 	}
 
-	// Determine if there are more results
-	var nextCursor string
-	if int32(len(users)) > req.PageSize {
-		// Trim to requested page size
-		users = users[:req.PageSize]
-		// Use last item's ID as next cursor
-		nextCursor = users[len(users)-1].ID
-	}
-
 	return &ListUsersResp{
-		Users:      users,
-		NextCursor: nextCursor,
+		Users:      resp.Users,
+		NextCursor: resp.NextCursor,
 	}, nil
 }
+
+// searchBackfillBatch is how many users BackfillSearchPrefixes reads per
+// transaction
+const searchBackfillBatch = 200
+
+// BackfillSearchPrefixes rebuilds the stored search prefixes of every user,
+// so users created before search existed can be found, and returns how many
+// it rewrote. Running it again rewrites nothing.
+func (u *usecase) BackfillSearchPrefixes(ctx context.Context) (int, error) {
+	ctx, span := tracer.Start(ctx, "UserUC.BackfillSearchPrefixes")
+	defer span.End()
+
+	total := 0
+	after := ""
+	for {
+		last, updated, err := u.userRepo.BackfillSearchPrefixes(ctx, after, searchBackfillBatch)
+		total += updated
+		if err != nil {
+			span.RecordError(err)
+			return total, err
+		}
+		if last == "" {
+			return total, nil
+		}
+		after = last
+	}
+}
@@ -0,0 +1,94 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+)
+
+// fakeListRepo records the list query and backfills pages of a fixed size
+type fakeListRepo struct {
+	port.UsersRepo
+	query   port.ListUserQuery
+	listErr error
+
+	pages    int // backfill pages left
+	backfill []string
+}
+
+func (r *fakeListRepo) List(_ context.Context, query port.ListUserQuery) (*port.ListUsersResp, error) {
+	r.query = query
+	if r.listErr != nil {
+		return nil, r.listErr
+	}
+	return &port.ListUsersResp{Users: []*domain.User{{ID: "u1"}}, NextCursor: "u1"}, nil
+}
+
+func (r *fakeListRepo) BackfillSearchPrefixes(_ context.Context, afterID string, limit int) (string, int, error) {
+	r.backfill = append(r.backfill, afterID)
+	if r.pages == 0 {
+		return "", 0, nil
+	}
+	r.pages--
+	return fmt.Sprintf("after-%d", len(r.backfill)), limit / 2, nil
+}
+
+func TestListUsers(t *testing.T) {
+	repo := &fakeListRepo{}
+	uc := &usecase{userRepo: repo}
+
+	resp, err := uc.ListUsers(context.Background(), &ListUsersReq{
+		PageSize:   500,
+		Cursor:     "c1",
+		Query:      "  ANN ",
+		EmailExact: " Ann@Example.com",
+		Role:       domain.RoleHost,
+		Status:     domain.UserStatusActive,
+	})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if resp.NextCursor != "u1" || len(resp.Users) != 1 {
+		t.Fatalf("resp = %+v", resp)
+	}
+
+	want := port.ListUserQuery{
+		Limit:      100,
+		Cursor:     "c1",
+		EmailExact: "ann@example.com",
+		Search:     "ann",
+		Role:       domain.RoleHost,
+		Status:     domain.UserStatusActive,
+	}
+	if repo.query != want {
+		t.Fatalf("query = %+v, want %+v", repo.query, want)
+	}
+
+	if _, err := uc.ListUsers(context.Background(), &ListUsersReq{}); err != nil || repo.query.Limit != 20 {
+		t.Fatalf("default page size = %d, %v; want 20", repo.query.Limit, err)
+	}
+
+	repo.listErr = fmt.Errorf("user gone: %w", port.ErrInvalidUserCursor)
+	if _, err := uc.ListUsers(context.Background(), &ListUsersReq{Cursor: "gone"}); err != ErrInvalidCursor {
+		t.Fatalf("stale cursor err = %v, want ErrInvalidCursor", err)
+	}
+}
+
+func TestBackfillSearchPrefixes(t *testing.T) {
+	repo := &fakeListRepo{pages: 2}
+	uc := &usecase{userRepo: repo}
+
+	updated, err := uc.BackfillSearchPrefixes(context.Background())
+	if err != nil {
+		t.Fatalf("backfill: %v", err)
+	}
+	if updated != searchBackfillBatch {
+		t.Fatalf("updated = %d, want %d", updated, searchBackfillBatch)
+	}
+	if got := fmt.Sprint(repo.backfill); got != "[ after-1 after-2]" {
+		t.Fatalf("pages started after %s, want [ after-1 after-2]", got)
+	}
+}
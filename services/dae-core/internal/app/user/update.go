@@ -4,6 +4,7 @@ import (
 	"context"
 	"strings"
 
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/authz"
 	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
 	"github.com/deni12345/dae-services/libs/apperror"
 )
@@ -18,6 +19,17 @@ func (u *usecase) UpdateUser(ctx context.Context, req *UpdateUserReq) (*domain.U
 		span.RecordError(err)
 		return nil, err
 	}
+	if err := authz.RequireSelfOrAdmin(ctx, req.ID); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	// Users may not re-enable themselves
+	if req.IsDisabled != nil {
+		if err := authz.RequireAdmin(ctx); err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+	}
 
 	user, err := u.userRepo.Update(ctx, req.ID, func(user *domain.User) error {
 		// Apply changes
@@ -2,6 +2,7 @@ package user
 
 import (
 	"context"
+	"time"
 
 	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
 	"github.com/deni12345/dae-services/services/dae-core/internal/port"
@@ -18,6 +19,30 @@ type Usecase interface {
 	GetUser(ctx context.Context, id string) (*domain.User, error)
 	ListUsers(ctx context.Context, req *ListUsersReq) (*ListUsersResp, error)
 
+	// Login, called by the gateway
+	GetUserByIdentity(ctx context.Context, req *GetUserByIdentityReq) (*domain.User, error)
+	RecordLogin(ctx context.Context, req *RecordLoginReq) (*domain.User, error)
+
+	// Identities
+	LinkIdentity(ctx context.Context, req *LinkIdentityReq) (*domain.UserIdentity, error)
+	UnlinkIdentity(ctx context.Context, req *UnlinkIdentityReq) error
+	ListIdentities(ctx context.Context, userID string) ([]*domain.UserIdentity, error)
+
+	// Passwords
+	AuthenticateLocal(ctx context.Context, req *AuthenticateLocalReq) (*domain.User, error)
+	ChangePassword(ctx context.Context, req *ChangePasswordReq) error
+	RequestPasswordReset(ctx context.Context, req *RequestPasswordResetReq) (*RequestPasswordResetResp, error)
+	ConfirmPasswordReset(ctx context.Context, req *ConfirmPasswordResetReq) (string, error)
+
+	// Account deletion
+	DeleteUser(ctx context.Context, userID string) (*domain.User, error)
+	ExportUserData(ctx context.Context, userID string) (*domain.UserDataExport, []byte, error)
+	// EraseDeletedUsers is run periodically, not exposed over gRPC
+	EraseDeletedUsers(ctx context.Context, deletedBefore time.Time) (int, error)
+	// BackfillSearchPrefixes is a migration run at startup when enabled, not
+	// exposed over gRPC
+	BackfillSearchPrefixes(ctx context.Context) (int, error)
+
 	// Admin operations
 	AdminSetUserRoles(ctx context.Context, req *AdminSetUserRolesReq) (*domain.User, error)
 	AdminSetUserDisabled(ctx context.Context, req *AdminSetUserDisabledReq) (*domain.User, error)
@@ -25,12 +50,18 @@ type Usecase interface {
 
 type usecase struct {
 	userRepo port.UsersRepo
+	hasher   port.PasswordHasher
+	throttle port.LoginThrottle
+	userData port.UserDataStore
 }
 
 // NewUsecase creates a new user usecase
-func NewUsecase(userRepo port.UsersRepo) Usecase {
+func NewUsecase(userRepo port.UsersRepo, hasher port.PasswordHasher, throttle port.LoginThrottle, userData port.UserDataStore) Usecase {
 	return &usecase{
 		userRepo: userRepo,
+		hasher:   hasher,
+		throttle: throttle,
+		userData: userData,
 	}
 }
 
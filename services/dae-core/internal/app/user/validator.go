@@ -7,6 +7,11 @@ import (
 	"github.com/deni12345/dae-services/libs/apperror"
 )
 
+const (
+	minPasswordLen = 8
+	maxPasswordLen = 128
+)
+
 // validateUser validates user domain rules
 func validateUser(u *domain.User) error {
 	if len(u.UserName) <= 0 || len(u.UserName) > 64 {
@@ -14,3 +19,11 @@ func validateUser(u *domain.User) error {
 	}
 	return nil
 }
+
+// validatePassword checks the length of a new password
+func validatePassword(password string) error {
+	if len(password) < minPasswordLen || len(password) > maxPasswordLen {
+		return apperror.InvalidInput(fmt.Sprintf("password must be between %d and %d characters", minPasswordLen, maxPasswordLen))
+	}
+	return nil
+}
@@ -0,0 +1,280 @@
+// Package audit records what an operation changed. Repositories write one
+// event per resource with Write, in the transaction that makes the change, so
+// an event exists exactly when its write was committed. The operation, its
+// actor and idempotency key come from the context: the audit interceptor sets
+// them for RPCs and background jobs set their own with NewContext.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+)
+
+// Collection holds the audit events
+const Collection = "audit_events"
+
+// Operation identifies what is writing: an RPC or a background job
+type Operation struct {
+	ActorID        string
+	Method         string
+	IdempotencyKey string
+}
+
+// SystemActor is the actor of writes made by background jobs
+const SystemActor = "system"
+
+// Job returns the operation of the background job name
+func Job(name string) Operation {
+	return Operation{ActorID: SystemActor, Method: "job:" + name}
+}
+
+type operationKey struct{}
+
+// NewContext returns a context whose writes are recorded against op
+func NewContext(ctx context.Context, op Operation) context.Context {
+	return context.WithValue(ctx, operationKey{}, op)
+}
+
+// OperationFromContext returns the operation set by NewContext
+func OperationFromContext(ctx context.Context) (Operation, bool) {
+	op, ok := ctx.Value(operationKey{}).(Operation)
+	return op, ok
+}
+
+// Write adds an event for a write to resourceID as part of tx. userIDs are
+// the users the resource or the changes name besides the actor, such as the
+// owner of an order. Writes made outside an operation are still recorded,
+// without an actor or method.
+func Write(ctx context.Context, tx *firestore.Transaction, client *firestore.Client, resourceType domain.AuditResourceType, resourceID string, changes []domain.AuditChange, userIDs ...string) error {
+	op, _ := OperationFromContext(ctx)
+	named := append([]string{op.ActorID}, userIDs...)
+	if resourceType == domain.AuditResourceUser {
+		named = append(named, resourceID)
+	}
+	event := &domain.AuditEvent{
+		ActorID:        op.ActorID,
+		Method:         op.Method,
+		ResourceType:   resourceType,
+		ResourceID:     resourceID,
+		Changes:        changes,
+		IdempotencyKey: op.IdempotencyKey,
+		OccurredAt:     time.Now().UTC(),
+		UserIDs:        domain.UniqueIDs(slices.DeleteFunc(named, func(id string) bool { return id == SystemActor })),
+	}
+	ref := client.Collection(Collection).NewDoc()
+	if err := tx.Create(ref, event); err != nil {
+		return fmt.Errorf("record audit event: %w", err)
+	}
+	return nil
+}
+
+// Changes describes the fields written by updates. before and after are the
+// document as read and as written, structs with firestore tags; fields whose
+// value did not change are left out and personal data is redacted.
+func Changes(before, after any, updates []firestore.Update) []domain.AuditChange {
+	var changes []domain.AuditChange
+	for _, u := range updates {
+		field := u.Path
+		if field == "" {
+			field = strings.Join(u.FieldPath, ".")
+		}
+
+		old, oldRedacted, _ := fieldValue(before, field)
+		cur, redacted, ok := fieldValue(after, field)
+		if !ok {
+			cur = u.Value
+		}
+		redacted = redacted || oldRedacted
+
+		change := domain.AuditChange{Field: field, Before: encode(old), After: encode(cur)}
+		if change.Before == change.After {
+			continue
+		}
+		if redacted {
+			change = redact(change)
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// Diff describes every field that differs between two versions of a
+// document. Pass a nil before for a document being created and a nil after
+// for one being deleted.
+func Diff(before, after any) []domain.AuditChange {
+	var changes []domain.AuditChange
+	for _, field := range fieldNames(before, after) {
+		old, oldRedacted, _ := fieldValue(before, field)
+		cur, redacted, _ := fieldValue(after, field)
+		redacted = redacted || oldRedacted
+
+		change := domain.AuditChange{Field: field, Before: encode(old), After: encode(cur)}
+		if change.Before == change.After {
+			continue
+		}
+		if redacted {
+			change = redact(change)
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// Change describes a single field set from before to after
+func Change(field string, before, after any) domain.AuditChange {
+	return domain.AuditChange{Field: field, Before: encode(before), After: encode(after)}
+}
+
+// Prefix reports changes to a nested document, such as a subcollection
+// entry, as changes to fields under prefix
+func Prefix(prefix string, changes []domain.AuditChange) []domain.AuditChange {
+	for i := range changes {
+		changes[i].Field = prefix + "." + changes[i].Field
+	}
+	return changes
+}
+
+// redact hides the values of a change while keeping whether it set or
+// cleared the field
+func redact(c domain.AuditChange) domain.AuditChange {
+	if c.Before != "" {
+		c.Before = domain.AuditRedacted
+	}
+	if c.After != "" {
+		c.After = domain.AuditRedacted
+	}
+	return c
+}
+
+// fieldNames lists the firestore fields of the structs, in declaration order
+func fieldNames(docs ...any) []string {
+	for _, doc := range docs {
+		rv := indirect(reflect.ValueOf(doc))
+		if rv.Kind() != reflect.Struct {
+			continue
+		}
+		var names []string
+		for i := 0; i < rv.NumField(); i++ {
+			if name := firestoreName(rv.Type().Field(i)); name != "" {
+				names = append(names, name)
+			}
+		}
+		return names
+	}
+	return nil
+}
+
+// fieldValue returns the struct field stored under the firestore name field,
+// whether it must be redacted and whether the struct has it. Zero values are
+// reported as nil, like unset fields; nested personal data is redacted in
+// place.
+func fieldValue(v any, field string) (any, bool, bool) {
+	rv := indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return nil, false, false
+	}
+
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		if firestoreName(rt.Field(i)) != field {
+			continue
+		}
+		if rv.Field(i).IsZero() {
+			return nil, isPersonal(rt.Field(i)), true
+		}
+		return scrub(rv.Field(i)), isPersonal(rt.Field(i)), true
+	}
+	return nil, false, false
+}
+
+// isPersonal reports whether a field holds personal data or a secret: it is
+// hidden from JSON or tagged audit:"redact"
+func isPersonal(f reflect.StructField) bool {
+	return f.Tag.Get("json") == "-" || f.Tag.Get("audit") == "redact"
+}
+
+func firestoreName(f reflect.StructField) string {
+	if !f.IsExported() {
+		return ""
+	}
+	name, _, _ := strings.Cut(f.Tag.Get("firestore"), ",")
+	if name == "-" {
+		return ""
+	}
+	if name == "" {
+		return f.Name
+	}
+	return name
+}
+
+// scrub returns v with the personal fields of the structs it contains
+// redacted, keyed by their firestore names
+func scrub(v reflect.Value) any {
+	v = indirect(v)
+	if !v.IsValid() {
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		switch v.Interface().(type) {
+		case time.Time, json.Marshaler:
+			return v.Interface()
+		}
+		out := make(map[string]any, v.NumField())
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Type().Field(i)
+			name := firestoreName(f)
+			if name == "" {
+				continue
+			}
+			if isPersonal(f) {
+				if !v.Field(i).IsZero() {
+					out[name] = "redacted"
+				}
+				continue
+			}
+			out[name] = scrub(v.Field(i))
+		}
+		return out
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return nil
+		}
+		out := make([]any, v.Len())
+		for i := range out {
+			out[i] = scrub(v.Index(i))
+		}
+		return out
+	default:
+		return v.Interface()
+	}
+}
+
+func indirect(rv reflect.Value) reflect.Value {
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return reflect.Value{}
+		}
+		rv = rv.Elem()
+	}
+	return rv
+}
+
+func encode(v any) string {
+	if v == nil {
+		return ""
+	}
+	b, err := json.Marshal(v)
+	if err != nil || string(b) == "null" {
+		return ""
+	}
+	return string(b)
+}
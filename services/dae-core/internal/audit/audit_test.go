@@ -0,0 +1,90 @@
+package audit
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+)
+
+func equalChanges(t *testing.T, got, want []domain.AuditChange) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("changes = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("change %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestChanges(t *testing.T) {
+	oldHash, newHash := "old", "new"
+	before := domain.User{Name: "Ann", Status: domain.UserStatusActive, PasswordHash: &oldHash}
+	after := before
+	after.Name = "Anna"
+	after.Status = domain.UserStatusSuspended
+	after.PasswordHash = &newHash
+
+	got := Changes(before, &after, []firestore.Update{
+		{Path: "name", Value: after.Name},
+		{Path: "status", Value: after.Status},
+		{Path: "password_hash", Value: newHash},
+		{Path: "roles", Value: after.Roles},
+	})
+
+	equalChanges(t, got, []domain.AuditChange{
+		{Field: "name", Before: domain.AuditRedacted, After: domain.AuditRedacted},
+		{Field: "status", Before: `"active"`, After: `"suspended"`},
+		{Field: "password_hash", Before: domain.AuditRedacted, After: domain.AuditRedacted},
+	})
+}
+
+func TestDiff(t *testing.T) {
+	order := &domain.Order{
+		UserID: "u1",
+		Lines:  []domain.OrderLine{{MenuItemID: "pho", Quantity: 1, Note: "no onions, flat 4B"}},
+		Note:   "call 0901 234 567",
+		Status: domain.OrderStatusPending,
+	}
+
+	// A create records every field set, with personal data redacted at any
+	// depth
+	got := Diff(nil, order)
+	byField := make(map[string]domain.AuditChange, len(got))
+	for _, c := range got {
+		byField[c.Field] = c
+	}
+	if c := byField["note"]; c.Before != "" || c.After != domain.AuditRedacted {
+		t.Fatalf("note = %+v", c)
+	}
+	if c := byField["lines"]; c.After == "" || strings.Contains(c.After, "onions") {
+		t.Fatalf("lines = %+v", c)
+	}
+	if c := byField["user_id"]; c.After != `"u1"` {
+		t.Fatalf("user_id = %+v", c)
+	}
+	if _, ok := byField["total"]; ok {
+		t.Fatalf("unset total recorded: %+v", got)
+	}
+
+	// A delete clears them
+	for _, c := range Diff(order, nil) {
+		if c.After != "" {
+			t.Fatalf("delete change = %+v", c)
+		}
+	}
+}
+
+func TestOperation(t *testing.T) {
+	if _, ok := OperationFromContext(context.Background()); ok {
+		t.Fatal("operation outside NewContext")
+	}
+	ctx := NewContext(context.Background(), Job("sheet-schedules"))
+	if op, ok := OperationFromContext(ctx); !ok || op.ActorID != SystemActor || op.Method != "job:sheet-schedules" {
+		t.Fatalf("operation = %+v, %v", op, ok)
+	}
+}
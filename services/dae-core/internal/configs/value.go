@@ -1,5 +1,7 @@
 package configs
 
+import "time"
+
 // Value holds dae-core service configuration
 type Value struct {
 	Environment        string `yaml:"environment" env:"ENVIRONMENT" env-default:"dev"`
@@ -13,6 +15,46 @@ type Value struct {
 	OtelCol            string `yaml:"otelcol" env:"OTELCOL" env-default:"tempo:4317"`
 	Insecure           bool   `yaml:"insecure" env:"INSECURE" env-default:"true"`
 
+	// Caller authentication: tokens must be signed by a key in the JWKS file
+	AuthJWKSFile string `yaml:"auth_jwks_file" env:"AUTH_JWKS_FILE" env-default:""`
+	AuthIssuer   string `yaml:"auth_issuer" env:"AUTH_ISSUER" env-default:"dae-gateway"`
+	AuthAudience string `yaml:"auth_audience" env:"AUTH_AUDIENCE" env-default:"dae-core"`
+
+	// Password logins: hashes are upgraded to PasswordAlgo on successful login
+	PasswordAlgo       string        `yaml:"password_algo" env:"PASSWORD_ALGO" env-default:"argon2id"`
+	LoginMaxAttempts   int64         `yaml:"login_max_attempts" env:"LOGIN_MAX_ATTEMPTS" env-default:"10"` // per minute
+	LoginMaxFailures   int64         `yaml:"login_max_failures" env:"LOGIN_MAX_FAILURES" env-default:"5"`
+	LoginFailureWindow time.Duration `yaml:"login_failure_window" env:"LOGIN_FAILURE_WINDOW" env-default:"15m"`
+	LoginLockout       time.Duration `yaml:"login_lockout" env:"LOGIN_LOCKOUT" env-default:"15m"`
+
+	// Deleted accounts are erased by the scheduler once they have been
+	// deleted for UserErasureGrace
+	UserErasureGrace time.Duration `yaml:"user_erasure_grace" env:"USER_ERASURE_GRACE" env-default:"720h"`
+
+	// Rebuilds the search prefixes of users stored before search existed once
+	// at startup; enable it for one deployment
+	BackfillSearchPrefixes bool `yaml:"backfill_search_prefixes" env:"BACKFILL_SEARCH_PREFIXES" env-default:"false"`
+
+	// Stores the pending status on orders written before statuses existed
+	// once at startup; enable it for one deployment
+	BackfillOrderStatuses bool `yaml:"backfill_order_statuses" env:"BACKFILL_ORDER_STATUSES" env-default:"false"`
+
+	// Outbox relay: events are published to the EventStream Redis stream. An
+	// event failing OutboxMaxAttempts times is moved to the dead letters;
+	// published events are deleted after OutboxRetention.
+	EventStream         string        `yaml:"event_stream" env:"EVENT_STREAM" env-default:"dae-core:events"`
+	EventStreamMaxLen   int64         `yaml:"event_stream_max_len" env:"EVENT_STREAM_MAX_LEN" env-default:"100000"`
+	EventDedupTTL       time.Duration `yaml:"event_dedup_ttl" env:"EVENT_DEDUP_TTL" env-default:"24h"`
+	OutboxRelayInterval time.Duration `yaml:"outbox_relay_interval" env:"OUTBOX_RELAY_INTERVAL" env-default:"1s"`
+	OutboxRelayBatch    int           `yaml:"outbox_relay_batch" env:"OUTBOX_RELAY_BATCH" env-default:"100"`
+	OutboxMaxAttempts   int           `yaml:"outbox_max_attempts" env:"OUTBOX_MAX_ATTEMPTS" env-default:"20"`
+	OutboxRetention     time.Duration `yaml:"outbox_retention" env:"OUTBOX_RETENTION" env-default:"168h"`
+
+	// Scheduler: opens and closes sheets on time. Each job runs on one replica
+	// at a time, holding a Redis lock for at most SchedulerLockTTL.
+	SchedulerInterval time.Duration `yaml:"scheduler_interval" env:"SCHEDULER_INTERVAL" env-default:"30s"`
+	SchedulerLockTTL  time.Duration `yaml:"scheduler_lock_ttl" env:"SCHEDULER_LOCK_TTL" env-default:"2m"`
+
 	// Observability toggles
 	EnableTracing bool `yaml:"enable_tracing" env:"ENABLE_TRACING" env-default:"true"`
 	EnableMetrics bool `yaml:"enable_metrics" env:"ENABLE_METRICS" env-default:"true"`
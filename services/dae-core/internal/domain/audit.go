@@ -0,0 +1,39 @@
+package domain
+
+import "time"
+
+type AuditResourceType string
+
+const (
+	AuditResourceUnspecified AuditResourceType = ""
+	AuditResourceUser        AuditResourceType = "user"
+	AuditResourceSheet       AuditResourceType = "sheet"
+	AuditResourceOrder       AuditResourceType = "order"
+	AuditResourcePayment     AuditResourceType = "payment"
+	AuditResourceTemplate    AuditResourceType = "sheet_template"
+)
+
+// AuditRedacted replaces the value of fields that must not be logged
+const AuditRedacted = `"redacted"`
+
+// AuditChange is one field written by an operation, with JSON-encoded values
+type AuditChange struct {
+	Field  string `firestore:"field" json:"field"`
+	Before string `firestore:"before" json:"before"`
+	After  string `firestore:"after" json:"after"`
+}
+
+// AuditEvent records a state-changing RPC and what it changed on one resource
+type AuditEvent struct {
+	ID             string            `firestore:"-" json:"id"`
+	ActorID        string            `firestore:"actor_id" json:"actor_id"`
+	Method         string            `firestore:"method" json:"method"`
+	ResourceType   AuditResourceType `firestore:"resource_type" json:"resource_type"`
+	ResourceID     string            `firestore:"resource_id" json:"resource_id"`
+	Changes        []AuditChange     `firestore:"changes" json:"changes"`
+	IdempotencyKey string            `firestore:"idempotency_key" json:"idempotency_key"`
+	OccurredAt     time.Time         `firestore:"occurred_at" json:"occurred_at"`
+
+	// Users the event names, so their erasure can find it
+	UserIDs []string `firestore:"user_ids,omitempty" json:"-"`
+}
@@ -1,5 +1,7 @@
 package domain
 
+import "slices"
+
 type Money struct {
 	CurrencyCode string `firestore:"currency_code" json:"currency_code"`
 	Amount       int64  `firestore:"amount" json:"amount"`
@@ -20,3 +22,14 @@ var Status_name = map[Status]string{
 	Status_PENDING: "PAUSED",
 	Status_CLOSED:  "CLOSED",
 }
+
+// UniqueIDs returns ids without empty or repeated entries, keeping their order
+func UniqueIDs(ids []string) []string {
+	var out []string
+	for _, id := range ids {
+		if id != "" && !slices.Contains(out, id) {
+			out = append(out, id)
+		}
+	}
+	return out
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type EventType string
+
+const (
+	EventOrderCreated   EventType = "order.created"
+	EventOrderUpdated   EventType = "order.updated"
+	EventOrderCancelled EventType = "order.cancelled"
+	EventSheetCreated   EventType = "sheet.created"
+	EventSheetUpdated   EventType = "sheet.updated"
+	EventSheetClosed    EventType = "sheet.closed"
+	EventMemberJoined   EventType = "sheet.member_joined"
+	EventMemberRemoved  EventType = "sheet.member_removed"
+)
+
+// Event is a domain event. It is written to the outbox in the transaction
+// that makes the change and later published by the relay. ID is unique per
+// event and is sent along so consumers can drop redeliveries.
+type Event struct {
+	ID            string    `firestore:"-" json:"id"`
+	Type          EventType `firestore:"type" json:"type"`
+	AggregateType string    `firestore:"aggregate_type" json:"aggregate_type"` // "order" | "sheet"
+	AggregateID   string    `firestore:"aggregate_id" json:"aggregate_id"`
+	Payload       string    `firestore:"payload" json:"payload"` // JSON of the aggregate after the change
+	OccurredAt    time.Time `firestore:"occurred_at" json:"occurred_at"`
+
+	// Users the payload names, so their erasure can find the event
+	UserIDs []string `firestore:"user_ids,omitempty" json:"-"`
+
+	// Relay bookkeeping
+	Published   bool       `firestore:"published" json:"-"`
+	PublishedAt *time.Time `firestore:"published_at,omitempty" json:"-"`
+	Attempts    int        `firestore:"attempts" json:"-"`
+	LastError   string     `firestore:"last_error,omitempty" json:"-"`
+}
+
+// NewOrderEvent describes a change to an order
+func NewOrderEvent(t EventType, o *Order) (*Event, error) {
+	return newEvent(t, "order", o.ID, o, o.UserID)
+}
+
+// NewSheetEvent describes a change to a sheet
+func NewSheetEvent(t EventType, s *Sheet) (*Event, error) {
+	return newEvent(t, "sheet", s.ID, s, append([]string{s.HostUserID}, s.MemberIDs...)...)
+}
+
+// NewMemberEvent describes a member joining or leaving a sheet
+func NewMemberEvent(t EventType, m *SheetMember) (*Event, error) {
+	return newEvent(t, "sheet", m.SheetID, m, m.UserID)
+}
+
+func newEvent(t EventType, aggregateType, aggregateID string, payload any, userIDs ...string) (*Event, error) {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("marshal %s payload: %w", t, err)
+	}
+	return &Event{
+		Type:          t,
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		Payload:       string(b),
+		OccurredAt:    time.Now().UTC(),
+		UserIDs:       UniqueIDs(userIDs),
+	}, nil
+}
@@ -15,6 +15,8 @@ type Option struct {
 	Price  int64  `firestore:"price" json:"price"` // minor units
 	Per    Per    `firestore:"per" json:"per"`     // unit|order
 	Active bool   `firestore:"active" json:"active"`
+
+	MaxQuantity int32 `firestore:"max_quantity" json:"max_quantity"` // per order line, 0 = unlimited
 }
 
 type OptionGroupType string
@@ -29,6 +31,7 @@ type OptionGroup struct {
 	Name      string            `firestore:"name" json:"name"`
 	Type      OptionGroupType   `firestore:"type" json:"type"`
 	Required  bool              `firestore:"required" json:"required"`
+	MinSelect int               `firestore:"min_select" json:"min_select"`
 	MaxSelect int               `firestore:"max_select" json:"max_select"` // 0 = unlimited
 	Options   map[string]Option `firestore:"options" json:"options"`
 }
@@ -0,0 +1,24 @@
+package domain
+
+// MenuImportFormat identifies an external menu payload format
+type MenuImportFormat string
+
+const (
+	MenuImportJSON MenuImportFormat = "json"
+	MenuImportCSV  MenuImportFormat = "csv"
+)
+
+// MenuImportError describes why one row of an imported menu was rejected.
+// Row is 1-based: the item position for JSON, the line number for CSV.
+type MenuImportError struct {
+	Row     int    `json:"row"`
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// MenuImport is the normalized result of parsing an external menu. Items
+// with errors are left out of Items.
+type MenuImport struct {
+	Items  []*MenuItem       `json:"items"`
+	Errors []MenuImportError `json:"errors,omitempty"`
+}
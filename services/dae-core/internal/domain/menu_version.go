@@ -0,0 +1,146 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// MenuVersion is an immutable snapshot of a sheet's menu, stored in
+// sheets/{sheetID}/menus/{menuID}. Sheet.ActiveMenuID points at the current one.
+type MenuVersion struct {
+	ID        string     `firestore:"-" json:"id"`
+	SheetID   string     `firestore:"-" json:"sheet_id"`
+	Version   int32      `firestore:"version" json:"version"` // 1 for the first menu of a sheet
+	ItemCount int32      `firestore:"item_count" json:"item_count"`
+	Items     []MenuItem `firestore:"items" json:"items"`
+	CreatedAt time.Time  `firestore:"created_at" json:"created_at"`
+}
+
+// Item returns the menu item with the given ID
+func (v *MenuVersion) Item(id string) (*MenuItem, bool) {
+	for i := range v.Items {
+		if v.Items[i].ID == id {
+			return &v.Items[i], true
+		}
+	}
+	return nil, false
+}
+
+type MenuChangeType string
+
+const (
+	MenuItemAdded   MenuChangeType = "added"
+	MenuItemRemoved MenuChangeType = "removed"
+	MenuItemChanged MenuChangeType = "changed"
+)
+
+// MenuItemChange describes how one menu item differs between two versions
+type MenuItemChange struct {
+	Type    MenuChangeType `json:"type"`
+	ItemID  string         `json:"item_id"`
+	Before  *MenuItem      `json:"before,omitempty"`
+	After   *MenuItem      `json:"after,omitempty"`
+	Changes []string       `json:"changes,omitempty"` // e.g. "price: 30000 -> 35000"
+}
+
+// DiffMenus compares two menus by item ID. Changes are ordered by item ID and
+// changed items list every differing field, including option groups and
+// options addressed as option_groups[id].options[id].
+func DiffMenus(from, to []MenuItem) []MenuItemChange {
+	before := make(map[string]*MenuItem, len(from))
+	for i := range from {
+		before[from[i].ID] = &from[i]
+	}
+	after := make(map[string]*MenuItem, len(to))
+	for i := range to {
+		after[to[i].ID] = &to[i]
+	}
+
+	var changes []MenuItemChange
+	for _, id := range unionKeys(before, after) {
+		b, a := before[id], after[id]
+		switch {
+		case b == nil:
+			changes = append(changes, MenuItemChange{Type: MenuItemAdded, ItemID: id, After: a})
+		case a == nil:
+			changes = append(changes, MenuItemChange{Type: MenuItemRemoved, ItemID: id, Before: b})
+		default:
+			if diff := diffMenuItem(b, a); len(diff) > 0 {
+				changes = append(changes, MenuItemChange{Type: MenuItemChanged, ItemID: id, Before: b, After: a, Changes: diff})
+			}
+		}
+	}
+	return changes
+}
+
+func diffMenuItem(b, a *MenuItem) []string {
+	var diff []string
+	diff = appendChange(diff, "name", b.Name, a.Name)
+	diff = appendChange(diff, "price", b.Price, a.Price)
+	diff = appendChange(diff, "currency", b.Currency, a.Currency)
+	diff = appendChange(diff, "active", b.Active, a.Active)
+
+	for _, id := range unionKeys(b.OptionGroups, a.OptionGroups) {
+		field := fmt.Sprintf("option_groups[%s]", id)
+		bg, inBefore := b.OptionGroups[id]
+		ag, inAfter := a.OptionGroups[id]
+		switch {
+		case !inBefore:
+			diff = append(diff, field+": added")
+		case !inAfter:
+			diff = append(diff, field+": removed")
+		default:
+			diff = appendChange(diff, field+".name", bg.Name, ag.Name)
+			diff = appendChange(diff, field+".type", bg.Type, ag.Type)
+			diff = appendChange(diff, field+".required", bg.Required, ag.Required)
+			diff = appendChange(diff, field+".min_select", bg.MinSelect, ag.MinSelect)
+			diff = appendChange(diff, field+".max_select", bg.MaxSelect, ag.MaxSelect)
+			diff = append(diff, diffOptions(field, bg.Options, ag.Options)...)
+		}
+	}
+	return diff
+}
+
+func diffOptions(prefix string, b, a map[string]Option) []string {
+	var diff []string
+	for _, id := range unionKeys(b, a) {
+		field := fmt.Sprintf("%s.options[%s]", prefix, id)
+		bo, inBefore := b[id]
+		ao, inAfter := a[id]
+		switch {
+		case !inBefore:
+			diff = append(diff, field+": added")
+		case !inAfter:
+			diff = append(diff, field+": removed")
+		default:
+			diff = appendChange(diff, field+".name", bo.Name, ao.Name)
+			diff = appendChange(diff, field+".price", bo.Price, ao.Price)
+			diff = appendChange(diff, field+".active", bo.Active, ao.Active)
+			diff = appendChange(diff, field+".max_quantity", bo.MaxQuantity, ao.MaxQuantity)
+		}
+	}
+	return diff
+}
+
+func appendChange[T comparable](diff []string, field string, before, after T) []string {
+	if before == after {
+		return diff
+	}
+	return append(diff, fmt.Sprintf("%s: %v -> %v", field, before, after))
+}
+
+// unionKeys returns the keys of both maps, sorted
+func unionKeys[V1, V2 any](a map[string]V1, b map[string]V2) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
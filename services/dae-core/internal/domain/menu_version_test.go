@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffMenus(t *testing.T) {
+	tea := MenuItem{
+		ID: "tea", Name: "Milk tea", Price: 30000, Currency: "VND", Active: true,
+		OptionGroups: map[string]OptionGroup{
+			"size": {ID: "size", Name: "Size", Type: GroupSingle, Required: true, Options: map[string]Option{
+				"m": {ID: "m", Name: "M", Active: true},
+				"l": {ID: "l", Name: "L", Price: 5000, Active: true},
+			}},
+		},
+	}
+	coffee := MenuItem{ID: "coffee", Name: "Coffee", Price: 25000, Currency: "VND", Active: true}
+	cake := MenuItem{ID: "cake", Name: "Cake", Price: 40000, Currency: "VND", Active: true}
+
+	newTea := tea
+	newTea.Price = 35000
+	newTea.OptionGroups = map[string]OptionGroup{
+		"size": {ID: "size", Name: "Size", Type: GroupSingle, Required: true, Options: map[string]Option{
+			"m": {ID: "m", Name: "M", Active: true},
+			"l": {ID: "l", Name: "L", Price: 7000, Active: true},
+		}},
+		"ice": {ID: "ice", Name: "Ice", Type: GroupSingle},
+	}
+
+	got := DiffMenus([]MenuItem{tea, coffee}, []MenuItem{newTea, cake, coffee})
+
+	want := []struct {
+		typ     MenuChangeType
+		id      string
+		changes []string
+	}{
+		{typ: MenuItemAdded, id: "cake"},
+		{typ: MenuItemChanged, id: "tea", changes: []string{
+			"price: 30000 -> 35000",
+			"option_groups[ice]: added",
+			"option_groups[size].options[l].price: 5000 -> 7000",
+		}},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d changes %+v, want %d", len(got), got, len(want))
+	}
+	for i, w := range want {
+		if got[i].Type != w.typ || got[i].ItemID != w.id || !reflect.DeepEqual(got[i].Changes, w.changes) {
+			t.Fatalf("change %d = %s %s %q, want %s %s %q", i, got[i].Type, got[i].ItemID, got[i].Changes, w.typ, w.id, w.changes)
+		}
+	}
+
+	removed := DiffMenus([]MenuItem{coffee}, nil)
+	if len(removed) != 1 || removed[0].Type != MenuItemRemoved || removed[0].Before.ID != "coffee" {
+		t.Fatalf("removed diff = %+v, want coffee removed", removed)
+	}
+	if same := DiffMenus([]MenuItem{tea}, []MenuItem{tea}); len(same) != 0 {
+		t.Fatalf("identical menus diff = %+v, want none", same)
+	}
+}
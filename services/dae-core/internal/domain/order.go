@@ -17,12 +17,45 @@ const (
 	OrderStatusCompleted   OrderStatus = "completed"
 )
 
+// CurrentStatus returns the order status, treating orders stored before
+// statuses existed as pending
+func (o *Order) CurrentStatus() OrderStatus {
+	if o.Status == OrderStatusUnspecified {
+		return OrderStatusPending
+	}
+	return o.Status
+}
+
+func (o *Order) IsCancelled() bool { return o.Status == OrderStatusCancelled }
+
+// PricingVersion is how the lines of new orders are priced: option price
+// deltas are for one unit of the option. Orders stored without a version
+// predate it and are upgraded by pricing.Upgrade when read.
+const PricingVersion int32 = 1
+
+type OrderEventType string
+
+const (
+	OrderEventCreated   OrderEventType = "created"
+	OrderEventUpdated   OrderEventType = "updated"
+	OrderEventCancelled OrderEventType = "cancelled"
+)
+
+// OrderEvent describes a change to an order within a sheet
+type OrderEvent struct {
+	Type       OrderEventType `json:"type"`
+	Order      *Order         `json:"order"`
+	Cursor     string         `json:"cursor"` // opaque position, used to resume a stream after this event
+	OccurredAt time.Time      `json:"occurred_at"`
+}
+
 type OrderLineOption struct {
 	GroupID    string `firestore:"group_id" json:"group_id"`
 	OptionID   string `firestore:"option_id" json:"option_id"`
 	Title      string `firestore:"title" json:"title"`
-	PriceDelta Money  `firestore:"price_delta" json:"price_delta"`
+	PriceDelta Money  `firestore:"price_delta" json:"price_delta"` // for one unit of the option
 	Quantity   int32  `firestore:"quantity" json:"quantity"`
+	Per        Per    `firestore:"per,omitempty" json:"per,omitempty"` // empty means PerUnit
 }
 
 type OrderLine struct {
@@ -33,7 +66,7 @@ type OrderLine struct {
 	OrderOptionsTotal Money             `firestore:"order_options_total" json:"order_options_total"`
 	OrderTotal        Money             `firestore:"order_total" json:"order_total"`
 	Options           []OrderLineOption `firestore:"options" json:"options"`
-	Note              string            `firestore:"note" json:"note"`
+	Note              string            `firestore:"note" json:"note" audit:"redact"`
 }
 
 type Order struct {
@@ -42,10 +75,21 @@ type Order struct {
 	UserID    string      `firestore:"user_id" json:"user_id"`
 	Lines     []OrderLine `firestore:"lines" json:"lines"`
 	Subtotal  Money       `firestore:"subtotal" json:"subtotal"`
-	Total     Money       `firestore:"total" json:"total"`
-	Note      string      `firestore:"note" json:"note"`
+	Total     Money       `firestore:"-" json:"-"` // derived, see below
+	Note      string      `firestore:"note" json:"note" audit:"redact"`
+	Status    OrderStatus `firestore:"status" json:"status"`
+	MenuID    string      `firestore:"menu_id" json:"menu_id"` // menu version the lines were priced with
 	CreatedAt time.Time   `firestore:"created_at" json:"created_at"`
 	UpdatedAt time.Time   `firestore:"updated_at" json:"updated_at"`
+
+	PricingVersion int32 `firestore:"pricing_version,omitempty" json:"pricing_version,omitempty"` // see PricingVersion
+
+	// Derived from the sheet by pricing.SheetCharges, with Total, and neither
+	// stored nor sent in order events, which carry Subtotal only. Read the
+	// charged total from an order returned by the order usecase or from the
+	// sheet settlement.
+	FeeShare      Money `firestore:"-" json:"-"`
+	DiscountShare Money `firestore:"-" json:"-"`
 }
 
 // GetMoneyAmount calculates the total amount in the smallest unit (considering nanos)
@@ -61,34 +105,31 @@ func NewMoney(amount int64, currencyCode string) Money {
 	}
 }
 
-func CalculateOrderLineTotals(line *OrderLine) {
-	optionsTotal := int64(0)
-	for _, opt := range line.Options {
-		optionsTotal += int64(opt.Quantity) * opt.PriceDelta.GetAmount()
-	}
-
-	line.OrderOptionsTotal = NewMoney(optionsTotal, line.OrderBasePrice.CurrencyCode)
+// Conversion functions between domain and proto
 
-	lineTotal := line.OrderBasePrice.GetAmount()*int64(line.Quantity) + optionsTotal
-	line.OrderTotal = NewMoney(lineTotal, line.OrderBasePrice.CurrencyCode)
+var orderStatusToProto = map[OrderStatus]corev1.OrderStatus{
+	OrderStatusPending:   corev1.OrderStatus_ORDER_STATUS_PENDING,
+	OrderStatusConfirmed: corev1.OrderStatus_ORDER_STATUS_CONFIRMED,
+	OrderStatusCancelled: corev1.OrderStatus_ORDER_STATUS_CANCELLED,
+	OrderStatusCompleted: corev1.OrderStatus_ORDER_STATUS_COMPLETED,
 }
 
-func CalculateOrderTotals(order *Order) {
-	subtotal := int64(0)
-	currency := ""
-	for i := range order.Lines {
-		CalculateOrderLineTotals(&order.Lines[i])
-		subtotal += order.Lines[i].OrderTotal.GetAmount()
-		if currency == "" {
-			currency = order.Lines[i].OrderBasePrice.CurrencyCode
-		}
-	}
+var orderStatusFromProto = map[corev1.OrderStatus]OrderStatus{
+	corev1.OrderStatus_ORDER_STATUS_PENDING:   OrderStatusPending,
+	corev1.OrderStatus_ORDER_STATUS_CONFIRMED: OrderStatusConfirmed,
+	corev1.OrderStatus_ORDER_STATUS_CANCELLED: OrderStatusCancelled,
+	corev1.OrderStatus_ORDER_STATUS_COMPLETED: OrderStatusCompleted,
+}
 
-	order.Subtotal = NewMoney(subtotal, currency)
-	order.Total = NewMoney(subtotal, currency)
+// OrderStatusToProto returns the proto status of s, unspecified when unknown
+func OrderStatusToProto(s OrderStatus) corev1.OrderStatus {
+	return orderStatusToProto[s]
 }
 
-// Conversion functions between domain and proto
+// OrderStatusFromProto returns the domain status of s, empty when unspecified
+func OrderStatusFromProto(s corev1.OrderStatus) OrderStatus {
+	return orderStatusFromProto[s]
+}
 
 func MoneyToProto(m Money) *corev1.Money {
 	return &corev1.Money{
@@ -114,6 +155,7 @@ func OrderLineOptionToProto(o OrderLineOption) *corev1.OrderLineOption {
 		Title:      o.Title,
 		PriceDelta: MoneyToProto(o.PriceDelta),
 		Quantity:   o.Quantity,
+		PerOrder:   o.Per == PerOrder,
 	}
 }
 
@@ -127,7 +169,15 @@ func OrderLineOptionFromProto(o *corev1.OrderLineOption) OrderLineOption {
 		Title:      o.Title,
 		PriceDelta: MoneyFromProto(o.PriceDelta),
 		Quantity:   o.Quantity,
+		Per:        perFromProto(o.PerOrder),
+	}
+}
+
+func perFromProto(perOrder bool) Per {
+	if perOrder {
+		return PerOrder
 	}
+	return PerUnit
 }
 
 func OrderLineToProto(l OrderLine) *corev1.OrderLine {
@@ -177,15 +227,19 @@ func OrderToProto(o Order) *corev1.Order {
 	}
 
 	return &corev1.Order{
-		Id:        o.ID,
-		SheetId:   o.SheetID,
-		UserId:    o.UserID,
-		Lines:     lines,
-		Subtotal:  MoneyToProto(o.Subtotal),
-		Total:     MoneyToProto(o.Total),
-		Note:      o.Note,
-		CreateAt:  timestamppb.New(o.CreatedAt),
-		UpdatedAt: timestamppb.New(o.UpdatedAt),
+		Id:            o.ID,
+		SheetId:       o.SheetID,
+		UserId:        o.UserID,
+		Lines:         lines,
+		Subtotal:      MoneyToProto(o.Subtotal),
+		Total:         MoneyToProto(o.Total),
+		Note:          o.Note,
+		FeeShare:      MoneyToProto(o.FeeShare),
+		DiscountShare: MoneyToProto(o.DiscountShare),
+		Status:        orderStatusToProto[o.CurrentStatus()],
+		MenuId:        o.MenuID,
+		CreateAt:      timestamppb.New(o.CreatedAt),
+		UpdatedAt:     timestamppb.New(o.UpdatedAt),
 	}
 }
 
@@ -210,14 +264,18 @@ func OrderFromProto(o *corev1.Order) Order {
 	}
 
 	return Order{
-		ID:        o.Id,
-		SheetID:   o.SheetId,
-		UserID:    o.UserId,
-		Lines:     lines,
-		Subtotal:  MoneyFromProto(o.Subtotal),
-		Total:     MoneyFromProto(o.Total),
-		Note:      o.Note,
-		CreatedAt: createdAt,
-		UpdatedAt: updatedAt,
+		ID:            o.Id,
+		SheetID:       o.SheetId,
+		UserID:        o.UserId,
+		Lines:         lines,
+		Subtotal:      MoneyFromProto(o.Subtotal),
+		Total:         MoneyFromProto(o.Total),
+		Note:          o.Note,
+		Status:        orderStatusFromProto[o.Status],
+		MenuID:        o.MenuId,
+		CreatedAt:     createdAt,
+		UpdatedAt:     updatedAt,
+		FeeShare:      MoneyFromProto(o.FeeShare),
+		DiscountShare: MoneyFromProto(o.DiscountShare),
 	}
 }
@@ -0,0 +1,7 @@
+package domain
+
+// Password hash algorithms stored in User.PasswordAlgo
+const (
+	PasswordAlgoBcrypt   = "bcrypt"
+	PasswordAlgoArgon2id = "argon2id"
+)
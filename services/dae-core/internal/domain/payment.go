@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+type PaymentStatus string
+
+const (
+	PaymentStatusUnpaid  PaymentStatus = "unpaid"
+	PaymentStatusPartial PaymentStatus = "partial"
+	PaymentStatusPaid    PaymentStatus = "paid"
+	PaymentStatusWaived  PaymentStatus = "waived"
+)
+
+// Payment records what a member has paid the host for one sheet. Every
+// status change is a new record stored in payments/{sheetID}_{userID}_{sequence};
+// the record with the highest sequence is the member's current payment.
+type Payment struct {
+	ID         string        `firestore:"-" json:"id"`
+	SheetID    string        `firestore:"sheet_id" json:"sheet_id"`
+	UserID     string        `firestore:"user_id" json:"user_id"`
+	Sequence   int64         `firestore:"sequence" json:"sequence"`
+	Status     PaymentStatus `firestore:"status" json:"status"`
+	AmountPaid Money         `firestore:"amount_paid" json:"amount_paid"`
+	Note       string        `firestore:"note" json:"note" audit:"redact"`
+	CreatedAt  time.Time     `firestore:"created_at" json:"created_at"`
+	UpdatedAt  time.Time     `firestore:"updated_at" json:"updated_at"`
+}
+
+// PaymentID returns the document ID of a member's payment record on a sheet
+func PaymentID(sheetID, userID string, sequence int64) string {
+	return fmt.Sprintf("%s_%s_%d", sheetID, userID, sequence)
+}
+
+// LatestPayments keeps the record with the highest sequence of every member
+// and sheet, keyed by sheet and then user ID
+func LatestPayments(payments []*Payment) map[string]map[string]*Payment {
+	latest := make(map[string]map[string]*Payment)
+	for _, p := range payments {
+		bySheet, ok := latest[p.SheetID]
+		if !ok {
+			bySheet = make(map[string]*Payment)
+			latest[p.SheetID] = bySheet
+		}
+		if cur, ok := bySheet[p.UserID]; !ok || p.Sequence > cur.Sequence {
+			bySheet[p.UserID] = p
+		}
+	}
+	return latest
+}
+
+// Balance is a member's settlement total on a sheet against what they paid
+type Balance struct {
+	SheetID     string        `json:"sheet_id"`
+	UserID      string        `json:"user_id"`
+	Status      PaymentStatus `json:"status"`
+	Owed        Money         `json:"owed"`
+	Paid        Money         `json:"paid"`
+	Outstanding Money         `json:"outstanding"`
+}
+
+// IsSettled reports whether nothing is left to pay
+func (b Balance) IsSettled() bool { return b.Outstanding.Amount <= 0 }
+
+// BuildBalances matches every non-host settlement line with its latest
+// payment on the same sheet. Members without a payment record are unpaid.
+func BuildBalances(s *Settlement, payments []*Payment) []Balance {
+	byUser := LatestPayments(payments)[s.SheetID]
+
+	balances := make([]Balance, 0, len(s.Lines))
+	for _, line := range s.Lines {
+		if line.IsHost {
+			continue
+		}
+
+		currency := line.Total.CurrencyCode
+		b := Balance{
+			SheetID: s.SheetID,
+			UserID:  line.UserID,
+			Status:  PaymentStatusUnpaid,
+			Owed:    line.Total,
+			Paid:    NewMoney(0, currency),
+		}
+		if p, ok := byUser[line.UserID]; ok {
+			b.Status = p.Status
+			b.Paid = NewMoney(p.AmountPaid.Amount, currency)
+		}
+
+		outstanding := max(line.Total.Amount-b.Paid.Amount, 0)
+		if b.Status == PaymentStatusWaived {
+			outstanding = 0
+		}
+		b.Outstanding = NewMoney(outstanding, currency)
+
+		balances = append(balances, b)
+	}
+
+	return balances
+}
@@ -0,0 +1,49 @@
+package domain
+
+import "testing"
+
+func TestBuildBalances(t *testing.T) {
+	s := &Settlement{
+		SheetID: "s1",
+		Lines: []SettlementLine{
+			{UserID: "host", IsHost: true, Total: NewMoney(100, "VND")},
+			{UserID: "a", Total: NewMoney(300, "VND")},
+			{UserID: "b", Total: NewMoney(200, "VND")},
+			{UserID: "c", Total: NewMoney(150, "VND")},
+			{UserID: "d", Total: NewMoney(50, "VND")},
+		},
+	}
+	payments := []*Payment{
+		{SheetID: "s1", UserID: "a", Sequence: 2, Status: PaymentStatusPartial, AmountPaid: NewMoney(120, "VND")},
+		{SheetID: "s1", UserID: "a", Sequence: 1, Status: PaymentStatusPaid, AmountPaid: NewMoney(300, "VND")}, // superseded
+		{SheetID: "s1", UserID: "b", Status: PaymentStatusPaid, AmountPaid: NewMoney(200, "VND")},
+		{SheetID: "s1", UserID: "c", Status: PaymentStatusWaived},
+		{SheetID: "s2", UserID: "d", Status: PaymentStatusPaid, AmountPaid: NewMoney(50, "VND")},
+	}
+
+	tests := map[string]struct {
+		status      PaymentStatus
+		paid        int64
+		outstanding int64
+	}{
+		"a": {status: PaymentStatusPartial, paid: 120, outstanding: 180},
+		"b": {status: PaymentStatusPaid, paid: 200, outstanding: 0},
+		"c": {status: PaymentStatusWaived, paid: 0, outstanding: 0},
+		"d": {status: PaymentStatusUnpaid, paid: 0, outstanding: 50}, // paid on another sheet
+	}
+
+	balances := BuildBalances(s, payments)
+	if len(balances) != len(tests) {
+		t.Fatalf("got %d balances, want %d", len(balances), len(tests))
+	}
+	for _, b := range balances {
+		want, ok := tests[b.UserID]
+		if !ok {
+			t.Fatalf("unexpected balance for %s", b.UserID)
+		}
+		if b.Status != want.status || b.Paid.Amount != want.paid || b.Outstanding.Amount != want.outstanding {
+			t.Fatalf("%s: got status %s paid %d outstanding %d, want %s %d %d",
+				b.UserID, b.Status, b.Paid.Amount, b.Outstanding.Amount, want.status, want.paid, want.outstanding)
+		}
+	}
+}
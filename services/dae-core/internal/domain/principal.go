@@ -0,0 +1,17 @@
+package domain
+
+// Principal is the authenticated caller of a request
+type Principal struct {
+	UserID string
+	Roles  []Role
+}
+
+// HasRole reports whether the caller holds role
+func (p *Principal) HasRole(role Role) bool {
+	for _, r := range p.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,232 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidRecurrence is returned for recurrence rules that cannot be parsed
+var ErrInvalidRecurrence = errors.New("invalid recurrence")
+
+type RecurrenceFrequency string
+
+const (
+	RecurDaily    RecurrenceFrequency = "daily"    // every day at TimeOfDay
+	RecurWeekdays RecurrenceFrequency = "weekdays" // Monday to Friday at TimeOfDay
+	RecurWeekly   RecurrenceFrequency = "weekly"   // on Weekdays at TimeOfDay
+	RecurCron     RecurrenceFrequency = "cron"     // on the Cron expression
+)
+
+// Recurrence says when a sheet template fires. Times are wall-clock times in
+// TimeZone.
+type Recurrence struct {
+	Frequency RecurrenceFrequency `firestore:"frequency" json:"frequency"`
+	TimeOfDay string              `firestore:"time_of_day,omitempty" json:"time_of_day,omitempty"` // "15:04", not used by cron
+	Weekdays  []time.Weekday      `firestore:"weekdays,omitempty" json:"weekdays,omitempty"`       // weekly only
+	// Cron is "minute hour day-of-month month day-of-week" with *, lists,
+	// ranges and steps; day-of-week 0 and 7 are Sunday
+	Cron     string `firestore:"cron,omitempty" json:"cron,omitempty"`
+	TimeZone string `firestore:"time_zone,omitempty" json:"time_zone,omitempty"` // IANA name, UTC when empty
+}
+
+// Next returns the first occurrence strictly after after, in UTC
+func (r Recurrence) Next(after time.Time) (time.Time, error) {
+	expr, err := r.cronExpr()
+	if err != nil {
+		return time.Time{}, err
+	}
+	spec, err := parseCron(expr)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	loc, err := r.Location()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	next, ok := spec.next(after, loc)
+	if !ok {
+		return time.Time{}, fmt.Errorf("%w: %q never fires", ErrInvalidRecurrence, expr)
+	}
+	return next.UTC(), nil
+}
+
+// Location is the time zone wall-clock times are read in
+func (r Recurrence) Location() (*time.Location, error) {
+	if r.TimeZone == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(r.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unknown time zone %q", ErrInvalidRecurrence, r.TimeZone)
+	}
+	return loc, nil
+}
+
+// Validate reports whether r can be scheduled
+func (r Recurrence) Validate() error {
+	_, err := r.Next(time.Now())
+	return err
+}
+
+// cronExpr expresses every frequency as a cron expression
+func (r Recurrence) cronExpr() (string, error) {
+	if r.Frequency == RecurCron {
+		return r.Cron, nil
+	}
+
+	at, err := time.Parse("15:04", r.TimeOfDay)
+	if err != nil {
+		return "", fmt.Errorf("%w: time_of_day must be HH:MM", ErrInvalidRecurrence)
+	}
+
+	days := "*"
+	switch r.Frequency {
+	case RecurDaily:
+	case RecurWeekdays:
+		days = "1-5"
+	case RecurWeekly:
+		if len(r.Weekdays) == 0 {
+			return "", fmt.Errorf("%w: weekly recurrence needs weekdays", ErrInvalidRecurrence)
+		}
+		list := make([]string, len(r.Weekdays))
+		for i, d := range r.Weekdays {
+			if d < time.Sunday || d > time.Saturday {
+				return "", fmt.Errorf("%w: unknown weekday %d", ErrInvalidRecurrence, d)
+			}
+			list[i] = strconv.Itoa(int(d))
+		}
+		days = strings.Join(list, ",")
+	default:
+		return "", fmt.Errorf("%w: unknown frequency %q", ErrInvalidRecurrence, r.Frequency)
+	}
+	return fmt.Sprintf("%d %d * * %s", at.Minute(), at.Hour(), days), nil
+}
+
+// cronSpec holds one bit per allowed value of each cron field
+type cronSpec struct {
+	minute, hour, dom, month, dow uint64
+	domAny, dowAny                bool
+}
+
+func parseCron(expr string) (*cronSpec, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("%w: cron %q must have 5 fields", ErrInvalidRecurrence, expr)
+	}
+
+	bounds := [5][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 7}}
+	var sets [5]uint64
+	for i, field := range fields {
+		set, err := parseCronField(field, bounds[i][0], bounds[i][1])
+		if err != nil {
+			return nil, fmt.Errorf("%w: cron field %q: %v", ErrInvalidRecurrence, field, err)
+		}
+		sets[i] = set
+	}
+	// 7 is another name for Sunday
+	if sets[4]&(1<<7) != 0 {
+		sets[4] |= 1
+	}
+
+	return &cronSpec{
+		minute: sets[0],
+		hour:   sets[1],
+		dom:    sets[2],
+		month:  sets[3],
+		dow:    sets[4],
+		domAny: fields[2] == "*",
+		dowAny: fields[4] == "*",
+	}, nil
+}
+
+func parseCronField(field string, min, max int) (uint64, error) {
+	var set uint64
+	for _, part := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			n, err := strconv.Atoi(stepPart)
+			if err != nil || n < 1 {
+				return 0, fmt.Errorf("bad step %q", stepPart)
+			}
+			step = n
+		}
+
+		lo, hi := min, max
+		if rangePart != "*" {
+			from, to, isRange := strings.Cut(rangePart, "-")
+			var err error
+			if lo, err = strconv.Atoi(from); err != nil {
+				return 0, fmt.Errorf("bad value %q", from)
+			}
+			hi = lo
+			if isRange {
+				if hi, err = strconv.Atoi(to); err != nil {
+					return 0, fmt.Errorf("bad value %q", to)
+				}
+			} else if hasStep {
+				hi = max
+			}
+		}
+		if lo < min || hi > max || lo > hi {
+			return 0, fmt.Errorf("%q is outside %d-%d", part, min, max)
+		}
+
+		for v := lo; v <= hi; v += step {
+			set |= 1 << v
+		}
+	}
+	return set, nil
+}
+
+// matchesDay follows cron: when both day fields are restricted either may match
+func (c *cronSpec) matchesDay(t time.Time) bool {
+	if c.month&(1<<uint(t.Month())) == 0 {
+		return false
+	}
+	dom := c.dom&(1<<uint(t.Day())) != 0
+	dow := c.dow&(1<<uint(t.Weekday())) != 0
+	switch {
+	case c.domAny && c.dowAny:
+		return true
+	case c.domAny:
+		return dow
+	case c.dowAny:
+		return dom
+	}
+	return dom || dow
+}
+
+// maxCronSearchDays bounds the search for rules such as "0 0 30 2 *"
+const maxCronSearchDays = 5 * 366
+
+func (c *cronSpec) next(after time.Time, loc *time.Location) (time.Time, bool) {
+	local := after.In(loc)
+	day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+
+	for i := 0; i < maxCronSearchDays; i++ {
+		d := day.AddDate(0, 0, i)
+		if !c.matchesDay(d) {
+			continue
+		}
+		for h := 0; h < 24; h++ {
+			if c.hour&(1<<h) == 0 {
+				continue
+			}
+			for m := 0; m < 60; m++ {
+				if c.minute&(1<<m) == 0 {
+					continue
+				}
+				if t := time.Date(d.Year(), d.Month(), d.Day(), h, m, 0, 0, loc); t.After(after) {
+					return t, true
+				}
+			}
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRecurrenceNext(t *testing.T) {
+	// Friday 2026-03-06 11:30 UTC
+	after := time.Date(2026, 3, 6, 11, 30, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		rule Recurrence
+		want time.Time
+	}{
+		"daily later today": {
+			rule: Recurrence{Frequency: RecurDaily, TimeOfDay: "12:00"},
+			want: time.Date(2026, 3, 6, 12, 0, 0, 0, time.UTC),
+		},
+		"daily at the same minute is tomorrow": {
+			rule: Recurrence{Frequency: RecurDaily, TimeOfDay: "11:30"},
+			want: time.Date(2026, 3, 7, 11, 30, 0, 0, time.UTC),
+		},
+		"weekdays skip the weekend": {
+			rule: Recurrence{Frequency: RecurWeekdays, TimeOfDay: "10:00"},
+			want: time.Date(2026, 3, 9, 10, 0, 0, 0, time.UTC),
+		},
+		"weekly on friday": {
+			rule: Recurrence{Frequency: RecurWeekly, Weekdays: []time.Weekday{time.Friday}, TimeOfDay: "10:00"},
+			want: time.Date(2026, 3, 13, 10, 0, 0, 0, time.UTC),
+		},
+		"weekly in a time zone": {
+			// 18:00 in Ho Chi Minh City is 11:00 UTC, already past
+			rule: Recurrence{Frequency: RecurWeekly, Weekdays: []time.Weekday{time.Friday, time.Monday}, TimeOfDay: "18:00", TimeZone: "Asia/Ho_Chi_Minh"},
+			want: time.Date(2026, 3, 9, 11, 0, 0, 0, time.UTC),
+		},
+		"cron every 15 minutes": {
+			rule: Recurrence{Frequency: RecurCron, Cron: "*/15 * * * *"},
+			want: time.Date(2026, 3, 6, 11, 45, 0, 0, time.UTC),
+		},
+		"cron first of the month": {
+			rule: Recurrence{Frequency: RecurCron, Cron: "0 9 1 * *"},
+			want: time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC),
+		},
+		"cron day of month or sunday": {
+			rule: Recurrence{Frequency: RecurCron, Cron: "0 9 10 * 7"},
+			want: time.Date(2026, 3, 8, 9, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for name, tc := range tests {
+		got, err := tc.rule.Next(after)
+		if err != nil {
+			t.Fatalf("%s: Next error = %v", name, err)
+		}
+		if !got.Equal(tc.want) {
+			t.Fatalf("%s: Next = %v, want %v", name, got, tc.want)
+		}
+	}
+}
+
+func TestRecurrenceValidate(t *testing.T) {
+	for name, rule := range map[string]Recurrence{
+		"unknown frequency": {Frequency: "hourly", TimeOfDay: "10:00"},
+		"bad time":          {Frequency: RecurDaily, TimeOfDay: "25:00"},
+		"weekly no days":    {Frequency: RecurWeekly, TimeOfDay: "10:00"},
+		"bad zone":          {Frequency: RecurDaily, TimeOfDay: "10:00", TimeZone: "Mars/Olympus"},
+		"cron fields":       {Frequency: RecurCron, Cron: "0 9 * *"},
+		"cron range":        {Frequency: RecurCron, Cron: "0 24 * * *"},
+		"cron never":        {Frequency: RecurCron, Cron: "0 0 30 2 *"},
+	} {
+		if err := rule.Validate(); !errors.Is(err, ErrInvalidRecurrence) {
+			t.Fatalf("%s: Validate = %v, want ErrInvalidRecurrence", name, err)
+		}
+	}
+}
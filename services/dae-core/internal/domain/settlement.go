@@ -0,0 +1,29 @@
+package domain
+
+import "errors"
+
+var ErrCurrencyMismatch = errors.New("orders and delivery fee use different currencies")
+
+// SettlementLine is one member's aggregated share of a sheet
+type SettlementLine struct {
+	UserID        string `json:"user_id"`
+	IsHost        bool   `json:"is_host"`
+	OrderCount    int32  `json:"order_count"`
+	Subtotal      Money  `json:"subtotal"`
+	FeeShare      Money  `json:"fee_share"`
+	DiscountShare Money  `json:"discount_share"`
+	Total         Money  `json:"total"`
+}
+
+// Settlement summarises what every member of a sheet owes the host
+type Settlement struct {
+	SheetID       string           `json:"sheet_id"`
+	HostUserID    string           `json:"host_user_id"`
+	Lines         []SettlementLine `json:"lines"`
+	Subtotal      Money            `json:"subtotal"`
+	FeeTotal      Money            `json:"fee_total"`
+	DiscountTotal Money            `json:"discount_total"`
+	Total         Money            `json:"total"`
+	OwedToHost    Money            `json:"owed_to_host"` // Total minus the host's own share
+	Provisional   bool             `json:"provisional"`  // the sheet is not closed yet
+}
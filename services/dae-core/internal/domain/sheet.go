@@ -2,15 +2,30 @@ package domain
 
 import "time"
 
+// FeeSplitMode controls how the delivery fee is divided between orders
+type FeeSplitMode string
+
+const (
+	FeeSplitEqual        FeeSplitMode = "equal"
+	FeeSplitProportional FeeSplitMode = "proportional" // by order subtotal
+)
+
 type Sheet struct {
-	ID          string   `firestore:"-" json:"id"`
-	Name        string   `firestore:"name" json:"name"`
-	Description string   `firestore:"description" json:"description"`
-	HostUserID  string   `firestore:"host_user_id"  json:"host_user_id"`
-	Status      Status   `firestore:"status"         json:"status"`
-	DeliveryFee Money    `firestore:"delivery_fee"   json:"delivery_fee"`
-	Discount    int32    `firestore:"discount"       json:"discount"`
-	MemberIDs   []string `firestore:"member_ids" json:"member_ids"` // Denormalized for backward compat
+	ID           string       `firestore:"-" json:"id"`
+	Name         string       `firestore:"name" json:"name"`
+	Description  string       `firestore:"description" json:"description"`
+	HostUserID   string       `firestore:"host_user_id"  json:"host_user_id"`
+	Status       Status       `firestore:"status"         json:"status"`
+	DeliveryFee  Money        `firestore:"delivery_fee"   json:"delivery_fee"`
+	Discount     int32        `firestore:"discount"       json:"discount"` // percentage, 0..100
+	FeeSplit     FeeSplitMode `firestore:"fee_split_mode" json:"fee_split_mode"`
+	ActiveMenuID string       `firestore:"active_menu_id" json:"active_menu_id"` // changes whenever the menu is replaced
+	MemberIDs    []string     `firestore:"member_ids" json:"member_ids"`         // Denormalized for backward compat
+
+	// Schedule: a pending sheet opens at OpensAt and an open or pending sheet
+	// closes at ClosesAt, the order cutoff. Either may be nil.
+	OpensAt  *time.Time `firestore:"opens_at,omitempty" json:"opens_at,omitempty"`
+	ClosesAt *time.Time `firestore:"closes_at,omitempty" json:"closes_at,omitempty"`
 
 	// Optimistic locking / auditing
 	UpdatedAt time.Time `firestore:"updated_at" json:"updated_at"`
@@ -19,10 +34,36 @@ type Sheet struct {
 
 func (s *Sheet) IsOpen() bool { return s.Status == Status_OPEN }
 
+// PastCutoff reports whether the sheet's closing time has been reached, even
+// if the scheduler has not closed it yet
+func (s *Sheet) PastCutoff(now time.Time) bool {
+	return s.ClosesAt != nil && !now.Before(*s.ClosesAt)
+}
+
+// ScheduledStatus returns the status the schedule moves the sheet to at now,
+// and false when no transition is due
+func (s *Sheet) ScheduledStatus(now time.Time) (Status, bool) {
+	switch {
+	case s.Status == Status_CLOSED:
+		return s.Status, false
+	case s.PastCutoff(now):
+		return Status_CLOSED, true
+	case s.Status == Status_PENDING && s.OpensAt != nil && !now.Before(*s.OpensAt):
+		return Status_OPEN, true
+	}
+	return s.Status, false
+}
+
+// Roles of sheet members
+const (
+	MemberRoleHost   = "host"
+	MemberRoleMember = "member"
+)
+
 // SheetMember represents membership in sheets/{sheetID}/members/{userID} subcollection
 type SheetMember struct {
-	SheetID  string    `firestore:"-"`
-	UserID   string    `firestore:"-"`
+	SheetID  string    `firestore:"-" json:"sheet_id"`
+	UserID   string    `firestore:"-" json:"user_id"`
 	Role     string    `firestore:"role" json:"role"` // "host" | "member"
 	JoinedAt time.Time `firestore:"joined_at" json:"joined_at"`
 }
@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"crypto/rand"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// inviteCodeAlphabet leaves out characters that are easily misread: 0/O,
+// 1/I/L and U/V
+const inviteCodeAlphabet = "ABCDEFGHJKMNPQRSTWXYZ23456789"
+
+// InviteCodeLength is the number of characters of a join code
+const InviteCodeLength = 8
+
+// SheetInvite lets whoever holds its code join a sheet
+type SheetInvite struct {
+	Code      string     `firestore:"-" json:"code"` // normalized, see NormalizeInviteCode
+	SheetID   string     `firestore:"sheet_id" json:"sheet_id"`
+	CreatedBy string     `firestore:"created_by" json:"created_by"`
+	Role      string     `firestore:"role" json:"role"`         // role of members who join with it
+	MaxUses   int32      `firestore:"max_uses" json:"max_uses"` // 0 = unlimited
+	Uses      int32      `firestore:"uses" json:"uses"`
+	ExpiresAt *time.Time `firestore:"expires_at,omitempty" json:"expires_at,omitempty"`
+	RevokedAt *time.Time `firestore:"revoked_at,omitempty" json:"revoked_at,omitempty"`
+
+	CreatedAt time.Time `firestore:"created_at" json:"created_at"`
+	UpdatedAt time.Time `firestore:"updated_at" json:"updated_at"`
+}
+
+// Expired reports whether the invite can no longer be used at now
+func (i *SheetInvite) Expired(now time.Time) bool {
+	return i.ExpiresAt != nil && !now.Before(*i.ExpiresAt)
+}
+
+// UsedUp reports whether the invite has been redeemed MaxUses times
+func (i *SheetInvite) UsedUp() bool {
+	return i.MaxUses > 0 && i.Uses >= i.MaxUses
+}
+
+// DisplayCode is the code split in two halves, as shown to people
+func (i *SheetInvite) DisplayCode() string {
+	if len(i.Code) != InviteCodeLength {
+		return i.Code
+	}
+	half := InviteCodeLength / 2
+	return i.Code[:half] + "-" + i.Code[half:]
+}
+
+// NewInviteCode returns a random join code
+func NewInviteCode() (string, error) {
+	// Bytes at or above limit are dropped so every character is equally likely
+	limit := 256 - 256%len(inviteCodeAlphabet)
+
+	code := make([]byte, 0, InviteCodeLength)
+	buf := make([]byte, 2*InviteCodeLength)
+	for len(code) < InviteCodeLength {
+		if _, err := rand.Read(buf); err != nil {
+			return "", fmt.Errorf("generate invite code: %w", err)
+		}
+		for _, b := range buf {
+			if int(b) < limit && len(code) < InviteCodeLength {
+				code = append(code, inviteCodeAlphabet[int(b)%len(inviteCodeAlphabet)])
+			}
+		}
+	}
+	return string(code), nil
+}
+
+// NormalizeInviteCode turns a code as typed by a person into its stored form:
+// upper case, without separators or spaces
+func NormalizeInviteCode(code string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '-', ' ', '\t':
+			return -1
+		}
+		if r >= 'a' && r <= 'z' {
+			return r - 'a' + 'A'
+		}
+		return r
+	}, strings.TrimSpace(code))
+}
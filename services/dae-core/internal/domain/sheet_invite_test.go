@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewInviteCode(t *testing.T) {
+	seen := make(map[string]bool)
+	for i := 0; i < 200; i++ {
+		code, err := NewInviteCode()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(code) != InviteCodeLength {
+			t.Fatalf("code %q has %d characters, want %d", code, len(code), InviteCodeLength)
+		}
+		for _, r := range code {
+			if !strings.ContainsRune(inviteCodeAlphabet, r) {
+				t.Fatalf("code %q contains %q", code, r)
+			}
+		}
+		if seen[code] {
+			t.Fatalf("code %q generated twice", code)
+		}
+		seen[code] = true
+	}
+}
+
+func TestInviteCodeRoundTrip(t *testing.T) {
+	invite := &SheetInvite{Code: "ABCD2345"}
+	if got := invite.DisplayCode(); got != "ABCD-2345" {
+		t.Fatalf("DisplayCode() = %q, want ABCD-2345", got)
+	}
+	for _, typed := range []string{"ABCD-2345", "abcd-2345", " abcd 2345 ", "ABCD2345"} {
+		if got := NormalizeInviteCode(typed); got != invite.Code {
+			t.Errorf("NormalizeInviteCode(%q) = %q, want %q", typed, got, invite.Code)
+		}
+	}
+}
+
+func TestInviteLimits(t *testing.T) {
+	now := time.Date(2026, 3, 6, 11, 0, 0, 0, time.UTC)
+	expires := now.Add(time.Hour)
+	invite := &SheetInvite{MaxUses: 2, Uses: 1, ExpiresAt: &expires}
+
+	if invite.Expired(now) || invite.UsedUp() {
+		t.Fatal("fresh invite reported unusable")
+	}
+	if !invite.Expired(expires) {
+		t.Fatal("invite still usable at its expiry")
+	}
+	invite.Uses = 2
+	if !invite.UsedUp() {
+		t.Fatal("invite usable past max uses")
+	}
+	if (&SheetInvite{Uses: 1000}).UsedUp() {
+		t.Fatal("invite without max uses reported used up")
+	}
+}
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// SheetTemplate is a saved sheet that the scheduler creates again on every
+// occurrence of its recurrence
+type SheetTemplate struct {
+	ID          string       `firestore:"-" json:"id"`
+	Name        string       `firestore:"name" json:"name"`
+	Description string       `firestore:"description" json:"description"`
+	HostUserID  string       `firestore:"host_user_id" json:"host_user_id"`
+	MemberIDs   []string     `firestore:"member_ids" json:"member_ids"`
+	DeliveryFee Money        `firestore:"delivery_fee" json:"delivery_fee"`
+	Discount    int32        `firestore:"discount" json:"discount"`
+	FeeSplit    FeeSplitMode `firestore:"fee_split_mode" json:"fee_split_mode"`
+	MenuItems   []MenuItem   `firestore:"menu_items" json:"menu_items"`
+
+	Recurrence Recurrence `firestore:"recurrence" json:"recurrence"`
+	// Sheets close this long after they are created; 0 means no cutoff
+	CloseAfterMinutes int32      `firestore:"close_after_minutes" json:"close_after_minutes"`
+	Paused            bool       `firestore:"paused" json:"paused"`
+	NextRunAt         *time.Time `firestore:"next_run_at,omitempty" json:"next_run_at,omitempty"` // nil while paused
+	LastSheetID       string     `firestore:"last_sheet_id,omitempty" json:"last_sheet_id,omitempty"`
+
+	CreatedAt time.Time `firestore:"created_at" json:"created_at"`
+	UpdatedAt time.Time `firestore:"updated_at" json:"updated_at"`
+}
+
+// OccurrenceKey is the idempotency key of the sheet created for the
+// occurrence at, so an occurrence creates at most one sheet
+func (t *SheetTemplate) OccurrenceKey(at time.Time) string {
+	return fmt.Sprintf("sheet-template:%s:%d", t.ID, at.Unix())
+}
+
+// Reschedule sets NextRunAt to the first occurrence after after, or clears it
+// while the template is paused
+func (t *SheetTemplate) Reschedule(after time.Time) error {
+	if t.Paused {
+		t.NextRunAt = nil
+		return nil
+	}
+	next, err := t.Recurrence.Next(after)
+	if err != nil {
+		return err
+	}
+	t.NextRunAt = &next
+	return nil
+}
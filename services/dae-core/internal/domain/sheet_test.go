@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSheetScheduledStatus(t *testing.T) {
+	now := time.Date(2026, 3, 6, 11, 30, 0, 0, time.UTC)
+	at := func(d time.Duration) *time.Time {
+		t := now.Add(d)
+		return &t
+	}
+
+	tests := map[string]struct {
+		sheet      Sheet
+		want       Status
+		due        bool
+		pastCutoff bool
+	}{
+		"unscheduled":          {sheet: Sheet{Status: Status_OPEN}, want: Status_OPEN},
+		"pending before open":  {sheet: Sheet{Status: Status_PENDING, OpensAt: at(time.Minute)}, want: Status_PENDING},
+		"pending at open":      {sheet: Sheet{Status: Status_PENDING, OpensAt: at(0)}, want: Status_OPEN, due: true},
+		"open before cutoff":   {sheet: Sheet{Status: Status_OPEN, OpensAt: at(-time.Hour), ClosesAt: at(time.Second)}, want: Status_OPEN},
+		"open at cutoff":       {sheet: Sheet{Status: Status_OPEN, ClosesAt: at(0)}, want: Status_CLOSED, due: true, pastCutoff: true},
+		"pending past both":    {sheet: Sheet{Status: Status_PENDING, OpensAt: at(-2 * time.Hour), ClosesAt: at(-time.Hour)}, want: Status_CLOSED, due: true, pastCutoff: true},
+		"closed stays closed":  {sheet: Sheet{Status: Status_CLOSED, ClosesAt: at(-time.Hour)}, want: Status_CLOSED, pastCutoff: true},
+		"open without opening": {sheet: Sheet{Status: Status_OPEN, OpensAt: at(time.Hour)}, want: Status_OPEN},
+	}
+
+	for name, tc := range tests {
+		got, due := tc.sheet.ScheduledStatus(now)
+		if got != tc.want || due != tc.due {
+			t.Fatalf("%s: ScheduledStatus = %v, %v; want %v, %v", name, got, due, tc.want, tc.due)
+		}
+		if tc.sheet.PastCutoff(now) != tc.pastCutoff {
+			t.Fatalf("%s: PastCutoff = %v, want %v", name, !tc.pastCutoff, tc.pastCutoff)
+		}
+	}
+}
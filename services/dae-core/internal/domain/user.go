@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"strings"
 	"time"
 )
 
@@ -12,6 +13,10 @@ const (
 	RoleHost        Role = "host"
 	RoleAdmin       Role = "admin"
 	RoleSuperAdmin  Role = "superadmin"
+
+	// RoleService is carried by tokens the gateway mints for itself. It is
+	// never stored on a user.
+	RoleService Role = "service"
 )
 
 var ValidRoles = map[Role]bool{
@@ -28,6 +33,72 @@ const (
 	UserStatusDeleted   UserStatus = "deleted"
 )
 
+// CanLogin reports whether the user may start a new session
+func (u *User) CanLogin() bool {
+	if u.IsDisabled {
+		return false
+	}
+	return u.Status == "" || u.Status == UserStatusActive
+}
+
+// IsDeleted reports whether the account was deleted by its owner or an admin
+func (u *User) IsDeleted() bool { return u.Status == UserStatusDeleted }
+
+// ErasedUserPrefix starts the pseudonym an erased user's ID is replaced with
+const ErasedUserPrefix = "erased-"
+
+// MaxSearchPrefixLen bounds the stored search prefixes. Longer queries are
+// looked up by their first MaxSearchPrefixLen runes and then matched in full.
+const MaxSearchPrefixLen = 20
+
+// BuildSearchPrefixes returns every prefix of the user's searchable words:
+// the words of the name and display name, the email and its local part
+func (u *User) BuildSearchPrefixes() []string {
+	seen := make(map[string]bool)
+	var prefixes []string
+	for _, word := range u.searchWords() {
+		runes := []rune(word)
+		for n := 1; n <= len(runes) && n <= MaxSearchPrefixLen; n++ {
+			p := string(runes[:n])
+			if !seen[p] {
+				seen[p] = true
+				prefixes = append(prefixes, p)
+			}
+		}
+	}
+	return prefixes
+}
+
+// MatchesSearch reports whether one of the user's searchable words starts
+// with the normalized query q
+func (u *User) MatchesSearch(q string) bool {
+	for _, word := range u.searchWords() {
+		if strings.HasPrefix(word, q) {
+			return true
+		}
+	}
+	return false
+}
+
+// SearchKey is the stored prefix a normalized query is looked up by
+func SearchKey(q string) string {
+	runes := []rune(q)
+	if len(runes) > MaxSearchPrefixLen {
+		runes = runes[:MaxSearchPrefixLen]
+	}
+	return string(runes)
+}
+
+func (u *User) searchWords() []string {
+	words := strings.Fields(strings.ToLower(u.Name))
+	words = append(words, strings.Fields(strings.ToLower(u.DisplayName))...)
+	if email := strings.ToLower(strings.TrimSpace(u.EmailNormalized)); email != "" {
+		local, _, _ := strings.Cut(email, "@")
+		words = append(words, email, local)
+	}
+	return words
+}
+
 type IdentityProvider string
 
 const (
@@ -37,27 +108,41 @@ const (
 
 type User struct {
 	ID              string     `firestore:"-" json:"id"`
-	Email           string     `firestore:"email" json:"email"`
-	EmailNormalized string     `firestore:"email_normalized" json:"email_normalized"`
+	Email           string     `firestore:"email" json:"email" audit:"redact"`
+	EmailNormalized string     `firestore:"email_normalized" json:"email_normalized" audit:"redact"`
 	EmailVerified   bool       `firestore:"email_verified" json:"email_verified"`
-	Name            string     `firestore:"name" json:"name"`
-	DisplayName     string     `firestore:"display_name" json:"display_name"`
-	PhotoURL        string     `firestore:"photo_url" json:"photo_url"`
-	Phone           string     `firestore:"phone" json:"phone"`
+	Name            string     `firestore:"name" json:"name" audit:"redact"`
+	DisplayName     string     `firestore:"display_name" json:"display_name" audit:"redact"`
+	PhotoURL        string     `firestore:"photo_url" json:"photo_url" audit:"redact"`
+	Phone           string     `firestore:"phone" json:"phone" audit:"redact"`
 	Roles           []Role     `firestore:"roles" json:"roles"`
 	Status          UserStatus `firestore:"status" json:"status"`
 	CreatedAt       time.Time  `firestore:"created_at" json:"created_at"`
 	UpdatedAt       time.Time  `firestore:"updated_at" json:"updated_at"`
 	LastLoginAt     *time.Time `firestore:"last_login_at,omitempty" json:"last_login_at,omitempty"`
 
+	// Set by DeleteUser and cleared once the erasure job has run
+	DeletedAt *time.Time `firestore:"deleted_at,omitempty" json:"deleted_at,omitempty"`
+	ErasedAt  *time.Time `firestore:"erased_at,omitempty" json:"erased_at,omitempty"`
+	// Pseudonym replacing the user ID in orders, sheets and payments, kept
+	// while an erasure is in progress so a retry reuses it
+	ErasedAs string `firestore:"erased_as,omitempty" json:"-"`
+
+	// Word prefixes ListUsers searches on, kept in sync by the repository
+	SearchPrefixes []string `firestore:"search_prefixes,omitempty" json:"-"`
+
 	// Password-based authentication (optional)
 	PasswordHash      *string    `firestore:"password_hash,omitempty" json:"-"`
 	PasswordAlgo      *string    `firestore:"password_algo,omitempty" json:"-"`
 	PasswordUpdatedAt *time.Time `firestore:"password_updated_at,omitempty" json:"password_updated_at,omitempty"`
+	// Hash of the outstanding reset token, cleared in the same write that
+	// sets the new password
+	PasswordResetHash      *string    `firestore:"password_reset_hash,omitempty" json:"-"`
+	PasswordResetExpiresAt *time.Time `firestore:"password_reset_expires_at,omitempty" json:"-"`
 
 	// Legacy fields for backward compatibility
-	UserName   string `firestore:"user_name,omitempty" json:"user_name,omitempty"`
-	AvatarURL  string `firestore:"avatar_url,omitempty" json:"avatar_url,omitempty"`
+	UserName   string `firestore:"user_name,omitempty" json:"user_name,omitempty" audit:"redact"`
+	AvatarURL  string `firestore:"avatar_url,omitempty" json:"avatar_url,omitempty" audit:"redact"`
 	IsDisabled bool   `firestore:"is_disabled,omitempty" json:"is_disabled,omitempty"`
 }
 
@@ -65,8 +150,8 @@ type UserIdentity struct {
 	ID            string           `firestore:"-" json:"id"` // {uid}/identities/{provider}
 	UserID        string           `firestore:"user_id" json:"user_id"`
 	Provider      IdentityProvider `firestore:"provider" json:"provider"`
-	Subject       string           `firestore:"subject" json:"subject"` // Provider's user ID
-	EmailAtSignup string           `firestore:"email_at_signup" json:"email_at_signup"`
+	Subject       string           `firestore:"subject" json:"subject" audit:"redact"` // Provider's user ID
+	EmailAtSignup string           `firestore:"email_at_signup" json:"email_at_signup" audit:"redact"`
 	LinkedAt      time.Time        `firestore:"linked_at" json:"linked_at"`
 	LastLoginAt   *time.Time       `firestore:"last_login_at,omitempty" json:"last_login_at,omitempty"`
 }
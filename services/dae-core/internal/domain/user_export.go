@@ -0,0 +1,14 @@
+package domain
+
+import "time"
+
+// UserDataExport is everything stored about one user, returned to them as a
+// JSON archive
+type UserDataExport struct {
+	ExportedAt  time.Time       `json:"exported_at"`
+	User        *User           `json:"user"`
+	Identities  []*UserIdentity `json:"identities"`
+	Memberships []*SheetMember  `json:"memberships"`
+	Orders      []*Order        `json:"orders"`
+	Payments    []*Payment      `json:"payments"`
+}
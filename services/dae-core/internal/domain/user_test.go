@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestUserSearch(t *testing.T) {
+	u := &User{Name: "Nguyen Van An", DisplayName: "An", EmailNormalized: "an.nguyen@example.com"}
+	prefixes := u.BuildSearchPrefixes()
+
+	for _, q := range []string{"n", "ngu", "van", "an", "an.n", "an.nguyen@ex"} {
+		if !slices.Contains(prefixes, SearchKey(q)) {
+			t.Fatalf("prefixes missing %q", q)
+		}
+		if !u.MatchesSearch(q) {
+			t.Fatalf("MatchesSearch(%q) = false", q)
+		}
+	}
+	for _, q := range []string{"guyen", "example", "bob"} {
+		if u.MatchesSearch(q) {
+			t.Fatalf("MatchesSearch(%q) = true, want false", q)
+		}
+	}
+
+	// Prefixes are capped; longer queries still match in full
+	long := "an.nguyen@example.com"
+	if slices.Contains(prefixes, long) {
+		t.Fatalf("stored a prefix longer than %d runes", MaxSearchPrefixLen)
+	}
+	if !slices.Contains(prefixes, SearchKey(long)) || !u.MatchesSearch(long) {
+		t.Fatalf("long query %q not found", long)
+	}
+	if u.MatchesSearch("an.nguyen@example.org") {
+		t.Fatalf("long query matched on its stored prefix only")
+	}
+
+	seen := map[string]bool{}
+	for _, p := range prefixes {
+		if seen[p] {
+			t.Fatalf("duplicate prefix %q", p)
+		}
+		seen[p] = true
+	}
+}
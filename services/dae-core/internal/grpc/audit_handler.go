@@ -0,0 +1,29 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/audit"
+	"github.com/deni12345/dae-services/services/dae-core/internal/grpc/converter"
+	"github.com/deni12345/dae-services/services/dae-core/internal/grpc/errors"
+	corev1 "github.com/deni12345/dae-services/proto/gen"
+)
+
+type AuditHandler struct {
+	corev1.UnimplementedAuditServiceServer
+	uc audit.Usecase
+}
+
+func NewAuditHandler(uc audit.Usecase) *AuditHandler {
+	return &AuditHandler{
+		uc: uc,
+	}
+}
+
+func (h *AuditHandler) ListAuditEvents(ctx context.Context, req *corev1.ListAuditEventsReq) (*corev1.ListAuditEventsResp, error) {
+	resp, err := h.uc.ListAuditEvents(ctx, converter.ListAuditEventsReqFromProto(req))
+	if err != nil {
+		return nil, errors.ToGRPCStatus(err)
+	}
+	return converter.ListAuditEventsRespToProto(resp), nil
+}
@@ -0,0 +1,75 @@
+package converter
+
+import (
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/audit"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	corev1 "github.com/deni12345/dae-services/proto/gen"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Audit resource type mappings
+var protoToDomainAuditResourceMap = map[corev1.AuditResourceType]domain.AuditResourceType{
+	corev1.AuditResourceType_AUDIT_RESOURCE_TYPE_USER:           domain.AuditResourceUser,
+	corev1.AuditResourceType_AUDIT_RESOURCE_TYPE_SHEET:          domain.AuditResourceSheet,
+	corev1.AuditResourceType_AUDIT_RESOURCE_TYPE_ORDER:          domain.AuditResourceOrder,
+	corev1.AuditResourceType_AUDIT_RESOURCE_TYPE_PAYMENT:        domain.AuditResourcePayment,
+	corev1.AuditResourceType_AUDIT_RESOURCE_TYPE_SHEET_TEMPLATE: domain.AuditResourceTemplate,
+}
+
+var domainToProtoAuditResourceMap = map[domain.AuditResourceType]corev1.AuditResourceType{
+	domain.AuditResourceUser:     corev1.AuditResourceType_AUDIT_RESOURCE_TYPE_USER,
+	domain.AuditResourceSheet:    corev1.AuditResourceType_AUDIT_RESOURCE_TYPE_SHEET,
+	domain.AuditResourceOrder:    corev1.AuditResourceType_AUDIT_RESOURCE_TYPE_ORDER,
+	domain.AuditResourcePayment:  corev1.AuditResourceType_AUDIT_RESOURCE_TYPE_PAYMENT,
+	domain.AuditResourceTemplate: corev1.AuditResourceType_AUDIT_RESOURCE_TYPE_SHEET_TEMPLATE,
+}
+
+func ListAuditEventsReqFromProto(req *corev1.ListAuditEventsReq) *audit.ListAuditEventsReq {
+	dto := &audit.ListAuditEventsReq{
+		PageSize: req.GetPageSize(),
+	}
+	if cursor := req.GetCursor(); cursor != nil && cursor.GetId() != "" {
+		dto.Cursor = cursor.GetId()
+	}
+	if filter := req.GetFilter(); filter != nil {
+		dto.ResourceType = protoToDomainAuditResourceMap[filter.GetResourceType()]
+		dto.ResourceID = filter.GetResourceId()
+		dto.ActorID = filter.GetActorId()
+	}
+	return dto
+}
+
+func AuditEventToProto(e *domain.AuditEvent) *corev1.AuditEvent {
+	if e == nil {
+		return nil
+	}
+
+	changes := make([]*corev1.AuditChange, 0, len(e.Changes))
+	for _, c := range e.Changes {
+		changes = append(changes, &corev1.AuditChange{Field: c.Field, Before: c.Before, After: c.After})
+	}
+
+	return &corev1.AuditEvent{
+		Id:             e.ID,
+		ActorId:        e.ActorID,
+		Method:         e.Method,
+		ResourceType:   domainToProtoAuditResourceMap[e.ResourceType],
+		ResourceId:     e.ResourceID,
+		Changes:        changes,
+		IdempotencyKey: e.IdempotencyKey,
+		OccurredAt:     timestamppb.New(e.OccurredAt),
+	}
+}
+
+func ListAuditEventsRespToProto(resp *audit.ListAuditEventsResp) *corev1.ListAuditEventsResp {
+	out := &corev1.ListAuditEventsResp{
+		Events: make([]*corev1.AuditEvent, 0, len(resp.Events)),
+	}
+	for _, e := range resp.Events {
+		out.Events = append(out.Events, AuditEventToProto(e))
+	}
+	if resp.NextCursor != "" {
+		out.NextCursor = &corev1.Cursor{Id: resp.NextCursor}
+	}
+	return out
+}
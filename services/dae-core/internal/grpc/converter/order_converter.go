@@ -32,7 +32,6 @@ func CreateOrderReqFromProto(req *corev1.CreateOrderReq) *order.CreateOrderReq {
 		SheetID: req.SheetId,
 		Lines:   lines,
 		Note:    req.Note,
-		UserID:  req.UserId,
 	}
 }
 
@@ -84,9 +83,18 @@ func ListOrdersReqFromProto(req *corev1.ListOrdersReq) *order.ListOrdersReq {
 			t := since.AsTime()
 			dto.Filter.Since = &t
 		}
+
+		dto.Filter.Status = domain.OrderStatusFromProto(filter.GetStatus())
 	}
 
 	return dto
+}
+
+func StreamOrdersReqFromProto(req *corev1.StreamOrdersRequest) *order.StreamOrdersReq {
+	return &order.StreamOrdersReq{
+		SheetID: req.GetSheetId(),
+		Cursor:  req.GetResumeCursor(),
+	}
 } // Domain to Proto conversions
 
 func OrderToProto(o *domain.Order) *corev1.Order {
@@ -100,15 +108,19 @@ func OrderToProto(o *domain.Order) *corev1.Order {
 	}
 
 	return &corev1.Order{
-		Id:        o.ID,
-		SheetId:   o.SheetID,
-		UserId:    o.UserID,
-		Lines:     lines,
-		Subtotal:  MoneyToProto(o.Subtotal),
-		Total:     MoneyToProto(o.Total),
-		Note:      o.Note,
-		CreateAt:  timestamppb.New(o.CreatedAt),
-		UpdatedAt: timestamppb.New(o.UpdatedAt),
+		Id:            o.ID,
+		SheetId:       o.SheetID,
+		UserId:        o.UserID,
+		Lines:         lines,
+		Subtotal:      MoneyToProto(o.Subtotal),
+		Total:         MoneyToProto(o.Total),
+		Note:          o.Note,
+		FeeShare:      MoneyToProto(o.FeeShare),
+		DiscountShare: MoneyToProto(o.DiscountShare),
+		Status:        domain.OrderStatusToProto(o.CurrentStatus()),
+		MenuId:        o.MenuID,
+		CreateAt:      timestamppb.New(o.CreatedAt),
+		UpdatedAt:     timestamppb.New(o.UpdatedAt),
 	}
 }
 
@@ -121,6 +133,7 @@ func OrderLineToProto(line domain.OrderLine) *corev1.OrderLine {
 			Title:      opt.Title,
 			PriceDelta: MoneyToProto(opt.PriceDelta),
 			Quantity:   opt.Quantity,
+			PerOrder:   opt.Per == domain.PerOrder,
 		}
 	}
 
@@ -170,3 +183,23 @@ func ListOrdersRespToProto(resp *order.ListOrdersResp) *corev1.ListOrdersResp {
 
 	return protoResp
 }
+
+var orderEventTypeToProto = map[domain.OrderEventType]corev1.OrderEventType{
+	domain.OrderEventCreated:   corev1.OrderEventType_ORDER_EVENT_TYPE_CREATED,
+	domain.OrderEventUpdated:   corev1.OrderEventType_ORDER_EVENT_TYPE_UPDATED,
+	domain.OrderEventCancelled: corev1.OrderEventType_ORDER_EVENT_TYPE_CANCELLED,
+}
+
+// OrderEventToProto converts a domain order event to a stream response
+func OrderEventToProto(ev *domain.OrderEvent) *corev1.StreamOrdersResponse {
+	if ev == nil {
+		return nil
+	}
+
+	return &corev1.StreamOrdersResponse{
+		Type:       orderEventTypeToProto[ev.Type],
+		Order:      OrderToProto(ev.Order),
+		Cursor:     ev.Cursor,
+		OccurredAt: timestamppb.New(ev.OccurredAt),
+	}
+}
@@ -0,0 +1,85 @@
+package converter
+
+import (
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/payment"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	corev1 "github.com/deni12345/dae-services/proto/gen"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Payment status mappings
+var protoToDomainPaymentStatusMap = map[corev1.PaymentStatus]domain.PaymentStatus{
+	corev1.PaymentStatus_PAYMENT_STATUS_UNPAID:  domain.PaymentStatusUnpaid,
+	corev1.PaymentStatus_PAYMENT_STATUS_PARTIAL: domain.PaymentStatusPartial,
+	corev1.PaymentStatus_PAYMENT_STATUS_PAID:    domain.PaymentStatusPaid,
+	corev1.PaymentStatus_PAYMENT_STATUS_WAIVED:  domain.PaymentStatusWaived,
+}
+
+var domainToProtoPaymentStatusMap = map[domain.PaymentStatus]corev1.PaymentStatus{
+	domain.PaymentStatusUnpaid:  corev1.PaymentStatus_PAYMENT_STATUS_UNPAID,
+	domain.PaymentStatusPartial: corev1.PaymentStatus_PAYMENT_STATUS_PARTIAL,
+	domain.PaymentStatusPaid:    corev1.PaymentStatus_PAYMENT_STATUS_PAID,
+	domain.PaymentStatusWaived:  corev1.PaymentStatus_PAYMENT_STATUS_WAIVED,
+}
+
+// Proto to DTO conversions
+
+func SetPaymentStatusReqFromProto(req *corev1.SetPaymentStatusReq) *payment.SetPaymentStatusReq {
+	dto := &payment.SetPaymentStatusReq{
+		SheetID: req.GetSheetId(),
+		UserID:  req.GetUserId(),
+		Status:  protoToDomainPaymentStatusMap[req.GetStatus()],
+		Note:    req.GetNote(),
+	}
+	if amount := req.GetAmountPaid(); amount != nil {
+		dto.AmountPaid = domain.NewMoney(amount.GetAmount(), amount.GetCurrencyCode())
+	}
+	return dto
+}
+
+// Domain to Proto conversions
+
+func PaymentToProto(p *domain.Payment) *corev1.Payment {
+	if p == nil {
+		return nil
+	}
+
+	return &corev1.Payment{
+		Id:         p.ID,
+		SheetId:    p.SheetID,
+		UserId:     p.UserID,
+		Sequence:   p.Sequence,
+		Status:     domainToProtoPaymentStatusMap[p.Status],
+		AmountPaid: MoneyToProto(p.AmountPaid),
+		Note:       p.Note,
+		CreatedAt:  timestamppb.New(p.CreatedAt),
+		UpdatedAt:  timestamppb.New(p.UpdatedAt),
+	}
+}
+
+func BalancesToProto(balances []domain.Balance) []*corev1.Balance {
+	result := make([]*corev1.Balance, len(balances))
+	for i, b := range balances {
+		result[i] = &corev1.Balance{
+			SheetId:     b.SheetID,
+			UserId:      b.UserID,
+			Status:      domainToProtoPaymentStatusMap[b.Status],
+			Owed:        MoneyToProto(b.Owed),
+			Paid:        MoneyToProto(b.Paid),
+			Outstanding: MoneyToProto(b.Outstanding),
+		}
+	}
+	return result
+}
+
+func ListUserDebtsRespToProto(resp *payment.ListUserDebtsResp) *corev1.ListUserDebtsResp {
+	totals := make([]*corev1.Money, len(resp.Totals))
+	for i, m := range resp.Totals {
+		totals[i] = MoneyToProto(m)
+	}
+
+	return &corev1.ListUserDebtsResp{
+		Balances: BalancesToProto(resp.Balances),
+		Totals:   totals,
+	}
+}
@@ -1,6 +1,9 @@
 package converter
 
 import (
+	"sort"
+	"time"
+
 	"github.com/deni12345/dae-services/services/dae-core/internal/app/sheet"
 	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
 	corev1 "github.com/deni12345/dae-services/proto/gen"
@@ -22,6 +25,18 @@ var domainToProtoStatusMap = map[domain.Status]corev1.SheetStatus{
 	domain.Status_UNKNOWN: corev1.SheetStatus_SHEET_STATUS_UNSPECIFIED,
 }
 
+// Fee split mappings
+var protoToDomainFeeSplitMap = map[corev1.FeeSplitMode]domain.FeeSplitMode{
+	corev1.FeeSplitMode_FEE_SPLIT_MODE_UNSPECIFIED:  domain.FeeSplitEqual,
+	corev1.FeeSplitMode_FEE_SPLIT_MODE_EQUAL:        domain.FeeSplitEqual,
+	corev1.FeeSplitMode_FEE_SPLIT_MODE_PROPORTIONAL: domain.FeeSplitProportional,
+}
+
+var domainToProtoFeeSplitMap = map[domain.FeeSplitMode]corev1.FeeSplitMode{
+	domain.FeeSplitEqual:        corev1.FeeSplitMode_FEE_SPLIT_MODE_EQUAL,
+	domain.FeeSplitProportional: corev1.FeeSplitMode_FEE_SPLIT_MODE_PROPORTIONAL,
+}
+
 // CreateSheetReqFromProto converts proto CreateSheetReq to DTO
 func CreateSheetReqFromProto(req *corev1.CreateSheetReq) *sheet.CreateSheetReq {
 	var deliveryFee *domain.Money
@@ -36,14 +51,25 @@ func CreateSheetReqFromProto(req *corev1.CreateSheetReq) *sheet.CreateSheetReq {
 		IdempotencyKey: req.GetIdempotencyKey(),
 		Name:           req.GetName(),
 		Description:    req.GetDescription(),
-		HostUserID:     req.GetHostUserId(),
 		DeliveryFee:    deliveryFee,
 		Discount:       req.GetDiscount(),
+		FeeSplit:       protoToDomainFeeSplitMap[req.GetFeeSplitMode()],
 		MemberIDs:      req.GetMemberIds(),
 		MenuItems:      MenuItemsFromProto(req.GetItems()),
+		OpensAt:        timeFromProto(req.GetOpensAt()),
+		ClosesAt:       timeFromProto(req.GetClosesAt()),
 	}
 }
 
+// timeFromProto returns nil for an unset timestamp
+func timeFromProto(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
 // MenuItemsFromProto converts proto MenuItems to DTO MenuItemReq
 func MenuItemsFromProto(protoItems []*corev1.MenuItem) []sheet.MenuItemReq {
 	if len(protoItems) == 0 {
@@ -107,10 +133,12 @@ func MenuOptionsFromProto(protoOptions []*corev1.MenuOption) []sheet.MenuOptionR
 		}
 
 		options[i] = sheet.MenuOptionReq{
-			ID:     opt.GetId(),
-			Name:   opt.GetTitle(),
-			Price:  price,
-			Active: opt.GetAvailable(),
+			ID:          opt.GetId(),
+			Name:        opt.GetTitle(),
+			Price:       price,
+			Active:      opt.GetAvailable(),
+			MaxQuantity: opt.GetMaxQuantity(),
+			PerOrder:    opt.GetPerOrder(),
 		}
 	}
 	return options
@@ -122,7 +150,7 @@ func SheetToProto(s *domain.Sheet) *corev1.Sheet {
 		return nil
 	}
 
-	return &corev1.Sheet{
+	protoSheet := &corev1.Sheet{
 		Id:          s.ID,
 		Name:        s.Name,
 		Description: s.Description,
@@ -131,11 +159,21 @@ func SheetToProto(s *domain.Sheet) *corev1.Sheet {
 			CurrencyCode: s.DeliveryFee.CurrencyCode,
 			Amount:       s.DeliveryFee.Amount,
 		},
-		Discount:  s.Discount,
-		Status:    domainToProtoStatusMap[s.Status],
-		CreatedAt: timestamppb.New(s.CreatedAt),
-		UpdatedAt: timestamppb.New(s.UpdatedAt),
+		Discount:     s.Discount,
+		ActiveMenuId: s.ActiveMenuID,
+		Status:       domainToProtoStatusMap[s.Status],
+		FeeSplitMode: domainToProtoFeeSplitMap[s.FeeSplit],
+		CreatedAt:    timestamppb.New(s.CreatedAt),
+		UpdatedAt:    timestamppb.New(s.UpdatedAt),
+	}
+	if s.OpensAt != nil {
+		protoSheet.OpensAt = timestamppb.New(*s.OpensAt)
 	}
+	if s.ClosesAt != nil {
+		protoSheet.ClosesAt = timestamppb.New(*s.ClosesAt)
+	}
+
+	return protoSheet
 }
 
 // UpdateSheetReqFromProto converts proto UpdateSheetReq to DTO
@@ -157,6 +195,14 @@ func UpdateSheetReqFromProto(req *corev1.UpdateSheetReq) *sheet.UpdateSheetReq {
 		dto.Status = &status
 	}
 
+	if req.FeeSplitMode != nil {
+		feeSplit := protoToDomainFeeSplitMap[*req.FeeSplitMode]
+		dto.FeeSplit = &feeSplit
+	}
+
+	dto.OpensAt = timeFromProto(req.GetOpensAt())
+	dto.ClosesAt = timeFromProto(req.GetClosesAt())
+
 	return dto
 }
 
@@ -177,10 +223,226 @@ func SheetMemberToProto(m *domain.SheetMember) *corev1.SheetMember {
 	return &corev1.SheetMember{
 		UserId:   m.UserID,
 		SheetId:  m.SheetID,
+		Role:     m.Role,
 		JoinedAt: timestamppb.New(m.JoinedAt),
 	}
 }
 
+// CreateSheetInviteReqFromProto converts proto CreateSheetInviteReq to DTO
+func CreateSheetInviteReqFromProto(req *corev1.CreateSheetInviteReq) *sheet.CreateSheetInviteReq {
+	return &sheet.CreateSheetInviteReq{
+		SheetID:   req.GetSheetId(),
+		ExpiresAt: timeFromProto(req.GetExpiresAt()),
+		MaxUses:   req.GetMaxUses(),
+		Role:      req.GetRole(),
+	}
+}
+
+// SheetInviteToProto converts domain SheetInvite to proto
+func SheetInviteToProto(i *domain.SheetInvite) *corev1.SheetInvite {
+	if i == nil {
+		return nil
+	}
+
+	out := &corev1.SheetInvite{
+		Code:      i.DisplayCode(),
+		SheetId:   i.SheetID,
+		CreatedBy: i.CreatedBy,
+		Role:      i.Role,
+		MaxUses:   i.MaxUses,
+		Uses:      i.Uses,
+		CreatedAt: timestamppb.New(i.CreatedAt),
+	}
+	if i.ExpiresAt != nil {
+		out.ExpiresAt = timestamppb.New(*i.ExpiresAt)
+	}
+	if i.RevokedAt != nil {
+		out.RevokedAt = timestamppb.New(*i.RevokedAt)
+	}
+	return out
+}
+
+// ListMembersReqFromProto converts proto ListMembersRequest to DTO
+func ListMembersReqFromProto(req *corev1.ListMembersRequest) *sheet.ListMembersReq {
+	dto := &sheet.ListMembersReq{
+		SheetID: req.GetSheetId(),
+		Limit:   req.GetPageSize(),
+	}
+
+	if cursor := req.GetCursor(); cursor != nil && cursor.GetId() != "" {
+		dto.Cursor = cursor.GetId()
+	}
+
+	return dto
+}
+
+// ListMembersRespToProto converts DTO ListMembersResp to proto
+func ListMembersRespToProto(resp *sheet.ListMembersResp) *corev1.ListMembersResponse {
+	members := make([]*corev1.SheetMember, len(resp.Members))
+	for i, m := range resp.Members {
+		members[i] = SheetMemberToProto(m)
+	}
+
+	protoResp := &corev1.ListMembersResponse{
+		Members: members,
+	}
+	if resp.NextCursor != "" {
+		protoResp.NextCursor = &corev1.Cursor{
+			Id: resp.NextCursor,
+		}
+	}
+
+	return protoResp
+}
+
+// AttachMenuReqFromProto converts proto AttachMenuWithPayloadReq to DTO
+func AttachMenuReqFromProto(req *corev1.AttachMenuWithPayloadReq) *sheet.AttachMenuReq {
+	return &sheet.AttachMenuReq{
+		SheetID: req.GetSheetId(),
+		Items:   MenuItemsFromProto(req.GetItems()),
+	}
+}
+
+// MenuItemsToProto converts domain MenuItems to proto, with groups and
+// options ordered by name
+func MenuItemsToProto(items []*domain.MenuItem) []*corev1.MenuItem {
+	result := make([]*corev1.MenuItem, len(items))
+	for i, item := range items {
+		groups := make([]*corev1.MenuOptionGroup, 0, len(item.OptionGroups))
+		for _, grp := range item.OptionGroups {
+			options := make([]*corev1.MenuOption, 0, len(grp.Options))
+			for _, opt := range grp.Options {
+				options = append(options, &corev1.MenuOption{
+					Id:          opt.ID,
+					Title:       opt.Name,
+					PriceDelta:  &corev1.Money{CurrencyCode: item.Currency, Amount: opt.Price},
+					MaxQuantity: opt.MaxQuantity,
+					Available:   opt.Active,
+					PerOrder:    opt.Per == domain.PerOrder,
+				})
+			}
+			sort.Slice(options, func(a, b int) bool { return options[a].Title < options[b].Title })
+
+			groups = append(groups, &corev1.MenuOptionGroup{
+				Id:          grp.ID,
+				Title:       grp.Name,
+				Required:    grp.Required,
+				MultiSelect: grp.Type == domain.GroupMulti,
+				MinSelect:   int32(grp.MinSelect),
+				MaxSelect:   int32(grp.MaxSelect),
+				Options:     options,
+			})
+		}
+		sort.Slice(groups, func(a, b int) bool { return groups[a].Title < groups[b].Title })
+
+		result[i] = &corev1.MenuItem{
+			Id:           item.ID,
+			Title:        item.Name,
+			Price:        &corev1.Money{CurrencyCode: item.Currency, Amount: item.Price},
+			Available:    item.Active,
+			OptionGroups: groups,
+		}
+	}
+	return result
+}
+
+var menuImportFormatFromProto = map[corev1.MenuImportFormat]domain.MenuImportFormat{
+	corev1.MenuImportFormat_MENU_IMPORT_FORMAT_JSON: domain.MenuImportJSON,
+	corev1.MenuImportFormat_MENU_IMPORT_FORMAT_CSV:  domain.MenuImportCSV,
+}
+
+// ImportMenuReqFromProto converts proto ImportMenuReq to DTO
+func ImportMenuReqFromProto(req *corev1.ImportMenuReq) *sheet.ImportMenuReq {
+	return &sheet.ImportMenuReq{
+		SheetID: req.GetSheetId(),
+		Format:  menuImportFormatFromProto[req.GetFormat()],
+		Payload: req.GetPayload(),
+		DryRun:  req.GetDryRun(),
+	}
+}
+
+// ImportMenuRespToProto converts DTO ImportMenuResp to proto
+func ImportMenuRespToProto(resp *sheet.ImportMenuResp) *corev1.ImportMenuResp {
+	errs := make([]*corev1.MenuImportError, len(resp.Errors))
+	for i, e := range resp.Errors {
+		errs[i] = &corev1.MenuImportError{
+			Row:     int32(e.Row),
+			Field:   e.Field,
+			Message: e.Message,
+		}
+	}
+
+	return &corev1.ImportMenuResp{
+		Items:  MenuItemsToProto(resp.Items),
+		Errors: errs,
+		Sheet:  SheetToProto(resp.Sheet),
+	}
+}
+
+var menuChangeTypeToProto = map[domain.MenuChangeType]corev1.MenuChangeType{
+	domain.MenuItemAdded:   corev1.MenuChangeType_MENU_CHANGE_TYPE_ADDED,
+	domain.MenuItemRemoved: corev1.MenuChangeType_MENU_CHANGE_TYPE_REMOVED,
+	domain.MenuItemChanged: corev1.MenuChangeType_MENU_CHANGE_TYPE_CHANGED,
+}
+
+// MenuVersionToProto converts a menu snapshot header to proto
+func MenuVersionToProto(v *domain.MenuVersion, activeMenuID string) *corev1.MenuVersion {
+	if v == nil {
+		return nil
+	}
+	return &corev1.MenuVersion{
+		Id:        v.ID,
+		SheetId:   v.SheetID,
+		Version:   v.Version,
+		ItemCount: v.ItemCount,
+		Active:    v.ID == activeMenuID,
+		CreatedAt: timestamppb.New(v.CreatedAt),
+	}
+}
+
+// ListMenuVersionsRespToProto converts DTO ListMenuVersionsResp to proto
+func ListMenuVersionsRespToProto(resp *sheet.ListMenuVersionsResp) *corev1.ListMenuVersionsResp {
+	versions := make([]*corev1.MenuVersion, len(resp.Versions))
+	for i, v := range resp.Versions {
+		versions[i] = MenuVersionToProto(v, resp.ActiveMenuID)
+	}
+	return &corev1.ListMenuVersionsResp{Versions: versions}
+}
+
+// DiffMenuVersionsReqFromProto converts proto DiffMenuVersionsReq to DTO
+func DiffMenuVersionsReqFromProto(req *corev1.DiffMenuVersionsReq) *sheet.DiffMenuVersionsReq {
+	return &sheet.DiffMenuVersionsReq{
+		SheetID:    req.GetSheetId(),
+		FromMenuID: req.GetFromMenuId(),
+		ToMenuID:   req.GetToMenuId(),
+	}
+}
+
+// DiffMenuVersionsRespToProto converts DTO DiffMenuVersionsResp to proto
+func DiffMenuVersionsRespToProto(resp *sheet.DiffMenuVersionsResp) *corev1.DiffMenuVersionsResp {
+	changes := make([]*corev1.MenuItemChange, len(resp.Changes))
+	for i, c := range resp.Changes {
+		change := &corev1.MenuItemChange{
+			Type:    menuChangeTypeToProto[c.Type],
+			ItemId:  c.ItemID,
+			Changes: c.Changes,
+		}
+		if c.Before != nil {
+			change.Before = MenuItemsToProto([]*domain.MenuItem{c.Before})[0]
+		}
+		if c.After != nil {
+			change.After = MenuItemsToProto([]*domain.MenuItem{c.After})[0]
+		}
+		changes[i] = change
+	}
+
+	return &corev1.DiffMenuVersionsResp{
+		From:    MenuVersionToProto(resp.From, resp.ActiveMenuID),
+		To:      MenuVersionToProto(resp.To, resp.ActiveMenuID),
+		Changes: changes,
+	}
+}
+
 // ListSheetsReqFromProto converts proto ListSheetsReq to DTO
 func ListSheetsReqFromProto(req *corev1.ListSheetsReq) *sheet.ListSheetsReq {
 	dto := &sheet.ListSheetsReq{
@@ -235,3 +497,35 @@ func SheetsToProto(sheets []*domain.Sheet) []*corev1.Sheet {
 	}
 	return result
 }
+
+// SettlementToProto converts a domain Settlement to proto
+func SettlementToProto(s *domain.Settlement) *corev1.GetSheetSettlementResp {
+	if s == nil {
+		return &corev1.GetSheetSettlementResp{}
+	}
+
+	lines := make([]*corev1.SettlementLine, len(s.Lines))
+	for i, line := range s.Lines {
+		lines[i] = &corev1.SettlementLine{
+			UserId:        line.UserID,
+			IsHost:        line.IsHost,
+			OrderCount:    line.OrderCount,
+			Subtotal:      MoneyToProto(line.Subtotal),
+			FeeShare:      MoneyToProto(line.FeeShare),
+			DiscountShare: MoneyToProto(line.DiscountShare),
+			Total:         MoneyToProto(line.Total),
+		}
+	}
+
+	return &corev1.GetSheetSettlementResp{
+		SheetId:       s.SheetID,
+		HostUserId:    s.HostUserID,
+		Lines:         lines,
+		Subtotal:      MoneyToProto(s.Subtotal),
+		FeeTotal:      MoneyToProto(s.FeeTotal),
+		DiscountTotal: MoneyToProto(s.DiscountTotal),
+		Total:         MoneyToProto(s.Total),
+		OwedToHost:    MoneyToProto(s.OwedToHost),
+		Provisional:   s.Provisional,
+	}
+}
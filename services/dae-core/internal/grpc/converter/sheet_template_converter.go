@@ -0,0 +1,142 @@
+package converter
+
+import (
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/sheettemplate"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	corev1 "github.com/deni12345/dae-services/proto/gen"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var protoToDomainFrequencyMap = map[corev1.RecurrenceFrequency]domain.RecurrenceFrequency{
+	corev1.RecurrenceFrequency_RECURRENCE_FREQUENCY_DAILY:    domain.RecurDaily,
+	corev1.RecurrenceFrequency_RECURRENCE_FREQUENCY_WEEKDAYS: domain.RecurWeekdays,
+	corev1.RecurrenceFrequency_RECURRENCE_FREQUENCY_WEEKLY:   domain.RecurWeekly,
+	corev1.RecurrenceFrequency_RECURRENCE_FREQUENCY_CRON:     domain.RecurCron,
+}
+
+var domainToProtoFrequencyMap = map[domain.RecurrenceFrequency]corev1.RecurrenceFrequency{
+	domain.RecurDaily:    corev1.RecurrenceFrequency_RECURRENCE_FREQUENCY_DAILY,
+	domain.RecurWeekdays: corev1.RecurrenceFrequency_RECURRENCE_FREQUENCY_WEEKDAYS,
+	domain.RecurWeekly:   corev1.RecurrenceFrequency_RECURRENCE_FREQUENCY_WEEKLY,
+	domain.RecurCron:     corev1.RecurrenceFrequency_RECURRENCE_FREQUENCY_CRON,
+}
+
+func RecurrenceFromProto(r *corev1.Recurrence) domain.Recurrence {
+	weekdays := make([]time.Weekday, 0, len(r.GetWeekdays()))
+	for _, d := range r.GetWeekdays() {
+		weekdays = append(weekdays, time.Weekday(d))
+	}
+	return domain.Recurrence{
+		Frequency: protoToDomainFrequencyMap[r.GetFrequency()],
+		TimeOfDay: r.GetTimeOfDay(),
+		Weekdays:  weekdays,
+		Cron:      r.GetCron(),
+		TimeZone:  r.GetTimeZone(),
+	}
+}
+
+func RecurrenceToProto(r domain.Recurrence) *corev1.Recurrence {
+	weekdays := make([]int32, 0, len(r.Weekdays))
+	for _, d := range r.Weekdays {
+		weekdays = append(weekdays, int32(d))
+	}
+	return &corev1.Recurrence{
+		Frequency: domainToProtoFrequencyMap[r.Frequency],
+		TimeOfDay: r.TimeOfDay,
+		Weekdays:  weekdays,
+		Cron:      r.Cron,
+		TimeZone:  r.TimeZone,
+	}
+}
+
+func CreateSheetTemplateReqFromProto(req *corev1.CreateSheetTemplateReq) *sheettemplate.CreateSheetTemplateReq {
+	return &sheettemplate.CreateSheetTemplateReq{
+		SheetID:           req.GetSheetId(),
+		Name:              req.GetName(),
+		Recurrence:        RecurrenceFromProto(req.GetRecurrence()),
+		CloseAfterMinutes: req.GetCloseAfterMinutes(),
+	}
+}
+
+func UpdateSheetTemplateReqFromProto(req *corev1.UpdateSheetTemplateReq) *sheettemplate.UpdateSheetTemplateReq {
+	dto := &sheettemplate.UpdateSheetTemplateReq{
+		ID:                req.GetId(),
+		Name:              req.Name,
+		Description:       req.Description,
+		CloseAfterMinutes: req.CloseAfterMinutes,
+		Paused:            req.Paused,
+	}
+	if fee := req.GetDeliveryFee(); fee != nil {
+		dto.DeliveryFee = &domain.Money{CurrencyCode: fee.GetCurrencyCode(), Amount: fee.GetAmount()}
+	}
+	if req.GetSetMemberIds() {
+		dto.MemberIDs = append([]string{}, req.GetMemberIds()...)
+	}
+	if r := req.GetRecurrence(); r != nil {
+		recurrence := RecurrenceFromProto(r)
+		dto.Recurrence = &recurrence
+	}
+	return dto
+}
+
+func ListSheetTemplatesReqFromProto(req *corev1.ListSheetTemplatesReq) *sheettemplate.ListSheetTemplatesReq {
+	dto := &sheettemplate.ListSheetTemplatesReq{
+		HostUserID: req.GetHostUserId(),
+		Limit:      req.GetPageSize(),
+	}
+	if cursor := req.GetCursor(); cursor != nil {
+		dto.Cursor = cursor.GetId()
+	}
+	return dto
+}
+
+func ListSheetTemplatesRespToProto(resp *sheettemplate.ListSheetTemplatesResp) *corev1.ListSheetTemplatesResp {
+	out := &corev1.ListSheetTemplatesResp{
+		Templates: make([]*corev1.SheetTemplate, 0, len(resp.Templates)),
+	}
+	for _, t := range resp.Templates {
+		out.Templates = append(out.Templates, SheetTemplateToProto(t))
+	}
+	if resp.NextCursor != "" {
+		out.NextCursor = &corev1.Cursor{Id: resp.NextCursor}
+	}
+	return out
+}
+
+func SheetTemplateToProto(t *domain.SheetTemplate) *corev1.SheetTemplate {
+	if t == nil {
+		return nil
+	}
+
+	items := make([]*domain.MenuItem, len(t.MenuItems))
+	for i := range t.MenuItems {
+		items[i] = &t.MenuItems[i]
+	}
+
+	out := &corev1.SheetTemplate{
+		Id:          t.ID,
+		Name:        t.Name,
+		Description: t.Description,
+		HostUserId:  t.HostUserID,
+		MemberIds:   t.MemberIDs,
+		DeliveryFee: &corev1.Money{
+			CurrencyCode: t.DeliveryFee.CurrencyCode,
+			Amount:       t.DeliveryFee.Amount,
+		},
+		Discount:          t.Discount,
+		FeeSplitMode:      domainToProtoFeeSplitMap[t.FeeSplit],
+		Items:             MenuItemsToProto(items),
+		Recurrence:        RecurrenceToProto(t.Recurrence),
+		CloseAfterMinutes: t.CloseAfterMinutes,
+		Paused:            t.Paused,
+		LastSheetId:       t.LastSheetID,
+		CreatedAt:         timestamppb.New(t.CreatedAt),
+		UpdatedAt:         timestamppb.New(t.UpdatedAt),
+	}
+	if t.NextRunAt != nil {
+		out.NextRunAt = timestamppb.New(*t.NextRunAt)
+	}
+	return out
+}
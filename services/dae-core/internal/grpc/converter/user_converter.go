@@ -1,6 +1,8 @@
 package converter
 
 import (
+	"fmt"
+
 	"github.com/deni12345/dae-services/services/dae-core/internal/app/user"
 	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
 	corev1 "github.com/deni12345/dae-services/proto/gen"
@@ -71,6 +73,79 @@ func AdminSetUserDisabledReqFromProto(req *corev1.AdminSetUserDisabledReq) *user
 	}
 }
 
+func GetUserByIdentityReqFromProto(req *corev1.GetUserByIdentityReq) *user.GetUserByIdentityReq {
+	return &user.GetUserByIdentityReq{
+		Provider: identityProviderFromProto(req.GetProvider()),
+		Subject:  req.GetSubject(),
+	}
+}
+
+func LinkIdentityReqFromProto(req *corev1.LinkIdentityReq) *user.LinkIdentityReq {
+	return &user.LinkIdentityReq{
+		UserID:   req.GetUserId(),
+		Provider: identityProviderFromProto(req.GetProvider()),
+		Subject:  req.GetSubject(),
+		Email:    req.GetEmail(),
+		Password: req.GetPassword(),
+	}
+}
+
+func UnlinkIdentityReqFromProto(req *corev1.UnlinkIdentityReq) *user.UnlinkIdentityReq {
+	return &user.UnlinkIdentityReq{
+		UserID:   req.GetUserId(),
+		Provider: identityProviderFromProto(req.GetProvider()),
+	}
+}
+
+// identityProviderFromProto returns "" for unspecified providers
+func identityProviderFromProto(p corev1.IdentityProvider) domain.IdentityProvider {
+	switch p {
+	case corev1.IdentityProvider_IDENTITY_PROVIDER_GOOGLE:
+		return domain.IdentityProviderGoogle
+	case corev1.IdentityProvider_IDENTITY_PROVIDER_LOCAL:
+		return domain.IdentityProviderLocal
+	}
+	return ""
+}
+
+func identityProviderToProto(p domain.IdentityProvider) corev1.IdentityProvider {
+	switch p {
+	case domain.IdentityProviderGoogle:
+		return corev1.IdentityProvider_IDENTITY_PROVIDER_GOOGLE
+	case domain.IdentityProviderLocal:
+		return corev1.IdentityProvider_IDENTITY_PROVIDER_LOCAL
+	}
+	return corev1.IdentityProvider_IDENTITY_PROVIDER_UNSPECIFIED
+}
+
+func RecordLoginReqFromProto(req *corev1.RecordLoginReq) *user.RecordLoginReq {
+	return &user.RecordLoginReq{UserID: req.GetUserId()}
+}
+
+func AuthenticateLocalReqFromProto(req *corev1.AuthenticateLocalReq) *user.AuthenticateLocalReq {
+	return &user.AuthenticateLocalReq{Email: req.GetEmail(), Password: req.GetPassword()}
+}
+
+func ChangePasswordReqFromProto(req *corev1.ChangePasswordReq) *user.ChangePasswordReq {
+	return &user.ChangePasswordReq{CurrentPassword: req.GetCurrentPassword(), NewPassword: req.GetNewPassword()}
+}
+
+func RequestPasswordResetReqFromProto(req *corev1.RequestPasswordResetReq) *user.RequestPasswordResetReq {
+	return &user.RequestPasswordResetReq{Email: req.GetEmail()}
+}
+
+func RequestPasswordResetRespToProto(resp *user.RequestPasswordResetResp) *corev1.RequestPasswordResetResp {
+	out := &corev1.RequestPasswordResetResp{ResetToken: resp.Token}
+	if resp.Token != "" {
+		out.ExpiresAt = timestamppb.New(resp.ExpiresAt)
+	}
+	return out
+}
+
+func ConfirmPasswordResetReqFromProto(req *corev1.ConfirmPasswordResetReq) *user.ConfirmPasswordResetReq {
+	return &user.ConfirmPasswordResetReq{Token: req.GetResetToken(), NewPassword: req.GetNewPassword()}
+}
+
 func ListUsersReqFromProto(req *corev1.ListUsersReq) *user.ListUsersReq {
 	dto := &user.ListUsersReq{
 		PageSize: req.GetPageSize(),
@@ -86,11 +161,26 @@ func ListUsersReqFromProto(req *corev1.ListUsersReq) *user.ListUsersReq {
 		dto.IncludeDisabled = filter.GetIncludeDisabled()
 		dto.Query = filter.GetQuery()
 		dto.EmailExact = filter.GetEmailExact()
+		dto.Role = protoToDomainRolesMap[filter.GetRole()]
+		dto.Status = userStatusFromProto(filter.GetStatus())
 	}
 
 	return dto
 }
 
+// userStatusFromProto returns "" for unspecified statuses
+func userStatusFromProto(s corev1.UserStatus) domain.UserStatus {
+	switch s {
+	case corev1.UserStatus_USER_STATUS_ACTIVE:
+		return domain.UserStatusActive
+	case corev1.UserStatus_USER_STATUS_SUSPENDED:
+		return domain.UserStatusSuspended
+	case corev1.UserStatus_USER_STATUS_DELETED:
+		return domain.UserStatusDeleted
+	}
+	return ""
+}
+
 // Domain to Proto conversions
 
 func UserToProto(u *domain.User) *corev1.User {
@@ -132,10 +222,36 @@ func UserToProto(u *domain.User) *corev1.User {
 	if u.LastLoginAt != nil {
 		protoUser.LastLoginAt = timestamppb.New(*u.LastLoginAt)
 	}
+	if u.DeletedAt != nil {
+		protoUser.DeletedAt = timestamppb.New(*u.DeletedAt)
+	}
 
 	return protoUser
 }
 
+func IdentityToProto(i *domain.UserIdentity) *corev1.ExternalIdentity {
+	if i == nil {
+		return nil
+	}
+
+	return &corev1.ExternalIdentity{
+		Id:       fmt.Sprintf("%s:%s", i.Provider, i.Subject),
+		UserId:   i.UserID,
+		Provider: identityProviderToProto(i.Provider),
+		Subject:  i.Subject,
+		Email:    i.EmailAtSignup,
+		LinkedAt: timestamppb.New(i.LinkedAt),
+	}
+}
+
+func IdentitiesToProto(identities []*domain.UserIdentity) []*corev1.ExternalIdentity {
+	result := make([]*corev1.ExternalIdentity, len(identities))
+	for i, identity := range identities {
+		result[i] = IdentityToProto(identity)
+	}
+	return result
+}
+
 func rolesToProto(roles []domain.Role) []corev1.UserRole {
 	roles = utils.ToSet(roles)
 	if len(roles) == 0 {
@@ -2,6 +2,7 @@ package errors
 
 import (
 	"github.com/deni12345/dae-services/libs/apperror"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -23,11 +24,30 @@ func ToGRPCStatus(err error) error {
 		code = codes.PermissionDenied
 	case apperror.CodeConflict:
 		code = codes.Aborted
+	case apperror.CodeTooMany:
+		code = codes.ResourceExhausted
 	case apperror.CodeInternal:
 		code = codes.Internal
 	default:
 		code = codes.Internal
 	}
 
-	return status.Error(code, err.Error())
+	violations := apperror.GetViolations(err)
+	if len(violations) == 0 {
+		return status.Error(code, err.Error())
+	}
+
+	// Attach field violations so clients can point at the offending input
+	br := &errdetails.BadRequest{}
+	for _, v := range violations {
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		})
+	}
+	st, detailErr := status.New(code, err.Error()).WithDetails(br)
+	if detailErr != nil {
+		return status.Error(code, err.Error())
+	}
+	return st.Err()
 }
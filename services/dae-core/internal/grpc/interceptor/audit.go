@@ -0,0 +1,62 @@
+package interceptor
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/audit"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// AuditInterceptor names the operation behind every state-changing RPC so
+// the repositories can record their writes against it. It runs after the
+// idempotency interceptor so the request's key is known. The events are
+// written by the repositories in the transactions that make the changes, so
+// a write is recorded even when the RPC fails after it.
+func AuditInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !isAuditedMethod(path.Base(info.FullMethod)) {
+			return handler(ctx, req)
+		}
+		return handler(audit.NewContext(ctx, auditOperation(ctx, info.FullMethod, req)), req)
+	}
+}
+
+// isAuditedMethod reports whether the method may change state. Unlike
+// isWriteMethod it errs on the side of recording.
+func isAuditedMethod(methodName string) bool {
+	for _, p := range []string{"Get", "List", "Diff", "Stream", "Check", "Export"} {
+		if strings.HasPrefix(methodName, p) {
+			return false
+		}
+	}
+	return true
+}
+
+func auditOperation(ctx context.Context, fullMethod string, req any) audit.Operation {
+	op := audit.Operation{Method: fullMethod, IdempotencyKey: idempotencyKeyFromContext(ctx)}
+	if p, ok := PrincipalFromContext(ctx); ok {
+		op.ActorID = p.UserID
+	}
+	if op.IdempotencyKey == "" {
+		op.IdempotencyKey = requestField(req, "idempotency_key")
+	}
+	return op
+}
+
+// requestField returns a top-level string field of a proto request
+func requestField(req any, name string) string {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return ""
+	}
+	m := msg.ProtoReflect()
+	fd := m.Descriptor().Fields().ByName(protoreflect.Name(name))
+	if fd == nil || fd.Kind() != protoreflect.StringKind || fd.IsList() {
+		return ""
+	}
+	return m.Get(fd).String()
+}
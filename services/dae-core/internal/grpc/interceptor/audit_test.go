@@ -0,0 +1,38 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	corev1 "github.com/deni12345/dae-services/proto/gen"
+	"github.com/deni12345/dae-services/services/dae-core/internal/audit"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"google.golang.org/grpc"
+)
+
+func TestAuditInterceptor(t *testing.T) {
+	unary := AuditInterceptor()
+	ctx := WithPrincipal(context.Background(), &domain.Principal{UserID: "u1"})
+	ctx = context.WithValue(ctx, IdemKey, "key-1")
+
+	operation := func(method string, req any) (audit.Operation, bool) {
+		t.Helper()
+		var op audit.Operation
+		var ok bool
+		_, _ = unary(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, _ any) (any, error) {
+			op, ok = audit.OperationFromContext(ctx)
+			return nil, nil
+		})
+		return op, ok
+	}
+
+	op, ok := operation("/core.v1.SheetsService/UpdateSheet", &corev1.UpdateSheetReq{})
+	if !ok || op.ActorID != "u1" || op.Method != "/core.v1.SheetsService/UpdateSheet" || op.IdempotencyKey != "key-1" {
+		t.Fatalf("operation = %+v, %v", op, ok)
+	}
+
+	// Reads are not audited
+	if op, ok := operation("/core.v1.SheetsService/GetSheet", &corev1.GetSheetReq{Id: "s1"}); ok {
+		t.Fatalf("read has operation %+v", op)
+	}
+}
@@ -0,0 +1,181 @@
+package interceptor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/libs/apperror"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationMD              = "authorization"
+	PrincipalKey      contextKey = "principal"
+	tokenClockLeeway             = 30 * time.Second
+)
+
+var tokenAlgorithms = []jose.SignatureAlgorithm{jose.RS256, jose.ES256, jose.EdDSA}
+
+// tokenClaims are the JWT claims dae-core reads: sub is the user ID
+type tokenClaims struct {
+	jwt.Claims
+	Roles []domain.Role `json:"roles"`
+}
+
+// TokenVerifier validates signed caller tokens against locally configured keys
+type TokenVerifier struct {
+	keys     jose.JSONWebKeySet
+	issuer   string
+	audience string
+	now      func() time.Time
+}
+
+// NewTokenVerifier creates a verifier from a JSON Web Key Set holding the
+// public keys tokens may be signed with
+func NewTokenVerifier(jwks []byte, issuer, audience string) (*TokenVerifier, error) {
+	var keys jose.JSONWebKeySet
+	if err := json.Unmarshal(jwks, &keys); err != nil {
+		return nil, fmt.Errorf("parse jwks: %w", err)
+	}
+	if len(keys.Keys) == 0 {
+		return nil, errors.New("jwks has no keys")
+	}
+	for _, k := range keys.Keys {
+		if !k.IsPublic() {
+			return nil, fmt.Errorf("jwks key %q is not a public key", k.KeyID)
+		}
+	}
+
+	return &TokenVerifier{
+		keys:     keys,
+		issuer:   issuer,
+		audience: audience,
+		now:      time.Now,
+	}, nil
+}
+
+// Verify checks the token signature and claims and returns its principal
+func (v *TokenVerifier) Verify(token string) (*domain.Principal, error) {
+	parsed, err := jwt.ParseSigned(token, tokenAlgorithms)
+	if err != nil {
+		return nil, fmt.Errorf("parse token: %w", err)
+	}
+
+	var claims tokenClaims
+	if err := parsed.Claims(&v.keys, &claims); err != nil {
+		return nil, fmt.Errorf("verify token: %w", err)
+	}
+	if claims.Expiry == nil {
+		return nil, errors.New("token has no expiry")
+	}
+
+	expected := jwt.Expected{Issuer: v.issuer, Time: v.now()}
+	if v.audience != "" {
+		expected.AnyAudience = jwt.Audience{v.audience}
+	}
+	if err := claims.ValidateWithLeeway(expected, tokenClockLeeway); err != nil {
+		return nil, fmt.Errorf("validate token: %w", err)
+	}
+	if claims.Subject == "" {
+		return nil, errors.New("token has no subject")
+	}
+
+	return &domain.Principal{UserID: claims.Subject, Roles: claims.Roles}, nil
+}
+
+// AuthInterceptor authenticates unary calls with the bearer token in the
+// authorization metadata and stores the caller's Principal in the context
+func AuthInterceptor(verifier *TokenVerifier) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if isPublicMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		ctx, err := authenticate(ctx, verifier)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// AuthStreamInterceptor is the streaming counterpart of AuthInterceptor
+func AuthStreamInterceptor(verifier *TokenVerifier) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if isPublicMethod(info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		ctx, err := authenticate(ss.Context(), verifier)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context { return s.ctx }
+
+func authenticate(ctx context.Context, verifier *TokenVerifier) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(authorizationMD)
+	if len(values) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+
+	scheme, token, ok := strings.Cut(strings.TrimSpace(values[0]), " ")
+	if !ok || !strings.EqualFold(scheme, "bearer") || token == "" {
+		return nil, status.Error(codes.Unauthenticated, "malformed authorization header")
+	}
+
+	principal, err := verifier.Verify(strings.TrimSpace(token))
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+	return WithPrincipal(ctx, principal), nil
+}
+
+// isPublicMethod reports whether fullMethod may be called without a token
+func isPublicMethod(fullMethod string) bool {
+	return isReflectionMethod(fullMethod) || methodPolicies[fullMethod].Public
+}
+
+func isReflectionMethod(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/grpc.reflection.")
+}
+
+// WithPrincipal returns a copy of ctx carrying the authenticated caller
+func WithPrincipal(ctx context.Context, p *domain.Principal) context.Context {
+	return context.WithValue(ctx, PrincipalKey, p)
+}
+
+// PrincipalFromContext returns the authenticated caller, if any
+func PrincipalFromContext(ctx context.Context) (*domain.Principal, bool) {
+	p, ok := ctx.Value(PrincipalKey).(*domain.Principal)
+	return p, ok && p != nil
+}
+
+// ActingUserID returns the ID of the authenticated caller. Usecases use it
+// instead of user IDs sent in request payloads.
+func ActingUserID(ctx context.Context) (string, error) {
+	p, ok := PrincipalFromContext(ctx)
+	if !ok || p.UserID == "" {
+		return "", apperror.Unauthorized("caller is not authenticated")
+	}
+	return p.UserID, nil
+}
@@ -0,0 +1,242 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type testKey struct {
+	t   *testing.T
+	key *ecdsa.PrivateKey
+	kid string
+}
+
+func newTestKey(t *testing.T, kid string) *testKey {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	return &testKey{t: t, key: key, kid: kid}
+}
+
+func (k *testKey) jwks() []byte {
+	k.t.Helper()
+
+	set := jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+		{Key: &k.key.PublicKey, KeyID: k.kid, Algorithm: string(jose.ES256), Use: "sig"},
+	}}
+	b, err := json.Marshal(set)
+	if err != nil {
+		k.t.Fatalf("marshal jwks: %v", err)
+	}
+	return b
+}
+
+func (k *testKey) sign(claims tokenClaims) string {
+	k.t.Helper()
+
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.ES256, Key: k.key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", k.kid),
+	)
+	if err != nil {
+		k.t.Fatalf("new signer: %v", err)
+	}
+	token, err := jwt.Signed(signer).Claims(claims).Serialize()
+	if err != nil {
+		k.t.Fatalf("sign token: %v", err)
+	}
+	return token
+}
+
+func validClaims(now time.Time) tokenClaims {
+	return tokenClaims{
+		Claims: jwt.Claims{
+			Subject:  "user-1",
+			Issuer:   "dae-gateway",
+			Audience: jwt.Audience{"dae-core"},
+			IssuedAt: jwt.NewNumericDate(now),
+			Expiry:   jwt.NewNumericDate(now.Add(time.Hour)),
+		},
+		Roles: []domain.Role{domain.RoleUser, domain.RoleHost},
+	}
+}
+
+func TestTokenVerifierVerify(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	key := newTestKey(t, "k1")
+	other := newTestKey(t, "k1")
+
+	verifier, err := NewTokenVerifier(key.jwks(), "dae-gateway", "dae-core")
+	if err != nil {
+		t.Fatalf("NewTokenVerifier error = %v", err)
+	}
+	verifier.now = func() time.Time { return now }
+
+	tests := map[string]struct {
+		token   func() string
+		wantErr bool
+	}{
+		"valid": {token: func() string { return key.sign(validClaims(now)) }},
+		"expired": {wantErr: true, token: func() string {
+			c := validClaims(now)
+			c.Expiry = jwt.NewNumericDate(now.Add(-time.Minute))
+			return key.sign(c)
+		}},
+		"no expiry": {wantErr: true, token: func() string {
+			c := validClaims(now)
+			c.Expiry = nil
+			return key.sign(c)
+		}},
+		"wrong issuer": {wantErr: true, token: func() string {
+			c := validClaims(now)
+			c.Issuer = "someone-else"
+			return key.sign(c)
+		}},
+		"wrong audience": {wantErr: true, token: func() string {
+			c := validClaims(now)
+			c.Audience = jwt.Audience{"dae-payments"}
+			return key.sign(c)
+		}},
+		"no subject": {wantErr: true, token: func() string {
+			c := validClaims(now)
+			c.Subject = ""
+			return key.sign(c)
+		}},
+		"unknown key": {wantErr: true, token: func() string { return other.sign(validClaims(now)) }},
+		"garbage":     {wantErr: true, token: func() string { return "not.a.jwt" }},
+	}
+
+	for name, tc := range tests {
+		p, err := verifier.Verify(tc.token())
+		if tc.wantErr {
+			if err == nil {
+				t.Fatalf("%s: Verify error = nil, want error", name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: Verify error = %v", name, err)
+		}
+		if p.UserID != "user-1" || !p.HasRole(domain.RoleHost) || p.HasRole(domain.RoleAdmin) {
+			t.Fatalf("%s: principal = %+v", name, p)
+		}
+	}
+}
+
+func TestNewTokenVerifierRejectsPrivateKeys(t *testing.T) {
+	key := newTestKey(t, "k1")
+	set, _ := json.Marshal(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{Key: key.key, KeyID: "k1"}}})
+
+	if _, err := NewTokenVerifier(set, "", ""); err == nil {
+		t.Fatalf("NewTokenVerifier error = nil, want error for private key")
+	}
+	if _, err := NewTokenVerifier([]byte(`{"keys": []}`), "", ""); err == nil {
+		t.Fatalf("NewTokenVerifier error = nil, want error for empty set")
+	}
+}
+
+func TestAuthInterceptor(t *testing.T) {
+	key := newTestKey(t, "k1")
+	verifier, err := NewTokenVerifier(key.jwks(), "dae-gateway", "dae-core")
+	if err != nil {
+		t.Fatalf("NewTokenVerifier error = %v", err)
+	}
+	token := key.sign(validClaims(time.Now()))
+
+	tests := map[string]struct {
+		method   string
+		header   string
+		wantCode codes.Code
+		wantUser string
+	}{
+		"bearer token":      {method: "/core.v1.SheetsService/CreateSheet", header: "Bearer " + token, wantUser: "user-1"},
+		"lowercase scheme":  {method: "/core.v1.SheetsService/CreateSheet", header: "bearer " + token, wantUser: "user-1"},
+		"missing header":    {method: "/core.v1.SheetsService/CreateSheet", wantCode: codes.Unauthenticated},
+		"wrong scheme":      {method: "/core.v1.SheetsService/CreateSheet", header: "Basic " + token, wantCode: codes.Unauthenticated},
+		"invalid token":     {method: "/core.v1.OrdersService/CreateOrder", header: "Bearer abc", wantCode: codes.Unauthenticated},
+		"public health":     {method: "/core.v1.HealthService/CheckHealth"},
+		"public reflection": {method: "/grpc.reflection.v1.ServerReflection/ServerReflectionInfo"},
+	}
+
+	intercept := AuthInterceptor(verifier)
+	for name, tc := range tests {
+		ctx := context.Background()
+		if tc.header != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tc.header))
+		}
+
+		var gotUser string
+		handler := func(ctx context.Context, req any) (any, error) {
+			if p, ok := PrincipalFromContext(ctx); ok {
+				gotUser = p.UserID
+			}
+			return nil, nil
+		}
+
+		_, err := intercept(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tc.method}, handler)
+		if status.Code(err) != tc.wantCode {
+			t.Fatalf("%s: code = %v, want %v", name, status.Code(err), tc.wantCode)
+		}
+		if gotUser != tc.wantUser {
+			t.Fatalf("%s: principal user = %q, want %q", name, gotUser, tc.wantUser)
+		}
+	}
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeServerStream) Context() context.Context { return s.ctx }
+
+func TestAuthStreamInterceptor(t *testing.T) {
+	key := newTestKey(t, "k1")
+	verifier, err := NewTokenVerifier(key.jwks(), "dae-gateway", "dae-core")
+	if err != nil {
+		t.Fatalf("NewTokenVerifier error = %v", err)
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs("authorization", "Bearer "+key.sign(validClaims(time.Now()))))
+	info := &grpc.StreamServerInfo{FullMethod: "/core.v1.OrdersService/StreamOrders"}
+
+	var gotUser string
+	err = AuthStreamInterceptor(verifier)(nil, &fakeServerStream{ctx: ctx}, info, func(srv any, ss grpc.ServerStream) error {
+		gotUser, err = ActingUserID(ss.Context())
+		return err
+	})
+	if err != nil || gotUser != "user-1" {
+		t.Fatalf("stream user = %q, err = %v, want user-1", gotUser, err)
+	}
+
+	err = AuthStreamInterceptor(verifier)(nil, &fakeServerStream{ctx: context.Background()}, info, func(any, grpc.ServerStream) error {
+		t.Fatalf("handler called without token")
+		return nil
+	})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("code = %v, want Unauthenticated", status.Code(err))
+	}
+}
+
+func TestActingUserIDWithoutPrincipal(t *testing.T) {
+	if _, err := ActingUserID(context.Background()); err == nil {
+		t.Fatalf("ActingUserID error = nil, want error")
+	}
+}
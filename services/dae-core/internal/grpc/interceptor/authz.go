@@ -0,0 +1,139 @@
+package interceptor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deni12345/dae-services/libs/apperror"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	grpcerrors "github.com/deni12345/dae-services/services/dae-core/internal/grpc/errors"
+	"google.golang.org/grpc"
+)
+
+// Policy says who may call an RPC. The interceptor enforces Public and
+// Roles; Resource documents the check the usecase makes once the sheet,
+// order or user involved has been loaded.
+type Policy struct {
+	Public   bool          // callable without a token
+	Roles    []domain.Role // caller needs one of these; empty means any authenticated user
+	Resource string
+}
+
+var (
+	public        = Policy{Public: true}
+	authenticated = Policy{}
+	adminOnly     = Policy{Roles: []domain.Role{domain.RoleAdmin, domain.RoleSuperAdmin}}
+	serviceOnly   = Policy{Roles: []domain.Role{domain.RoleService}}
+)
+
+func resource(check string) Policy { return Policy{Resource: check} }
+
+// methodPolicies lists every RPC dae-core serves. Methods missing from the
+// table are denied.
+var methodPolicies = map[string]Policy{
+	"/core.v1.HealthService/CheckHealth": public,
+
+	"/core.v1.UsersService/CreateUser":           {Roles: append([]domain.Role{domain.RoleService}, adminOnly.Roles...)},
+	"/core.v1.UsersService/GetUser":              authenticated,
+	"/core.v1.UsersService/UpdateUser":           resource("self or admin"),
+	"/core.v1.UsersService/ListUsers":            adminOnly,
+	"/core.v1.UsersService/AdminSetUserRoles":    {Roles: adminOnly.Roles, Resource: "granting admin roles needs superadmin"},
+	"/core.v1.UsersService/AdminSetUserDisabled": adminOnly,
+	"/core.v1.UsersService/GetUserByIdentity":    serviceOnly,
+	"/core.v1.UsersService/RecordLogin":          serviceOnly,
+	"/core.v1.UsersService/AuthenticateLocal":    serviceOnly,
+	"/core.v1.UsersService/RequestPasswordReset": serviceOnly,
+	"/core.v1.UsersService/ConfirmPasswordReset": serviceOnly,
+	"/core.v1.UsersService/ChangePassword":       authenticated,
+	"/core.v1.UsersService/LinkIdentity":         serviceOnly,
+	"/core.v1.UsersService/UnlinkIdentity":       resource("self or admin"),
+	"/core.v1.UsersService/ListIdentities":       resource("self or admin"),
+	"/core.v1.UsersService/DeleteUser":           resource("self or admin"),
+	"/core.v1.UsersService/ExportUserData":       resource("self or admin"),
+
+	"/core.v1.SheetsService/CreateSheet":           authenticated,
+	"/core.v1.SheetsService/GetSheet":              resource("sheet member or admin"),
+	"/core.v1.SheetsService/UpdateSheet":           resource("sheet host or admin"),
+	"/core.v1.SheetsService/ListSheets":            authenticated,
+	"/core.v1.SheetsService/JoinSheet":             resource("sheet host or admin"),
+	"/core.v1.SheetsService/RemoveMember":          resource("the member, sheet host or admin"),
+	"/core.v1.SheetsService/ListMembers":           resource("sheet member or admin"),
+	"/core.v1.SheetsService/CreateSheetInvite":     resource("sheet host or admin"),
+	"/core.v1.SheetsService/RedeemSheetInvite":     authenticated,
+	"/core.v1.SheetsService/RevokeSheetInvite":     resource("sheet host or admin"),
+	"/core.v1.SheetsService/AttachMenuWithPayload": resource("sheet host or admin"),
+	"/core.v1.SheetsService/ImportMenu":            resource("sheet host or admin"),
+	"/core.v1.SheetsService/GetMenu":               resource("sheet member or admin"),
+	"/core.v1.SheetsService/ListMenuVersions":      resource("sheet member or admin"),
+	"/core.v1.SheetsService/DiffMenuVersions":      resource("sheet member or admin"),
+	"/core.v1.SheetsService/GetSheetSettlement":    resource("sheet member or admin"),
+
+	"/core.v1.OrdersService/CreateOrder":  resource("sheet member or admin"),
+	"/core.v1.OrdersService/UpdateOrder":  resource("order owner"),
+	"/core.v1.OrdersService/GetOrder":     resource("order owner, sheet host or admin"),
+	"/core.v1.OrdersService/ListOrders":   resource("sheet member or admin"),
+	"/core.v1.OrdersService/CancelOrder":  resource("order owner, sheet host or admin"),
+	"/core.v1.OrdersService/ConfirmOrder": resource("sheet host or admin"),
+	"/core.v1.OrdersService/StreamOrders": resource("sheet member or admin"),
+
+	"/core.v1.PaymentsService/SetPaymentStatus":  resource("sheet host or admin"),
+	"/core.v1.PaymentsService/ListSheetBalances": resource("sheet member or admin"),
+	"/core.v1.PaymentsService/ListUserDebts":     resource("self or admin"),
+
+	"/core.v1.SheetTemplatesService/CreateSheetTemplate": resource("sheet host or admin"),
+	"/core.v1.SheetTemplatesService/GetSheetTemplate":    resource("template host or admin"),
+	"/core.v1.SheetTemplatesService/ListSheetTemplates":  resource("self or admin"),
+	"/core.v1.SheetTemplatesService/UpdateSheetTemplate": resource("template host or admin"),
+	"/core.v1.SheetTemplatesService/DeleteSheetTemplate": resource("template host or admin"),
+
+	"/core.v1.AuditService/ListAuditEvents": adminOnly,
+}
+
+// AuthzInterceptor enforces methodPolicies on unary calls. It must run after
+// AuthInterceptor so the caller's Principal is in the context.
+func AuthzInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := authorize(ctx, info.FullMethod); err != nil {
+			return nil, grpcerrors.ToGRPCStatus(err)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// AuthzStreamInterceptor is the streaming counterpart of AuthzInterceptor
+func AuthzStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := authorize(ss.Context(), info.FullMethod); err != nil {
+			return grpcerrors.ToGRPCStatus(err)
+		}
+		return handler(srv, ss)
+	}
+}
+
+func authorize(ctx context.Context, fullMethod string) error {
+	if isReflectionMethod(fullMethod) {
+		return nil
+	}
+
+	policy, ok := methodPolicies[fullMethod]
+	if !ok {
+		return apperror.Forbidden(fmt.Sprintf("no access policy for %s", fullMethod))
+	}
+	if policy.Public {
+		return nil
+	}
+
+	p, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return apperror.Unauthorized("caller is not authenticated")
+	}
+	if len(policy.Roles) == 0 {
+		return nil
+	}
+	for _, role := range policy.Roles {
+		if p.HasRole(role) {
+			return nil
+		}
+	}
+	return apperror.Forbidden("caller lacks the role required for this method")
+}
@@ -0,0 +1,204 @@
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	corev1 "github.com/deni12345/dae-services/proto/gen"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	grpcerrors "github.com/deni12345/dae-services/services/dae-core/internal/grpc/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestMethodPoliciesCoverEveryRPC(t *testing.T) {
+	files := []protoreflect.FileDescriptor{
+		corev1.File_users_proto,
+		corev1.File_sheets_proto,
+		corev1.File_orders_proto,
+		corev1.File_payments_proto,
+		corev1.File_sheet_templates_proto,
+		corev1.File_health_proto,
+		corev1.File_audit_proto,
+	}
+
+	served := make(map[string]bool)
+	for _, file := range files {
+		services := file.Services()
+		for i := 0; i < services.Len(); i++ {
+			svc := services.Get(i)
+			methods := svc.Methods()
+			for j := 0; j < methods.Len(); j++ {
+				name := fmt.Sprintf("/%s/%s", svc.FullName(), methods.Get(j).Name())
+				served[name] = true
+				if _, ok := methodPolicies[name]; !ok {
+					t.Fatalf("%s has no access policy", name)
+				}
+			}
+		}
+	}
+
+	for name := range methodPolicies {
+		if !served[name] {
+			t.Fatalf("policy for %s matches no RPC", name)
+		}
+	}
+}
+
+// access is the outcome of authorize for each kind of caller
+type access struct {
+	anonymous codes.Code
+	user      codes.Code
+	admin     codes.Code
+}
+
+var (
+	openAccess   = access{anonymous: codes.OK, user: codes.OK, admin: codes.OK}
+	signedIn     = access{anonymous: codes.Unauthenticated, user: codes.OK, admin: codes.OK}
+	adminsAccess = access{anonymous: codes.Unauthenticated, user: codes.PermissionDenied, admin: codes.OK}
+	// serviceAccess methods are reserved for the gateway's service token
+	serviceAccess = access{anonymous: codes.Unauthenticated, user: codes.PermissionDenied, admin: codes.PermissionDenied}
+)
+
+// TestAuthorize checks the method-level policies. Host-only and member-only
+// RPCs pass here for any signed-in caller, hosts or not; the usecases refuse
+// non-hosts and non-members once the sheet is loaded, which the access tests
+// of the sheet, order and payment packages cover.
+func TestAuthorize(t *testing.T) {
+	tests := map[string]access{
+		"/core.v1.HealthService/CheckHealth": openAccess,
+
+		"/core.v1.UsersService/CreateUser":           adminsAccess,
+		"/core.v1.UsersService/GetUser":              signedIn,
+		"/core.v1.UsersService/UpdateUser":           signedIn,
+		"/core.v1.UsersService/ListUsers":            adminsAccess,
+		"/core.v1.UsersService/AdminSetUserRoles":    adminsAccess,
+		"/core.v1.UsersService/AdminSetUserDisabled": adminsAccess,
+		"/core.v1.UsersService/GetUserByIdentity":    serviceAccess,
+		"/core.v1.UsersService/RecordLogin":          serviceAccess,
+		"/core.v1.UsersService/AuthenticateLocal":    serviceAccess,
+		"/core.v1.UsersService/RequestPasswordReset": serviceAccess,
+		"/core.v1.UsersService/ConfirmPasswordReset": serviceAccess,
+		"/core.v1.UsersService/ChangePassword":       signedIn,
+		"/core.v1.UsersService/LinkIdentity":         serviceAccess,
+		"/core.v1.UsersService/UnlinkIdentity":       signedIn,
+		"/core.v1.UsersService/ListIdentities":       signedIn,
+		"/core.v1.UsersService/DeleteUser":           signedIn,
+		"/core.v1.UsersService/ExportUserData":       signedIn,
+
+		"/core.v1.SheetsService/CreateSheet":           signedIn,
+		"/core.v1.SheetsService/GetSheet":              signedIn,
+		"/core.v1.SheetsService/UpdateSheet":           signedIn,
+		"/core.v1.SheetsService/ListSheets":            signedIn,
+		"/core.v1.SheetsService/JoinSheet":             signedIn,
+		"/core.v1.SheetsService/RemoveMember":          signedIn,
+		"/core.v1.SheetsService/CreateSheetInvite":     signedIn,
+		"/core.v1.SheetsService/RedeemSheetInvite":     signedIn,
+		"/core.v1.SheetsService/RevokeSheetInvite":     signedIn,
+		"/core.v1.SheetsService/ListMembers":           signedIn,
+		"/core.v1.SheetsService/AttachMenuWithPayload": signedIn,
+		"/core.v1.SheetsService/ImportMenu":            signedIn,
+		"/core.v1.SheetsService/GetMenu":               signedIn,
+		"/core.v1.SheetsService/ListMenuVersions":      signedIn,
+		"/core.v1.SheetsService/DiffMenuVersions":      signedIn,
+		"/core.v1.SheetsService/GetSheetSettlement":    signedIn,
+
+		"/core.v1.OrdersService/CreateOrder":  signedIn,
+		"/core.v1.OrdersService/UpdateOrder":  signedIn,
+		"/core.v1.OrdersService/GetOrder":     signedIn,
+		"/core.v1.OrdersService/ListOrders":   signedIn,
+		"/core.v1.OrdersService/CancelOrder":  signedIn,
+		"/core.v1.OrdersService/ConfirmOrder": signedIn,
+		"/core.v1.OrdersService/StreamOrders": signedIn,
+
+		"/core.v1.PaymentsService/SetPaymentStatus":  signedIn,
+		"/core.v1.PaymentsService/ListSheetBalances": signedIn,
+		"/core.v1.PaymentsService/ListUserDebts":     signedIn,
+
+		"/core.v1.SheetTemplatesService/CreateSheetTemplate": signedIn,
+		"/core.v1.SheetTemplatesService/GetSheetTemplate":    signedIn,
+		"/core.v1.SheetTemplatesService/ListSheetTemplates":  signedIn,
+		"/core.v1.SheetTemplatesService/UpdateSheetTemplate": signedIn,
+		"/core.v1.SheetTemplatesService/DeleteSheetTemplate": signedIn,
+
+		"/core.v1.AuditService/ListAuditEvents": adminsAccess,
+
+		"/core.v1.SheetsService/DropEverything": {
+			anonymous: codes.PermissionDenied, user: codes.PermissionDenied, admin: codes.PermissionDenied,
+		},
+	}
+
+	callers := map[string]*domain.Principal{
+		"user":       {UserID: "u1", Roles: []domain.Role{domain.RoleUser, domain.RoleHost}},
+		"non-host":   {UserID: "u2", Roles: []domain.Role{domain.RoleUser}},
+		"admin":      {UserID: "a1", Roles: []domain.Role{domain.RoleAdmin}},
+		"superadmin": {UserID: "s1", Roles: []domain.Role{domain.RoleSuperAdmin}},
+	}
+
+	unary := AuthzInterceptor()
+	for method, want := range tests {
+		for caller, expected := range map[string]codes.Code{
+			"anonymous":  want.anonymous,
+			"user":       want.user,
+			"non-host":   want.user,
+			"admin":      want.admin,
+			"superadmin": want.admin,
+		} {
+			ctx := context.Background()
+			if p, ok := callers[caller]; ok {
+				ctx = WithPrincipal(ctx, p)
+			}
+
+			called := false
+			_, err := unary(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(context.Context, any) (any, error) {
+				called = true
+				return nil, nil
+			})
+			if got := status.Code(err); got != expected {
+				t.Fatalf("%s as %s: code = %v, want %v", method, caller, got, expected)
+			}
+			if called != (expected == codes.OK) {
+				t.Fatalf("%s as %s: handler called = %v", method, caller, called)
+			}
+		}
+	}
+}
+
+func TestAuthorizeServiceCaller(t *testing.T) {
+	ctx := WithPrincipal(context.Background(), &domain.Principal{UserID: "svc:dae-gateway", Roles: []domain.Role{domain.RoleService}})
+
+	for method, want := range map[string]codes.Code{
+		"/core.v1.UsersService/GetUserByIdentity": codes.OK,
+		"/core.v1.UsersService/RecordLogin":       codes.OK,
+		"/core.v1.UsersService/AuthenticateLocal": codes.OK,
+		"/core.v1.UsersService/LinkIdentity":      codes.OK,
+		"/core.v1.UsersService/CreateUser":        codes.OK,
+		"/core.v1.UsersService/ListUsers":         codes.PermissionDenied,
+	} {
+		got := codes.OK
+		if err := authorize(ctx, method); err != nil {
+			got = status.Code(grpcerrors.ToGRPCStatus(err))
+		}
+		if got != want {
+			t.Fatalf("%s: code = %v, want %v", method, got, want)
+		}
+	}
+}
+
+func TestAuthzStreamInterceptor(t *testing.T) {
+	info := &grpc.StreamServerInfo{FullMethod: "/core.v1.OrdersService/StreamOrders"}
+	ctx := WithPrincipal(context.Background(), &domain.Principal{UserID: "u1"})
+
+	err := AuthzStreamInterceptor()(nil, &fakeServerStream{ctx: ctx}, info, func(any, grpc.ServerStream) error { return nil })
+	if err != nil {
+		t.Fatalf("stream error = %v, want nil", err)
+	}
+
+	err = AuthzStreamInterceptor()(nil, &fakeServerStream{ctx: context.Background()}, info, func(any, grpc.ServerStream) error { return nil })
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("code = %v, want Unauthenticated", status.Code(err))
+	}
+}
@@ -28,6 +28,10 @@ func IdemInterceptor() grpc.UnaryServerInterceptor {
 		if v := md.Get(mdKey); len(v) > 0 {
 			key = strings.TrimSpace(v[0])
 		}
+		// Requests that carry their own key count when the header is absent
+		if key == "" {
+			key = strings.TrimSpace(requestField(req, "idempotency_key"))
+		}
 
 		// Only enforce idempotency for write-like RPCs
 		methodName := path.Base(info.FullMethod)
@@ -44,6 +48,13 @@ func IdemInterceptor() grpc.UnaryServerInterceptor {
 	}
 }
 
+// WithIdempotencyKey returns ctx carrying key as if sent to method, for work
+// started outside a gRPC request that must be idempotent all the same
+func WithIdempotencyKey(ctx context.Context, method, key string) context.Context {
+	ctx = context.WithValue(ctx, IdemMethodKey, method)
+	return context.WithValue(ctx, IdemKey, key)
+}
+
 // idempotencyKeyFromContext returns idempotency key stored in context, or empty string when absent.
 func idempotencyKeyFromContext(ctx context.Context) string {
 	if v := ctx.Value(IdemKey); v != nil {
@@ -64,15 +75,22 @@ func methodNameFromContext(ctx context.Context) string {
 	return ""
 }
 
-// GetOrCreateIdempotencyKeyWithHash returns the idempotency key if present in context; otherwise it builds one from method name, the provided parts, and payload hash.
+// GetOrCreateIdempotencyKeyWithHash returns the key a write's result is stored under. A key
+// sent by the client is scoped to the method and acting user, so a caller reusing another
+// user's key never gets that user's result; otherwise the key is built from method name, the
+// provided parts, and payload hash.
 func GetOrCreateIdempotencyKeyWithHash(ctx context.Context, payloadHash string, parts ...string) string {
-	if k := idempotencyKeyFromContext(ctx); k != "" {
-		return k
-	}
 	method := methodNameFromContext(ctx)
 	if method == "" {
 		method = "unknown"
 	}
+	if k := idempotencyKeyFromContext(ctx); k != "" {
+		userID := "anonymous"
+		if p, ok := PrincipalFromContext(ctx); ok && p.UserID != "" {
+			userID = p.UserID
+		}
+		return fmt.Sprintf("%s:%s:%s", method, userID, k)
+	}
 	if len(parts) == 0 && payloadHash == "" {
 		return method
 	}
@@ -96,10 +114,11 @@ func GetOrCreateIdempotencyKeyWithHash(ctx context.Context, payloadHash string,
 
 // isWriteMethod determines whether a gRPC method should require idempotency key.
 func isWriteMethod(methodName string) bool {
-	// Simple heuristics: if name starts with or contains these prefixes.
-	prefixes := []string{"Create", "Update", "Delete", "Set", "AdminSet", "Close", "Reopen", "Join", "Leave"}
+	// Simple heuristics: the name starts with one of these verbs. Only whole
+	// prefixes count, so a read such as GetSheetSettlement is not a Set.
+	prefixes := []string{"Create", "Update", "Delete", "Set", "AdminSet", "Close", "Reopen", "Join", "Leave", "Cancel", "Confirm", "Import", "Attach", "Remove"}
 	for _, p := range prefixes {
-		if strings.HasPrefix(methodName, p) || strings.Contains(methodName, p) {
+		if strings.HasPrefix(methodName, p) {
 			return true
 		}
 	}
@@ -1,22 +1,36 @@
 package interceptor
 
-import "testing"
+import (
+	"context"
+	"testing"
+
+	corev1 "github.com/deni12345/dae-services/proto/gen"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
 
 func TestIsWriteMethod(t *testing.T) {
 	tests := map[string]bool{
-		"CreateUser":           true,
-		"GetUser":              false,
-		"ListUsers":            false,
-		"UpdateUser":           true,
-		"AdminSetUserRoles":    true,
-		"AdminSetUserDisabled": true,
-		"DeleteOrder":          true,
-		"CloseSheet":           true,
-		"ReopenSheet":          true,
-		"JoinSheet":            true,
-		"LeaveSheet":           true,
-		"StreamOrders":         false,
-		"ListOrders":           false,
+		"CreateUser":            true,
+		"GetUser":               false,
+		"ListUsers":             false,
+		"UpdateUser":            true,
+		"AdminSetUserRoles":     true,
+		"AdminSetUserDisabled":  true,
+		"DeleteOrder":           true,
+		"CloseSheet":            true,
+		"ReopenSheet":           true,
+		"JoinSheet":             true,
+		"LeaveSheet":            true,
+		"CancelOrder":           true,
+		"ConfirmOrder":          true,
+		"ImportMenu":            true,
+		"AttachMenuWithPayload": true,
+		"RemoveMember":          true,
+		"StreamOrders":          false,
+		"ListOrders":            false,
+		"GetSheetSettlement":    false,
 	}
 
 	for name, want := range tests {
@@ -26,3 +40,48 @@ func TestIsWriteMethod(t *testing.T) {
 		}
 	}
 }
+
+func TestIdempotencyKeyScopedToUserAndMethod(t *testing.T) {
+	request := func(userID, method, key string) context.Context {
+		ctx := WithPrincipal(context.Background(), &domain.Principal{UserID: userID})
+		return WithIdempotencyKey(ctx, method, key)
+	}
+
+	alice := GetOrCreateIdempotencyKeyWithHash(request("alice", "CreateOrder", "k1"), "hash")
+	if again := GetOrCreateIdempotencyKeyWithHash(request("alice", "CreateOrder", "k1"), "other-hash"); again != alice {
+		t.Fatalf("retry key = %q, want %q", again, alice)
+	}
+	if bob := GetOrCreateIdempotencyKeyWithHash(request("bob", "CreateOrder", "k1"), "hash"); bob == alice {
+		t.Fatalf("two users sharing key k1 both stored under %q", bob)
+	}
+	if other := GetOrCreateIdempotencyKeyWithHash(request("alice", "UpdateOrder", "k1"), "hash"); other == alice {
+		t.Fatalf("two methods sharing key k1 both stored under %q", other)
+	}
+}
+
+func TestIdemInterceptorFallsBackToRequestKey(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/core.v1.PaymentsService/SetPaymentStatus"}
+	var got string
+	handler := func(ctx context.Context, _ any) (any, error) {
+		got = idempotencyKeyFromContext(ctx)
+		return nil, nil
+	}
+	call := func(ctx context.Context, req any) error {
+		got = ""
+		_, err := IdemInterceptor()(ctx, req, info, handler)
+		return err
+	}
+
+	if err := call(context.Background(), &corev1.SetPaymentStatusReq{IdempotencyKey: "body"}); err != nil || got != "body" {
+		t.Fatalf("body key = %q, %v; want body", got, err)
+	}
+
+	header := metadata.NewIncomingContext(context.Background(), metadata.Pairs(mdKey, "header"))
+	if err := call(header, &corev1.SetPaymentStatusReq{IdempotencyKey: "body"}); err != nil || got != "header" {
+		t.Fatalf("header key = %q, %v; want header", got, err)
+	}
+
+	if err := call(context.Background(), &corev1.SetPaymentStatusReq{}); err == nil {
+		t.Fatal("write without any key succeeded")
+	}
+}
@@ -24,28 +24,45 @@ func LoggingInterceptor() grpc.UnaryServerInterceptor {
 		// Call handler
 		resp, err := handler(ctx, req)
 
-		// Log after completion
-		code := codes.OK
-		if err != nil {
-			st, _ := status.FromError(err)
-			code = st.Code()
-		}
-
-		traceID := ""
-		if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
-			traceID = sc.TraceID().String()
-		}
-
-		idemKey := idempotencyKeyFromContext(ctx)
-
-		slog.Info("grpc_request",
-			"method", info.FullMethod,
-			"code", code.String(),
-			"duration_ms", time.Since(start).Milliseconds(),
-			"trace_id", traceID,
-			"idempotency_key", idemKey,
-		)
-
+		logRPC(ctx, info.FullMethod, start, err)
 		return resp, err
 	}
 }
+
+// LoggingStreamInterceptor logs every stream once it ends
+func LoggingStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		logRPC(ss.Context(), info.FullMethod, start, err)
+		return err
+	}
+}
+
+func logRPC(ctx context.Context, method string, start time.Time, err error) {
+	code := codes.OK
+	if err != nil {
+		st, _ := status.FromError(err)
+		code = st.Code()
+	}
+
+	traceID := ""
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		traceID = sc.TraceID().String()
+	}
+
+	idemKey := idempotencyKeyFromContext(ctx)
+
+	slog.Info("grpc_request",
+		"method", method,
+		"code", code.String(),
+		"duration_ms", time.Since(start).Milliseconds(),
+		"trace_id", traceID,
+		"idempotency_key", idemKey,
+	)
+}
@@ -21,24 +21,43 @@ func MetricsInterceptor(metrics *observability.Metrics) grpc.UnaryServerIntercep
 
 		start := time.Now()
 		resp, err := handler(ctx, req)
-		elapsed := time.Since(start).Seconds()
+		recordRPC(ctx, metrics, info.FullMethod, start, err)
+		return resp, err
+	}
+}
 
-		// no metrics registered
-		if metrics == nil {
-			return resp, err
-		}
+// MetricsStreamInterceptor is the streaming counterpart of MetricsInterceptor.
+// A stream is counted once, when it ends, with its whole duration.
+func MetricsStreamInterceptor(metrics *observability.Metrics) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		recordRPC(ss.Context(), metrics, info.FullMethod, start, err)
+		return err
+	}
+}
 
-		st := status.Code(err)
+func recordRPC(ctx context.Context, metrics *observability.Metrics, method string, start time.Time, err error) {
+	elapsed := time.Since(start).Seconds()
 
-		attributes := []attribute.KeyValue{
-			attribute.String("rpc.system", "grpc"),
-			attribute.String("rpc.method", info.FullMethod),
-			attribute.String("rpc.grpc.status_code", st.String()),
-		}
+	// no metrics registered
+	if metrics == nil {
+		return
+	}
 
-		metrics.RPCCounter.Add(ctx, 1, metric.WithAttributes(attributes...))
-		metrics.RPCLatency.Record(ctx, elapsed, metric.WithAttributes(attributes...))
+	st := status.Code(err)
 
-		return resp, err
+	attributes := []attribute.KeyValue{
+		attribute.String("rpc.system", "grpc"),
+		attribute.String("rpc.method", method),
+		attribute.String("rpc.grpc.status_code", st.String()),
 	}
+
+	metrics.RPCCounter.Add(ctx, 1, metric.WithAttributes(attributes...))
+	metrics.RPCLatency.Record(ctx, elapsed, metric.WithAttributes(attributes...))
 }
@@ -31,54 +31,87 @@ func ValidateRequestInterceptor(metrics *observability.Metrics) grpc.UnaryServer
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
-		if req == nil {
-			return nil, status.Error(codes.InvalidArgument, "request must not be nil")
+		if err := validateRequest(ctx, metrics, req); err != nil {
+			return nil, err
 		}
+		return handler(ctx, req)
+	}
+}
 
-		var err error
+// ValidateStreamInterceptor validates every message received on a stream
+func ValidateStreamInterceptor(metrics *observability.Metrics) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return handler(srv, &validatingStream{ServerStream: ss, metrics: metrics})
+	}
+}
 
-		// try ValidateAll() or generated Validate()
-		if v, ok := req.(Validator); ok {
-			err = v.ValidateAll()
-		} else if p, ok := req.(ProtoValidator); ok {
-			err = p.Validate()
-		}
+type validatingStream struct {
+	grpc.ServerStream
+	metrics *observability.Metrics
+}
 
-		if err != nil {
-			if metrics != nil {
-				metrics.ValidationFailures.Add(ctx, 1)
-			}
+func (s *validatingStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return validateRequest(s.Context(), s.metrics, m)
+}
 
-			st := status.New(codes.InvalidArgument, err.Error())
-			br := &errdetails.BadRequest{}
-			var mErr multiError
-			if errors.As(err, &mErr) {
-				inner := mErr.AllErrors()
-				br.FieldViolations = make([]*errdetails.BadRequest_FieldViolation, 0, len(inner))
-				for _, ie := range inner {
-					var fe fieldError
-					if errors.As(ie, &fe) {
-						br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{Field: fe.Field(), Description: fe.Reason()})
-					} else {
-						br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{Field: "", Description: ie.Error()})
-					}
-				}
-			} else {
-				// Single validation error (generated validators expose Field() and Reason())
+// validateRequest runs the generated validators and converts violations to an
+// InvalidArgument status carrying BadRequest details
+func validateRequest(ctx context.Context, metrics *observability.Metrics, req interface{}) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "request must not be nil")
+	}
+
+	var err error
+
+	// try ValidateAll() or generated Validate()
+	if v, ok := req.(Validator); ok {
+		err = v.ValidateAll()
+	} else if p, ok := req.(ProtoValidator); ok {
+		err = p.Validate()
+	}
+
+	if err != nil {
+		if metrics != nil {
+			metrics.ValidationFailures.Add(ctx, 1)
+		}
+
+		st := status.New(codes.InvalidArgument, err.Error())
+		br := &errdetails.BadRequest{}
+		var mErr multiError
+		if errors.As(err, &mErr) {
+			inner := mErr.AllErrors()
+			br.FieldViolations = make([]*errdetails.BadRequest_FieldViolation, 0, len(inner))
+			for _, ie := range inner {
 				var fe fieldError
-				if errors.As(err, &fe) {
-					br.FieldViolations = []*errdetails.BadRequest_FieldViolation{{Field: fe.Field(), Description: fe.Reason()}}
+				if errors.As(ie, &fe) {
+					br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{Field: fe.Field(), Description: fe.Reason()})
 				} else {
-					br.FieldViolations = []*errdetails.BadRequest_FieldViolation{{Field: "", Description: err.Error()}}
+					br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{Field: "", Description: ie.Error()})
 				}
 			}
-			stWithDetails, e := st.WithDetails(br)
-			if e != nil {
-				slog.Warn("failed to attach validation details to status", "error", e)
-				return nil, st.Err()
+		} else {
+			// Single validation error (generated validators expose Field() and Reason())
+			var fe fieldError
+			if errors.As(err, &fe) {
+				br.FieldViolations = []*errdetails.BadRequest_FieldViolation{{Field: fe.Field(), Description: fe.Reason()}}
+			} else {
+				br.FieldViolations = []*errdetails.BadRequest_FieldViolation{{Field: "", Description: err.Error()}}
 			}
-			return nil, stWithDetails.Err()
 		}
-		return handler(ctx, req)
+		stWithDetails, e := st.WithDetails(br)
+		if e != nil {
+			slog.Warn("failed to attach validation details to status", "error", e)
+			return st.Err()
+		}
+		return stWithDetails.Err()
 	}
+	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/deni12345/dae-services/services/dae-core/internal/app/order"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
 	"github.com/deni12345/dae-services/services/dae-core/internal/grpc/converter"
 	"github.com/deni12345/dae-services/services/dae-core/internal/grpc/errors"
 	corev1 "github.com/deni12345/dae-services/proto/gen"
@@ -30,6 +31,26 @@ func (h *OrderHandler) CreateOrder(ctx context.Context, req *corev1.CreateOrderR
 	}, nil
 }
 
+func (h *OrderHandler) CancelOrder(ctx context.Context, req *corev1.CancelOrderReq) (*corev1.CancelOrderResp, error) {
+	o, err := h.uc.CancelOrder(ctx, req.GetId())
+	if err != nil {
+		return nil, errors.ToGRPCStatus(err)
+	}
+	return &corev1.CancelOrderResp{
+		Order: converter.OrderToProto(o),
+	}, nil
+}
+
+func (h *OrderHandler) ConfirmOrder(ctx context.Context, req *corev1.ConfirmOrderReq) (*corev1.ConfirmOrderResp, error) {
+	o, err := h.uc.ConfirmOrder(ctx, req.GetId())
+	if err != nil {
+		return nil, errors.ToGRPCStatus(err)
+	}
+	return &corev1.ConfirmOrderResp{
+		Order: converter.OrderToProto(o),
+	}, nil
+}
+
 func (h *OrderHandler) GetOrder(ctx context.Context, req *corev1.GetOrderReq) (*corev1.GetOrderResp, error) {
 	o, err := h.uc.GetOrderByID(ctx, req.GetId())
 	if err != nil {
@@ -48,3 +69,13 @@ func (h *OrderHandler) ListOrders(ctx context.Context, req *corev1.ListOrdersReq
 
 	return converter.ListOrdersRespToProto(resp), nil
 }
+
+func (h *OrderHandler) StreamOrders(req *corev1.StreamOrdersRequest, stream corev1.OrdersService_StreamOrdersServer) error {
+	err := h.uc.StreamOrders(stream.Context(), converter.StreamOrdersReqFromProto(req), func(ev *domain.OrderEvent) error {
+		return stream.Send(converter.OrderEventToProto(ev))
+	})
+	if err != nil {
+		return errors.ToGRPCStatus(err)
+	}
+	return nil
+}
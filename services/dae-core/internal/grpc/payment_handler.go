@@ -0,0 +1,49 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/payment"
+	"github.com/deni12345/dae-services/services/dae-core/internal/grpc/converter"
+	"github.com/deni12345/dae-services/services/dae-core/internal/grpc/errors"
+	corev1 "github.com/deni12345/dae-services/proto/gen"
+)
+
+type PaymentHandler struct {
+	corev1.UnimplementedPaymentsServiceServer
+	uc payment.Usecase
+}
+
+func NewPaymentHandler(uc payment.Usecase) *PaymentHandler {
+	return &PaymentHandler{
+		uc: uc,
+	}
+}
+
+func (h *PaymentHandler) SetPaymentStatus(ctx context.Context, req *corev1.SetPaymentStatusReq) (*corev1.SetPaymentStatusResp, error) {
+	p, err := h.uc.SetPaymentStatus(ctx, converter.SetPaymentStatusReqFromProto(req))
+	if err != nil {
+		return nil, errors.ToGRPCStatus(err)
+	}
+	return &corev1.SetPaymentStatusResp{
+		Payment: converter.PaymentToProto(p),
+	}, nil
+}
+
+func (h *PaymentHandler) ListSheetBalances(ctx context.Context, req *corev1.ListSheetBalancesReq) (*corev1.ListSheetBalancesResp, error) {
+	balances, err := h.uc.ListSheetBalances(ctx, req.GetSheetId())
+	if err != nil {
+		return nil, errors.ToGRPCStatus(err)
+	}
+	return &corev1.ListSheetBalancesResp{
+		Balances: converter.BalancesToProto(balances),
+	}, nil
+}
+
+func (h *PaymentHandler) ListUserDebts(ctx context.Context, req *corev1.ListUserDebtsReq) (*corev1.ListUserDebtsResp, error) {
+	resp, err := h.uc.ListUserDebts(ctx, req.GetUserId())
+	if err != nil {
+		return nil, errors.ToGRPCStatus(err)
+	}
+	return converter.ListUserDebtsRespToProto(resp), nil
+}
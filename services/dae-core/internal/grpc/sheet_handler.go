@@ -61,3 +61,127 @@ func (h *SheetHandler) ListSheets(ctx context.Context, req *corev1.ListSheetsReq
 
 	return converter.ListSheetsRespToProto(resp), nil
 }
+
+func (h *SheetHandler) GetSheetSettlement(ctx context.Context, req *corev1.GetSheetSettlementReq) (*corev1.GetSheetSettlementResp, error) {
+	settlement, err := h.uc.GetSheetSettlement(ctx, req.GetSheetId())
+	if err != nil {
+		return nil, errors.ToGRPCStatus(err)
+	}
+
+	return converter.SettlementToProto(settlement), nil
+}
+
+func (h *SheetHandler) JoinSheet(ctx context.Context, req *corev1.JoinSheetRequest) (*corev1.JoinSheetResponse, error) {
+	member, err := h.uc.JoinSheet(ctx, converter.JoinSheetReqFromProto(req))
+	if err != nil {
+		return nil, errors.ToGRPCStatus(err)
+	}
+
+	return &corev1.JoinSheetResponse{
+		Member: converter.SheetMemberToProto(member),
+	}, nil
+}
+
+func (h *SheetHandler) CreateSheetInvite(ctx context.Context, req *corev1.CreateSheetInviteReq) (*corev1.CreateSheetInviteResp, error) {
+	invite, err := h.uc.CreateSheetInvite(ctx, converter.CreateSheetInviteReqFromProto(req))
+	if err != nil {
+		return nil, errors.ToGRPCStatus(err)
+	}
+
+	return &corev1.CreateSheetInviteResp{
+		Invite: converter.SheetInviteToProto(invite),
+	}, nil
+}
+
+func (h *SheetHandler) RedeemSheetInvite(ctx context.Context, req *corev1.RedeemSheetInviteReq) (*corev1.RedeemSheetInviteResp, error) {
+	member, err := h.uc.RedeemSheetInvite(ctx, req.GetCode())
+	if err != nil {
+		return nil, errors.ToGRPCStatus(err)
+	}
+
+	return &corev1.RedeemSheetInviteResp{
+		Member: converter.SheetMemberToProto(member),
+	}, nil
+}
+
+func (h *SheetHandler) RevokeSheetInvite(ctx context.Context, req *corev1.RevokeSheetInviteReq) (*corev1.RevokeSheetInviteResp, error) {
+	invite, err := h.uc.RevokeSheetInvite(ctx, req.GetCode())
+	if err != nil {
+		return nil, errors.ToGRPCStatus(err)
+	}
+
+	return &corev1.RevokeSheetInviteResp{
+		Invite: converter.SheetInviteToProto(invite),
+	}, nil
+}
+
+func (h *SheetHandler) RemoveMember(ctx context.Context, req *corev1.RemoveMemberRequest) (*corev1.RemoveMemberResponse, error) {
+	err := h.uc.LeaveSheet(ctx, &sheet.LeaveSheetReq{
+		SheetID: req.GetSheetId(),
+		UserID:  req.GetUserId(),
+	})
+	if err != nil {
+		return nil, errors.ToGRPCStatus(err)
+	}
+
+	return &corev1.RemoveMemberResponse{}, nil
+}
+
+func (h *SheetHandler) ListMembers(ctx context.Context, req *corev1.ListMembersRequest) (*corev1.ListMembersResponse, error) {
+	resp, err := h.uc.ListMembers(ctx, converter.ListMembersReqFromProto(req))
+	if err != nil {
+		return nil, errors.ToGRPCStatus(err)
+	}
+
+	return converter.ListMembersRespToProto(resp), nil
+}
+
+func (h *SheetHandler) AttachMenuWithPayload(ctx context.Context, req *corev1.AttachMenuWithPayloadReq) (*corev1.AttachMenuWithPayloadResp, error) {
+	sheet, items, err := h.uc.AttachMenu(ctx, converter.AttachMenuReqFromProto(req))
+	if err != nil {
+		return nil, errors.ToGRPCStatus(err)
+	}
+
+	return &corev1.AttachMenuWithPayloadResp{
+		Items: converter.MenuItemsToProto(items),
+		Sheet: converter.SheetToProto(sheet),
+	}, nil
+}
+
+func (h *SheetHandler) ImportMenu(ctx context.Context, req *corev1.ImportMenuReq) (*corev1.ImportMenuResp, error) {
+	resp, err := h.uc.ImportMenu(ctx, converter.ImportMenuReqFromProto(req))
+	if err != nil {
+		return nil, errors.ToGRPCStatus(err)
+	}
+
+	return converter.ImportMenuRespToProto(resp), nil
+}
+
+func (h *SheetHandler) GetMenu(ctx context.Context, req *corev1.GetMenuReq) (*corev1.GetMenuResp, error) {
+	items, err := h.uc.GetMenu(ctx, req.GetSheetId())
+	if err != nil {
+		return nil, errors.ToGRPCStatus(err)
+	}
+
+	return &corev1.GetMenuResp{
+		Items: converter.MenuItemsToProto(items),
+	}, nil
+}
+
+func (h *SheetHandler) ListMenuVersions(ctx context.Context, req *corev1.ListMenuVersionsReq) (*corev1.ListMenuVersionsResp, error) {
+	resp, err := h.uc.ListMenuVersions(ctx, req.GetSheetId())
+	if err != nil {
+		return nil, errors.ToGRPCStatus(err)
+	}
+
+	return converter.ListMenuVersionsRespToProto(resp), nil
+}
+
+func (h *SheetHandler) DiffMenuVersions(ctx context.Context, req *corev1.DiffMenuVersionsReq) (*corev1.DiffMenuVersionsResp, error) {
+	resp, err := h.uc.DiffMenuVersions(ctx, converter.DiffMenuVersionsReqFromProto(req))
+	if err != nil {
+		return nil, errors.ToGRPCStatus(err)
+	}
+
+	return converter.DiffMenuVersionsRespToProto(resp), nil
+}
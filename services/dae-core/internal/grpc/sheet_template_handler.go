@@ -0,0 +1,66 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/app/sheettemplate"
+	"github.com/deni12345/dae-services/services/dae-core/internal/grpc/converter"
+	"github.com/deni12345/dae-services/services/dae-core/internal/grpc/errors"
+	corev1 "github.com/deni12345/dae-services/proto/gen"
+)
+
+type SheetTemplateHandler struct {
+	corev1.UnimplementedSheetTemplatesServiceServer
+	uc sheettemplate.Usecase
+}
+
+func NewSheetTemplateHandler(uc sheettemplate.Usecase) *SheetTemplateHandler {
+	return &SheetTemplateHandler{
+		uc: uc,
+	}
+}
+
+func (h *SheetTemplateHandler) CreateSheetTemplate(ctx context.Context, req *corev1.CreateSheetTemplateReq) (*corev1.CreateSheetTemplateResp, error) {
+	t, err := h.uc.CreateSheetTemplate(ctx, converter.CreateSheetTemplateReqFromProto(req))
+	if err != nil {
+		return nil, errors.ToGRPCStatus(err)
+	}
+	return &corev1.CreateSheetTemplateResp{
+		Template: converter.SheetTemplateToProto(t),
+	}, nil
+}
+
+func (h *SheetTemplateHandler) GetSheetTemplate(ctx context.Context, req *corev1.GetSheetTemplateReq) (*corev1.GetSheetTemplateResp, error) {
+	t, err := h.uc.GetSheetTemplate(ctx, req.GetId())
+	if err != nil {
+		return nil, errors.ToGRPCStatus(err)
+	}
+	return &corev1.GetSheetTemplateResp{
+		Template: converter.SheetTemplateToProto(t),
+	}, nil
+}
+
+func (h *SheetTemplateHandler) ListSheetTemplates(ctx context.Context, req *corev1.ListSheetTemplatesReq) (*corev1.ListSheetTemplatesResp, error) {
+	resp, err := h.uc.ListSheetTemplates(ctx, converter.ListSheetTemplatesReqFromProto(req))
+	if err != nil {
+		return nil, errors.ToGRPCStatus(err)
+	}
+	return converter.ListSheetTemplatesRespToProto(resp), nil
+}
+
+func (h *SheetTemplateHandler) UpdateSheetTemplate(ctx context.Context, req *corev1.UpdateSheetTemplateReq) (*corev1.UpdateSheetTemplateResp, error) {
+	t, err := h.uc.UpdateSheetTemplate(ctx, converter.UpdateSheetTemplateReqFromProto(req))
+	if err != nil {
+		return nil, errors.ToGRPCStatus(err)
+	}
+	return &corev1.UpdateSheetTemplateResp{
+		Template: converter.SheetTemplateToProto(t),
+	}, nil
+}
+
+func (h *SheetTemplateHandler) DeleteSheetTemplate(ctx context.Context, req *corev1.DeleteSheetTemplateReq) (*corev1.DeleteSheetTemplateResp, error) {
+	if err := h.uc.DeleteSheetTemplate(ctx, req.GetId()); err != nil {
+		return nil, errors.ToGRPCStatus(err)
+	}
+	return &corev1.DeleteSheetTemplateResp{}, nil
+}
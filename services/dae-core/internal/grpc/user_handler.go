@@ -7,6 +7,7 @@ import (
 	"github.com/deni12345/dae-services/services/dae-core/internal/grpc/converter"
 	"github.com/deni12345/dae-services/services/dae-core/internal/grpc/errors"
 	corev1 "github.com/deni12345/dae-services/proto/gen"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type UserHandler struct {
@@ -62,6 +63,118 @@ func (h *UserHandler) ListUsers(ctx context.Context, req *corev1.ListUsersReq) (
 	return converter.ListUsersRespToProto(resp), nil
 }
 
+func (h *UserHandler) GetUserByIdentity(ctx context.Context, req *corev1.GetUserByIdentityReq) (*corev1.GetUserByIdentityResp, error) {
+	u, err := h.uc.GetUserByIdentity(ctx, converter.GetUserByIdentityReqFromProto(req))
+	if err != nil {
+		return nil, errors.ToGRPCStatus(err)
+	}
+
+	return &corev1.GetUserByIdentityResp{
+		User: converter.UserToProto(u),
+	}, nil
+}
+
+func (h *UserHandler) RecordLogin(ctx context.Context, req *corev1.RecordLoginReq) (*corev1.RecordLoginResp, error) {
+	u, err := h.uc.RecordLogin(ctx, converter.RecordLoginReqFromProto(req))
+	if err != nil {
+		return nil, errors.ToGRPCStatus(err)
+	}
+
+	return &corev1.RecordLoginResp{
+		User: converter.UserToProto(u),
+	}, nil
+}
+
+func (h *UserHandler) AuthenticateLocal(ctx context.Context, req *corev1.AuthenticateLocalReq) (*corev1.AuthenticateLocalResp, error) {
+	u, err := h.uc.AuthenticateLocal(ctx, converter.AuthenticateLocalReqFromProto(req))
+	if err != nil {
+		return nil, errors.ToGRPCStatus(err)
+	}
+
+	return &corev1.AuthenticateLocalResp{
+		User: converter.UserToProto(u),
+	}, nil
+}
+
+func (h *UserHandler) ChangePassword(ctx context.Context, req *corev1.ChangePasswordReq) (*corev1.ChangePasswordResp, error) {
+	if err := h.uc.ChangePassword(ctx, converter.ChangePasswordReqFromProto(req)); err != nil {
+		return nil, errors.ToGRPCStatus(err)
+	}
+
+	return &corev1.ChangePasswordResp{}, nil
+}
+
+func (h *UserHandler) RequestPasswordReset(ctx context.Context, req *corev1.RequestPasswordResetReq) (*corev1.RequestPasswordResetResp, error) {
+	resp, err := h.uc.RequestPasswordReset(ctx, converter.RequestPasswordResetReqFromProto(req))
+	if err != nil {
+		return nil, errors.ToGRPCStatus(err)
+	}
+
+	return converter.RequestPasswordResetRespToProto(resp), nil
+}
+
+func (h *UserHandler) ConfirmPasswordReset(ctx context.Context, req *corev1.ConfirmPasswordResetReq) (*corev1.ConfirmPasswordResetResp, error) {
+	userID, err := h.uc.ConfirmPasswordReset(ctx, converter.ConfirmPasswordResetReqFromProto(req))
+	if err != nil {
+		return nil, errors.ToGRPCStatus(err)
+	}
+
+	return &corev1.ConfirmPasswordResetResp{UserId: userID}, nil
+}
+
+func (h *UserHandler) LinkIdentity(ctx context.Context, req *corev1.LinkIdentityReq) (*corev1.LinkIdentityResp, error) {
+	identity, err := h.uc.LinkIdentity(ctx, converter.LinkIdentityReqFromProto(req))
+	if err != nil {
+		return nil, errors.ToGRPCStatus(err)
+	}
+
+	return &corev1.LinkIdentityResp{
+		Identity: converter.IdentityToProto(identity),
+	}, nil
+}
+
+func (h *UserHandler) UnlinkIdentity(ctx context.Context, req *corev1.UnlinkIdentityReq) (*corev1.UnlinkIdentityResp, error) {
+	if err := h.uc.UnlinkIdentity(ctx, converter.UnlinkIdentityReqFromProto(req)); err != nil {
+		return nil, errors.ToGRPCStatus(err)
+	}
+
+	return &corev1.UnlinkIdentityResp{}, nil
+}
+
+func (h *UserHandler) ListIdentities(ctx context.Context, req *corev1.ListIdentitiesReq) (*corev1.ListIdentitiesResp, error) {
+	identities, err := h.uc.ListIdentities(ctx, req.GetUserId())
+	if err != nil {
+		return nil, errors.ToGRPCStatus(err)
+	}
+
+	return &corev1.ListIdentitiesResp{
+		Identities: converter.IdentitiesToProto(identities),
+	}, nil
+}
+
+func (h *UserHandler) DeleteUser(ctx context.Context, req *corev1.DeleteUserReq) (*corev1.DeleteUserResp, error) {
+	u, err := h.uc.DeleteUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, errors.ToGRPCStatus(err)
+	}
+
+	return &corev1.DeleteUserResp{
+		User: converter.UserToProto(u),
+	}, nil
+}
+
+func (h *UserHandler) ExportUserData(ctx context.Context, req *corev1.ExportUserDataReq) (*corev1.ExportUserDataResp, error) {
+	export, archive, err := h.uc.ExportUserData(ctx, req.GetUserId())
+	if err != nil {
+		return nil, errors.ToGRPCStatus(err)
+	}
+
+	return &corev1.ExportUserDataResp{
+		Archive:    archive,
+		ExportedAt: timestamppb.New(export.ExportedAt),
+	}, nil
+}
+
 func (h *UserHandler) AdminSetUserRoles(ctx context.Context, req *corev1.AdminSetUserRolesReq) (*corev1.AdminSetUserRolesResp, error) {
 	u, err := h.uc.AdminSetUserRoles(ctx, converter.AdminSetUserRolesReqFromProto(req))
 	if err != nil {
@@ -0,0 +1,81 @@
+package auditlog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cloud.google.com/go/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// List pages through events newest first, ordered by occurred_at and then
+// document ID so cursors stay stable while events are appended
+func (l *auditLog) List(ctx context.Context, query port.ListAuditQuery) (*port.ListAuditEventsResp, error) {
+	ctx, span := tracer.Start(ctx, "AuditLog.List")
+	defer span.End()
+
+	limit := int(query.Limit)
+	if limit <= 0 || limit > 1000 {
+		limit = int(l.defaultPageSize)
+	}
+
+	q := l.collection.Query
+	if query.ResourceType != "" {
+		q = q.Where("resource_type", "==", query.ResourceType)
+		if query.ResourceID != "" {
+			q = q.Where("resource_id", "==", query.ResourceID)
+		}
+	}
+	if query.ActorID != "" {
+		q = q.Where("actor_id", "==", query.ActorID)
+	}
+	q = q.OrderBy("occurred_at", firestore.Desc).
+		OrderBy(firestore.DocumentID, firestore.Desc).
+		Limit(limit + 1)
+
+	if query.Cursor != "" {
+		cursorSnap, err := l.collection.Doc(query.Cursor).Get(ctx)
+		if err != nil {
+			span.RecordError(err)
+			if status.Code(err) == codes.NotFound {
+				return nil, fmt.Errorf("audit event %s: %w", query.Cursor, port.ErrInvalidAuditCursor)
+			}
+			return nil, fmt.Errorf("get cursor event: %w", err)
+		}
+		q = q.StartAfter(cursorSnap)
+	}
+
+	iter := q.Documents(ctx)
+	defer iter.Stop()
+
+	resp := &port.ListAuditEventsResp{}
+	for {
+		doc, err := iter.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			span.RecordError(err)
+			return nil, fmt.Errorf("list audit events: %w", err)
+		}
+		if len(resp.Events) == limit {
+			resp.NextCursor = resp.Events[limit-1].ID
+			break
+		}
+
+		var event domain.AuditEvent
+		if err := doc.DataTo(&event); err != nil {
+			span.RecordError(err)
+			return nil, fmt.Errorf("unmarshal audit event: %w", err)
+		}
+		event.ID = doc.Ref.ID
+		resp.Events = append(resp.Events, &event)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,22 @@
+package auditlog
+
+import (
+	"cloud.google.com/go/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/audit"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("firestore/auditlog")
+
+type auditLog struct {
+	collection      *firestore.CollectionRef
+	defaultPageSize int32
+}
+
+func NewAuditLog(client *firestore.Client, defaultPageSize int32) port.AuditLog {
+	return &auditLog{
+		collection:      client.Collection(audit.Collection),
+		defaultPageSize: defaultPageSize,
+	}
+}
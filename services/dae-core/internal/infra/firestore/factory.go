@@ -2,8 +2,12 @@ package firestore
 
 import (
 	"cloud.google.com/go/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/infra/firestore/auditlog"
 	"github.com/deni12345/dae-services/services/dae-core/internal/infra/firestore/order"
+	"github.com/deni12345/dae-services/services/dae-core/internal/infra/firestore/outbox"
+	"github.com/deni12345/dae-services/services/dae-core/internal/infra/firestore/payment"
 	"github.com/deni12345/dae-services/services/dae-core/internal/infra/firestore/sheet"
+	"github.com/deni12345/dae-services/services/dae-core/internal/infra/firestore/sheettemplate"
 	"github.com/deni12345/dae-services/services/dae-core/internal/infra/firestore/user"
 	"github.com/deni12345/dae-services/services/dae-core/internal/port"
 )
@@ -19,3 +23,31 @@ func NewOrderRepo(client *firestore.Client, defaultPageSize int32) port.OrdersRe
 func NewSheetRepo(client *firestore.Client, defaultPageSize int32) port.SheetRepo {
 	return sheet.NewSheetRepo(client, defaultPageSize)
 }
+
+func NewOrderChangeSource(client *firestore.Client) port.OrderChangeSource {
+	return order.NewOrderChangeSource(client)
+}
+
+func NewPaymentRepo(client *firestore.Client) port.PaymentsRepo {
+	return payment.NewPaymentRepo(client)
+}
+
+func NewUserDataStore(client *firestore.Client) port.UserDataStore {
+	return user.NewUserDataStore(client)
+}
+
+func NewAuditLog(client *firestore.Client, defaultPageSize int32) port.AuditLog {
+	return auditlog.NewAuditLog(client, defaultPageSize)
+}
+
+func NewOutbox(client *firestore.Client) port.Outbox {
+	return outbox.NewOutbox(client)
+}
+
+func NewSheetTemplateRepo(client *firestore.Client, defaultPageSize int32) port.SheetTemplateRepo {
+	return sheettemplate.NewSheetTemplateRepo(client, defaultPageSize)
+}
+
+func NewSheetInviteRepo(client *firestore.Client) port.SheetInviteRepo {
+	return sheet.NewSheetInviteRepo(client)
+}
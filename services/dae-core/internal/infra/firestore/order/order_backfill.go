@@ -0,0 +1,76 @@
+package order
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/audit"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+)
+
+// BackfillStatuses pages through orders by document ID. Each page is read
+// again and rewritten in one transaction, so a concurrent status change is
+// never overwritten. Only status is written: the order already read as
+// pending, so updated_at, the change log and the outbox are left alone.
+func (r *orderRepo) BackfillStatuses(ctx context.Context, afterID string, limit int) (string, int, error) {
+	ctx, span := tracer.Start(ctx, "OrderRepo.BackfillStatuses")
+	defer span.End()
+
+	q := r.collection.OrderBy(firestore.DocumentID, firestore.Asc).Limit(limit)
+	if afterID != "" {
+		q = q.StartAfter(afterID)
+	}
+	docs, err := q.Select().Documents(ctx).GetAll()
+	if err != nil {
+		span.RecordError(err)
+		return "", 0, fmt.Errorf("list orders: %w", err)
+	}
+	if len(docs) == 0 {
+		return "", 0, nil
+	}
+
+	refs := make([]*firestore.DocumentRef, len(docs))
+	for i, doc := range docs {
+		refs[i] = doc.Ref
+	}
+
+	var updated int
+	err = r.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		updated = 0
+		snaps, err := tx.GetAll(refs)
+		if err != nil {
+			return fmt.Errorf("get orders: %w", err)
+		}
+		for _, snap := range snaps {
+			if !snap.Exists() {
+				continue
+			}
+			var before domain.Order
+			if err := snap.DataTo(&before); err != nil {
+				return fmt.Errorf("unmarshal order %s: %w", snap.Ref.ID, err)
+			}
+			if before.Status != domain.OrderStatusUnspecified {
+				continue
+			}
+
+			after := before
+			after.Status = after.CurrentStatus()
+			updates := []firestore.Update{{Path: "status", Value: after.Status}}
+			if err := tx.Update(snap.Ref, updates); err != nil {
+				return fmt.Errorf("update order %s: %w", snap.Ref.ID, err)
+			}
+			if err := audit.Write(ctx, tx, r.client, domain.AuditResourceOrder, snap.Ref.ID, audit.Changes(before, after, updates), before.UserID); err != nil {
+				return err
+			}
+			updated++
+		}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return "", 0, err
+	}
+
+	return docs[len(docs)-1].Ref.ID, updated, nil
+}
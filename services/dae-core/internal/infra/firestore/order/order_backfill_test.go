@@ -0,0 +1,66 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+)
+
+func TestBackfillStatusesEmulator(t *testing.T) {
+	r := newEmulatorRepo(t)
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	// An order written before statuses existed has no status field at all
+	sheetID := fmt.Sprintf("sheet-%s-%d", t.Name(), now.UnixNano())
+	legacyID := sheetID + "-legacy"
+	if _, err := r.collection.Doc(legacyID).Set(ctx, map[string]any{
+		"sheet_id":   sheetID,
+		"user_id":    "u1",
+		"created_at": now,
+		"updated_at": now,
+	}); err != nil {
+		t.Fatalf("seed legacy order: %v", err)
+	}
+	seedOrders(t, r, []*domain.Order{{ID: "confirmed", UserID: "u1", Status: domain.OrderStatusConfirmed, CreatedAt: now, UpdatedAt: now}})
+
+	pending := port.ListOrdersQuery{SheetID: sheetID, Status: domain.OrderStatusPending}
+	if got, err := r.List(ctx, pending); err != nil || len(got) != 0 {
+		t.Fatalf("pending before backfill = %d, %v; want none", len(got), err)
+	}
+
+	// backfill runs over the whole collection, as the usecase does
+	backfill := func() int {
+		total, after := 0, ""
+		for {
+			last, updated, err := r.BackfillStatuses(ctx, after, 50)
+			if err != nil {
+				t.Fatalf("backfill: %v", err)
+			}
+			total += updated
+			if last == "" {
+				return total
+			}
+			after = last
+		}
+	}
+	if backfill() == 0 {
+		t.Fatal("backfill rewrote no orders")
+	}
+
+	got, err := r.List(ctx, pending)
+	if err != nil || len(got) != 1 || got[0].ID != legacyID {
+		t.Fatalf("pending after backfill = %v, %v; want only %s", got, err, legacyID)
+	}
+	if !got[0].UpdatedAt.Equal(now.Truncate(time.Microsecond)) {
+		t.Fatalf("updated_at = %v, want it unchanged at %v", got[0].UpdatedAt, now)
+	}
+
+	if updated := backfill(); updated != 0 {
+		t.Fatalf("second run rewrote %d orders, want none", updated)
+	}
+}
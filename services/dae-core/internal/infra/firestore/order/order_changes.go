@@ -0,0 +1,48 @@
+package order
+
+import (
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+)
+
+const (
+	// ChangesCollection keeps one immutable record per order write. Watch
+	// replays it on resume so a client sees every change it missed, not just
+	// the latest state of each order.
+	ChangesCollection = "order_changes"
+
+	// changeRetention is how far back a stream can resume. A Firestore TTL
+	// policy on expire_at deletes older records.
+	changeRetention = 7 * 24 * time.Hour
+)
+
+// orderChange is a stored order event. OccurredAt is the commit time, so
+// records sort in the order their writes became visible.
+type orderChange struct {
+	SheetID    string                `firestore:"sheet_id"`
+	OrderID    string                `firestore:"order_id"`
+	Type       domain.OrderEventType `firestore:"type"`
+	Order      domain.Order          `firestore:"order"`
+	OccurredAt time.Time             `firestore:"occurred_at,serverTimestamp"`
+	ExpireAt   time.Time             `firestore:"expire_at"`
+}
+
+// recordChange adds the change record of an order write to tx
+func (r *orderRepo) recordChange(tx *firestore.Transaction, eventType domain.OrderEventType, order *domain.Order) error {
+	return RecordChange(tx, r.client, eventType, order)
+}
+
+// RecordChange adds the change record of an order write to tx. Orders written
+// outside this repository, such as those a closing sheet confirms, must be
+// recorded with it or resumed streams miss the change.
+func RecordChange(tx *firestore.Transaction, client *firestore.Client, eventType domain.OrderEventType, order *domain.Order) error {
+	return tx.Create(client.Collection(ChangesCollection).NewDoc(), &orderChange{
+		SheetID:  order.SheetID,
+		OrderID:  order.ID,
+		Type:     eventType,
+		Order:    *order,
+		ExpireAt: time.Now().UTC().Add(changeRetention),
+	})
+}
@@ -4,7 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"cloud.google.com/go/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/audit"
 	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/infra/firestore/outbox"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -19,8 +22,25 @@ func (r *orderRepo) Create(ctx context.Context, order *domain.Order) (*domain.Or
 		return nil, err
 	}
 
+	event, err := domain.NewOrderEvent(domain.EventOrderCreated, order)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
 	docRef := r.collection.Doc(order.ID)
-	_, err := docRef.Create(ctx, order)
+	err = r.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		if err := tx.Create(docRef, order); err != nil {
+			return err
+		}
+		if err := audit.Write(ctx, tx, r.client, domain.AuditResourceOrder, order.ID, audit.Diff(nil, order), order.UserID); err != nil {
+			return err
+		}
+		if err := r.recordChange(tx, domain.OrderEventCreated, order); err != nil {
+			return err
+		}
+		return outbox.Enqueue(tx, r.client, event)
+	})
 	if err != nil {
 		if status.Code(err) == codes.AlreadyExists {
 			span.RecordError(ErrOrderExists)
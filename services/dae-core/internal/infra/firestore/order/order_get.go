@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/pricing"
 )
 
 func (r *orderRepo) GetByID(ctx context.Context, id string) (*domain.Order, error) {
@@ -24,5 +25,6 @@ func (r *orderRepo) GetByID(ctx context.Context, id string) (*domain.Order, erro
 	if order.ID == "" {
 		order.ID = doc.Ref.ID
 	}
+	pricing.Upgrade(&order)
 	return &order, nil
 }
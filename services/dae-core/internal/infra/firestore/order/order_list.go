@@ -7,8 +7,11 @@ import (
 	"cloud.google.com/go/firestore"
 	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
 	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"github.com/deni12345/dae-services/services/dae-core/internal/pricing"
 )
 
+// List pages through orders matching the query, newest first. Each filter
+// combination needs a composite index in deployments/firebase/firebase.json.
 func (r *orderRepo) List(ctx context.Context, query port.ListOrdersQuery) ([]*domain.Order, error) {
 	ctx, span := tracer.Start(ctx, "OrderRepo.List")
 	defer span.End()
@@ -18,7 +21,33 @@ func (r *orderRepo) List(ctx context.Context, query port.ListOrdersQuery) ([]*do
 		limit = r.defaultPageSize
 	}
 	q := r.collection.Query
-	q = q.Limit(int(limit))
+	if query.SheetID != "" {
+		q = q.Where("sheet_id", "==", query.SheetID)
+	}
+	if query.UserID != "" {
+		q = q.Where("user_id", "==", query.UserID)
+	}
+	switch query.Status {
+	case domain.OrderStatusUnspecified:
+	case domain.OrderStatusPending:
+		// Orders stored with an empty status count as pending. Firestore
+		// never matches a missing field, so orders stored before statuses
+		// existed are listed only once BackfillStatuses has run.
+		q = q.Where("status", "in", []domain.OrderStatus{domain.OrderStatusPending, domain.OrderStatusUnspecified})
+	default:
+		q = q.Where("status", "==", query.Status)
+	}
+
+	// Firestore orders by the range-filtered field first, so an updated-since
+	// listing is ordered by updated_at
+	orderField := "created_at"
+	if !query.UpdatedSince.IsZero() {
+		q = q.Where("updated_at", ">=", query.UpdatedSince)
+		orderField = "updated_at"
+	}
+	q = q.OrderBy(orderField, firestore.Desc).
+		OrderBy(firestore.DocumentID, firestore.Desc).
+		Limit(int(limit))
 
 	// If cursor is provided, start after that document
 	if query.Cursor != "" {
@@ -30,9 +59,6 @@ func (r *orderRepo) List(ctx context.Context, query port.ListOrdersQuery) ([]*do
 		q = q.StartAfter(cursorSnap)
 	}
 
-	// Add ordering (most recent first)
-	q = q.OrderBy("created_at", firestore.Desc)
-
 	docs, err := q.Documents(ctx).GetAll()
 	if err != nil {
 		span.RecordError(err)
@@ -49,6 +75,7 @@ func (r *orderRepo) List(ctx context.Context, query port.ListOrdersQuery) ([]*do
 		if order.ID == "" {
 			order.ID = doc.Ref.ID
 		}
+		pricing.Upgrade(&order)
 		orders = append(orders, &order)
 	}
 
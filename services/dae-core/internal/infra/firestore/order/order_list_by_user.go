@@ -0,0 +1,36 @@
+package order
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/pricing"
+)
+
+func (r *orderRepo) ListByUser(ctx context.Context, userID string) ([]*domain.Order, error) {
+	ctx, span := tracer.Start(ctx, "OrderRepo.ListByUser")
+	defer span.End()
+
+	docs, err := r.collection.Where("user_id", "==", userID).Documents(ctx).GetAll()
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("list orders by user: %w", err)
+	}
+
+	orders := make([]*domain.Order, 0, len(docs))
+	for _, doc := range docs {
+		var order domain.Order
+		if err := doc.DataTo(&order); err != nil {
+			span.RecordError(err)
+			return nil, fmt.Errorf("unmarshal order: %w", err)
+		}
+		if order.ID == "" {
+			order.ID = doc.Ref.ID
+		}
+		pricing.Upgrade(&order)
+		orders = append(orders, &order)
+	}
+
+	return orders, nil
+}
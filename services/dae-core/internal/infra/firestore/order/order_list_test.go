@@ -0,0 +1,125 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+)
+
+// newEmulatorRepo connects to the Firestore emulator, e.g. the one started by
+// docker-compose, and skips the test when none is configured
+func newEmulatorRepo(t *testing.T) *orderRepo {
+	t.Helper()
+	if os.Getenv("FIRESTORE_EMULATOR_HOST") == "" {
+		t.Skip("FIRESTORE_EMULATOR_HOST not set")
+	}
+
+	client, err := firestore.NewClient(context.Background(), "demo-dae-core")
+	if err != nil {
+		t.Fatalf("firestore client: %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+	return NewOrderRepo(client, 20).(*orderRepo)
+}
+
+// seedOrders stores orders under a sheet ID unique to this run, prefixing
+// their IDs with it, and returns the sheet ID
+func seedOrders(t *testing.T, r *orderRepo, orders []*domain.Order) string {
+	t.Helper()
+	sheetID := fmt.Sprintf("sheet-%s-%d", t.Name(), time.Now().UnixNano())
+	for _, o := range orders {
+		o.SheetID = sheetID
+		o.ID = sheetID + "-" + o.ID
+		if _, err := r.collection.Doc(o.ID).Set(context.Background(), o); err != nil {
+			t.Fatalf("seed order: %v", err)
+		}
+	}
+	return sheetID
+}
+
+func orderIDs(sheetID string, orders []*domain.Order) []string {
+	ids := make([]string, len(orders))
+	for i, o := range orders {
+		ids[i] = o.ID[len(sheetID)+1:]
+	}
+	return ids
+}
+
+func TestListFiltersEmulator(t *testing.T) {
+	r := newEmulatorRepo(t)
+	ctx := context.Background()
+	base := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	at := func(min int) time.Time { return base.Add(time.Duration(min) * time.Minute) }
+
+	sheetID := seedOrders(t, r, []*domain.Order{
+		{ID: "a", UserID: "u1", Status: domain.OrderStatusPending, CreatedAt: at(1), UpdatedAt: at(1)},
+		{ID: "b", UserID: "u2", Status: domain.OrderStatusConfirmed, CreatedAt: at(2), UpdatedAt: at(10)},
+		{ID: "c", UserID: "u1", Status: domain.OrderStatusCancelled, CreatedAt: at(3), UpdatedAt: at(4)},
+		{ID: "d", UserID: "u1", Status: domain.OrderStatusUnspecified, CreatedAt: at(4), UpdatedAt: at(5)},
+	})
+	// Another sheet's order must never leak in
+	seedOrders(t, r, []*domain.Order{{ID: "x", UserID: "u1", CreatedAt: at(9), UpdatedAt: at(9)}})
+
+	tests := map[string]struct {
+		query port.ListOrdersQuery
+		want  []string
+	}{
+		"sheet":                   {query: port.ListOrdersQuery{}, want: []string{"d", "c", "b", "a"}},
+		"user":                    {query: port.ListOrdersQuery{UserID: "u1"}, want: []string{"d", "c", "a"}},
+		"status":                  {query: port.ListOrdersQuery{Status: domain.OrderStatusConfirmed}, want: []string{"b"}},
+		"pending includes legacy": {query: port.ListOrdersQuery{Status: domain.OrderStatusPending}, want: []string{"d", "a"}},
+		"updated since":           {query: port.ListOrdersQuery{UpdatedSince: at(4)}, want: []string{"b", "d", "c"}},
+		"user and since":          {query: port.ListOrdersQuery{UserID: "u1", UpdatedSince: at(4)}, want: []string{"d", "c"}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt.query.SheetID = sheetID
+			orders, err := r.List(ctx, tt.query)
+			if err != nil {
+				t.Fatalf("list: %v", err)
+			}
+			if got := orderIDs(sheetID, orders); fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Fatalf("orders = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListPagesStablyEmulator(t *testing.T) {
+	r := newEmulatorRepo(t)
+	ctx := context.Background()
+	base := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	// Orders created in the same instant are ordered by ID
+	sheetID := seedOrders(t, r, []*domain.Order{
+		{ID: "a", CreatedAt: base}, {ID: "b", CreatedAt: base}, {ID: "c", CreatedAt: base}, {ID: "d", CreatedAt: base},
+	})
+
+	first, err := r.List(ctx, port.ListOrdersQuery{SheetID: sheetID, Limit: 2})
+	if err != nil {
+		t.Fatalf("first page: %v", err)
+	}
+
+	// An order placed between pages sorts before the cursor
+	late := &domain.Order{ID: sheetID + "-e", SheetID: sheetID, CreatedAt: base.Add(time.Hour)}
+	if _, err := r.collection.Doc(late.ID).Set(ctx, late); err != nil {
+		t.Fatalf("insert: %v", err)
+	}
+
+	second, err := r.List(ctx, port.ListOrdersQuery{SheetID: sheetID, Limit: 2, Cursor: first[len(first)-1].ID})
+	if err != nil {
+		t.Fatalf("second page: %v", err)
+	}
+
+	got := append(orderIDs(sheetID, first), orderIDs(sheetID, second)...)
+	if fmt.Sprint(got) != fmt.Sprint([]string{"d", "c", "b", "a"}) {
+		t.Fatalf("pages = %v, want [d c b a]", got)
+	}
+}
@@ -19,6 +19,7 @@ var (
 type orderRepo struct {
 	client          *firestore.Client
 	collection      *firestore.CollectionRef
+	changes         *firestore.CollectionRef
 	defaultPageSize int32
 }
 
@@ -26,6 +27,7 @@ func NewOrderRepo(client *firestore.Client, defaultPageSize int32) port.OrdersRe
 	return &orderRepo{
 		client:          client,
 		collection:      client.Collection("orders"),
+		changes:         client.Collection(ChangesCollection),
 		defaultPageSize: defaultPageSize,
 	}
 }
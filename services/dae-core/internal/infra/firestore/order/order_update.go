@@ -3,10 +3,14 @@ package order
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"time"
 
 	"cloud.google.com/go/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/audit"
 	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/infra/firestore/outbox"
+	"github.com/deni12345/dae-services/services/dae-core/internal/pricing"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -36,7 +40,12 @@ func (r *orderRepo) Update(ctx context.Context, id string, fn func(o *domain.Ord
 			cur.ID = snap.Ref.ID
 		}
 
+		// Orders on an older pricing version are upgraded, and the upgrade
+		// written back, by any update
+		stored := cur.PricingVersion
+		pricing.Upgrade(&cur)
 		before := cur
+		before.PricingVersion = stored
 
 		// Apply business logic from usecase
 		if err := fn(&cur); err != nil {
@@ -64,6 +73,25 @@ func (r *orderRepo) Update(ctx context.Context, id string, fn func(o *domain.Ord
 			return fmt.Errorf("update order: %w", err)
 		}
 
+		if err := audit.Write(ctx, tx, r.client, domain.AuditResourceOrder, id, audit.Changes(before, cur, updates), cur.UserID); err != nil {
+			return err
+		}
+
+		eventType, changeType := domain.EventOrderUpdated, domain.OrderEventUpdated
+		if cur.IsCancelled() && !before.IsCancelled() {
+			eventType, changeType = domain.EventOrderCancelled, domain.OrderEventCancelled
+		}
+		if err := r.recordChange(tx, changeType, &cur); err != nil {
+			return err
+		}
+		event, err := domain.NewOrderEvent(eventType, &cur)
+		if err != nil {
+			return err
+		}
+		if err := outbox.Enqueue(tx, r.client, event); err != nil {
+			return err
+		}
+
 		out = &cur
 		return nil
 	})
@@ -80,15 +108,27 @@ func (r *orderRepo) Update(ctx context.Context, id string, fn func(o *domain.Ord
 func buildOrderDiff(before, after domain.Order) []firestore.Update {
 	var updates []firestore.Update
 
-	// Compare lines (simplified - always update if fn was called)
-	if len(before.Lines) != len(after.Lines) || before.Note != after.Note {
+	// Totals are derived from the lines, so they are written together
+	if !reflect.DeepEqual(before.Lines, after.Lines) || before.Subtotal != after.Subtotal ||
+		before.PricingVersion != after.PricingVersion {
 		updates = append(updates,
 			firestore.Update{Path: "lines", Value: after.Lines},
 			firestore.Update{Path: "subtotal", Value: after.Subtotal},
-			firestore.Update{Path: "total", Value: after.Total},
-			firestore.Update{Path: "note", Value: after.Note},
+			firestore.Update{Path: "pricing_version", Value: after.PricingVersion},
 		)
 	}
 
+	if before.Note != after.Note {
+		updates = append(updates, firestore.Update{Path: "note", Value: after.Note})
+	}
+
+	if before.MenuID != after.MenuID {
+		updates = append(updates, firestore.Update{Path: "menu_id", Value: after.MenuID})
+	}
+
+	if before.Status != after.Status {
+		updates = append(updates, firestore.Update{Path: "status", Value: after.Status})
+	}
+
 	return updates
 }
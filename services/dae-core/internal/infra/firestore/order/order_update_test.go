@@ -0,0 +1,148 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/audit"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+)
+
+// auditEvents returns the audit events stored for an order, oldest first
+func auditEvents(t *testing.T, r *orderRepo, orderID string) []domain.AuditEvent {
+	t.Helper()
+	docs, err := r.client.Collection(audit.Collection).
+		Where("resource_type", "==", domain.AuditResourceOrder).
+		Where("resource_id", "==", orderID).
+		Documents(context.Background()).GetAll()
+	if err != nil {
+		t.Fatalf("list audit events: %v", err)
+	}
+	events := make([]domain.AuditEvent, len(docs))
+	for i, doc := range docs {
+		if err := doc.DataTo(&events[i]); err != nil {
+			t.Fatalf("unmarshal audit event: %v", err)
+		}
+	}
+	return events
+}
+
+func TestWritesAreAuditedEmulator(t *testing.T) {
+	r := newEmulatorRepo(t)
+	ctx := audit.NewContext(context.Background(), audit.Operation{ActorID: "u1", Method: "/core.v1.OrdersService/CreateOrder", IdempotencyKey: "k1"})
+	now := time.Now().UTC()
+
+	order := &domain.Order{
+		ID:        fmt.Sprintf("order-%s-%d", t.Name(), now.UnixNano()),
+		SheetID:   "s1",
+		UserID:    "u1",
+		Note:      "call me on 0901 234 567",
+		Status:    domain.OrderStatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if _, err := r.Create(ctx, order); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	// A rejected update writes nothing, so it records nothing
+	if _, err := r.Update(ctx, order.ID, func(*domain.Order) error { return errors.New("rejected") }); err == nil {
+		t.Fatal("update error = nil")
+	}
+	if _, err := r.Update(ctx, order.ID, func(o *domain.Order) error {
+		o.Status = domain.OrderStatusCancelled
+		return nil
+	}); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+
+	events := auditEvents(t, r, order.ID)
+	if len(events) != 2 {
+		t.Fatalf("events = %+v, want create and update", events)
+	}
+	for _, event := range events {
+		if event.ActorID != "u1" || event.IdempotencyKey != "k1" {
+			t.Fatalf("event = %+v", event)
+		}
+		for _, change := range event.Changes {
+			if change.Field == "note" && change.After != domain.AuditRedacted {
+				t.Fatalf("note recorded as %s", change.After)
+			}
+		}
+	}
+
+	var cancelled bool
+	for _, event := range events {
+		for _, change := range event.Changes {
+			cancelled = cancelled || change.Field == "status" && change.After == `"cancelled"`
+		}
+	}
+	if !cancelled {
+		t.Fatalf("events = %+v, want the cancellation", events)
+	}
+}
+
+// outboxEvents returns the types of the outbox events stored for an order
+func outboxEvents(t *testing.T, r *orderRepo, orderID string) []domain.EventType {
+	t.Helper()
+	docs, err := r.client.Collection("outbox").Where("aggregate_id", "==", orderID).Documents(context.Background()).GetAll()
+	if err != nil {
+		t.Fatalf("list outbox: %v", err)
+	}
+	types := make([]domain.EventType, len(docs))
+	for i, doc := range docs {
+		var event domain.Event
+		if err := doc.DataTo(&event); err != nil {
+			t.Fatalf("unmarshal event: %v", err)
+		}
+		types[i] = event.Type
+	}
+	slices.Sort(types)
+	return types
+}
+
+// TestWritesEnqueueEventsEmulator checks that events are enqueued exactly
+// when the write that raises them commits
+func TestWritesEnqueueEventsEmulator(t *testing.T) {
+	r := newEmulatorRepo(t)
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	order := &domain.Order{
+		ID:        fmt.Sprintf("order-%s-%d", t.Name(), now.UnixNano()),
+		SheetID:   "s1",
+		UserID:    "u1",
+		Status:    domain.OrderStatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if _, err := r.Create(ctx, order); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	// A create of an existing order and a rejected update both roll back
+	if _, err := r.Create(ctx, order); !errors.Is(err, ErrOrderExists) {
+		t.Fatalf("second create error = %v, want ErrOrderExists", err)
+	}
+	if _, err := r.Update(ctx, order.ID, func(*domain.Order) error { return errors.New("rejected") }); err == nil {
+		t.Fatal("update error = nil")
+	}
+	if got := outboxEvents(t, r, order.ID); !slices.Equal(got, []domain.EventType{domain.EventOrderCreated}) {
+		t.Fatalf("events = %v, want only the creation", got)
+	}
+
+	if _, err := r.Update(ctx, order.ID, func(o *domain.Order) error {
+		o.Status = domain.OrderStatusCancelled
+		return nil
+	}); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	want := []domain.EventType{domain.EventOrderCancelled, domain.EventOrderCreated}
+	if got := outboxEvents(t, r, order.ID); !slices.Equal(got, want) {
+		t.Fatalf("events = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,146 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"github.com/deni12345/dae-services/services/dae-core/internal/pricing"
+)
+
+// orderChangeSource streams the order_changes records of a sheet. Every
+// order write stores one, so a resumed stream replays each intermediate
+// change in commit order. Cursors are the record's commit time in unix
+// nanoseconds and its ID; records older than changeRetention are gone and
+// cannot be resumed from.
+type orderChangeSource struct {
+	changes *firestore.CollectionRef
+}
+
+func NewOrderChangeSource(client *firestore.Client) port.OrderChangeSource {
+	return &orderChangeSource{
+		changes: client.Collection(ChangesCollection),
+	}
+}
+
+func (s *orderChangeSource) Watch(ctx context.Context, sheetID string, cursor string, fn func(ev *domain.OrderEvent) error) error {
+	ctx, span := tracer.Start(ctx, "OrderChangeSource.Watch")
+	defer span.End()
+
+	var since time.Time
+	var afterID string
+	if cursor != "" {
+		var err error
+		since, afterID, err = decodeEventCursor(cursor)
+		if err != nil {
+			span.RecordError(err)
+			return err
+		}
+		if since.Before(time.Now().Add(-changeRetention)) {
+			err := fmt.Errorf("cursor %q is older than the retained changes: %w", cursor, port.ErrInvalidCursor)
+			span.RecordError(err)
+			return err
+		}
+	}
+
+	q := s.changes.Where("sheet_id", "==", sheetID).
+		OrderBy("occurred_at", firestore.Asc).
+		OrderBy(firestore.DocumentID, firestore.Asc)
+	switch {
+	case afterID != "":
+		q = q.StartAfter(since, afterID)
+	case cursor != "":
+		q = q.StartAfter(since)
+	}
+
+	it := q.Snapshots(ctx)
+	defer it.Stop()
+
+	initial := true
+	for {
+		snap, err := it.Next()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			span.RecordError(err)
+			return fmt.Errorf("watch orders: %w", err)
+		}
+
+		for _, change := range snap.Changes {
+			// Records are never modified, and removals are TTL expiry. Without a
+			// cursor the first snapshot is history and only later records are
+			// live.
+			if change.Kind != firestore.DocumentAdded || (initial && cursor == "") {
+				continue
+			}
+
+			ev, err := orderEventFromChange(change.Doc)
+			if err != nil {
+				span.RecordError(err)
+				return err
+			}
+			if err := fn(ev); err != nil {
+				return err
+			}
+		}
+		initial = false
+	}
+}
+
+func (s *orderChangeSource) LatestCursor(ctx context.Context, sheetID string) (string, error) {
+	ctx, span := tracer.Start(ctx, "OrderChangeSource.LatestCursor")
+	defer span.End()
+
+	docs, err := s.changes.Where("sheet_id", "==", sheetID).
+		OrderBy("occurred_at", firestore.Desc).
+		OrderBy(firestore.DocumentID, firestore.Desc).
+		Limit(1).Select("occurred_at").Documents(ctx).GetAll()
+	if err != nil {
+		span.RecordError(err)
+		return "", fmt.Errorf("get latest order change: %w", err)
+	}
+	if len(docs) == 0 {
+		return "", nil
+	}
+
+	at, _ := docs[0].Data()["occurred_at"].(time.Time)
+	return encodeEventCursor(at, docs[0].Ref.ID), nil
+}
+
+func orderEventFromChange(doc *firestore.DocumentSnapshot) (*domain.OrderEvent, error) {
+	var change orderChange
+	if err := doc.DataTo(&change); err != nil {
+		return nil, fmt.Errorf("unmarshal order change: %w", err)
+	}
+	order := change.Order
+	order.ID = change.OrderID
+	pricing.Upgrade(&order)
+
+	return &domain.OrderEvent{
+		Type:       change.Type,
+		Order:      &order,
+		OccurredAt: change.OccurredAt,
+		Cursor:     encodeEventCursor(change.OccurredAt, doc.Ref.ID),
+	}, nil
+}
+
+func encodeEventCursor(t time.Time, changeID string) string {
+	return strconv.FormatInt(t.UnixNano(), 10) + "." + changeID
+}
+
+// decodeEventCursor also accepts a bare time, the cursor format before
+// change records, and resumes after everything committed at it
+func decodeEventCursor(cursor string) (time.Time, string, error) {
+	nanosText, changeID, _ := strings.Cut(cursor, ".")
+	nanos, err := strconv.ParseInt(nanosText, 10, 64)
+	if err != nil {
+		return time.Time{}, "", fmt.Errorf("decode cursor %q: %w", cursor, port.ErrInvalidCursor)
+	}
+	return time.Unix(0, nanos).UTC(), changeID, nil
+}
@@ -0,0 +1,112 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+)
+
+func TestEventCursor(t *testing.T) {
+	at := time.Unix(1700000000, 123456000).UTC()
+
+	since, changeID, err := decodeEventCursor(encodeEventCursor(at, "abc"))
+	if err != nil || !since.Equal(at) || changeID != "abc" {
+		t.Fatalf("round trip = %v, %q, %v", since, changeID, err)
+	}
+
+	// Cursors handed out before change records carry only the time
+	since, changeID, err = decodeEventCursor("1700000000123456000")
+	if err != nil || !since.Equal(at) || changeID != "" {
+		t.Fatalf("bare time = %v, %q, %v", since, changeID, err)
+	}
+
+	if _, _, err := decodeEventCursor("yesterday"); !errors.Is(err, port.ErrInvalidCursor) {
+		t.Fatalf("malformed cursor: err = %v, want ErrInvalidCursor", err)
+	}
+}
+
+func TestWatchReplaysEveryChangeEmulator(t *testing.T) {
+	r := newEmulatorRepo(t)
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	order := &domain.Order{
+		ID:        fmt.Sprintf("order-%s-%d", t.Name(), now.UnixNano()),
+		SheetID:   fmt.Sprintf("sheet-%s-%d", t.Name(), now.UnixNano()),
+		UserID:    "u1",
+		Status:    domain.OrderStatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if _, err := r.Create(ctx, order); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	for _, note := range []string{"first", "second"} {
+		if _, err := r.Update(ctx, order.ID, func(o *domain.Order) error {
+			o.Note = note
+			return nil
+		}); err != nil {
+			t.Fatalf("update %s: %v", note, err)
+		}
+	}
+	if _, err := r.Update(ctx, order.ID, func(o *domain.Order) error {
+		o.Status = domain.OrderStatusCancelled
+		return nil
+	}); err != nil {
+		t.Fatalf("cancel: %v", err)
+	}
+
+	// Resume from the creation, as a client that saw only that event would
+	created, err := r.changes.Where("order_id", "==", order.ID).Where("type", "==", domain.OrderEventCreated).Documents(ctx).GetAll()
+	if err != nil || len(created) != 1 {
+		t.Fatalf("created record = %d, %v", len(created), err)
+	}
+	first, err := orderEventFromChange(created[0])
+	if err != nil {
+		t.Fatalf("created event: %v", err)
+	}
+
+	watchCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	errDone := errors.New("done")
+	var got []*domain.OrderEvent
+	err = NewOrderChangeSource(r.client).Watch(watchCtx, order.SheetID, first.Cursor, func(ev *domain.OrderEvent) error {
+		got = append(got, ev)
+		if len(got) == 3 {
+			return errDone
+		}
+		return nil
+	})
+	if !errors.Is(err, errDone) {
+		t.Fatalf("watch = %v after %d events", err, len(got))
+	}
+
+	want := []struct {
+		typ  domain.OrderEventType
+		note string
+	}{
+		{domain.OrderEventUpdated, "first"},
+		{domain.OrderEventUpdated, "second"},
+		{domain.OrderEventCancelled, "second"},
+	}
+	for i, w := range want {
+		if got[i].Type != w.typ || got[i].Order.Note != w.note || got[i].Order.ID != order.ID {
+			t.Fatalf("event %d = %s %+v, want %s with note %q", i, got[i].Type, got[i].Order, w.typ, w.note)
+		}
+	}
+}
+
+// Records the TTL policy may already have removed are not resumed from
+func TestWatchRejectsExpiredCursor(t *testing.T) {
+	s := &orderChangeSource{}
+	old := encodeEventCursor(time.Now().Add(-changeRetention-time.Hour), "abc")
+	err := s.Watch(context.Background(), "s1", old, func(*domain.OrderEvent) error { return nil })
+	if !errors.Is(err, port.ErrInvalidCursor) {
+		t.Fatalf("err = %v, want ErrInvalidCursor", err)
+	}
+}
@@ -0,0 +1,187 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"go.opentelemetry.io/otel"
+	"google.golang.org/api/iterator"
+)
+
+const collection = "outbox"
+
+// DeadLetterCollection holds the events the relay gave up on
+const DeadLetterCollection = "outbox_dead_letters"
+
+// maxErrorLen bounds the publish error kept on an event
+const maxErrorLen = 500
+
+var tracer = otel.Tracer("firestore/outbox")
+
+// Enqueue adds events to the outbox as part of tx, setting their IDs. Call it
+// from the transaction that writes the change the events describe.
+func Enqueue(tx *firestore.Transaction, client *firestore.Client, events ...*domain.Event) error {
+	for _, event := range events {
+		ref := client.Collection(collection).NewDoc()
+		if err := tx.Create(ref, event); err != nil {
+			return fmt.Errorf("enqueue %s event: %w", event.Type, err)
+		}
+		event.ID = ref.ID
+	}
+	return nil
+}
+
+type outboxRepo struct {
+	client      *firestore.Client
+	collection  *firestore.CollectionRef
+	deadLetters *firestore.CollectionRef
+}
+
+func NewOutbox(client *firestore.Client) port.Outbox {
+	return &outboxRepo{
+		client:      client,
+		collection:  client.Collection(collection),
+		deadLetters: client.Collection(DeadLetterCollection),
+	}
+}
+
+func (r *outboxRepo) Pending(ctx context.Context, limit int) ([]*domain.Event, error) {
+	ctx, span := tracer.Start(ctx, "Outbox.Pending")
+	defer span.End()
+
+	iter := r.collection.
+		Where("published", "==", false).
+		OrderBy("occurred_at", firestore.Asc).
+		Limit(limit).
+		Documents(ctx)
+	defer iter.Stop()
+
+	var events []*domain.Event
+	for {
+		doc, err := iter.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			span.RecordError(err)
+			return nil, fmt.Errorf("list pending events: %w", err)
+		}
+
+		var event domain.Event
+		if err := doc.DataTo(&event); err != nil {
+			span.RecordError(err)
+			return nil, fmt.Errorf("unmarshal event: %w", err)
+		}
+		event.ID = doc.Ref.ID
+		events = append(events, &event)
+	}
+	return events, nil
+}
+
+func (r *outboxRepo) MarkPublished(ctx context.Context, id string) error {
+	ctx, span := tracer.Start(ctx, "Outbox.MarkPublished")
+	defer span.End()
+
+	_, err := r.collection.Doc(id).Update(ctx, []firestore.Update{
+		{Path: "published", Value: true},
+		{Path: "published_at", Value: time.Now().UTC()},
+		{Path: "attempts", Value: firestore.Increment(1)},
+	})
+	if err != nil {
+		span.RecordError(err)
+		return fmt.Errorf("mark event %s published: %w", id, err)
+	}
+	return nil
+}
+
+func (r *outboxRepo) MarkFailed(ctx context.Context, id string, cause error) error {
+	ctx, span := tracer.Start(ctx, "Outbox.MarkFailed")
+	defer span.End()
+
+	_, err := r.collection.Doc(id).Update(ctx, []firestore.Update{
+		{Path: "attempts", Value: firestore.Increment(1)},
+		{Path: "last_error", Value: errorText(cause)},
+	})
+	if err != nil {
+		span.RecordError(err)
+		return fmt.Errorf("mark event %s failed: %w", id, err)
+	}
+	return nil
+}
+
+func (r *outboxRepo) DeadLetter(ctx context.Context, id string, cause error) error {
+	ctx, span := tracer.Start(ctx, "Outbox.DeadLetter")
+	defer span.End()
+
+	ref := r.collection.Doc(id)
+	err := r.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		snap, err := tx.Get(ref)
+		if err != nil {
+			return fmt.Errorf("get event: %w", err)
+		}
+		var event domain.Event
+		if err := snap.DataTo(&event); err != nil {
+			return fmt.Errorf("unmarshal event: %w", err)
+		}
+		event.Attempts++
+		event.LastError = errorText(cause)
+
+		if err := tx.Set(r.deadLetters.Doc(id), &event); err != nil {
+			return fmt.Errorf("write dead letter: %w", err)
+		}
+		return tx.Delete(ref)
+	})
+	if err != nil {
+		span.RecordError(err)
+		return fmt.Errorf("dead-letter event %s: %w", id, err)
+	}
+	return nil
+}
+
+// DeletePublished deletes in one transaction, so limit must stay within its
+// 500 writes
+func (r *outboxRepo) DeletePublished(ctx context.Context, before time.Time, limit int) (int, error) {
+	ctx, span := tracer.Start(ctx, "Outbox.DeletePublished")
+	defer span.End()
+
+	docs, err := r.collection.
+		Where("published", "==", true).
+		Where("published_at", "<", before).
+		Limit(limit).
+		Documents(ctx).GetAll()
+	if err != nil {
+		span.RecordError(err)
+		return 0, fmt.Errorf("list published events: %w", err)
+	}
+	if len(docs) == 0 {
+		return 0, nil
+	}
+
+	err = r.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		for _, doc := range docs {
+			if err := tx.Delete(doc.Ref); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return 0, fmt.Errorf("delete published events: %w", err)
+	}
+	return len(docs), nil
+}
+
+// errorText is cause's message, bounded to what is kept on an event
+func errorText(cause error) string {
+	msg := cause.Error()
+	if len(msg) > maxErrorLen {
+		msg = msg[:maxErrorLen]
+	}
+	return msg
+}
@@ -0,0 +1,53 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+)
+
+func (r *paymentRepo) ListBySheet(ctx context.Context, sheetID string) ([]*domain.Payment, error) {
+	ctx, span := tracer.Start(ctx, "PaymentRepo.ListBySheet")
+	defer span.End()
+
+	payments, err := r.list(r.collection.Where("sheet_id", "==", sheetID).Documents(ctx))
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("list payments by sheet: %w", err)
+	}
+	return payments, nil
+}
+
+func (r *paymentRepo) ListByUser(ctx context.Context, userID string) ([]*domain.Payment, error) {
+	ctx, span := tracer.Start(ctx, "PaymentRepo.ListByUser")
+	defer span.End()
+
+	payments, err := r.list(r.collection.Where("user_id", "==", userID).Documents(ctx))
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("list payments by user: %w", err)
+	}
+	return payments, nil
+}
+
+// list reads every payment record iter yields, legacy records without a
+// sequence included
+func (r *paymentRepo) list(iter *firestore.DocumentIterator) ([]*domain.Payment, error) {
+	docs, err := iter.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	payments := make([]*domain.Payment, 0, len(docs))
+	for _, doc := range docs {
+		var payment domain.Payment
+		if err := doc.DataTo(&payment); err != nil {
+			return nil, fmt.Errorf("unmarshal payment: %w", err)
+		}
+		payment.ID = doc.Ref.ID
+		payments = append(payments, &payment)
+	}
+	return payments, nil
+}
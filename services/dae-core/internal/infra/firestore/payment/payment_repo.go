@@ -0,0 +1,22 @@
+package payment
+
+import (
+	"cloud.google.com/go/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("firestore/payment")
+
+type paymentRepo struct {
+	client     *firestore.Client
+	collection *firestore.CollectionRef
+}
+
+// NewPaymentRepo creates a new Firestore-backed payment repository
+func NewPaymentRepo(client *firestore.Client) port.PaymentsRepo {
+	return &paymentRepo{
+		client:     client,
+		collection: client.Collection("payments"),
+	}
+}
@@ -0,0 +1,65 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/audit"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+)
+
+// Save appends payment as the member's newest record on the sheet. The
+// previous records are read in the same transaction, so two concurrent saves
+// cannot both take the next sequence.
+func (r *paymentRepo) Save(ctx context.Context, payment *domain.Payment) (*domain.Payment, error) {
+	ctx, span := tracer.Start(ctx, "PaymentRepo.Save")
+	defer span.End()
+
+	if payment.SheetID == "" || payment.UserID == "" {
+		err := fmt.Errorf("payment sheet ID and user ID are required")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	q := r.collection.Where("sheet_id", "==", payment.SheetID).Where("user_id", "==", payment.UserID)
+	var out domain.Payment
+
+	err := r.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		previous, err := r.list(tx.Documents(q))
+		if err != nil {
+			return fmt.Errorf("list payments: %w", err)
+		}
+
+		out = *payment
+		now := time.Now().UTC()
+		out.CreatedAt = now
+		out.UpdatedAt = now
+
+		// Diff against the record this one supersedes
+		changes := audit.Diff(nil, &out)
+		if cur := domain.LatestPayments(previous)[out.SheetID][out.UserID]; cur != nil {
+			out.Sequence = cur.Sequence
+			changes = audit.Changes(*cur, out, paymentFields)
+		}
+		out.Sequence++
+		out.ID = domain.PaymentID(out.SheetID, out.UserID, out.Sequence)
+
+		docRef := r.collection.Doc(out.ID)
+		if err := tx.Create(docRef, &out); err != nil {
+			return err
+		}
+		return audit.Write(ctx, tx, r.client, domain.AuditResourcePayment, docRef.ID, changes, out.UserID)
+	})
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return &out, nil
+}
+
+// paymentFields are the fields SetPaymentStatus may change
+var paymentFields = []firestore.Update{{Path: "status"}, {Path: "amount_paid"}, {Path: "note"}}
@@ -0,0 +1,257 @@
+package sheet
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/audit"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/infra/firestore/outbox"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type inviteRepo struct {
+	client     *firestore.Client
+	collection *firestore.CollectionRef
+	sheets     *firestore.CollectionRef
+}
+
+// NewSheetInviteRepo creates a Firestore-backed sheet invite repository.
+// Invites live in a top-level collection so codes can be looked up without
+// knowing the sheet.
+func NewSheetInviteRepo(client *firestore.Client) port.SheetInviteRepo {
+	return &inviteRepo{
+		client:     client,
+		collection: client.Collection("sheet_invites"),
+		sheets:     client.Collection("sheets"),
+	}
+}
+
+func decodeInvite(snap *firestore.DocumentSnapshot) (*domain.SheetInvite, error) {
+	var invite domain.SheetInvite
+	if err := snap.DataTo(&invite); err != nil {
+		return nil, fmt.Errorf("unmarshal sheet invite: %w", err)
+	}
+	invite.Code = snap.Ref.ID
+	return &invite, nil
+}
+
+func inviteNotFound(err error, code string) error {
+	if status.Code(err) == codes.NotFound {
+		return fmt.Errorf("invite %s: %w", code, port.ErrSheetInviteNotFound)
+	}
+	return fmt.Errorf("invite %s: %w", code, err)
+}
+
+// inviteChanges describes a write to an invite as changes to its sheet. The
+// code is left out: it grants access to the sheet.
+func inviteChanges(before, after *domain.SheetInvite) []domain.AuditChange {
+	return audit.Prefix("invite", audit.Diff(before, after))
+}
+
+func (r *inviteRepo) Create(ctx context.Context, invite *domain.SheetInvite) (*domain.SheetInvite, error) {
+	ctx, span := tracer.Start(ctx, "SheetInviteRepo.Create")
+	defer span.End()
+
+	if invite.Code == "" {
+		err := fmt.Errorf("invite code is required")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	err := r.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		if err := tx.Create(r.collection.Doc(invite.Code), invite); err != nil {
+			return err
+		}
+		return audit.Write(ctx, tx, r.client, domain.AuditResourceSheet, invite.SheetID, inviteChanges(nil, invite), invite.CreatedBy)
+	})
+	if err != nil {
+		span.RecordError(err)
+		if status.Code(err) == codes.AlreadyExists {
+			return nil, port.ErrInviteCodeTaken
+		}
+		return nil, fmt.Errorf("create sheet invite: %w", err)
+	}
+	return invite, nil
+}
+
+func (r *inviteRepo) GetByCode(ctx context.Context, code string) (*domain.SheetInvite, error) {
+	ctx, span := tracer.Start(ctx, "SheetInviteRepo.GetByCode")
+	defer span.End()
+
+	snap, err := r.collection.Doc(code).Get(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, inviteNotFound(err, code)
+	}
+
+	invite, err := decodeInvite(snap)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	return invite, nil
+}
+
+func (r *inviteRepo) Update(ctx context.Context, code string, fn func(*domain.SheetInvite) error) (*domain.SheetInvite, error) {
+	ctx, span := tracer.Start(ctx, "SheetInviteRepo.Update")
+	defer span.End()
+
+	doc := r.collection.Doc(code)
+	var out *domain.SheetInvite
+
+	err := r.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		snap, err := tx.Get(doc)
+		if err != nil {
+			return inviteNotFound(err, code)
+		}
+
+		cur, err := decodeInvite(snap)
+		if err != nil {
+			return err
+		}
+		before := *cur
+		if err := fn(cur); err != nil {
+			return err
+		}
+
+		changes := inviteChanges(&before, cur)
+		if len(changes) == 0 {
+			out = cur
+			return nil // no-op
+		}
+
+		cur.UpdatedAt = time.Now().UTC()
+		if err := tx.Set(doc, cur); err != nil {
+			return fmt.Errorf("update sheet invite: %w", err)
+		}
+		if err := audit.Write(ctx, tx, r.client, domain.AuditResourceSheet, cur.SheetID, changes, cur.CreatedBy); err != nil {
+			return err
+		}
+
+		out = cur
+		return nil
+	})
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (r *inviteRepo) Redeem(ctx context.Context, code, userID string, check func(*domain.SheetInvite, *domain.Sheet) error) (*domain.SheetMember, bool, error) {
+	ctx, span := tracer.Start(ctx, "SheetInviteRepo.Redeem")
+	defer span.End()
+
+	inviteRef := r.collection.Doc(code)
+	var out *domain.SheetMember
+	var joined bool
+
+	err := r.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		joined = false
+
+		inviteSnap, err := tx.Get(inviteRef)
+		if err != nil {
+			return inviteNotFound(err, code)
+		}
+		invite, err := decodeInvite(inviteSnap)
+		if err != nil {
+			return err
+		}
+
+		sheetRef := r.sheets.Doc(invite.SheetID)
+		sheetSnap, err := tx.Get(sheetRef)
+		if err != nil {
+			return mapFirestoreError(err, "get sheet")
+		}
+		var sheet domain.Sheet
+		if err := sheetSnap.DataTo(&sheet); err != nil {
+			return fmt.Errorf("unmarshal sheet: %w", err)
+		}
+		sheet.ID = sheetSnap.Ref.ID
+
+		memberRef := sheetRef.Collection("members").Doc(userID)
+		memberSnap, err := tx.Get(memberRef)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return fmt.Errorf("get member: %w", err)
+		}
+		hasDoc := err == nil
+
+		listed := false
+		for _, id := range sheet.MemberIDs {
+			if id == userID {
+				listed = true
+				break
+			}
+		}
+
+		if hasDoc && listed {
+			var member domain.SheetMember
+			if err := memberSnap.DataTo(&member); err != nil {
+				return fmt.Errorf("unmarshal member: %w", err)
+			}
+			member.SheetID = sheet.ID
+			member.UserID = userID
+			out = &member
+			return nil
+		}
+
+		if err := check(invite, &sheet); err != nil {
+			return err
+		}
+
+		changes := []domain.AuditChange{audit.Change("invite.uses", invite.Uses, invite.Uses+1)}
+		if !listed {
+			members := append(append([]string(nil), sheet.MemberIDs...), userID)
+			changes = append(changes, audit.Change("member_ids", sheet.MemberIDs, members))
+		}
+		if err := audit.Write(ctx, tx, r.client, domain.AuditResourceSheet, sheet.ID, changes, userID); err != nil {
+			return err
+		}
+
+		now := time.Now().UTC()
+		if err := tx.Update(inviteRef, []firestore.Update{
+			{Path: "uses", Value: firestore.Increment(1)},
+			{Path: "updated_at", Value: now},
+		}); err != nil {
+			return fmt.Errorf("count invite use: %w", err)
+		}
+
+		if !listed {
+			if err := tx.Update(sheetRef, []firestore.Update{
+				{Path: "member_ids", Value: firestore.ArrayUnion(userID)},
+				{Path: "updated_at", Value: now},
+			}); err != nil {
+				return fmt.Errorf("update sheet members: %w", err)
+			}
+		}
+
+		role := invite.Role
+		if sheet.HostUserID == userID {
+			role = MemberRoleHost
+		}
+		out = &domain.SheetMember{SheetID: sheet.ID, UserID: userID, Role: role, JoinedAt: now}
+		if err := tx.Set(memberRef, memberData(userID, role, now)); err != nil {
+			return err
+		}
+
+		event, err := domain.NewMemberEvent(domain.EventMemberJoined, out)
+		if err != nil {
+			return err
+		}
+		joined = true
+		return outbox.Enqueue(tx, r.client, event)
+	})
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, false, err
+	}
+	return out, joined, nil
+}
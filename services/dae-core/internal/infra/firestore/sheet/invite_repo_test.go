@@ -0,0 +1,99 @@
+package sheet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+)
+
+var errUsedUp = errors.New("used up")
+
+// TestRedeemCountsUsesEmulator redeems an invite from more users at once
+// than it has uses and checks that exactly max uses joined
+func TestRedeemCountsUsesEmulator(t *testing.T) {
+	r := newEmulatorRepo(t)
+	invites := NewSheetInviteRepo(r.client)
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+	sheet := &domain.Sheet{
+		ID:         fmt.Sprintf("sheet-%d", now.UnixNano()),
+		Name:       "Lunch",
+		HostUserID: "host",
+		Status:     domain.Status_OPEN,
+		MemberIDs:  []string{"host"},
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if _, err := r.Create(ctx, sheet, "", nil); err != nil {
+		t.Fatalf("create sheet: %v", err)
+	}
+	code, err := domain.NewInviteCode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	invite := &domain.SheetInvite{Code: code, SheetID: sheet.ID, CreatedBy: "host", Role: MemberRoleMember, MaxUses: 3, CreatedAt: now, UpdatedAt: now}
+	if _, err := invites.Create(ctx, invite); err != nil {
+		t.Fatalf("create invite: %v", err)
+	}
+
+	check := func(invite *domain.SheetInvite, _ *domain.Sheet) error {
+		if invite.UsedUp() {
+			return errUsedUp
+		}
+		return nil
+	}
+
+	const users = 6
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	joined, refused := 0, 0
+	for i := 0; i < users; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, ok, err := invites.Redeem(ctx, code, fmt.Sprintf("u%d", i), check)
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case errors.Is(err, errUsedUp):
+				refused++
+			case err != nil:
+				t.Errorf("redeem as u%d: %v", i, err)
+			case ok:
+				joined++
+			}
+		}()
+	}
+	wg.Wait()
+
+	if joined != 3 || refused != users-3 {
+		t.Fatalf("joined %d and refused %d, want 3 and %d", joined, refused, users-3)
+	}
+	stored, err := invites.GetByCode(ctx, code)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Uses != 3 {
+		t.Fatalf("uses = %d, want 3", stored.Uses)
+	}
+	ids, err := r.ListMemberIDs(ctx, sheet.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 4 {
+		t.Fatalf("members = %v, want the host and 3 invitees", ids)
+	}
+
+	// A member redeeming again gets their membership without a use
+	member := ids[1]
+	got, ok, err := invites.Redeem(ctx, code, member, check)
+	if err != nil || ok || got.UserID != member || got.Role != MemberRoleMember {
+		t.Fatalf("Redeem again = %+v, %v, %v; want the existing membership", got, ok, err)
+	}
+}
@@ -4,11 +4,17 @@ import (
 	"context"
 	"fmt"
 
+	"cloud.google.com/go/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/audit"
 	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/infra/firestore/outbox"
 )
 
-// Create creates a new sheet in Firestore
-func (r *sheetRepo) Create(ctx context.Context, sheet *domain.Sheet) (*domain.Sheet, error) {
+// Create writes a new sheet, a member document and joined event for every ID
+// in sheet.MemberIDs and, when menuItems is not empty, the first menu version
+// menuID, all in one transaction. A failure leaves nothing behind, so a
+// retried create starts from scratch.
+func (r *sheetRepo) Create(ctx context.Context, sheet *domain.Sheet, menuID string, menuItems []*domain.MenuItem) (*domain.Sheet, error) {
 	ctx, span := tracer.Start(ctx, "SheetRepo.Create")
 	defer span.End()
 
@@ -17,9 +23,64 @@ func (r *sheetRepo) Create(ctx context.Context, sheet *domain.Sheet) (*domain.Sh
 		span.RecordError(err)
 		return nil, err
 	}
+	if len(menuItems) > 0 {
+		if menuID == "" {
+			err := fmt.Errorf("menu ID is required")
+			span.RecordError(err)
+			return nil, err
+		}
+		for _, item := range menuItems {
+			if item.ID == "" {
+				err := fmt.Errorf("menu item ID is required")
+				span.RecordError(err)
+				return nil, err
+			}
+		}
+		sheet.ActiveMenuID = menuID
+	}
+
+	sheetRef := r.collection.Doc(sheet.ID)
+	err := r.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		if err := tx.Create(sheetRef, sheet); err != nil {
+			return err
+		}
+
+		// Events are built per attempt so a retried transaction enqueues
+		// each of them once
+		event, err := domain.NewSheetEvent(domain.EventSheetCreated, sheet)
+		if err != nil {
+			return err
+		}
+		events := []*domain.Event{event}
+
+		for _, userID := range sheet.MemberIDs {
+			role := MemberRoleMember
+			if userID == sheet.HostUserID {
+				role = MemberRoleHost
+			}
+			memberRef := sheetRef.Collection("members").Doc(userID)
+			if err := tx.Create(memberRef, memberData(userID, role, sheet.CreatedAt)); err != nil {
+				return fmt.Errorf("create member %s: %w", userID, err)
+			}
+
+			joined, err := domain.NewMemberEvent(domain.EventMemberJoined, &domain.SheetMember{SheetID: sheet.ID, UserID: userID, Role: role, JoinedAt: sheet.CreatedAt})
+			if err != nil {
+				return err
+			}
+			events = append(events, joined)
+		}
+
+		if len(menuItems) > 0 {
+			if err := writeMenu(tx, sheetRef, menuID, 1, menuItems, sheet.CreatedAt); err != nil {
+				return err
+			}
+		}
 
-	docRef := r.collection.Doc(sheet.ID)
-	_, err := docRef.Create(ctx, sheet)
+		if err := audit.Write(ctx, tx, r.client, domain.AuditResourceSheet, sheet.ID, audit.Diff(nil, sheet), append([]string{sheet.HostUserID}, sheet.MemberIDs...)...); err != nil {
+			return err
+		}
+		return outbox.Enqueue(tx, r.client, events...)
+	})
 	if err != nil {
 		span.RecordError(err)
 		return nil, mapFirestoreError(err, "create sheet")
@@ -0,0 +1,150 @@
+package sheet
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newEmulatorRepo connects to the Firestore emulator, e.g. the one started by
+// docker-compose, and skips the test when none is configured
+func newEmulatorRepo(t *testing.T) *sheetRepo {
+	t.Helper()
+	if os.Getenv("FIRESTORE_EMULATOR_HOST") == "" {
+		t.Skip("FIRESTORE_EMULATOR_HOST not set")
+	}
+
+	client, err := firestore.NewClient(context.Background(), "demo-dae-core")
+	if err != nil {
+		t.Fatalf("firestore client: %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+	return NewSheetRepo(client, 20).(*sheetRepo)
+}
+
+// TestCreateLeavesNoPartialSheetEmulator makes a late write of Create fail
+// and checks that none of the earlier writes survive
+func TestCreateLeavesNoPartialSheetEmulator(t *testing.T) {
+	r := newEmulatorRepo(t)
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		// fault seeds a document that makes one of Create's writes fail
+		fault func(sheetRef *firestore.DocumentRef) error
+		items []*domain.MenuItem
+	}{
+		"last member exists": {
+			fault: func(sheetRef *firestore.DocumentRef) error {
+				_, err := sheetRef.Collection("members").Doc("u3").Set(ctx, memberData("u3", MemberRoleMember, time.Now()))
+				return err
+			},
+		},
+		"menu version exists": {
+			fault: func(sheetRef *firestore.DocumentRef) error {
+				_, err := sheetRef.Collection("menus").Doc("menu-1").Set(ctx, &domain.MenuVersion{Version: 1})
+				return err
+			},
+		},
+		"menu item without ID": {
+			items: []*domain.MenuItem{{ID: "pho", Name: "Pho"}, {Name: "Bun"}},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			now := time.Now().UTC()
+			sheet := &domain.Sheet{
+				ID:         fmt.Sprintf("sheet-%d", now.UnixNano()),
+				Name:       "Lunch",
+				HostUserID: "u1",
+				Status:     domain.Status_OPEN,
+				MemberIDs:  []string{"u1", "u2", "u3"},
+				CreatedAt:  now,
+				UpdatedAt:  now,
+			}
+			sheetRef := r.collection.Doc(sheet.ID)
+
+			seeded := 0
+			if tc.fault != nil {
+				if err := tc.fault(sheetRef); err != nil {
+					t.Fatalf("inject fault: %v", err)
+				}
+				seeded = 1
+			}
+			items := tc.items
+			if items == nil {
+				items = []*domain.MenuItem{{ID: "pho", Name: "Pho", Price: 45000, Currency: "VND"}}
+			}
+
+			if _, err := r.Create(ctx, sheet, "menu-1", items); err == nil {
+				t.Fatal("Create succeeded, want the injected failure")
+			}
+
+			if _, err := sheetRef.Get(ctx); status.Code(err) != codes.NotFound {
+				t.Fatalf("sheet doc: err = %v, want NotFound", err)
+			}
+			written := 0
+			for _, sub := range []string{"members", "menu", "menus"} {
+				docs, err := sheetRef.Collection(sub).Documents(ctx).GetAll()
+				if err != nil {
+					t.Fatalf("list %s: %v", sub, err)
+				}
+				written += len(docs)
+			}
+			if written != seeded {
+				t.Fatalf("%d subcollection docs left, want only the %d seeded", written, seeded)
+			}
+			events, err := r.client.Collection("outbox").Where("aggregate_id", "==", sheet.ID).Documents(ctx).GetAll()
+			if err != nil {
+				t.Fatalf("list outbox: %v", err)
+			}
+			if len(events) > 0 {
+				t.Fatalf("%d outbox events left for the failed sheet", len(events))
+			}
+		})
+	}
+}
+
+// TestCreateEnqueuesEventsEmulator checks that a created sheet comes with its
+// created event and a joined event for every initial member
+func TestCreateEnqueuesEventsEmulator(t *testing.T) {
+	r := newEmulatorRepo(t)
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	sheet := &domain.Sheet{
+		ID:         fmt.Sprintf("sheet-%d", now.UnixNano()),
+		Name:       "Lunch",
+		HostUserID: "u1",
+		Status:     domain.Status_OPEN,
+		MemberIDs:  []string{"u1", "u2"},
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if _, err := r.Create(ctx, sheet, "", nil); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	docs, err := r.client.Collection("outbox").Where("aggregate_id", "==", sheet.ID).Documents(ctx).GetAll()
+	if err != nil {
+		t.Fatalf("list outbox: %v", err)
+	}
+	counts := map[domain.EventType]int{}
+	for _, doc := range docs {
+		var event domain.Event
+		if err := doc.DataTo(&event); err != nil {
+			t.Fatalf("unmarshal event: %v", err)
+		}
+		counts[event.Type]++
+	}
+	if counts[domain.EventSheetCreated] != 1 || counts[domain.EventMemberJoined] != 2 || len(docs) != 3 {
+		t.Fatalf("events = %v, want one created and two joined", counts)
+	}
+}
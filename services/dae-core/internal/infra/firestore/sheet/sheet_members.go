@@ -6,25 +6,32 @@ import (
 	"time"
 
 	"cloud.google.com/go/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/audit"
 	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/infra/firestore/outbox"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
 	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
-	MemberRoleHost   = "host"
-	MemberRoleMember = "member"
+	MemberRoleHost   = domain.MemberRoleHost
+	MemberRoleMember = domain.MemberRoleMember
 )
 
-// AddMember adds a member (denormalized + subcollection)
-func (r *sheetRepo) AddMember(ctx context.Context, sheetID, userID string) error {
+// AddMember adds a member (denormalized + subcollection). Members already in
+// member_ids but missing from the subcollection are repaired.
+func (r *sheetRepo) AddMember(ctx context.Context, sheetID, userID string) (*domain.SheetMember, error) {
 	ctx, span := tracer.Start(ctx, "SheetRepo.AddMember")
 	defer span.End()
 
+	var out *domain.SheetMember
 	err := r.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
 		sheetRef := r.collection.Doc(sheetID)
 		snap, err := tx.Get(sheetRef)
 		if err != nil {
-			return fmt.Errorf("get sheet: %w", err)
+			return mapFirestoreError(err, "get sheet")
 		}
 
 		var sheet domain.Sheet
@@ -32,38 +39,84 @@ func (r *sheetRepo) AddMember(ctx context.Context, sheetID, userID string) error
 			return fmt.Errorf("unmarshal sheet: %w", err)
 		}
 
+		memberRef := sheetRef.Collection("members").Doc(userID)
+		memberSnap, err := tx.Get(memberRef)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return fmt.Errorf("get member: %w", err)
+		}
+		hasDoc := err == nil
+
+		listed := false
 		for _, id := range sheet.MemberIDs {
 			if id == userID {
+				listed = true
+				break
+			}
+		}
+
+		if hasDoc {
+			var member domain.SheetMember
+			if err := memberSnap.DataTo(&member); err != nil {
+				return fmt.Errorf("unmarshal member: %w", err)
+			}
+			member.SheetID = sheetID
+			member.UserID = userID
+			out = &member
+			if listed {
 				return nil
 			}
 		}
 
-		sheet.MemberIDs = append(sheet.MemberIDs, userID)
 		now := time.Now().UTC()
-
-		updates := []firestore.Update{
-			{Path: "member_ids", Value: sheet.MemberIDs},
-			{Path: "updated_at", Value: now},
+		if !listed {
+			members := append(append([]string(nil), sheet.MemberIDs...), userID)
+			change := audit.Change("member_ids", sheet.MemberIDs, members)
+			if err := audit.Write(ctx, tx, r.client, domain.AuditResourceSheet, sheetID, []domain.AuditChange{change}, userID); err != nil {
+				return err
+			}
+			sheet.MemberIDs = members
+			updates := []firestore.Update{
+				{Path: "member_ids", Value: sheet.MemberIDs},
+				{Path: "updated_at", Value: now},
+			}
+			if err := tx.Update(sheetRef, updates); err != nil {
+				return fmt.Errorf("update sheet members: %w", err)
+			}
 		}
 
-		if err := tx.Update(sheetRef, updates); err != nil {
-			return fmt.Errorf("update sheet members: %w", err)
+		if hasDoc {
+			return nil
 		}
 
-		memberRef := sheetRef.Collection("members").Doc(userID)
-		memberData := map[string]interface{}{
-			"user_id":   userID,
-			"role":      MemberRoleMember,
-			"joined_at": now,
+		role := MemberRoleMember
+		if sheet.HostUserID == userID {
+			role = MemberRoleHost
 		}
-
-		return tx.Set(memberRef, memberData)
+		out = &domain.SheetMember{SheetID: sheetID, UserID: userID, Role: role, JoinedAt: now}
+		if err := tx.Set(memberRef, memberData(userID, role, now)); err != nil {
+			return err
+		}
+		event, err := domain.NewMemberEvent(domain.EventMemberJoined, out)
+		if err != nil {
+			return err
+		}
+		return outbox.Enqueue(tx, r.client, event)
 	})
 
 	if err != nil {
 		span.RecordError(err)
+		return nil, err
+	}
+	return out, nil
+}
+
+// memberData is the members subcollection document of userID
+func memberData(userID, role string, joinedAt time.Time) map[string]interface{} {
+	return map[string]interface{}{
+		"user_id":   userID,
+		"role":      role,
+		"joined_at": joinedAt,
 	}
-	return err
 }
 
 // RemoveMember removes a user from a sheet's member list
@@ -107,10 +160,22 @@ func (r *sheetRepo) RemoveMember(ctx context.Context, sheetID, userID string) er
 		if err := tx.Update(sheetRef, updates); err != nil {
 			return fmt.Errorf("update sheet members: %w", err)
 		}
+		change := audit.Change("member_ids", sheet.MemberIDs, newMembers)
+		if err := audit.Write(ctx, tx, r.client, domain.AuditResourceSheet, sheetID, []domain.AuditChange{change}, userID); err != nil {
+			return err
+		}
 
 		// Remove from subcollection
 		memberRef := sheetRef.Collection("members").Doc(userID)
-		return tx.Delete(memberRef)
+		if err := tx.Delete(memberRef); err != nil {
+			return err
+		}
+
+		event, err := domain.NewMemberEvent(domain.EventMemberRemoved, &domain.SheetMember{SheetID: sheetID, UserID: userID})
+		if err != nil {
+			return err
+		}
+		return outbox.Enqueue(tx, r.client, event)
 	})
 
 	if err != nil {
@@ -144,6 +209,64 @@ func (r *sheetRepo) ListMemberIDs(ctx context.Context, sheetID string) ([]string
 	return sheet.MemberIDs, nil
 }
 
+// ListMembers returns a page of members from the subcollection, ordered by
+// join time
+func (r *sheetRepo) ListMembers(ctx context.Context, query port.ListMembersQuery) (*port.ListMembersResp, error) {
+	ctx, span := tracer.Start(ctx, "SheetRepo.ListMembers")
+	defer span.End()
+
+	limit := int(query.Limit)
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+
+	members := r.collection.Doc(query.SheetID).Collection("members")
+	q := members.
+		OrderBy("joined_at", firestore.Asc).
+		OrderBy(firestore.DocumentID, firestore.Asc).
+		Limit(limit + 1) // fetch one extra to determine if there's more
+
+	if query.Cursor != "" {
+		cursorSnap, err := members.Doc(query.Cursor).Get(ctx)
+		if err != nil {
+			span.RecordError(err)
+			if status.Code(err) == codes.NotFound {
+				return nil, fmt.Errorf("member %s: %w", query.Cursor, ErrInvalidCursor)
+			}
+			return nil, fmt.Errorf("get cursor member: %w", err)
+		}
+		q = q.StartAfter(cursorSnap)
+	}
+
+	docs, err := q.Documents(ctx).GetAll()
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("list members: %w", err)
+	}
+
+	resp := &port.ListMembersResp{Members: make([]*domain.SheetMember, 0, len(docs))}
+	for _, doc := range docs {
+		var member domain.SheetMember
+		if err := doc.DataTo(&member); err != nil {
+			span.RecordError(err)
+			return nil, fmt.Errorf("unmarshal member: %w", err)
+		}
+		member.SheetID = query.SheetID
+		member.UserID = doc.Ref.ID
+		resp.Members = append(resp.Members, &member)
+	}
+
+	if len(resp.Members) > limit {
+		resp.Members = resp.Members[:limit]
+		resp.NextCursor = resp.Members[limit-1].UserID
+	}
+
+	return resp, nil
+}
+
 // syncMemberToSubcollection is a helper to ensure subcollection is in sync
 // Use this during migration or repair operations
 func (r *sheetRepo) syncMemberToSubcollection(ctx context.Context, sheetID, userID string, role string, joinedAt time.Time) error {
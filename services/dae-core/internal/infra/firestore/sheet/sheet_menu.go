@@ -4,14 +4,23 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	"cloud.google.com/go/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/audit"
 	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
 	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (r *sheetRepo) GetMenuItemByID(ctx context.Context, sheetID string, id string) (*domain.MenuItem, error) {
 	doc, err := r.collection.Doc(sheetID).Collection("menu").Doc(id).Get(ctx)
 	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, fmt.Errorf("get menu item %s: %w", id, port.ErrMenuItemNotFound)
+		}
 		return nil, err
 	}
 
@@ -47,40 +56,189 @@ func (r *sheetRepo) GetMenuItems(ctx context.Context, sheetID string) ([]*domain
 	return menuItems, nil
 }
 
-// AttachMenuItems attaches menu items to a sheet's menu subcollection
-// Handles batching automatically for lists larger than 500 items
-func (r *sheetRepo) AttachMenuItems(ctx context.Context, sheetID string, menuItems []*domain.MenuItem) error {
-	if len(menuItems) == 0 {
-		return nil // no-op
-	}
+// ReplaceMenu replaces the sheet's menu subcollection with menuItems, writes
+// the immutable snapshot menus/{menuID} and records it as the active menu,
+// all in one transaction. The menu subcollection is the working copy used
+// for item lookups; snapshots are never modified. If menus/{menuID} already
+// exists the sheet is returned unchanged.
+func (r *sheetRepo) ReplaceMenu(ctx context.Context, sheetID string, menuID string, menuItems []*domain.MenuItem) (*domain.Sheet, error) {
+	ctx, span := tracer.Start(ctx, "SheetRepo.ReplaceMenu")
+	defer span.End()
+
+	sheetRef := r.collection.Doc(sheetID)
+	menuCollection := sheetRef.Collection("menu")
+	versions := sheetRef.Collection("menus")
+	var out *domain.Sheet
+
+	err := r.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		snap, err := tx.Get(sheetRef)
+		if err != nil {
+			return mapFirestoreError(err, "get sheet for menu")
+		}
 
-	menuCollection := r.collection.Doc(sheetID).Collection("menu")
+		var sheet domain.Sheet
+		if err := snap.DataTo(&sheet); err != nil {
+			return fmt.Errorf("unmarshal sheet: %w", err)
+		}
+		if sheet.ID == "" {
+			sheet.ID = snap.Ref.ID
+		}
 
-	// Firestore batch limit is 500 operations
-	const batchSize = 500
+		// A stored menuID is a retry of a replace that already committed
+		if _, err := tx.Get(versions.Doc(menuID)); err == nil {
+			out = &sheet
+			return nil
+		} else if status.Code(err) != codes.NotFound {
+			return fmt.Errorf("get menu version: %w", err)
+		}
 
-	for i := 0; i < len(menuItems); i += batchSize {
-		end := i + batchSize
-		if end > len(menuItems) {
-			end = len(menuItems)
+		version := int32(1)
+		if sheet.ActiveMenuID != "" {
+			activeSnap, err := tx.Get(versions.Doc(sheet.ActiveMenuID))
+			if err != nil && status.Code(err) != codes.NotFound {
+				return fmt.Errorf("get active menu: %w", err)
+			}
+			if err == nil {
+				var active domain.MenuVersion
+				if err := activeSnap.DataTo(&active); err != nil {
+					return fmt.Errorf("unmarshal active menu: %w", err)
+				}
+				version = active.Version + 1
+			}
 		}
 
-		batch := r.client.Batch()
-		chunk := menuItems[i:end]
+		existing, err := tx.Documents(menuCollection).GetAll()
+		if err != nil {
+			return fmt.Errorf("list menu items: %w", err)
+		}
 
-		for _, item := range chunk {
+		keep := make(map[string]bool, len(menuItems))
+		for _, item := range menuItems {
 			if item.ID == "" {
 				return fmt.Errorf("menu item ID is required")
 			}
+			keep[item.ID] = true
+		}
 
-			menuRef := menuCollection.Doc(item.ID)
-			batch.Set(menuRef, item)
+		// All reads are done; delete what the new menu drops, then write it
+		for _, doc := range existing {
+			if keep[doc.Ref.ID] {
+				continue
+			}
+			if err := tx.Delete(doc.Ref); err != nil {
+				return fmt.Errorf("delete menu item %s: %w", doc.Ref.ID, err)
+			}
+		}
+
+		now := time.Now().UTC()
+		if err := writeMenu(tx, sheetRef, menuID, version, menuItems, now); err != nil {
+			return err
+		}
+
+		changes := []domain.AuditChange{
+			audit.Change("active_menu_id", sheet.ActiveMenuID, menuID),
+			audit.Change("menu_item_count", len(existing), len(menuItems)),
+		}
+		if err := audit.Write(ctx, tx, r.client, domain.AuditResourceSheet, sheet.ID, changes); err != nil {
+			return err
 		}
 
-		if _, err := batch.Commit(ctx); err != nil {
-			return fmt.Errorf("attach menu items (batch %d-%d): %w", i, end, err)
+		sheet.ActiveMenuID = menuID
+		sheet.UpdatedAt = now
+		updates := []firestore.Update{
+			{Path: "active_menu_id", Value: menuID},
+			{Path: "updated_at", Value: now},
+		}
+		if err := tx.Update(sheetRef, updates); err != nil {
+			return mapFirestoreError(err, "update active menu")
+		}
+
+		out = &sheet
+		return nil
+	})
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// writeMenu sets the working copy of every item in menuItems and creates the
+// snapshot menus/{menuID}. Items dropped from the menu must be deleted by the
+// caller.
+func writeMenu(tx *firestore.Transaction, sheetRef *firestore.DocumentRef, menuID string, version int32, menuItems []*domain.MenuItem, now time.Time) error {
+	menuCollection := sheetRef.Collection("menu")
+	for _, item := range menuItems {
+		if err := tx.Set(menuCollection.Doc(item.ID), item); err != nil {
+			return fmt.Errorf("set menu item %s: %w", item.ID, err)
 		}
 	}
 
+	snapshot := &domain.MenuVersion{
+		Version:   version,
+		ItemCount: int32(len(menuItems)),
+		Items:     make([]domain.MenuItem, len(menuItems)),
+		CreatedAt: now,
+	}
+	for i, item := range menuItems {
+		snapshot.Items[i] = *item
+	}
+	if err := tx.Create(sheetRef.Collection("menus").Doc(menuID), snapshot); err != nil {
+		return mapFirestoreError(err, "create menu version")
+	}
 	return nil
 }
+
+func (r *sheetRepo) GetMenuVersion(ctx context.Context, sheetID string, menuID string) (*domain.MenuVersion, error) {
+	ctx, span := tracer.Start(ctx, "SheetRepo.GetMenuVersion")
+	defer span.End()
+
+	snap, err := r.collection.Doc(sheetID).Collection("menus").Doc(menuID).Get(ctx)
+	if err != nil {
+		span.RecordError(err)
+		if status.Code(err) == codes.NotFound {
+			return nil, fmt.Errorf("get menu %s: %w", menuID, port.ErrMenuVersionNotFound)
+		}
+		return nil, fmt.Errorf("get menu %s: %w", menuID, err)
+	}
+
+	var version domain.MenuVersion
+	if err := snap.DataTo(&version); err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("unmarshal menu: %w", err)
+	}
+	version.ID = snap.Ref.ID
+	version.SheetID = sheetID
+
+	return &version, nil
+}
+
+func (r *sheetRepo) ListMenuVersions(ctx context.Context, sheetID string) ([]*domain.MenuVersion, error) {
+	ctx, span := tracer.Start(ctx, "SheetRepo.ListMenuVersions")
+	defer span.End()
+
+	docs, err := r.collection.Doc(sheetID).Collection("menus").
+		Select("version", "item_count", "created_at").
+		OrderBy("version", firestore.Desc).
+		Documents(ctx).GetAll()
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("list menu versions: %w", err)
+	}
+
+	versions := make([]*domain.MenuVersion, 0, len(docs))
+	for _, doc := range docs {
+		var version domain.MenuVersion
+		if err := doc.DataTo(&version); err != nil {
+			span.RecordError(err)
+			return nil, fmt.Errorf("unmarshal menu: %w", err)
+		}
+		version.ID = doc.Ref.ID
+		version.SheetID = sheetID
+		versions = append(versions, &version)
+	}
+
+	return versions, nil
+}
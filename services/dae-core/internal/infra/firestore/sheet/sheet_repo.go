@@ -14,6 +14,14 @@ type sheetRepo struct {
 
 var tracer = otel.Tracer("firestore/sheet")
 
+// ordersCollection holds the orders a closing sheet confirms
+const ordersCollection = "orders"
+
+// maxConfirmOnClose keeps the orders confirmed by a close, with their audit
+// events, change records and outbox events, plus the sheet update, its audit
+// event and its outbox event within Firestore's 500 writes per transaction
+const maxConfirmOnClose = 120
+
 // NewSheetRepo creates a new Firestore-backed sheet repository
 func NewSheetRepo(client *firestore.Client, defaultPageSize int32) port.SheetRepo {
 	return &sheetRepo{
@@ -0,0 +1,60 @@
+package sheet
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+)
+
+// ListScheduleDue returns up to limit sheets of each kind whose schedule calls
+// for a transition at now: pending sheets past opens_at, and open or pending
+// sheets past closes_at
+func (r *sheetRepo) ListScheduleDue(ctx context.Context, now time.Time, limit int) ([]*domain.Sheet, error) {
+	ctx, span := tracer.Start(ctx, "SheetRepo.ListScheduleDue")
+	defer span.End()
+
+	queries := []firestore.Query{
+		r.collection.
+			Where("status", "==", domain.Status_PENDING).
+			Where("opens_at", "<=", now).
+			OrderBy("opens_at", firestore.Asc).
+			Limit(limit),
+		r.collection.
+			Where("status", "in", []domain.Status{domain.Status_OPEN, domain.Status_PENDING}).
+			Where("closes_at", "<=", now).
+			OrderBy("closes_at", firestore.Asc).
+			Limit(limit),
+	}
+
+	seen := make(map[string]bool)
+	var sheets []*domain.Sheet
+	for _, q := range queries {
+		docs, err := q.Documents(ctx).GetAll()
+		if err != nil {
+			span.RecordError(err)
+			return nil, fmt.Errorf("list scheduled sheets: %w", err)
+		}
+
+		for _, doc := range docs {
+			if seen[doc.Ref.ID] {
+				continue
+			}
+			seen[doc.Ref.ID] = true
+
+			var sheet domain.Sheet
+			if err := doc.DataTo(&sheet); err != nil {
+				span.RecordError(err)
+				return nil, fmt.Errorf("unmarshal sheet: %w", err)
+			}
+			if sheet.ID == "" {
+				sheet.ID = doc.Ref.ID
+			}
+			sheets = append(sheets, &sheet)
+		}
+	}
+
+	return sheets, nil
+}
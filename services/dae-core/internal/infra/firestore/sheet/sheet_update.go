@@ -3,10 +3,16 @@ package sheet
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"cloud.google.com/go/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/audit"
 	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/infra/firestore/order"
+	"github.com/deni12345/dae-services/services/dae-core/internal/infra/firestore/outbox"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"github.com/deni12345/dae-services/services/dae-core/internal/pricing"
 )
 
 // Update updates a sheet using the callback pattern with optimistic locking
@@ -45,6 +51,15 @@ func (r *sheetRepo) Update(ctx context.Context, id string, fn func(*domain.Sheet
 			return nil // no-op
 		}
 
+		closing := cur.Status == domain.Status_CLOSED && before.Status != domain.Status_CLOSED
+		var pending []*firestore.DocumentSnapshot
+		if closing {
+			// Transactions read before they write
+			if pending, err = r.pendingOrders(tx, id); err != nil {
+				return err
+			}
+		}
+
 		// Add updated_at with actual timestamp
 		now := time.Now().UTC()
 		cur.UpdatedAt = now
@@ -56,6 +71,60 @@ func (r *sheetRepo) Update(ctx context.Context, id string, fn func(*domain.Sheet
 			return mapFirestoreError(err, "update sheet")
 		}
 
+		if err := audit.Write(ctx, tx, r.client, domain.AuditResourceSheet, id, audit.Changes(before, cur, updates), changedUsers(&before, &cur)...); err != nil {
+			return err
+		}
+
+		// Closing confirms every pending order with the sheet, so a sheet is
+		// never closed with orders left pending. Each is recorded and
+		// published as orderRepo.Update would.
+		for _, doc := range pending {
+			if err := tx.Update(doc.Ref, []firestore.Update{
+				{Path: "status", Value: domain.OrderStatusConfirmed},
+				{Path: "updated_at", Value: now},
+			}, firestore.LastUpdateTime(doc.UpdateTime)); err != nil {
+				return mapFirestoreError(err, "confirm order "+doc.Ref.ID)
+			}
+
+			var confirmed domain.Order
+			if err := doc.DataTo(&confirmed); err != nil {
+				return fmt.Errorf("unmarshal order %s: %w", doc.Ref.ID, err)
+			}
+			if confirmed.ID == "" {
+				confirmed.ID = doc.Ref.ID
+			}
+			pricing.Upgrade(&confirmed)
+			change := audit.Change("status", confirmed.Status, domain.OrderStatusConfirmed)
+			confirmed.Status = domain.OrderStatusConfirmed
+			confirmed.UpdatedAt = now
+
+			if err := audit.Write(ctx, tx, r.client, domain.AuditResourceOrder, doc.Ref.ID, []domain.AuditChange{change}, confirmed.UserID); err != nil {
+				return err
+			}
+			if err := order.RecordChange(tx, r.client, domain.OrderEventUpdated, &confirmed); err != nil {
+				return err
+			}
+			orderEvent, err := domain.NewOrderEvent(domain.EventOrderUpdated, &confirmed)
+			if err != nil {
+				return err
+			}
+			if err := outbox.Enqueue(tx, r.client, orderEvent); err != nil {
+				return err
+			}
+		}
+
+		eventType := domain.EventSheetUpdated
+		if closing {
+			eventType = domain.EventSheetClosed
+		}
+		event, err := domain.NewSheetEvent(eventType, &cur)
+		if err != nil {
+			return err
+		}
+		if err := outbox.Enqueue(tx, r.client, event); err != nil {
+			return err
+		}
+
 		out = &cur
 		return nil
 	})
@@ -68,6 +137,28 @@ func (r *sheetRepo) Update(ctx context.Context, id string, fn func(*domain.Sheet
 	return out, nil
 }
 
+// pendingOrders reads the orders of a sheet that are still pending, within
+// tx. Orders stored before statuses existed count as pending.
+func (r *sheetRepo) pendingOrders(tx *firestore.Transaction, sheetID string) ([]*firestore.DocumentSnapshot, error) {
+	docs, err := tx.Documents(r.client.Collection(ordersCollection).Where("sheet_id", "==", sheetID)).GetAll()
+	if err != nil {
+		return nil, mapFirestoreError(err, "list orders of sheet")
+	}
+
+	var pending []*firestore.DocumentSnapshot
+	for _, doc := range docs {
+		status, _ := doc.Data()["status"].(string)
+		if domain.OrderStatus(status) == domain.OrderStatusPending || status == "" {
+			pending = append(pending, doc)
+		}
+	}
+	// Four writes per order plus the sheet and its events must fit a transaction
+	if len(pending) > maxConfirmOnClose {
+		return nil, port.ErrTooManyPendingOrders
+	}
+	return pending, nil
+}
+
 // buildDiff computes the differences between two sheet instances
 func buildDiff(before, after domain.Sheet) []firestore.Update {
 	var updates []firestore.Update
@@ -81,11 +172,55 @@ func buildDiff(before, after domain.Sheet) []firestore.Update {
 	if before.Discount != after.Discount {
 		updates = append(updates, firestore.Update{Path: "discount", Value: after.Discount})
 	}
+	if before.FeeSplit != after.FeeSplit {
+		updates = append(updates, firestore.Update{Path: "fee_split_mode", Value: after.FeeSplit})
+	}
 	if before.Description != after.Description {
 		updates = append(updates, firestore.Update{Path: "description", Value: after.Description})
 	}
+	if !sameTime(before.OpensAt, after.OpensAt) {
+		updates = append(updates, timeUpdate("opens_at", after.OpensAt))
+	}
+	if !sameTime(before.ClosesAt, after.ClosesAt) {
+		updates = append(updates, timeUpdate("closes_at", after.ClosesAt))
+	}
 	// Note: MemberIDs should be updated via AddMember/RemoveMember methods
 	// to keep subcollection in sync, not through Update patch function
 
 	return updates
 }
+
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+// timeUpdate sets path to t, or deletes the field when t is nil
+func timeUpdate(path string, t *time.Time) firestore.Update {
+	if t == nil {
+		return firestore.Update{Path: path, Value: firestore.Delete}
+	}
+	return firestore.Update{Path: path, Value: *t}
+}
+
+// changedUsers returns the users an update adds to or removes from the sheet,
+// as host or member
+func changedUsers(before, after *domain.Sheet) []string {
+	var users []string
+	if before.HostUserID != after.HostUserID {
+		users = append(users, before.HostUserID, after.HostUserID)
+	}
+	for _, id := range before.MemberIDs {
+		if !slices.Contains(after.MemberIDs, id) {
+			users = append(users, id)
+		}
+	}
+	for _, id := range after.MemberIDs {
+		if !slices.Contains(before.MemberIDs, id) {
+			users = append(users, id)
+		}
+	}
+	return users
+}
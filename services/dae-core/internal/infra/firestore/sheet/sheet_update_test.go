@@ -0,0 +1,72 @@
+package sheet
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/infra/firestore/order"
+)
+
+// TestUpdateCloseConfirmsPendingOrdersEmulator checks that closing a sheet
+// confirms its pending orders, legacy ones included, in the same write, and
+// records each confirmation for resumed order streams
+func TestUpdateCloseConfirmsPendingOrdersEmulator(t *testing.T) {
+	r := newEmulatorRepo(t)
+	ctx := context.Background()
+
+	sheetID := fmt.Sprintf("close-%d", time.Now().UnixNano())
+	if _, err := r.collection.Doc(sheetID).Set(ctx, &domain.Sheet{ID: sheetID, HostUserID: "host", Status: domain.Status_OPEN}); err != nil {
+		t.Fatalf("seed sheet: %v", err)
+	}
+
+	orders := r.client.Collection(ordersCollection)
+	seed := map[string]string{
+		"pending":   string(domain.OrderStatusPending),
+		"legacy":    "",
+		"cancelled": string(domain.OrderStatusCancelled),
+	}
+	for suffix, status := range seed {
+		if _, err := orders.Doc(sheetID+"-"+suffix).Set(ctx, map[string]any{"sheet_id": sheetID, "status": status}); err != nil {
+			t.Fatalf("seed order: %v", err)
+		}
+	}
+
+	if _, err := r.Update(ctx, sheetID, func(s *domain.Sheet) error {
+		s.Status = domain.Status_CLOSED
+		return nil
+	}); err != nil {
+		t.Fatalf("close sheet: %v", err)
+	}
+
+	want := map[string]domain.OrderStatus{
+		"pending":   domain.OrderStatusConfirmed,
+		"legacy":    domain.OrderStatusConfirmed,
+		"cancelled": domain.OrderStatusCancelled,
+	}
+	for suffix, status := range want {
+		snap, err := orders.Doc(sheetID + "-" + suffix).Get(ctx)
+		if err != nil {
+			t.Fatalf("get order: %v", err)
+		}
+		if got, _ := snap.Data()["status"].(string); domain.OrderStatus(got) != status {
+			t.Fatalf("%s order status = %q, want %q", suffix, got, status)
+		}
+	}
+
+	// Only the two confirmed orders changed
+	changes, err := r.client.Collection(order.ChangesCollection).Where("sheet_id", "==", sheetID).Documents(ctx).GetAll()
+	if err != nil {
+		t.Fatalf("list order changes: %v", err)
+	}
+	if len(changes) != 2 {
+		t.Fatalf("close recorded %d order changes, want 2", len(changes))
+	}
+	for _, c := range changes {
+		if got, _ := c.Data()["order"].(map[string]any)["status"].(string); domain.OrderStatus(got) != domain.OrderStatusConfirmed {
+			t.Fatalf("recorded order status = %q, want confirmed", got)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package sheettemplate
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/audit"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+)
+
+func (r *templateRepo) Create(ctx context.Context, template *domain.SheetTemplate) (*domain.SheetTemplate, error) {
+	ctx, span := tracer.Start(ctx, "SheetTemplateRepo.Create")
+	defer span.End()
+
+	if template.ID == "" {
+		err := fmt.Errorf("sheet template ID is required")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	err := r.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		if err := tx.Create(r.collection.Doc(template.ID), template); err != nil {
+			return err
+		}
+		return audit.Write(ctx, tx, r.client, domain.AuditResourceTemplate, template.ID, audit.Diff(nil, template), templateUsers(template)...)
+	})
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("create sheet template: %w", err)
+	}
+	return template, nil
+}
@@ -0,0 +1,25 @@
+package sheettemplate
+
+import (
+	"context"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+)
+
+func (r *templateRepo) GetByID(ctx context.Context, id string) (*domain.SheetTemplate, error) {
+	ctx, span := tracer.Start(ctx, "SheetTemplateRepo.GetByID")
+	defer span.End()
+
+	snap, err := r.collection.Doc(id).Get(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, mapNotFound(err, id)
+	}
+
+	template, err := decode(snap)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	return template, nil
+}
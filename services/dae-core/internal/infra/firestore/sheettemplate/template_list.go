@@ -0,0 +1,89 @@
+package sheettemplate
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (r *templateRepo) List(ctx context.Context, query port.ListSheetTemplatesQuery) (*port.ListSheetTemplatesResp, error) {
+	ctx, span := tracer.Start(ctx, "SheetTemplateRepo.List")
+	defer span.End()
+
+	limit := int(query.Limit)
+	if limit <= 0 || limit > 1000 {
+		limit = int(r.defaultPageSize)
+	}
+
+	q := r.collection.
+		Where("host_user_id", "==", query.HostUserID).
+		OrderBy("created_at", firestore.Asc).
+		OrderBy(firestore.DocumentID, firestore.Asc).
+		Limit(limit + 1)
+
+	if query.Cursor != "" {
+		cursorSnap, err := r.collection.Doc(query.Cursor).Get(ctx)
+		if err != nil {
+			span.RecordError(err)
+			if status.Code(err) == codes.NotFound {
+				return nil, fmt.Errorf("sheet template %s: %w", query.Cursor, port.ErrInvalidSheetTemplateCursor)
+			}
+			return nil, fmt.Errorf("get cursor template: %w", err)
+		}
+		q = q.StartAfter(cursorSnap)
+	}
+
+	docs, err := q.Documents(ctx).GetAll()
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("list sheet templates: %w", err)
+	}
+
+	resp := &port.ListSheetTemplatesResp{}
+	for i, doc := range docs {
+		if i == limit {
+			resp.NextCursor = resp.Templates[limit-1].ID
+			break
+		}
+		template, err := decode(doc)
+		if err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+		resp.Templates = append(resp.Templates, template)
+	}
+	return resp, nil
+}
+
+func (r *templateRepo) ListDue(ctx context.Context, now time.Time, limit int) ([]*domain.SheetTemplate, error) {
+	ctx, span := tracer.Start(ctx, "SheetTemplateRepo.ListDue")
+	defer span.End()
+
+	// Paused templates have no next_run_at and never match
+	docs, err := r.collection.
+		Where("next_run_at", "<=", now).
+		OrderBy("next_run_at", firestore.Asc).
+		Limit(limit).
+		Documents(ctx).GetAll()
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("list due sheet templates: %w", err)
+	}
+
+	templates := make([]*domain.SheetTemplate, 0, len(docs))
+	for _, doc := range docs {
+		template, err := decode(doc)
+		if err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+		templates = append(templates, template)
+	}
+	return templates, nil
+}
@@ -0,0 +1,50 @@
+package sheettemplate
+
+import (
+	"fmt"
+
+	"cloud.google.com/go/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"go.opentelemetry.io/otel"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type templateRepo struct {
+	client          *firestore.Client
+	collection      *firestore.CollectionRef
+	defaultPageSize int32
+}
+
+var tracer = otel.Tracer("firestore/sheettemplate")
+
+// NewSheetTemplateRepo creates a Firestore-backed sheet template repository
+func NewSheetTemplateRepo(client *firestore.Client, defaultPageSize int32) port.SheetTemplateRepo {
+	return &templateRepo{
+		client:          client,
+		collection:      client.Collection("sheet_templates"),
+		defaultPageSize: defaultPageSize,
+	}
+}
+
+func decode(snap *firestore.DocumentSnapshot) (*domain.SheetTemplate, error) {
+	var template domain.SheetTemplate
+	if err := snap.DataTo(&template); err != nil {
+		return nil, fmt.Errorf("unmarshal sheet template: %w", err)
+	}
+	template.ID = snap.Ref.ID
+	return &template, nil
+}
+
+func mapNotFound(err error, id string) error {
+	if status.Code(err) == codes.NotFound {
+		return fmt.Errorf("sheet template %s: %w", id, port.ErrSheetTemplateNotFound)
+	}
+	return fmt.Errorf("sheet template %s: %w", id, err)
+}
+
+// templateUsers returns the host and members of a template
+func templateUsers(t *domain.SheetTemplate) []string {
+	return append([]string{t.HostUserID}, t.MemberIDs...)
+}
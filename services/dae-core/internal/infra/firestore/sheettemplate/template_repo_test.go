@@ -0,0 +1,164 @@
+package sheettemplate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+)
+
+// newEmulatorRepo connects to the Firestore emulator, e.g. the one started by
+// docker-compose, and skips the test when none is configured
+func newEmulatorRepo(t *testing.T) *templateRepo {
+	t.Helper()
+	if os.Getenv("FIRESTORE_EMULATOR_HOST") == "" {
+		t.Skip("FIRESTORE_EMULATOR_HOST not set")
+	}
+
+	client, err := firestore.NewClient(context.Background(), "demo-dae-core")
+	if err != nil {
+		t.Fatalf("firestore client: %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+	return NewSheetTemplateRepo(client, 20).(*templateRepo)
+}
+
+// seed creates a template per nextRunAt, nil for a paused one, with IDs
+// starting with prefix
+func seed(t *testing.T, r *templateRepo, prefix, hostUserID string, nextRunAt ...*time.Time) []string {
+	t.Helper()
+	created := time.Now().UTC()
+	var ids []string
+	for i, next := range nextRunAt {
+		template := &domain.SheetTemplate{
+			ID:         fmt.Sprintf("%s-%d", prefix, i),
+			Name:       "Lunch",
+			HostUserID: hostUserID,
+			Paused:     next == nil,
+			NextRunAt:  next,
+			CreatedAt:  created.Add(time.Duration(i) * time.Millisecond),
+		}
+		if _, err := r.Create(context.Background(), template); err != nil {
+			t.Fatalf("seed template: %v", err)
+		}
+		ids = append(ids, template.ID)
+	}
+	return ids
+}
+
+func TestListDueEmulator(t *testing.T) {
+	r := newEmulatorRepo(t)
+	prefix := fmt.Sprintf("due-%d", time.Now().UnixNano())
+	now := time.Now().UTC()
+	early, onTime, later := now.Add(-time.Hour), now, now.Add(time.Hour)
+	seed(t, r, prefix, "host", &onTime, &later, nil, &early)
+
+	due, err := r.ListDue(context.Background(), now, 1000)
+	if err != nil {
+		t.Fatalf("list due: %v", err)
+	}
+
+	// Other tests share the emulator, so only this test's templates count
+	var got []string
+	for _, template := range due {
+		if strings.HasPrefix(template.ID, prefix) {
+			got = append(got, template.ID)
+		}
+	}
+	want := []string{prefix + "-3", prefix + "-0"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("due = %v, want %v, earliest first", got, want)
+	}
+}
+
+func TestUpdateEmulator(t *testing.T) {
+	r := newEmulatorRepo(t)
+	ctx := context.Background()
+	id := seed(t, r, fmt.Sprintf("update-%d", time.Now().UnixNano()), "host", nil)[0]
+
+	// Concurrent updates are serialized by the transaction, none is lost
+	const writers = 3
+	var wg sync.WaitGroup
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, err := r.Update(ctx, id, func(cur *domain.SheetTemplate) error {
+				cur.MemberIDs = append(cur.MemberIDs, fmt.Sprintf("u%d", i))
+				return nil
+			})
+			if err != nil {
+				t.Errorf("update %d: %v", i, err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	got, err := r.GetByID(ctx, id)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if len(got.MemberIDs) != writers {
+		t.Fatalf("members = %v, want one per writer", got.MemberIDs)
+	}
+
+	// A refused update writes nothing
+	refused := errors.New("refused")
+	if _, err := r.Update(ctx, id, func(cur *domain.SheetTemplate) error {
+		cur.Name = "Changed"
+		return refused
+	}); !errors.Is(err, refused) {
+		t.Fatalf("refused update: err = %v", err)
+	}
+	if got, _ := r.GetByID(ctx, id); got.Name != "Lunch" {
+		t.Fatalf("name = %q after a refused update", got.Name)
+	}
+
+	if _, err := r.Update(ctx, id+"-missing", func(*domain.SheetTemplate) error { return nil }); !errors.Is(err, port.ErrSheetTemplateNotFound) {
+		t.Fatalf("missing: err = %v, want ErrSheetTemplateNotFound", err)
+	}
+}
+
+func TestListPagesEmulator(t *testing.T) {
+	r := newEmulatorRepo(t)
+	ctx := context.Background()
+	host := fmt.Sprintf("host-%d", time.Now().UnixNano())
+	want := seed(t, r, host, host, nil, nil, nil, nil, nil)
+
+	var got []string
+	cursor := ""
+	for pages := 0; ; pages++ {
+		if pages > len(want) {
+			t.Fatalf("paging did not end")
+		}
+		resp, err := r.List(ctx, port.ListSheetTemplatesQuery{HostUserID: host, Limit: 2, Cursor: cursor})
+		if err != nil {
+			t.Fatalf("list: %v", err)
+		}
+		if len(resp.Templates) > 2 {
+			t.Fatalf("page of %d, want at most 2", len(resp.Templates))
+		}
+		for _, template := range resp.Templates {
+			got = append(got, template.ID)
+		}
+		if resp.NextCursor == "" {
+			break
+		}
+		cursor = resp.NextCursor
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("templates = %v, want %v, oldest first", got, want)
+	}
+
+	if _, err := r.List(ctx, port.ListSheetTemplatesQuery{HostUserID: host, Cursor: "no-such-template"}); !errors.Is(err, port.ErrInvalidSheetTemplateCursor) {
+		t.Fatalf("bad cursor: err = %v, want ErrInvalidSheetTemplateCursor", err)
+	}
+}
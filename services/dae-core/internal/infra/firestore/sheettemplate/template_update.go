@@ -0,0 +1,80 @@
+package sheettemplate
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/audit"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+)
+
+// Update applies fn to the stored template in a transaction and writes the
+// result back whole
+func (r *templateRepo) Update(ctx context.Context, id string, fn func(*domain.SheetTemplate) error) (*domain.SheetTemplate, error) {
+	ctx, span := tracer.Start(ctx, "SheetTemplateRepo.Update")
+	defer span.End()
+
+	doc := r.collection.Doc(id)
+	var out *domain.SheetTemplate
+
+	err := r.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		snap, err := tx.Get(doc)
+		if err != nil {
+			return mapNotFound(err, id)
+		}
+
+		cur, err := decode(snap)
+		if err != nil {
+			return err
+		}
+		before := *cur
+		if err := fn(cur); err != nil {
+			return err
+		}
+
+		cur.UpdatedAt = time.Now().UTC()
+		if err := tx.Set(doc, cur); err != nil {
+			return fmt.Errorf("update sheet template: %w", err)
+		}
+		if err := audit.Write(ctx, tx, r.client, domain.AuditResourceTemplate, id, audit.Diff(&before, cur), append(templateUsers(&before), templateUsers(cur)...)...); err != nil {
+			return err
+		}
+
+		out = cur
+		return nil
+	})
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *templateRepo) Delete(ctx context.Context, id string) error {
+	ctx, span := tracer.Start(ctx, "SheetTemplateRepo.Delete")
+	defer span.End()
+
+	doc := r.collection.Doc(id)
+	err := r.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		snap, err := tx.Get(doc)
+		if err != nil {
+			return mapNotFound(err, id)
+		}
+		cur, err := decode(snap)
+		if err != nil {
+			return err
+		}
+		if err := tx.Delete(doc, firestore.Exists); err != nil {
+			return fmt.Errorf("delete sheet template: %w", err)
+		}
+		return audit.Write(ctx, tx, r.client, domain.AuditResourceTemplate, id, audit.Diff(cur, nil), templateUsers(cur)...)
+	})
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"cloud.google.com/go/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/audit"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+)
+
+// BackfillSearchPrefixes pages through users by document ID. Each page is
+// read again and rewritten in one transaction, so a concurrent update is
+// never overwritten with stale prefixes. Deleted users keep no prefixes.
+func (r *userRepo) BackfillSearchPrefixes(ctx context.Context, afterID string, limit int) (string, int, error) {
+	ctx, span := tracer.Start(ctx, "UserRepo.BackfillSearchPrefixes")
+	defer span.End()
+
+	q := r.collection.OrderBy(firestore.DocumentID, firestore.Asc).Limit(limit)
+	if afterID != "" {
+		q = q.StartAfter(afterID)
+	}
+	docs, err := q.Select().Documents(ctx).GetAll()
+	if err != nil {
+		span.RecordError(err)
+		return "", 0, fmt.Errorf("list users: %w", err)
+	}
+	if len(docs) == 0 {
+		return "", 0, nil
+	}
+
+	refs := make([]*firestore.DocumentRef, len(docs))
+	for i, doc := range docs {
+		refs[i] = doc.Ref
+	}
+
+	var updated int
+	err = r.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		updated = 0
+		snaps, err := tx.GetAll(refs)
+		if err != nil {
+			return fmt.Errorf("get users: %w", err)
+		}
+		for _, snap := range snaps {
+			if !snap.Exists() {
+				continue
+			}
+			before, err := userFromSnapshot(snap)
+			if err != nil {
+				return err
+			}
+
+			after := *before
+			after.SearchPrefixes = nil
+			if !after.IsDeleted() {
+				after.SearchPrefixes = after.BuildSearchPrefixes()
+			}
+			if slices.Equal(before.SearchPrefixes, after.SearchPrefixes) {
+				continue
+			}
+
+			updates := []firestore.Update{{Path: "search_prefixes", Value: after.SearchPrefixes}}
+			if after.SearchPrefixes == nil {
+				updates[0].Value = firestore.Delete
+			}
+			if err := tx.Update(snap.Ref, updates); err != nil {
+				return fmt.Errorf("update user %s: %w", snap.Ref.ID, err)
+			}
+			if err := audit.Write(ctx, tx, r.client, domain.AuditResourceUser, snap.Ref.ID, audit.Changes(before, &after, updates)); err != nil {
+				return err
+			}
+			updated++
+		}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return "", 0, err
+	}
+
+	return docs[len(docs)-1].Ref.ID, updated, nil
+}
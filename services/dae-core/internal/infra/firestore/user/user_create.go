@@ -6,10 +6,10 @@ import (
 	"time"
 
 	"cloud.google.com/go/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/audit"
 	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
 	"github.com/deni12345/dae-services/services/dae-core/internal/port"
 	"github.com/deni12345/dae-services/libs/utils"
-	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -43,17 +43,12 @@ func (r *userRepo) Create(ctx context.Context, req port.CreateUserRequest) (*dom
 		return nil, err
 	}
 
-	// Hash password if local provider
+	// Store the password hash for local accounts
 	var passwordHash, passwordAlgo *string
 	var passwordUpdatedAt *time.Time
-	if req.Provider == domain.IdentityProviderLocal && req.Password != "" {
-		hash, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
-		if err != nil {
-			span.RecordError(err)
-			return nil, fmt.Errorf("hash password: %w", err)
-		}
-		hashStr := string(hash)
-		algoStr := "bcrypt"
+	if req.Provider == domain.IdentityProviderLocal && req.PasswordHash != "" {
+		hashStr := req.PasswordHash
+		algoStr := req.PasswordAlgo
 		now := time.Now().UTC()
 		passwordHash = &hashStr
 		passwordAlgo = &algoStr
@@ -110,6 +105,7 @@ func (r *userRepo) Create(ctx context.Context, req port.CreateUserRequest) (*dom
 			PasswordAlgo:      passwordAlgo,
 			PasswordUpdatedAt: passwordUpdatedAt,
 		}
+		user.SearchPrefixes = user.BuildSearchPrefixes()
 
 		if err := tx.Set(userRef, user); err != nil {
 			return fmt.Errorf("create user: %w", err)
@@ -146,6 +142,11 @@ func (r *userRepo) Create(ctx context.Context, req port.CreateUserRequest) (*dom
 			return fmt.Errorf("create unique identity: %w", err)
 		}
 
+		changes := append(audit.Diff(nil, user), audit.Prefix("identities."+string(req.Provider), audit.Diff(nil, identity))...)
+		if err := audit.Write(ctx, tx, r.client, domain.AuditResourceUser, userID, changes); err != nil {
+			return err
+		}
+
 		createdUser = user
 		return nil
 	})
@@ -161,51 +162,6 @@ func (r *userRepo) Create(ctx context.Context, req port.CreateUserRequest) (*dom
 	return createdUser, nil
 }
 
-// CreateIdentity adds a new identity provider to an existing user
-func (r *userRepo) CreateIdentity(ctx context.Context, userID string, identity *domain.UserIdentity) error {
-	ctx, span := tracer.Start(ctx, "UserRepo.CreateIdentity")
-	defer span.End()
-
-	err := r.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
-		// Check if identity already exists
-		identityKey := fmt.Sprintf("%s:%s", identity.Provider, identity.Subject)
-		uniqueIdentityRef := r.client.Collection("unique_identities").Doc(identityKey)
-		uniqueIdentitySnap, err := tx.Get(uniqueIdentityRef)
-		if err != nil && status.Code(err) != codes.NotFound {
-			return fmt.Errorf("check identity uniqueness: %w", err)
-		}
-		if uniqueIdentitySnap.Exists() {
-			return ErrAlreadyExists
-		}
-
-		// Create identity subcollection document
-		userRef := r.collection.Doc(userID)
-		identityRef := userRef.Collection("identities").Doc(string(identity.Provider))
-		if err := tx.Set(identityRef, identity); err != nil {
-			return fmt.Errorf("create identity: %w", err)
-		}
-
-		// Create unique_identities entry
-		now := time.Now().UTC()
-		uniqueIdentity := &domain.UniqueIdentity{
-			UserID:    userID,
-			CreatedAt: now,
-		}
-		if err := tx.Set(uniqueIdentityRef, uniqueIdentity); err != nil {
-			return fmt.Errorf("create unique identity: %w", err)
-		}
-
-		return nil
-	})
-
-	if err != nil {
-		span.RecordError(err)
-		return fmt.Errorf("create identity transaction: %w", err)
-	}
-
-	return nil
-}
-
 // GetIdentityByProvider retrieves an identity for a user by provider
 func (r *userRepo) GetIdentityByProvider(ctx context.Context, userID string, provider domain.IdentityProvider) (*domain.UserIdentity, error) {
 	ctx, span := tracer.Start(ctx, "UserRepo.GetIdentityByProvider")
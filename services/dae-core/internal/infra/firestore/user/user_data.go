@@ -0,0 +1,69 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/audit"
+	"github.com/deni12345/dae-services/services/dae-core/internal/infra/firestore/order"
+	"github.com/deni12345/dae-services/services/dae-core/internal/infra/firestore/outbox"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+)
+
+// userDataStore reaches into the other collections that store user IDs:
+// orders with their change records, sheets with their members and invites,
+// sheet templates, payments, outbox events, dead letters and audit events
+type userDataStore struct {
+	client      *firestore.Client
+	users       *firestore.CollectionRef
+	orders      *firestore.CollectionRef
+	changes     *firestore.CollectionRef
+	sheets      *firestore.CollectionRef
+	invites     *firestore.CollectionRef
+	templates   *firestore.CollectionRef
+	payments    *firestore.CollectionRef
+	outbox      *firestore.CollectionRef
+	deadLetters *firestore.CollectionRef
+	auditEvents *firestore.CollectionRef
+}
+
+func NewUserDataStore(client *firestore.Client) port.UserDataStore {
+	return &userDataStore{
+		client:      client,
+		users:       client.Collection("users"),
+		orders:      client.Collection("orders"),
+		changes:     client.Collection(order.ChangesCollection),
+		sheets:      client.Collection("sheets"),
+		invites:     client.Collection("sheet_invites"),
+		templates:   client.Collection("sheet_templates"),
+		payments:    client.Collection("payments"),
+		outbox:      client.Collection("outbox"),
+		deadLetters: client.Collection(outbox.DeadLetterCollection),
+		auditEvents: client.Collection(audit.Collection),
+	}
+}
+
+// ListErasable relies on deleted_at being present only between SoftDelete
+// and a completed Erase
+func (s *userDataStore) ListErasable(ctx context.Context, deletedBefore time.Time, limit int) ([]string, error) {
+	ctx, span := tracer.Start(ctx, "UserDataStore.ListErasable")
+	defer span.End()
+
+	snaps, err := s.users.
+		Where("deleted_at", "<", deletedBefore).
+		OrderBy("deleted_at", firestore.Asc).
+		Limit(limit).
+		Documents(ctx).GetAll()
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("list deleted users: %w", err)
+	}
+
+	ids := make([]string, 0, len(snaps))
+	for _, snap := range snaps {
+		ids = append(ids, snap.Ref.ID)
+	}
+	return ids, nil
+}
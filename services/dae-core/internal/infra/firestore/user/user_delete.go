@@ -0,0 +1,168 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/audit"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SoftDelete marks the user deleted and frees their unique_emails and
+// unique_identities entries so the email and provider accounts can sign up
+// again. The identities themselves are kept until the user is erased.
+// Templates the user hosts are paused and the user is taken off the member
+// lists of the others, so no sheet is created for or with them any more.
+func (r *userRepo) SoftDelete(ctx context.Context, id string) (*domain.User, error) {
+	ctx, span := tracer.Start(ctx, "UserRepo.SoftDelete")
+	defer span.End()
+
+	userRef := r.collection.Doc(id)
+	var out *domain.User
+
+	err := r.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		snap, err := tx.Get(userRef)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return port.ErrUserNotFound
+			}
+			return fmt.Errorf("get user: %w", err)
+		}
+
+		var user domain.User
+		if err := snap.DataTo(&user); err != nil {
+			return fmt.Errorf("unmarshal user: %w", err)
+		}
+		user.ID = snap.Ref.ID
+		if user.IsDeleted() {
+			out = &user
+			return nil
+		}
+
+		// Reservations to release, read before any write
+		var owned []*firestore.DocumentRef
+		if user.EmailNormalized != "" {
+			ref := r.client.Collection("unique_emails").Doc(user.EmailNormalized)
+			if ok, err := reservedBy(tx, ref, id); err != nil {
+				return fmt.Errorf("get unique email: %w", err)
+			} else if ok {
+				owned = append(owned, ref)
+			}
+		}
+		identities, err := tx.Documents(userRef.Collection("identities")).GetAll()
+		if err != nil {
+			return fmt.Errorf("list identities: %w", err)
+		}
+		for _, identitySnap := range identities {
+			var identity domain.UserIdentity
+			if err := identitySnap.DataTo(&identity); err != nil {
+				return fmt.Errorf("unmarshal identity: %w", err)
+			}
+			identityKey := fmt.Sprintf("%s:%s", identity.Provider, identity.Subject)
+			ref := r.client.Collection("unique_identities").Doc(identityKey)
+			if ok, err := reservedBy(tx, ref, id); err != nil {
+				return fmt.Errorf("get unique identity: %w", err)
+			} else if ok {
+				owned = append(owned, ref)
+			}
+		}
+
+		hosted, err := tx.Documents(r.client.Collection("sheet_templates").Where("host_user_id", "==", id)).GetAll()
+		if err != nil {
+			return fmt.Errorf("list hosted templates: %w", err)
+		}
+		joined, err := tx.Documents(r.client.Collection("sheet_templates").Where("member_ids", "array-contains", id)).GetAll()
+		if err != nil {
+			return fmt.Errorf("list template memberships: %w", err)
+		}
+
+		for _, ref := range owned {
+			if err := tx.Delete(ref); err != nil {
+				return fmt.Errorf("release %s: %w", ref.Path, err)
+			}
+		}
+		now := time.Now().UTC()
+		for _, snap := range hosted {
+			if err := tx.Update(snap.Ref, pauseTemplate(now)); err != nil {
+				return fmt.Errorf("pause template %s: %w", snap.Ref.ID, err)
+			}
+			paused, _ := snap.Data()["paused"].(bool)
+			change := audit.Change("paused", paused, true)
+			if err := audit.Write(ctx, tx, r.client, domain.AuditResourceTemplate, snap.Ref.ID, []domain.AuditChange{change}, id); err != nil {
+				return err
+			}
+		}
+		for _, snap := range joined {
+			if err := tx.Update(snap.Ref, []firestore.Update{
+				{Path: "member_ids", Value: firestore.ArrayRemove(id)},
+				{Path: "updated_at", Value: now},
+			}); err != nil {
+				return fmt.Errorf("leave template %s: %w", snap.Ref.ID, err)
+			}
+			var template domain.SheetTemplate
+			if err := snap.DataTo(&template); err != nil {
+				return fmt.Errorf("unmarshal template %s: %w", snap.Ref.ID, err)
+			}
+			change := audit.Change("member_ids", template.MemberIDs, slices.DeleteFunc(slices.Clone(template.MemberIDs), func(m string) bool { return m == id }))
+			if err := audit.Write(ctx, tx, r.client, domain.AuditResourceTemplate, snap.Ref.ID, []domain.AuditChange{change}, id); err != nil {
+				return err
+			}
+		}
+
+		before := user
+		user.Status = domain.UserStatusDeleted
+		user.DeletedAt = &now
+		user.UpdatedAt = now
+		user.SearchPrefixes = nil
+		out = &user
+
+		changes := audit.Changes(before, &user, []firestore.Update{{Path: "status"}, {Path: "deleted_at"}, {Path: "search_prefixes"}})
+		if err := audit.Write(ctx, tx, r.client, domain.AuditResourceUser, id, changes); err != nil {
+			return err
+		}
+
+		return tx.Update(userRef, []firestore.Update{
+			{Path: "status", Value: domain.UserStatusDeleted},
+			{Path: "deleted_at", Value: now},
+			{Path: "search_prefixes", Value: firestore.Delete},
+			{Path: "updated_at", Value: now},
+		})
+	})
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("delete user transaction: %w", err)
+	}
+
+	return out, nil
+}
+
+// pauseTemplate stops a sheet template from being materialized, as pausing
+// it through the template usecase does
+func pauseTemplate(now time.Time) []firestore.Update {
+	return []firestore.Update{
+		{Path: "paused", Value: true},
+		{Path: "next_run_at", Value: firestore.Delete},
+		{Path: "updated_at", Value: now},
+	}
+}
+
+// reservedBy reports whether the unique_emails or unique_identities entry at
+// ref belongs to userID
+func reservedBy(tx *firestore.Transaction, ref *firestore.DocumentRef, userID string) (bool, error) {
+	snap, err := tx.Get(ref)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	owner, _ := snap.Data()["user_id"].(string)
+	return owner == userID, nil
+}
@@ -0,0 +1,548 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/audit"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Erase replaces the user ID with a pseudonym everywhere it is stored and
+// strips the user document. Every step only touches documents still holding
+// the real user ID, so a failed erasure is finished by running it again.
+func (s *userDataStore) Erase(ctx context.Context, userID string) error {
+	ctx, span := tracer.Start(ctx, "UserDataStore.Erase")
+	defer span.End()
+
+	anonID, err := s.pseudonym(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+	if anonID == "" {
+		return nil // already erased
+	}
+
+	steps := []struct {
+		name string
+		run  func(ctx context.Context, userID, anonID string) error
+	}{
+		{"orders", s.eraseOrders},
+		{"order changes", s.eraseOrderChanges},
+		{"payments", s.erasePayments},
+		{"memberships", s.eraseMemberships},
+		{"templates", s.eraseTemplates},
+		{"invites", s.eraseInvites},
+		{"outbox", s.eraseOutbox},
+		{"audit", s.eraseAuditEvents},
+		{"profile", s.eraseProfile},
+	}
+	for _, step := range steps {
+		if err := step.run(ctx, userID, anonID); err != nil {
+			span.RecordError(err)
+			return fmt.Errorf("erase %s: %w", step.name, err)
+		}
+	}
+
+	return nil
+}
+
+// pseudonym returns the ID the user's records are moved to, storing a new
+// one on the first attempt. It returns "" for a user already erased.
+func (s *userDataStore) pseudonym(ctx context.Context, userID string) (string, error) {
+	var anonID string
+	err := s.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		userRef := s.users.Doc(userID)
+		snap, err := tx.Get(userRef)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return port.ErrUserNotFound
+			}
+			return fmt.Errorf("get user: %w", err)
+		}
+
+		var user domain.User
+		if err := snap.DataTo(&user); err != nil {
+			return fmt.Errorf("unmarshal user: %w", err)
+		}
+		if user.ErasedAt != nil {
+			anonID = ""
+			return nil
+		}
+		if !user.IsDeleted() {
+			return port.ErrUserNotDeleted
+		}
+
+		anonID = user.ErasedAs
+		if anonID != "" {
+			return nil
+		}
+		anonID = domain.ErasedUserPrefix + s.users.NewDoc().ID
+		return tx.Update(userRef, []firestore.Update{{Path: "erased_as", Value: anonID}})
+	})
+	if err != nil {
+		return "", fmt.Errorf("pseudonym transaction: %w", err)
+	}
+	return anonID, nil
+}
+
+// eraseOrders moves the user's orders to anonID and clears their notes.
+// Lines, prices and totals are kept so sheet settlements do not change.
+func (s *userDataStore) eraseOrders(ctx context.Context, userID, anonID string) error {
+	snaps, err := s.orders.Where("user_id", "==", userID).Documents(ctx).GetAll()
+	if err != nil {
+		return fmt.Errorf("list orders: %w", err)
+	}
+
+	for _, snap := range snaps {
+		err := s.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+			cur, err := tx.Get(snap.Ref)
+			if err != nil {
+				if status.Code(err) == codes.NotFound {
+					return nil
+				}
+				return err
+			}
+
+			var order domain.Order
+			if err := cur.DataTo(&order); err != nil {
+				return fmt.Errorf("unmarshal order: %w", err)
+			}
+			if order.UserID != userID {
+				return nil
+			}
+			for i := range order.Lines {
+				order.Lines[i].Note = ""
+			}
+
+			return tx.Update(snap.Ref, []firestore.Update{
+				{Path: "user_id", Value: anonID},
+				{Path: "note", Value: ""},
+				{Path: "lines", Value: order.Lines},
+			})
+		})
+		if err != nil {
+			return fmt.Errorf("order %s: %w", snap.Ref.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// eraseOrderChanges deletes the change records of the user's orders. They
+// copy the order with its notes and only serve to resume order streams.
+func (s *userDataStore) eraseOrderChanges(ctx context.Context, userID, _ string) error {
+	return s.rewriteMatching(ctx, s.changes.Where("order.user_id", "==", userID), func(tx *firestore.Transaction, snap *firestore.DocumentSnapshot) error {
+		return tx.Delete(snap.Ref)
+	})
+}
+
+// erasePayments moves payments/{sheetID}_{userID}_{sequence} to
+// payments/{sheetID}_{anonID}_{sequence}
+func (s *userDataStore) erasePayments(ctx context.Context, userID, anonID string) error {
+	snaps, err := s.payments.Where("user_id", "==", userID).Documents(ctx).GetAll()
+	if err != nil {
+		return fmt.Errorf("list payments: %w", err)
+	}
+
+	for _, snap := range snaps {
+		err := s.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+			cur, err := tx.Get(snap.Ref)
+			if err != nil {
+				if status.Code(err) == codes.NotFound {
+					return nil
+				}
+				return err
+			}
+
+			var payment domain.Payment
+			if err := cur.DataTo(&payment); err != nil {
+				return fmt.Errorf("unmarshal payment: %w", err)
+			}
+			payment.UserID = anonID
+			payment.Note = ""
+
+			if err := tx.Set(s.payments.Doc(domain.PaymentID(payment.SheetID, anonID, payment.Sequence)), &payment); err != nil {
+				return err
+			}
+			return tx.Delete(snap.Ref)
+		})
+		if err != nil {
+			return fmt.Errorf("payment %s: %w", snap.Ref.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// eraseMemberships rewrites member_ids, host_user_id and the members
+// subcollection of every sheet the user hosted or joined
+func (s *userDataStore) eraseMemberships(ctx context.Context, userID, anonID string) error {
+	sheetIDs := make(map[string]bool)
+
+	for _, q := range []firestore.Query{
+		s.sheets.Where("member_ids", "array-contains", userID),
+		s.sheets.Where("host_user_id", "==", userID),
+	} {
+		snaps, err := q.Documents(ctx).GetAll()
+		if err != nil {
+			return fmt.Errorf("list sheets: %w", err)
+		}
+		for _, snap := range snaps {
+			sheetIDs[snap.Ref.ID] = true
+		}
+	}
+	members, err := s.client.CollectionGroup("members").
+		Where("user_id", "==", userID).
+		OrderBy("joined_at", firestore.Desc).
+		Documents(ctx).GetAll()
+	if err != nil {
+		return fmt.Errorf("list memberships: %w", err)
+	}
+	for _, snap := range members {
+		sheetIDs[snap.Ref.Parent.Parent.ID] = true
+	}
+
+	for sheetID := range sheetIDs {
+		err := s.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+			sheetRef := s.sheets.Doc(sheetID)
+			sheetSnap, err := tx.Get(sheetRef)
+			if err != nil && status.Code(err) != codes.NotFound {
+				return err
+			}
+			memberRef := sheetRef.Collection("members").Doc(userID)
+			memberSnap, err := tx.Get(memberRef)
+			if err != nil && status.Code(err) != codes.NotFound {
+				return err
+			}
+
+			if sheetSnap.Exists() {
+				var sheet domain.Sheet
+				if err := sheetSnap.DataTo(&sheet); err != nil {
+					return fmt.Errorf("unmarshal sheet: %w", err)
+				}
+
+				var updates []firestore.Update
+				memberIDs := make([]string, len(sheet.MemberIDs))
+				changed := false
+				for i, id := range sheet.MemberIDs {
+					memberIDs[i] = id
+					if id == userID {
+						memberIDs[i] = anonID
+						changed = true
+					}
+				}
+				if changed {
+					updates = append(updates, firestore.Update{Path: "member_ids", Value: memberIDs})
+				}
+				if sheet.HostUserID == userID {
+					updates = append(updates, firestore.Update{Path: "host_user_id", Value: anonID})
+				}
+				if len(updates) > 0 {
+					if err := tx.Update(sheetRef, updates); err != nil {
+						return err
+					}
+				}
+			}
+
+			if memberSnap.Exists() {
+				member := memberSnap.Data()
+				member["user_id"] = anonID
+				if err := tx.Set(sheetRef.Collection("members").Doc(anonID), member); err != nil {
+					return err
+				}
+				return tx.Delete(memberRef)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("sheet %s: %w", sheetID, err)
+		}
+	}
+
+	return nil
+}
+
+// eraseTemplates moves the templates the user hosted to anonID, paused, and
+// takes the user off the member lists of the others. SoftDelete already did
+// both for templates that existed then.
+func (s *userDataStore) eraseTemplates(ctx context.Context, userID, anonID string) error {
+	for _, q := range []firestore.Query{
+		s.templates.Where("host_user_id", "==", userID),
+		s.templates.Where("member_ids", "array-contains", userID),
+	} {
+		snaps, err := q.Documents(ctx).GetAll()
+		if err != nil {
+			return fmt.Errorf("list templates: %w", err)
+		}
+
+		for _, snap := range snaps {
+			err := s.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+				cur, err := tx.Get(snap.Ref)
+				if err != nil {
+					if status.Code(err) == codes.NotFound {
+						return nil
+					}
+					return err
+				}
+
+				var template domain.SheetTemplate
+				if err := cur.DataTo(&template); err != nil {
+					return fmt.Errorf("unmarshal template: %w", err)
+				}
+
+				now := time.Now().UTC()
+				updates := []firestore.Update{
+					{Path: "member_ids", Value: firestore.ArrayRemove(userID)},
+					{Path: "updated_at", Value: now},
+				}
+				if template.HostUserID == userID {
+					updates = append(pauseTemplate(now), firestore.Update{Path: "host_user_id", Value: anonID},
+						firestore.Update{Path: "member_ids", Value: firestore.ArrayRemove(userID)})
+				}
+				return tx.Update(snap.Ref, updates)
+			})
+			if err != nil {
+				return fmt.Errorf("template %s: %w", snap.Ref.ID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// eraseInvites moves the invites the user created to anonID
+func (s *userDataStore) eraseInvites(ctx context.Context, userID, anonID string) error {
+	return s.rewriteMatching(ctx, s.invites.Where("created_by", "==", userID), func(tx *firestore.Transaction, snap *firestore.DocumentSnapshot) error {
+		return tx.Update(snap.Ref, []firestore.Update{{Path: "created_by", Value: anonID}})
+	})
+}
+
+// eraseOutbox deletes the published events naming the user and moves the
+// payloads of those still pending or dead-lettered to anonID, without their
+// notes
+func (s *userDataStore) eraseOutbox(ctx context.Context, userID, anonID string) error {
+	for _, events := range []*firestore.CollectionRef{s.outbox, s.deadLetters} {
+		err := s.rewriteMatching(ctx, events.Where("user_ids", "array-contains", userID), func(tx *firestore.Transaction, snap *firestore.DocumentSnapshot) error {
+			var event domain.Event
+			if err := snap.DataTo(&event); err != nil {
+				return fmt.Errorf("unmarshal event: %w", err)
+			}
+			if event.Published {
+				return tx.Delete(snap.Ref)
+			}
+
+			payload, err := pseudonymizePayload(event.Payload, userID, anonID)
+			if err != nil {
+				return fmt.Errorf("event %s: %w", snap.Ref.ID, err)
+			}
+			return tx.Update(snap.Ref, []firestore.Update{
+				{Path: "payload", Value: payload},
+				{Path: "user_ids", Value: replaceID(event.UserIDs, userID, anonID)},
+			})
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// eraseAuditEvents moves the audit events naming the user to anonID. Their
+// personal data was redacted when they were written.
+func (s *userDataStore) eraseAuditEvents(ctx context.Context, userID, anonID string) error {
+	return s.rewriteMatching(ctx, s.auditEvents.Where("user_ids", "array-contains", userID), func(tx *firestore.Transaction, snap *firestore.DocumentSnapshot) error {
+		var event domain.AuditEvent
+		if err := snap.DataTo(&event); err != nil {
+			return fmt.Errorf("unmarshal audit event: %w", err)
+		}
+
+		for i := range event.Changes {
+			event.Changes[i].Before = strings.ReplaceAll(event.Changes[i].Before, userID, anonID)
+			event.Changes[i].After = strings.ReplaceAll(event.Changes[i].After, userID, anonID)
+		}
+		if event.ActorID == userID {
+			event.ActorID = anonID
+		}
+		return tx.Update(snap.Ref, []firestore.Update{
+			{Path: "actor_id", Value: event.ActorID},
+			{Path: "resource_id", Value: strings.ReplaceAll(event.ResourceID, userID, anonID)},
+			{Path: "changes", Value: event.Changes},
+			{Path: "user_ids", Value: replaceID(event.UserIDs, userID, anonID)},
+		})
+	})
+}
+
+// erasePageSize bounds the documents rewriteMatching writes per transaction
+const erasePageSize = 200
+
+// rewriteMatching calls fn on the documents q matches, a page per
+// transaction, until q matches none. fn must write every document so that q
+// no longer matches it.
+func (s *userDataStore) rewriteMatching(ctx context.Context, q firestore.Query, fn func(tx *firestore.Transaction, snap *firestore.DocumentSnapshot) error) error {
+	for {
+		snaps, err := q.Limit(erasePageSize).Documents(ctx).GetAll()
+		if err != nil {
+			return fmt.Errorf("list documents: %w", err)
+		}
+		if len(snaps) == 0 {
+			return nil
+		}
+
+		err = s.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+			for _, snap := range snaps {
+				if err := fn(tx, snap); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// pseudonymizePayload replaces userID with anonID in a JSON event payload and
+// clears the notes it holds
+func pseudonymizePayload(payload, userID, anonID string) (string, error) {
+	dec := json.NewDecoder(strings.NewReader(payload))
+	dec.UseNumber() // amounts must survive the round trip exactly
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return "", fmt.Errorf("decode payload: %w", err)
+	}
+	b, err := json.Marshal(pseudonymizeValue(v, userID, anonID))
+	if err != nil {
+		return "", fmt.Errorf("encode payload: %w", err)
+	}
+	return string(b), nil
+}
+
+func pseudonymizeValue(v any, userID, anonID string) any {
+	switch v := v.(type) {
+	case string:
+		if v == userID {
+			return anonID
+		}
+		return v
+	case []any:
+		for i := range v {
+			v[i] = pseudonymizeValue(v[i], userID, anonID)
+		}
+		return v
+	case map[string]any:
+		for k := range v {
+			if k == "note" {
+				v[k] = ""
+				continue
+			}
+			v[k] = pseudonymizeValue(v[k], userID, anonID)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// replaceID returns ids with userID replaced by anonID
+func replaceID(ids []string, userID, anonID string) []string {
+	out := make([]string, len(ids))
+	for i, id := range ids {
+		out[i] = id
+		if id == userID {
+			out[i] = anonID
+		}
+	}
+	return out
+}
+
+// eraseProfile deletes the identities and any reservation still held by the
+// user, then strips the user document down to its roles, status and
+// timestamps
+func (s *userDataStore) eraseProfile(ctx context.Context, userID, anonID string) error {
+	return s.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		userRef := s.users.Doc(userID)
+		snap, err := tx.Get(userRef)
+		if err != nil {
+			return fmt.Errorf("get user: %w", err)
+		}
+		var user domain.User
+		if err := snap.DataTo(&user); err != nil {
+			return fmt.Errorf("unmarshal user: %w", err)
+		}
+
+		identities, err := tx.Documents(userRef.Collection("identities")).GetAll()
+		if err != nil {
+			return fmt.Errorf("list identities: %w", err)
+		}
+		var deletes []*firestore.DocumentRef
+		for _, identitySnap := range identities {
+			var identity domain.UserIdentity
+			if err := identitySnap.DataTo(&identity); err != nil {
+				return fmt.Errorf("unmarshal identity: %w", err)
+			}
+			identityKey := fmt.Sprintf("%s:%s", identity.Provider, identity.Subject)
+			ref := s.client.Collection("unique_identities").Doc(identityKey)
+			if ok, err := reservedBy(tx, ref, userID); err != nil {
+				return fmt.Errorf("get unique identity: %w", err)
+			} else if ok {
+				deletes = append(deletes, ref)
+			}
+			deletes = append(deletes, identitySnap.Ref)
+		}
+		if user.EmailNormalized != "" {
+			ref := s.client.Collection("unique_emails").Doc(user.EmailNormalized)
+			if ok, err := reservedBy(tx, ref, userID); err != nil {
+				return fmt.Errorf("get unique email: %w", err)
+			} else if ok {
+				deletes = append(deletes, ref)
+			}
+		}
+
+		for _, ref := range deletes {
+			if err := tx.Delete(ref); err != nil {
+				return fmt.Errorf("delete %s: %w", ref.Path, err)
+			}
+		}
+
+		// The pseudonymized orders, sheets and payments are not audited one
+		// by one: their events would link the pseudonym back to the user
+		now := time.Now().UTC()
+		change := audit.Change("erased_at", nil, now)
+		if err := audit.Write(ctx, tx, s.client, domain.AuditResourceUser, userID, []domain.AuditChange{change}); err != nil {
+			return err
+		}
+		return tx.Update(userRef, []firestore.Update{
+			{Path: "email", Value: ""},
+			{Path: "email_normalized", Value: ""},
+			{Path: "email_verified", Value: false},
+			{Path: "name", Value: ""},
+			{Path: "display_name", Value: ""},
+			{Path: "photo_url", Value: ""},
+			{Path: "phone", Value: ""},
+			{Path: "last_login_at", Value: firestore.Delete},
+			{Path: "search_prefixes", Value: firestore.Delete},
+			{Path: "password_hash", Value: firestore.Delete},
+			{Path: "password_algo", Value: firestore.Delete},
+			{Path: "password_updated_at", Value: firestore.Delete},
+			{Path: "user_name", Value: firestore.Delete},
+			{Path: "username", Value: firestore.Delete}, // written by older UpdateUser calls
+			{Path: "avatar_url", Value: firestore.Delete},
+			// Dropping deleted_at takes the user out of ListErasable, and
+			// erased_as no longer links the pseudonym back to the account
+			{Path: "deleted_at", Value: firestore.Delete},
+			{Path: "erased_as", Value: firestore.Delete},
+			{Path: "erased_at", Value: now},
+			{Path: "updated_at", Value: now},
+		})
+	})
+}
@@ -0,0 +1,273 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/audit"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+)
+
+// newEmulatorStores connects to the Firestore emulator, e.g. the one started
+// by docker-compose, and skips the test when none is configured
+func newEmulatorStores(t *testing.T) (*userRepo, *userDataStore) {
+	t.Helper()
+	if os.Getenv("FIRESTORE_EMULATOR_HOST") == "" {
+		t.Skip("FIRESTORE_EMULATOR_HOST not set")
+	}
+
+	client, err := firestore.NewClient(context.Background(), "demo-dae-core")
+	if err != nil {
+		t.Fatalf("firestore client: %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+	return NewUserRepo(client, 20).(*userRepo), NewUserDataStore(client).(*userDataStore)
+}
+
+// userFixture is a user with an order, a payment, a sheet membership, an
+// invite, outbox events and an audit trail
+type userFixture struct {
+	user                           *domain.User
+	sheetID, orderID, inviteCode   string
+	publishedEvent, pendingEvent   string
+	orderChange                    string
+	auditEventByUser, auditOfOther string
+}
+
+func seedUser(t *testing.T, repo *userRepo, store *userDataStore) *userFixture {
+	t.Helper()
+	ctx := context.Background()
+	suffix := fmt.Sprintf("%s-%d", strings.ToLower(t.Name()), time.Now().UnixNano())
+
+	ctx = audit.NewContext(ctx, audit.Operation{Method: "/core.v1.UsersService/CreateUser"})
+	user, err := repo.Create(ctx, port.CreateUserRequest{
+		Email:    suffix + "@example.com",
+		Name:     "Ann Example",
+		Phone:    "0901 234 567",
+		Provider: domain.IdentityProviderGoogle,
+		Subject:  "sub-" + suffix,
+	})
+	if err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+	ctx = audit.NewContext(ctx, audit.Operation{ActorID: user.ID, Method: "/core.v1.OrdersService/CreateOrder"})
+
+	f := &userFixture{user: user, sheetID: "sheet-" + suffix, orderID: "order-" + suffix, inviteCode: "INV" + suffix}
+	now := time.Now().UTC()
+	order := &domain.Order{ID: f.orderID, SheetID: f.sheetID, UserID: user.ID, Note: "ring twice", CreatedAt: now}
+	sheet := &domain.Sheet{ID: f.sheetID, HostUserID: "host-" + suffix, MemberIDs: []string{"host-" + suffix, user.ID}, CreatedAt: now}
+
+	set := func(ref *firestore.DocumentRef, data any) {
+		t.Helper()
+		if _, err := ref.Set(ctx, data); err != nil {
+			t.Fatalf("seed %s: %v", ref.Path, err)
+		}
+	}
+	set(store.orders.Doc(order.ID), order)
+	changeRef := store.changes.NewDoc()
+	set(changeRef, map[string]any{"sheet_id": f.sheetID, "order_id": order.ID, "type": domain.OrderEventCreated, "order": order, "occurred_at": now})
+	f.orderChange = changeRef.ID
+	set(store.sheets.Doc(sheet.ID), sheet)
+	set(store.sheets.Doc(sheet.ID).Collection("members").Doc(user.ID), map[string]any{"user_id": user.ID, "role": domain.MemberRoleMember, "joined_at": now})
+	set(store.payments.Doc(domain.PaymentID(sheet.ID, user.ID, 1)), &domain.Payment{SheetID: sheet.ID, UserID: user.ID, Sequence: 1, Status: domain.PaymentStatusPaid, Note: "cash", CreatedAt: now})
+	set(store.invites.Doc(f.inviteCode), &domain.SheetInvite{SheetID: sheet.ID, CreatedBy: user.ID, Role: domain.MemberRoleMember, CreatedAt: now})
+
+	event, err := domain.NewOrderEvent(domain.EventOrderCreated, order)
+	if err != nil {
+		t.Fatalf("order event: %v", err)
+	}
+	published := *event
+	published.Published = true
+	publishedRef, pendingRef := store.outbox.NewDoc(), store.outbox.NewDoc()
+	set(publishedRef, &published)
+	set(pendingRef, event)
+	f.publishedEvent, f.pendingEvent = publishedRef.ID, pendingRef.ID
+
+	// One event written by the user, one naming them as a new member
+	err = store.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		if err := audit.Write(ctx, tx, store.client, domain.AuditResourceOrder, order.ID, audit.Diff(nil, order), order.UserID); err != nil {
+			return err
+		}
+		change := audit.Change("member_ids", []string{sheet.HostUserID}, sheet.MemberIDs)
+		ctx = audit.NewContext(ctx, audit.Operation{ActorID: sheet.HostUserID, Method: "/core.v1.SheetsService/JoinSheet"})
+		return audit.Write(ctx, tx, store.client, domain.AuditResourceSheet, sheet.ID, []domain.AuditChange{change}, user.ID)
+	})
+	if err != nil {
+		t.Fatalf("seed audit events: %v", err)
+	}
+
+	events, err := store.auditEvents.Where("user_ids", "array-contains", user.ID).Documents(context.Background()).GetAll()
+	if err != nil {
+		t.Fatalf("list audit events: %v", err)
+	}
+	for _, snap := range events {
+		if snap.Data()["actor_id"] == user.ID {
+			f.auditEventByUser = snap.Ref.ID
+		} else if snap.Data()["resource_id"] == sheet.ID {
+			f.auditOfOther = snap.Ref.ID
+		}
+	}
+	if f.auditEventByUser == "" || f.auditOfOther == "" {
+		t.Fatalf("audit events = %d, want the order and the join", len(events))
+	}
+	return f
+}
+
+func TestSoftDeleteEmulator(t *testing.T) {
+	repo, store := newEmulatorStores(t)
+	f := seedUser(t, repo, store)
+	ctx := context.Background()
+
+	deleted, err := repo.SoftDelete(ctx, f.user.ID)
+	if err != nil {
+		t.Fatalf("soft delete: %v", err)
+	}
+	if !deleted.IsDeleted() || deleted.DeletedAt == nil {
+		t.Fatalf("deleted user = %+v", deleted)
+	}
+
+	// The email is free to sign up again
+	if _, err := store.client.Collection("unique_emails").Doc(f.user.EmailNormalized).Get(ctx); err == nil {
+		t.Fatal("unique email still reserved")
+	}
+
+	// Deleting again changes nothing
+	again, err := repo.SoftDelete(ctx, f.user.ID)
+	if err != nil || !again.DeletedAt.Equal(*deleted.DeletedAt) {
+		t.Fatalf("second delete = %+v, %v", again, err)
+	}
+
+	if _, err := repo.SoftDelete(ctx, "missing-"+f.user.ID); !errors.Is(err, port.ErrUserNotFound) {
+		t.Fatalf("delete missing user error = %v, want ErrUserNotFound", err)
+	}
+}
+
+func TestExportEmulator(t *testing.T) {
+	repo, store := newEmulatorStores(t)
+	f := seedUser(t, repo, store)
+
+	export, err := store.Export(context.Background(), f.user.ID)
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
+	if export.User.ID != f.user.ID || len(export.Identities) != 1 {
+		t.Fatalf("export user = %+v, identities = %+v", export.User, export.Identities)
+	}
+	if len(export.Orders) != 1 || export.Orders[0].ID != f.orderID {
+		t.Fatalf("export orders = %+v", export.Orders)
+	}
+	if len(export.Memberships) != 1 || export.Memberships[0].SheetID != f.sheetID {
+		t.Fatalf("export memberships = %+v", export.Memberships)
+	}
+	if len(export.Payments) != 1 || export.Payments[0].Note != "cash" {
+		t.Fatalf("export payments = %+v", export.Payments)
+	}
+
+	if _, err := store.Export(context.Background(), "missing-"+f.user.ID); !errors.Is(err, port.ErrUserNotFound) {
+		t.Fatalf("export missing user error = %v, want ErrUserNotFound", err)
+	}
+}
+
+func TestEraseEmulator(t *testing.T) {
+	repo, store := newEmulatorStores(t)
+	f := seedUser(t, repo, store)
+	ctx := context.Background()
+	userID := f.user.ID
+
+	if err := store.Erase(ctx, userID); !errors.Is(err, port.ErrUserNotDeleted) {
+		t.Fatalf("erase before delete error = %v, want ErrUserNotDeleted", err)
+	}
+	if _, err := repo.SoftDelete(ctx, userID); err != nil {
+		t.Fatalf("soft delete: %v", err)
+	}
+	if err := store.Erase(ctx, userID); err != nil {
+		t.Fatalf("erase: %v", err)
+	}
+	// A second run finds nothing left to do
+	if err := store.Erase(ctx, userID); err != nil {
+		t.Fatalf("erase again: %v", err)
+	}
+
+	get := func(ref *firestore.DocumentRef) *firestore.DocumentSnapshot {
+		t.Helper()
+		snap, err := ref.Get(ctx)
+		if err != nil {
+			t.Fatalf("get %s: %v", ref.Path, err)
+		}
+		return snap
+	}
+
+	user, err := userFromSnapshot(get(store.users.Doc(userID)))
+	if err != nil {
+		t.Fatalf("user: %v", err)
+	}
+	if user.ErasedAt == nil || user.Email != "" || user.Name != "" || user.Phone != "" {
+		t.Fatalf("erased user = %+v", user)
+	}
+
+	var order domain.Order
+	if err := get(store.orders.Doc(f.orderID)).DataTo(&order); err != nil {
+		t.Fatalf("order: %v", err)
+	}
+	anonID := order.UserID
+	if !strings.HasPrefix(anonID, domain.ErasedUserPrefix) || order.Note != "" {
+		t.Fatalf("erased order = %+v", order)
+	}
+
+	if _, err := store.changes.Doc(f.orderChange).Get(ctx); err == nil {
+		t.Fatal("order change record kept")
+	}
+
+	var invite domain.SheetInvite
+	if err := get(store.invites.Doc(f.inviteCode)).DataTo(&invite); err != nil {
+		t.Fatalf("invite: %v", err)
+	}
+	if invite.CreatedBy != anonID {
+		t.Fatalf("invite created by %s, want %s", invite.CreatedBy, anonID)
+	}
+
+	// Published events are gone, pending ones name the pseudonym
+	if _, err := store.outbox.Doc(f.publishedEvent).Get(ctx); err == nil {
+		t.Fatal("published event kept")
+	}
+	var pending domain.Event
+	if err := get(store.outbox.Doc(f.pendingEvent)).DataTo(&pending); err != nil {
+		t.Fatalf("pending event: %v", err)
+	}
+	var payload domain.Order
+	if err := json.Unmarshal([]byte(pending.Payload), &payload); err != nil {
+		t.Fatalf("pending payload: %v", err)
+	}
+	if payload.UserID != anonID || payload.Note != "" || strings.Contains(strings.Join(pending.UserIDs, ","), userID) {
+		t.Fatalf("pending event = %+v", pending)
+	}
+
+	for _, id := range []string{f.auditEventByUser, f.auditOfOther} {
+		var event domain.AuditEvent
+		if err := get(store.auditEvents.Doc(id)).DataTo(&event); err != nil {
+			t.Fatalf("audit event: %v", err)
+		}
+		if b, _ := json.Marshal(event); strings.Contains(string(b), userID) || strings.Contains(strings.Join(event.UserIDs, ","), userID) {
+			t.Fatalf("audit event still names the user: %+v", event)
+		}
+	}
+
+	// Only the erasure itself is still recorded against the account
+	left, err := store.auditEvents.Where("user_ids", "array-contains", userID).Documents(ctx).GetAll()
+	if err != nil {
+		t.Fatalf("list audit events: %v", err)
+	}
+	for _, snap := range left {
+		if snap.Data()["resource_id"] != userID {
+			t.Fatalf("audit event %s still names the user", snap.Ref.ID)
+		}
+	}
+}
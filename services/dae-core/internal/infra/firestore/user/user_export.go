@@ -0,0 +1,120 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"github.com/deni12345/dae-services/services/dae-core/internal/pricing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Export collects the user document, identities, sheet memberships, orders
+// and payments of one user
+func (s *userDataStore) Export(ctx context.Context, userID string) (*domain.UserDataExport, error) {
+	ctx, span := tracer.Start(ctx, "UserDataStore.Export")
+	defer span.End()
+
+	userSnap, err := s.users.Doc(userID).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, port.ErrUserNotFound
+		}
+		span.RecordError(err)
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+	user, err := userFromSnapshot(userSnap)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	out := &domain.UserDataExport{
+		ExportedAt:  time.Now().UTC(),
+		User:        user,
+		Identities:  []*domain.UserIdentity{},
+		Memberships: []*domain.SheetMember{},
+		Orders:      []*domain.Order{},
+		Payments:    []*domain.Payment{},
+	}
+
+	identities, err := s.users.Doc(userID).Collection("identities").
+		OrderBy("linked_at", firestore.Asc).
+		Documents(ctx).GetAll()
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("list identities: %w", err)
+	}
+	for _, snap := range identities {
+		var identity domain.UserIdentity
+		if err := snap.DataTo(&identity); err != nil {
+			span.RecordError(err)
+			return nil, fmt.Errorf("unmarshal identity: %w", err)
+		}
+		identity.ID = snap.Ref.ID
+		out.Identities = append(out.Identities, &identity)
+	}
+
+	members, err := s.client.CollectionGroup("members").
+		Where("user_id", "==", userID).
+		OrderBy("joined_at", firestore.Desc).
+		Documents(ctx).GetAll()
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("list memberships: %w", err)
+	}
+	for _, snap := range members {
+		var member domain.SheetMember
+		if err := snap.DataTo(&member); err != nil {
+			span.RecordError(err)
+			return nil, fmt.Errorf("unmarshal member: %w", err)
+		}
+		member.SheetID = snap.Ref.Parent.Parent.ID
+		member.UserID = userID
+		out.Memberships = append(out.Memberships, &member)
+	}
+
+	orders, err := s.orders.Where("user_id", "==", userID).Documents(ctx).GetAll()
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("list orders: %w", err)
+	}
+	for _, snap := range orders {
+		var order domain.Order
+		if err := snap.DataTo(&order); err != nil {
+			span.RecordError(err)
+			return nil, fmt.Errorf("unmarshal order: %w", err)
+		}
+		order.ID = snap.Ref.ID
+		pricing.Upgrade(&order)
+		out.Orders = append(out.Orders, &order)
+	}
+	sort.Slice(out.Orders, func(i, j int) bool {
+		return out.Orders[i].CreatedAt.Before(out.Orders[j].CreatedAt)
+	})
+
+	payments, err := s.payments.Where("user_id", "==", userID).Documents(ctx).GetAll()
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("list payments: %w", err)
+	}
+	for _, snap := range payments {
+		var payment domain.Payment
+		if err := snap.DataTo(&payment); err != nil {
+			span.RecordError(err)
+			return nil, fmt.Errorf("unmarshal payment: %w", err)
+		}
+		payment.ID = snap.Ref.ID
+		out.Payments = append(out.Payments, &payment)
+	}
+	sort.Slice(out.Payments, func(i, j int) bool {
+		return out.Payments[i].CreatedAt.Before(out.Payments[j].CreatedAt)
+	})
+
+	return out, nil
+}
@@ -5,6 +5,9 @@ import (
 	"fmt"
 
 	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (r *userRepo) GetByID(ctx context.Context, id string) (*domain.User, error) {
@@ -13,6 +16,9 @@ func (r *userRepo) GetByID(ctx context.Context, id string) (*domain.User, error)
 
 	doc, err := r.collection.Doc(id).Get(ctx)
 	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, port.ErrUserNotFound
+		}
 		span.RecordError(err)
 		return nil, fmt.Errorf("get user by id: %w", err)
 	}
@@ -28,23 +34,28 @@ func (r *userRepo) GetByID(ctx context.Context, id string) (*domain.User, error)
 	return &user, nil
 }
 
+// GetByEmail resolves a normalized email through unique_emails
 func (r *userRepo) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
 	ctx, span := tracer.Start(ctx, "UserRepo.GetByEmail")
 	defer span.End()
 
-	doc, err := r.collection.Where("Email", "==", email).Limit(1).Documents(ctx).Next()
+	snap, err := r.client.Collection("unique_emails").Doc(email).Get(ctx)
 	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, port.ErrUserNotFound
+		}
 		span.RecordError(err)
-		return nil, fmt.Errorf("get user by email: %w", err)
+		return nil, fmt.Errorf("get unique email: %w", err)
 	}
 
-	var user domain.User
-	if err := doc.DataTo(&user); err != nil {
+	var unique domain.UniqueEmail
+	if err := snap.DataTo(&unique); err != nil {
 		span.RecordError(err)
-		return nil, fmt.Errorf("data to user: %w", err)
+		return nil, fmt.Errorf("unmarshal unique email: %w", err)
 	}
-	if user.ID == "" {
-		user.ID = doc.Ref.ID
+	if unique.UserID == "" {
+		return nil, port.ErrUserNotFound
 	}
-	return &user, nil
+
+	return r.GetByID(ctx, unique.UserID)
 }
@@ -0,0 +1,227 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/audit"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetUserIDByIdentity resolves a provider subject through unique_identities
+func (r *userRepo) GetUserIDByIdentity(ctx context.Context, provider domain.IdentityProvider, subject string) (string, error) {
+	ctx, span := tracer.Start(ctx, "UserRepo.GetUserIDByIdentity")
+	defer span.End()
+
+	identityKey := fmt.Sprintf("%s:%s", provider, subject)
+	snap, err := r.client.Collection("unique_identities").Doc(identityKey).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return "", port.ErrIdentityNotFound
+		}
+		span.RecordError(err)
+		return "", fmt.Errorf("get unique identity: %w", err)
+	}
+
+	var identity domain.UniqueIdentity
+	if err := snap.DataTo(&identity); err != nil {
+		span.RecordError(err)
+		return "", fmt.Errorf("unmarshal unique identity: %w", err)
+	}
+	if identity.UserID == "" {
+		return "", port.ErrIdentityNotFound
+	}
+
+	return identity.UserID, nil
+}
+
+// LinkIdentity adds a login method to an existing user. The identity, its
+// unique_identities entry and, for the local provider, the password are
+// written in one transaction.
+func (r *userRepo) LinkIdentity(ctx context.Context, req port.LinkIdentityRequest) (*domain.UserIdentity, error) {
+	ctx, span := tracer.Start(ctx, "UserRepo.LinkIdentity")
+	defer span.End()
+
+	now := time.Now().UTC()
+	identity := &domain.UserIdentity{
+		ID:            string(req.Provider),
+		UserID:        req.UserID,
+		Provider:      req.Provider,
+		Subject:       req.Subject,
+		EmailAtSignup: req.Email,
+		LinkedAt:      now,
+	}
+
+	err := r.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		userRef := r.collection.Doc(req.UserID)
+		if _, err := tx.Get(userRef); err != nil {
+			if status.Code(err) == codes.NotFound {
+				return port.ErrUserNotFound
+			}
+			return fmt.Errorf("get user: %w", err)
+		}
+
+		// One identity per provider per user
+		identityRef := userRef.Collection("identities").Doc(string(req.Provider))
+		identitySnap, err := tx.Get(identityRef)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return fmt.Errorf("get identity: %w", err)
+		}
+		if identitySnap.Exists() {
+			return port.ErrProviderLinked
+		}
+
+		identityKey := fmt.Sprintf("%s:%s", req.Provider, req.Subject)
+		uniqueIdentityRef := r.client.Collection("unique_identities").Doc(identityKey)
+		uniqueIdentitySnap, err := tx.Get(uniqueIdentityRef)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return fmt.Errorf("check identity uniqueness: %w", err)
+		}
+		if uniqueIdentitySnap.Exists() {
+			return port.ErrIdentityTaken
+		}
+
+		if err := tx.Create(identityRef, identity); err != nil {
+			return fmt.Errorf("create identity: %w", err)
+		}
+		uniqueIdentity := &domain.UniqueIdentity{
+			UserID:    req.UserID,
+			CreatedAt: now,
+		}
+		if err := tx.Create(uniqueIdentityRef, uniqueIdentity); err != nil {
+			return fmt.Errorf("create unique identity: %w", err)
+		}
+
+		changes := audit.Prefix("identities."+string(req.Provider), audit.Diff(nil, identity))
+		if req.Provider == domain.IdentityProviderLocal {
+			changes = append(changes, domain.AuditChange{Field: "password_hash", After: domain.AuditRedacted})
+		}
+		if err := audit.Write(ctx, tx, r.client, domain.AuditResourceUser, req.UserID, changes); err != nil {
+			return err
+		}
+
+		if req.Provider == domain.IdentityProviderLocal {
+			return tx.Update(userRef, []firestore.Update{
+				{Path: "password_hash", Value: req.PasswordHash},
+				{Path: "password_algo", Value: req.PasswordAlgo},
+				{Path: "password_updated_at", Value: now},
+				{Path: "updated_at", Value: now},
+			})
+		}
+		return nil
+	})
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("link identity transaction: %w", err)
+	}
+
+	return identity, nil
+}
+
+// UnlinkIdentity removes a login method from a user, refusing to remove the
+// last one. Unlinking the local provider also clears the password.
+func (r *userRepo) UnlinkIdentity(ctx context.Context, userID string, provider domain.IdentityProvider) error {
+	ctx, span := tracer.Start(ctx, "UserRepo.UnlinkIdentity")
+	defer span.End()
+
+	err := r.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		userRef := r.collection.Doc(userID)
+		if _, err := tx.Get(userRef); err != nil {
+			if status.Code(err) == codes.NotFound {
+				return port.ErrUserNotFound
+			}
+			return fmt.Errorf("get user: %w", err)
+		}
+
+		// Read every identity so a concurrent unlink of another provider
+		// aborts this transaction instead of leaving the user without one
+		snaps, err := tx.Documents(userRef.Collection("identities")).GetAll()
+		if err != nil {
+			return fmt.Errorf("list identities: %w", err)
+		}
+
+		var target *firestore.DocumentSnapshot
+		for _, snap := range snaps {
+			if snap.Ref.ID == string(provider) {
+				target = snap
+			}
+		}
+		if target == nil {
+			return port.ErrIdentityNotLinked
+		}
+		if len(snaps) == 1 {
+			return port.ErrLastLoginMethod
+		}
+
+		var identity domain.UserIdentity
+		if err := target.DataTo(&identity); err != nil {
+			return fmt.Errorf("unmarshal identity: %w", err)
+		}
+
+		if err := tx.Delete(target.Ref); err != nil {
+			return fmt.Errorf("delete identity: %w", err)
+		}
+		identityKey := fmt.Sprintf("%s:%s", identity.Provider, identity.Subject)
+		if err := tx.Delete(r.client.Collection("unique_identities").Doc(identityKey)); err != nil {
+			return fmt.Errorf("delete unique identity: %w", err)
+		}
+
+		changes := audit.Prefix("identities."+string(provider), audit.Diff(&identity, nil))
+		if provider == domain.IdentityProviderLocal {
+			changes = append(changes, domain.AuditChange{Field: "password_hash", Before: domain.AuditRedacted})
+		}
+		if err := audit.Write(ctx, tx, r.client, domain.AuditResourceUser, userID, changes); err != nil {
+			return err
+		}
+
+		if provider == domain.IdentityProviderLocal {
+			return tx.Update(userRef, []firestore.Update{
+				{Path: "password_hash", Value: firestore.Delete},
+				{Path: "password_algo", Value: firestore.Delete},
+				{Path: "password_updated_at", Value: firestore.Delete},
+				{Path: "updated_at", Value: time.Now().UTC()},
+			})
+		}
+		return nil
+	})
+
+	if err != nil {
+		span.RecordError(err)
+		return fmt.Errorf("unlink identity transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ListIdentities returns the user's linked identities, oldest first
+func (r *userRepo) ListIdentities(ctx context.Context, userID string) ([]*domain.UserIdentity, error) {
+	ctx, span := tracer.Start(ctx, "UserRepo.ListIdentities")
+	defer span.End()
+
+	snaps, err := r.collection.Doc(userID).Collection("identities").
+		OrderBy("linked_at", firestore.Asc).
+		Documents(ctx).GetAll()
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("list identities: %w", err)
+	}
+
+	identities := make([]*domain.UserIdentity, 0, len(snaps))
+	for _, snap := range snaps {
+		var identity domain.UserIdentity
+		if err := snap.DataTo(&identity); err != nil {
+			span.RecordError(err)
+			return nil, fmt.Errorf("unmarshal identity: %w", err)
+		}
+		identity.ID = snap.Ref.ID
+		identities = append(identities, &identity)
+	}
+
+	return identities, nil
+}
@@ -2,57 +2,151 @@ package user
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 
 	"cloud.google.com/go/firestore"
 	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
 	"github.com/deni12345/dae-services/services/dae-core/internal/port"
 	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-func (r *userRepo) List(ctx context.Context, query port.ListUserQuery) ([]*domain.User, error) {
+// maxListScan bounds how many documents one List call reads while skipping
+// users that fail the filters Firestore cannot apply. A page may come back
+// short with a NextCursor when the bound is hit.
+const maxListScan = 1000
+
+// List pages through users newest first. Ordering by created_at and then
+// document ID keeps cursors stable: users created meanwhile sort before the
+// cursor and never shift later pages.
+func (r *userRepo) List(ctx context.Context, query port.ListUserQuery) (*port.ListUsersResp, error) {
 	ctx, span := tracer.Start(ctx, "UserRepo.List")
 	defer span.End()
 
-	var resp []*domain.User
+	if query.EmailExact != "" {
+		return r.listByEmail(ctx, query)
+	}
 
-	q := r.collection.Query
-	q = q.Where("IsDisabled", "==", query.IncludeDisabled)
+	limit := int(query.Limit)
+	if limit <= 0 || limit > 1000 {
+		limit = int(r.defaultPageSize)
+	}
 
-	q = q.OrderBy(firestore.DocumentID, firestore.Desc)
-	if query.Cursor != "" {
-		q = q.StartAfter(query.Cursor)
+	// Firestore allows one array-contains per query; a search takes it and the
+	// role is then checked in memory. is_disabled is omitted when false, so
+	// it is always checked in memory.
+	q := r.collection.Query
+	switch {
+	case query.Search != "":
+		q = q.Where("search_prefixes", "array-contains", domain.SearchKey(query.Search))
+	case query.Role != "":
+		q = q.Where("roles", "array-contains", query.Role)
 	}
+	if query.Status != "" {
+		q = q.Where("status", "==", query.Status)
+	}
+	q = q.OrderBy("created_at", firestore.Desc).
+		OrderBy(firestore.DocumentID, firestore.Desc).
+		Limit(maxListScan)
 
-	limit := query.Limit
-	if limit <= 0 || limit > 1000 {
-		limit = r.defaultPageSize
+	if query.Cursor != "" {
+		cursorSnap, err := r.collection.Doc(query.Cursor).Get(ctx)
+		if err != nil {
+			span.RecordError(err)
+			if status.Code(err) == codes.NotFound {
+				return nil, fmt.Errorf("user %s: %w", query.Cursor, port.ErrInvalidUserCursor)
+			}
+			return nil, fmt.Errorf("get cursor user: %w", err)
+		}
+		q = q.StartAfter(cursorSnap)
 	}
-	q = q.Limit(int(limit))
 
 	iter := q.Documents(ctx)
 	defer iter.Stop()
 
+	resp := &port.ListUsersResp{}
+	var lastScanned string
+	scanned := 0
 	for {
 		doc, err := iter.Next()
-		if err == iterator.Done {
+		if errors.Is(err, iterator.Done) {
 			break
 		}
 		if err != nil {
 			span.RecordError(err)
-			return resp, fmt.Errorf("list users: %w", err)
+			return nil, fmt.Errorf("list users: %w", err)
 		}
+		scanned++
+		lastScanned = doc.Ref.ID
 
-		var user domain.User
-		if err := doc.DataTo(&user); err != nil {
+		user, err := userFromSnapshot(doc)
+		if err != nil {
 			span.RecordError(err)
-			return resp, fmt.Errorf("data to user: %w", err)
+			return nil, err
+		}
+		if !matchesQuery(user, query) {
+			continue
 		}
-		if user.ID == "" {
-			user.ID = doc.Ref.ID
+		if len(resp.Users) == limit {
+			// One more match exists, so the page is full
+			resp.NextCursor = resp.Users[limit-1].ID
+			return resp, nil
 		}
-		resp = append(resp, &user)
+		resp.Users = append(resp.Users, user)
 	}
 
+	if scanned == maxListScan {
+		resp.NextCursor = lastScanned
+	}
 	return resp, nil
 }
+
+// listByEmail resolves the exact email and applies the remaining filters
+func (r *userRepo) listByEmail(ctx context.Context, query port.ListUserQuery) (*port.ListUsersResp, error) {
+	if query.Cursor != "" {
+		return &port.ListUsersResp{}, nil
+	}
+
+	user, err := r.GetByEmail(ctx, query.EmailExact)
+	if errors.Is(err, port.ErrUserNotFound) {
+		return &port.ListUsersResp{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !matchesQuery(user, query) {
+		return &port.ListUsersResp{}, nil
+	}
+	return &port.ListUsersResp{Users: []*domain.User{user}}, nil
+}
+
+// matchesQuery applies every filter of the query to a loaded user
+func matchesQuery(u *domain.User, query port.ListUserQuery) bool {
+	if u.IsDisabled && !query.IncludeDisabled {
+		return false
+	}
+	if query.Search != "" && !u.MatchesSearch(query.Search) {
+		return false
+	}
+	if query.Role != "" && !slices.Contains(u.Roles, query.Role) {
+		return false
+	}
+	if query.Status != "" && u.Status != query.Status {
+		return false
+	}
+	return true
+}
+
+func userFromSnapshot(doc *firestore.DocumentSnapshot) (*domain.User, error) {
+	var user domain.User
+	if err := doc.DataTo(&user); err != nil {
+		return nil, fmt.Errorf("data to user: %w", err)
+	}
+	if user.ID == "" {
+		user.ID = doc.Ref.ID
+	}
+	return &user, nil
+}
@@ -0,0 +1,136 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+)
+
+// createUser stores a user named name whose email starts with token
+func createUser(t *testing.T, repo *userRepo, token, name string) *domain.User {
+	t.Helper()
+	user, err := repo.Create(context.Background(), port.CreateUserRequest{
+		Email:    fmt.Sprintf("%s.%d@example.com", token, time.Now().UnixNano()),
+		Name:     name,
+		Provider: domain.IdentityProviderGoogle,
+		Subject:  fmt.Sprintf("sub-%s-%d", token, time.Now().UnixNano()),
+	})
+	if err != nil {
+		t.Fatalf("create %s: %v", name, err)
+	}
+	return user
+}
+
+func userIDs(users []*domain.User) []string {
+	ids := make([]string, len(users))
+	for i, u := range users {
+		ids[i] = u.ID
+	}
+	return ids
+}
+
+func TestListEmulator(t *testing.T) {
+	repo, _ := newEmulatorStores(t)
+	ctx := context.Background()
+
+	// token keeps this run's users apart from those of earlier runs
+	token := fmt.Sprintf("zq%d", time.Now().UnixNano())
+	ann := createUser(t, repo, token, "Ann "+token)
+	bob := createUser(t, repo, token, "Bob "+token)
+	cat := createUser(t, repo, token, "Cat "+token)
+	if _, err := repo.SetRoles(ctx, bob.ID, []domain.Role{domain.RoleUser, domain.RoleHost}); err != nil {
+		t.Fatalf("set roles: %v", err)
+	}
+	if _, err := repo.SetDisabled(ctx, cat.ID, true); err != nil {
+		t.Fatalf("disable: %v", err)
+	}
+
+	list := func(q port.ListUserQuery) *port.ListUsersResp {
+		t.Helper()
+		resp, err := repo.List(ctx, q)
+		if err != nil {
+			t.Fatalf("list %+v: %v", q, err)
+		}
+		return resp
+	}
+
+	tests := map[string]struct {
+		query port.ListUserQuery
+		want  []string
+	}{
+		"search, newest first":    {port.ListUserQuery{Search: token}, []string{bob.ID, ann.ID}},
+		"search with disabled":    {port.ListUserQuery{Search: token, IncludeDisabled: true}, []string{cat.ID, bob.ID, ann.ID}},
+		"search and role":         {port.ListUserQuery{Search: token, Role: domain.RoleHost}, []string{bob.ID}},
+		"search and status":       {port.ListUserQuery{Search: token, Status: domain.UserStatusSuspended}, nil},
+		"exact email":             {port.ListUserQuery{EmailExact: ann.EmailNormalized}, []string{ann.ID}},
+		"exact email of disabled": {port.ListUserQuery{EmailExact: cat.EmailNormalized}, nil},
+	}
+	for name, tc := range tests {
+		if got := userIDs(list(tc.query).Users); !slices.Equal(got, tc.want) {
+			t.Errorf("%s: users = %v, want %v", name, got, tc.want)
+		}
+	}
+
+	// A user created between pages sorts before the cursor and shifts nothing
+	first := list(port.ListUserQuery{Search: token, IncludeDisabled: true, Limit: 1})
+	if got := userIDs(first.Users); !slices.Equal(got, []string{cat.ID}) || first.NextCursor != cat.ID {
+		t.Fatalf("first page = %v, cursor %q", got, first.NextCursor)
+	}
+	createUser(t, repo, token, "Dan "+token)
+	second := list(port.ListUserQuery{Search: token, IncludeDisabled: true, Limit: 2, Cursor: first.NextCursor})
+	if got := userIDs(second.Users); !slices.Equal(got, []string{bob.ID, ann.ID}) || second.NextCursor != "" {
+		t.Fatalf("second page = %v, cursor %q, want [bob ann] and no cursor", got, second.NextCursor)
+	}
+
+	if _, err := repo.List(ctx, port.ListUserQuery{Cursor: "missing-" + token}); !errors.Is(err, port.ErrInvalidUserCursor) {
+		t.Fatalf("missing cursor error = %v, want ErrInvalidUserCursor", err)
+	}
+}
+
+func TestBackfillSearchPrefixesEmulator(t *testing.T) {
+	repo, _ := newEmulatorStores(t)
+	ctx := context.Background()
+
+	token := fmt.Sprintf("zb%d", time.Now().UnixNano())
+	user := createUser(t, repo, token, "Eve "+token)
+
+	// As stored before search existed
+	if _, err := repo.collection.Doc(user.ID).Update(ctx, []firestore.Update{{Path: "search_prefixes", Value: firestore.Delete}}); err != nil {
+		t.Fatalf("strip prefixes: %v", err)
+	}
+	if resp, _ := repo.List(ctx, port.ListUserQuery{Search: token}); len(resp.Users) != 0 {
+		t.Fatalf("legacy user found before the backfill: %v", userIDs(resp.Users))
+	}
+
+	backfill := func() int {
+		t.Helper()
+		total, after := 0, ""
+		for {
+			last, updated, err := repo.BackfillSearchPrefixes(ctx, after, 100)
+			if err != nil {
+				t.Fatalf("backfill: %v", err)
+			}
+			total += updated
+			if last == "" {
+				return total
+			}
+			after = last
+		}
+	}
+	if updated := backfill(); updated < 1 {
+		t.Fatalf("backfill updated %d users, want the legacy one", updated)
+	}
+	if resp, _ := repo.List(ctx, port.ListUserQuery{Search: token}); !slices.Equal(userIDs(resp.Users), []string{user.ID}) {
+		t.Fatalf("search after backfill = %v, want %s", userIDs(resp.Users), user.ID)
+	}
+	if updated := backfill(); updated != 0 {
+		t.Fatalf("second backfill updated %d users, want 0", updated)
+	}
+}
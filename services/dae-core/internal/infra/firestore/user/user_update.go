@@ -3,9 +3,11 @@ package user
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"cloud.google.com/go/firestore"
+	"github.com/deni12345/dae-services/services/dae-core/internal/audit"
 	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -42,6 +44,8 @@ func (r *userRepo) Update(ctx context.Context, id string, fn func(u *domain.User
 			return err
 		}
 
+		cur.SearchPrefixes = cur.BuildSearchPrefixes()
+
 		// Build diff
 		updates := buildUserDiff(before, cur)
 		if len(updates) == 0 {
@@ -62,6 +66,9 @@ func (r *userRepo) Update(ctx context.Context, id string, fn func(u *domain.User
 			}
 			return fmt.Errorf("update user: %w", err)
 		}
+		if err := audit.Write(ctx, tx, r.client, domain.AuditResourceUser, id, audit.Changes(before, cur, updates)); err != nil {
+			return err
+		}
 
 		out = &cur
 		return nil
@@ -71,6 +78,7 @@ func (r *userRepo) Update(ctx context.Context, id string, fn func(u *domain.User
 		span.RecordError(err)
 		return nil, err
 	}
+
 	return out, nil
 }
 
@@ -84,9 +92,35 @@ func buildUserDiff(before, after domain.User) []firestore.Update {
 	if before.AvatarURL != after.AvatarURL {
 		updates = append(updates, firestore.Update{Path: "avatar_url", Value: after.AvatarURL})
 	}
+	if !slices.Equal(before.SearchPrefixes, after.SearchPrefixes) {
+		updates = append(updates, firestore.Update{Path: "search_prefixes", Value: after.SearchPrefixes})
+	}
 	if before.IsDisabled != after.IsDisabled {
 		updates = append(updates, firestore.Update{Path: "is_disabled", Value: after.IsDisabled})
 	}
+	if after.PasswordHash != nil && (before.PasswordHash == nil || *before.PasswordHash != *after.PasswordHash) {
+		updates = append(updates,
+			firestore.Update{Path: "password_hash", Value: *after.PasswordHash},
+			firestore.Update{Path: "password_algo", Value: *after.PasswordAlgo},
+			firestore.Update{Path: "password_updated_at", Value: *after.PasswordUpdatedAt},
+		)
+	}
+	if resetChanged(before, after) {
+		if after.PasswordResetHash == nil {
+			updates = append(updates,
+				firestore.Update{Path: "password_reset_hash", Value: firestore.Delete},
+				firestore.Update{Path: "password_reset_expires_at", Value: firestore.Delete},
+			)
+		} else {
+			updates = append(updates,
+				firestore.Update{Path: "password_reset_hash", Value: *after.PasswordResetHash},
+				firestore.Update{Path: "password_reset_expires_at", Value: *after.PasswordResetExpiresAt},
+			)
+		}
+	}
+	if after.LastLoginAt != nil && (before.LastLoginAt == nil || !before.LastLoginAt.Equal(*after.LastLoginAt)) {
+		updates = append(updates, firestore.Update{Path: "last_login_at", Value: *after.LastLoginAt})
+	}
 
 	// Compare roles
 	if len(before.Roles) != len(after.Roles) {
@@ -108,6 +142,14 @@ func buildUserDiff(before, after domain.User) []firestore.Update {
 	return updates
 }
 
+// resetChanged reports whether a password reset token was issued or cleared
+func resetChanged(before, after domain.User) bool {
+	if before.PasswordResetHash == nil || after.PasswordResetHash == nil {
+		return before.PasswordResetHash != after.PasswordResetHash
+	}
+	return *before.PasswordResetHash != *after.PasswordResetHash
+}
+
 // SetRoles updates user roles (admin operation)
 func (r *userRepo) SetRoles(ctx context.Context, userID string, roles []domain.Role) (*domain.User, error) {
 	return r.Update(ctx, userID, func(u *domain.User) error {
@@ -0,0 +1,43 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+)
+
+var _ port.EventPublisher = (*EventPublisher)(nil)
+
+// EventPublisher is an in-memory port.EventPublisher for tests and local runs.
+// Like the Redis publisher it ignores event IDs it has already seen.
+type EventPublisher struct {
+	mu     sync.Mutex
+	seen   map[string]bool
+	events []domain.Event // in publish order
+}
+
+func NewEventPublisher() *EventPublisher {
+	return &EventPublisher{seen: make(map[string]bool)}
+}
+
+func (p *EventPublisher) Publish(_ context.Context, event *domain.Event) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.seen[event.ID] {
+		return nil
+	}
+	p.seen[event.ID] = true
+	p.events = append(p.events, *event)
+	return nil
+}
+
+// Events returns copies of the published events in publish order
+func (p *EventPublisher) Events() []domain.Event {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]domain.Event(nil), p.events...)
+}
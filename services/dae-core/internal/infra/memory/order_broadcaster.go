@@ -0,0 +1,175 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+)
+
+var _ port.OrderChangeSource = (*OrderBroadcaster)(nil)
+
+// OrderBroadcaster is an in-memory port.OrderChangeSource. Published events
+// are fanned out to the watchers of the order's sheet and the last backlog
+// events per sheet are retained so watchers can resume from a cursor.
+type OrderBroadcaster struct {
+	mu       sync.Mutex
+	seq      uint64
+	backlog  int
+	history  map[string][]domain.OrderEvent
+	evicted  map[string]uint64 // per sheet, sequence of the newest event dropped from history
+	watchers map[string]map[*orderWatcher]struct{}
+}
+
+func NewOrderBroadcaster(backlog int) *OrderBroadcaster {
+	if backlog <= 0 {
+		backlog = 100
+	}
+	return &OrderBroadcaster{
+		backlog:  backlog,
+		history:  make(map[string][]domain.OrderEvent),
+		evicted:  make(map[string]uint64),
+		watchers: make(map[string]map[*orderWatcher]struct{}),
+	}
+}
+
+// Publish records an event for the order's sheet and delivers it to watchers
+func (b *OrderBroadcaster) Publish(eventType domain.OrderEventType, order *domain.Order) domain.OrderEvent {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.seq++
+	ev := domain.OrderEvent{
+		Type:       eventType,
+		Order:      order,
+		Cursor:     strconv.FormatUint(b.seq, 10),
+		OccurredAt: time.Now().UTC(),
+	}
+
+	events := append(b.history[order.SheetID], ev)
+	if len(events) > b.backlog {
+		b.evicted[order.SheetID] = eventSeq(events[len(events)-b.backlog-1])
+		events = events[len(events)-b.backlog:]
+	}
+	b.history[order.SheetID] = events
+
+	for w := range b.watchers[order.SheetID] {
+		w.push(ev)
+	}
+
+	return ev
+}
+
+func (b *OrderBroadcaster) Watch(ctx context.Context, sheetID string, cursor string, fn func(ev *domain.OrderEvent) error) error {
+	w := &orderWatcher{notify: make(chan struct{}, 1)}
+
+	b.mu.Lock()
+	replay, err := b.replayLocked(sheetID, cursor)
+	if err != nil {
+		b.mu.Unlock()
+		return err
+	}
+	for _, ev := range replay {
+		w.push(ev)
+	}
+	if b.watchers[sheetID] == nil {
+		b.watchers[sheetID] = make(map[*orderWatcher]struct{})
+	}
+	b.watchers[sheetID][w] = struct{}{}
+	b.mu.Unlock()
+
+	defer func() {
+		b.mu.Lock()
+		delete(b.watchers[sheetID], w)
+		if len(b.watchers[sheetID]) == 0 {
+			delete(b.watchers, sheetID)
+		}
+		b.mu.Unlock()
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-w.notify:
+			for _, ev := range w.drain() {
+				if err := fn(&ev); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+func (b *OrderBroadcaster) LatestCursor(_ context.Context, sheetID string) (string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	events := b.history[sheetID]
+	if len(events) == 0 {
+		return "", nil
+	}
+	return events[len(events)-1].Cursor, nil
+}
+
+// replayLocked returns the retained events of a sheet that come after cursor
+func (b *OrderBroadcaster) replayLocked(sheetID string, cursor string) ([]domain.OrderEvent, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+
+	after, err := strconv.ParseUint(cursor, 10, 64)
+	if err != nil || after > b.seq {
+		return nil, fmt.Errorf("decode cursor %q: %w", cursor, port.ErrInvalidCursor)
+	}
+
+	// Events right after the cursor have already been dropped from history
+	if after < b.evicted[sheetID] {
+		return nil, fmt.Errorf("cursor %q expired: %w", cursor, port.ErrInvalidCursor)
+	}
+
+	var out []domain.OrderEvent
+	for _, ev := range b.history[sheetID] {
+		if eventSeq(ev) > after {
+			out = append(out, ev)
+		}
+	}
+	return out, nil
+}
+
+func eventSeq(ev domain.OrderEvent) uint64 {
+	seq, _ := strconv.ParseUint(ev.Cursor, 10, 64)
+	return seq
+}
+
+// orderWatcher queues events for one Watch call so a slow consumer never
+// blocks Publish
+type orderWatcher struct {
+	mu      sync.Mutex
+	pending []domain.OrderEvent
+	notify  chan struct{}
+}
+
+func (w *orderWatcher) push(ev domain.OrderEvent) {
+	w.mu.Lock()
+	w.pending = append(w.pending, ev)
+	w.mu.Unlock()
+
+	select {
+	case w.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (w *orderWatcher) drain() []domain.OrderEvent {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	events := w.pending
+	w.pending = nil
+	return events
+}
@@ -0,0 +1,111 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+)
+
+var errStop = errors.New("stop")
+
+// collect watches sheetID from cursor and returns the first n events received
+func collect(t *testing.T, b *OrderBroadcaster, sheetID, cursor string, n int) ([]domain.OrderEvent, error) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	var got []domain.OrderEvent
+	err := b.Watch(ctx, sheetID, cursor, func(ev *domain.OrderEvent) error {
+		got = append(got, *ev)
+		if len(got) == n {
+			return errStop
+		}
+		return nil
+	})
+	if errors.Is(err, errStop) {
+		err = nil
+	}
+	return got, err
+}
+
+func TestOrderBroadcasterResume(t *testing.T) {
+	b := NewOrderBroadcaster(3)
+	first := b.Publish(domain.OrderEventCreated, &domain.Order{ID: "o1", SheetID: "s1"})
+	b.Publish(domain.OrderEventCreated, &domain.Order{ID: "x1", SheetID: "s2"})
+	b.Publish(domain.OrderEventUpdated, &domain.Order{ID: "o1", SheetID: "s1"})
+	b.Publish(domain.OrderEventCancelled, &domain.Order{ID: "o1", SheetID: "s1"})
+
+	tests := map[string]struct {
+		cursor  string
+		want    []domain.OrderEventType
+		wantErr error
+	}{
+		"from start":   {cursor: "0", want: []domain.OrderEventType{domain.OrderEventCreated, domain.OrderEventUpdated, domain.OrderEventCancelled}},
+		"after first":  {cursor: first.Cursor, want: []domain.OrderEventType{domain.OrderEventUpdated, domain.OrderEventCancelled}},
+		"malformed":    {cursor: "abc", wantErr: port.ErrInvalidCursor},
+		"from future":  {cursor: "99", wantErr: port.ErrInvalidCursor},
+		"other sheets": {cursor: "1", want: []domain.OrderEventType{domain.OrderEventUpdated, domain.OrderEventCancelled}},
+	}
+
+	for name, tc := range tests {
+		got, err := collect(t, b, "s1", tc.cursor, len(tc.want))
+		if !errors.Is(err, tc.wantErr) {
+			t.Fatalf("%s: Watch error = %v, want %v", name, err, tc.wantErr)
+		}
+		if len(got) != len(tc.want) {
+			t.Fatalf("%s: got %d events, want %d", name, len(got), len(tc.want))
+		}
+		for i, ev := range got {
+			if ev.Type != tc.want[i] {
+				t.Fatalf("%s: event %d type = %s, want %s", name, i, ev.Type, tc.want[i])
+			}
+		}
+	}
+}
+
+func TestOrderBroadcasterExpiredCursor(t *testing.T) {
+	b := NewOrderBroadcaster(2)
+	first := b.Publish(domain.OrderEventCreated, &domain.Order{ID: "o1", SheetID: "s1"})
+	b.Publish(domain.OrderEventCreated, &domain.Order{ID: "o2", SheetID: "s1"})
+	b.Publish(domain.OrderEventCreated, &domain.Order{ID: "o3", SheetID: "s1"})
+	b.Publish(domain.OrderEventCreated, &domain.Order{ID: "o4", SheetID: "s1"})
+
+	if _, err := collect(t, b, "s1", first.Cursor, 1); !errors.Is(err, port.ErrInvalidCursor) {
+		t.Fatalf("Watch error = %v, want %v", err, port.ErrInvalidCursor)
+	}
+}
+
+func TestOrderBroadcasterLive(t *testing.T) {
+	b := NewOrderBroadcaster(10)
+	b.Publish(domain.OrderEventCreated, &domain.Order{ID: "old", SheetID: "s1"})
+
+	done := make(chan []domain.OrderEvent)
+	go func() {
+		got, err := collect(t, b, "s1", "", 1)
+		if err != nil {
+			t.Errorf("Watch error = %v", err)
+		}
+		done <- got
+	}()
+
+	// Publish until the watcher has registered and received a live event
+	for i := 0; ; i++ {
+		b.Publish(domain.OrderEventCreated, &domain.Order{ID: "new", SheetID: "s1"})
+		select {
+		case got := <-done:
+			if len(got) != 1 || got[0].Order.ID != "new" {
+				t.Fatalf("got %+v, want a single live event for order new", got)
+			}
+			return
+		case <-time.After(10 * time.Millisecond):
+		}
+		if i > 100 {
+			t.Fatal("watcher never received a live event")
+		}
+	}
+}
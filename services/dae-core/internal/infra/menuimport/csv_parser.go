@@ -0,0 +1,236 @@
+package menuimport
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+)
+
+// CSV uploads have a header row and one row per option. Rows with the same
+// item (by item_id, or item_name when no ID is given) are merged, and so are
+// rows with the same group of an item. Item and group attributes are read
+// from the first row that mentions them.
+var csvColumns = []string{
+	"item_id", "item_name", "item_price", "item_currency", "item_available",
+	"group_id", "group_name", "group_required", "group_multi_select", "group_min_select", "group_max_select",
+	"option_id", "option_name", "option_price", "option_available", "option_max_quantity",
+}
+
+var csvRequiredColumns = []string{"item_name", "item_price", "item_currency"}
+
+type csvParser struct{}
+
+// NewCSVParser creates a parser for CSV menu uploads
+func NewCSVParser() port.MenuParser {
+	return csvParser{}
+}
+
+func (csvParser) Format() domain.MenuImportFormat {
+	return domain.MenuImportCSV
+}
+
+func (csvParser) Parse(data []byte) (*domain.MenuImport, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("csv menu is empty")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read csv header: %w", err)
+	}
+	columns, err := csvHeader(header)
+	if err != nil {
+		return nil, err
+	}
+
+	n := newNormalizer()
+	var items []*rawItem
+	itemsByKey := make(map[string]*rawItem)
+	broken := make(map[*rawItem]bool)
+
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read csv: %w", err)
+		}
+		line, _ := r.FieldPos(0)
+		row := csvRow{line: line, record: record, columns: columns, n: n}
+
+		key := row.get("item_id")
+		if key == "" {
+			key = idOrSlug("", row.get("item_name"))
+		}
+		if key == "" {
+			key = fmt.Sprintf("#%d", line)
+		}
+
+		item, ok := itemsByKey[key]
+		if !ok {
+			item = &rawItem{
+				row:       line,
+				prefix:    "item_",
+				id:        row.get("item_id"),
+				name:      row.get("item_name"),
+				price:     row.int64("item_price"),
+				currency:  row.get("item_currency"),
+				available: row.bool("item_available"),
+			}
+			itemsByKey[key] = item
+			items = append(items, item)
+		}
+
+		if row.addTo(item) != nil || row.failed {
+			broken[item] = true
+		}
+	}
+
+	valid := make([]*rawItem, 0, len(items))
+	for _, item := range items {
+		if !broken[item] {
+			valid = append(valid, item)
+		}
+	}
+	return n.normalize(valid), nil
+}
+
+func csvHeader(header []string) (map[string]int, error) {
+	known := make(map[string]bool, len(csvColumns))
+	for _, c := range csvColumns {
+		known[c] = true
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !known[name] {
+			return nil, fmt.Errorf("unknown csv column %q", name)
+		}
+		if _, dup := columns[name]; dup {
+			return nil, fmt.Errorf("duplicate csv column %q", name)
+		}
+		columns[name] = i
+	}
+	for _, name := range csvRequiredColumns {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("missing csv column %q", name)
+		}
+	}
+	return columns, nil
+}
+
+// csvRow reads typed cells from one record and reports bad cells as import
+// errors on the row's line
+type csvRow struct {
+	line    int
+	record  []string
+	columns map[string]int
+	n       *normalizer
+	failed  bool
+}
+
+func (r *csvRow) get(column string) string {
+	i, ok := r.columns[column]
+	if !ok || i >= len(r.record) {
+		return ""
+	}
+	return strings.TrimSpace(r.record[i])
+}
+
+func (r *csvRow) int64(column string) int64 {
+	v := r.get(column)
+	if v == "" {
+		return 0
+	}
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		r.n.fail(r.line, column, "%q is not a whole number", v)
+		r.failed = true
+	}
+	return n
+}
+
+// bool reads a true/false cell; empty cells are true so that availability
+// columns can be left out
+func (r *csvRow) bool(column string) bool {
+	v := r.get(column)
+	if v == "" {
+		return true
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		r.n.fail(r.line, column, "%q is not true or false", v)
+		r.failed = true
+	}
+	return b
+}
+
+// flag reads an optional true/false cell that defaults to false
+func (r *csvRow) flag(column string) bool {
+	if r.get(column) == "" {
+		return false
+	}
+	return r.bool(column)
+}
+
+// addTo merges the row's group and option into item
+func (r *csvRow) addTo(item *rawItem) error {
+	groupID, groupName := r.get("group_id"), r.get("group_name")
+	optionID, optionName := r.get("option_id"), r.get("option_name")
+
+	if groupID == "" && groupName == "" {
+		if optionID != "" || optionName != "" {
+			r.n.fail(r.line, "group_name", "options need a group")
+			return errors.New("option without group")
+		}
+		return nil
+	}
+
+	key := idOrSlug(groupID, groupName)
+	var group *rawGroup
+	for _, g := range item.groups {
+		if idOrSlug(g.id, g.name) == key {
+			group = g
+			break
+		}
+	}
+	if group == nil {
+		group = &rawGroup{
+			row:         r.line,
+			prefix:      "group_",
+			id:          groupID,
+			name:        groupName,
+			required:    r.flag("group_required"),
+			multiSelect: r.flag("group_multi_select"),
+			minSelect:   int(r.int64("group_min_select")),
+			maxSelect:   int(r.int64("group_max_select")),
+		}
+		item.groups = append(item.groups, group)
+	}
+
+	if optionID == "" && optionName == "" {
+		return nil
+	}
+	group.options = append(group.options, &rawOption{
+		row:         r.line,
+		prefix:      "option_",
+		id:          optionID,
+		name:        optionName,
+		price:       r.int64("option_price"),
+		available:   r.bool("option_available"),
+		maxQuantity: int32(r.int64("option_max_quantity")),
+	})
+	return nil
+}
@@ -0,0 +1,127 @@
+package menuimport
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+)
+
+// jsonMenu is the generic JSON import schema:
+//
+//	{
+//	  "currency": "VND",
+//	  "items": [{
+//	    "id": "bun-bo", "name": "Bún bò", "price": 45000, "available": true,
+//	    "option_groups": [{
+//	      "name": "Size", "required": true, "multi_select": false,
+//	      "options": [{"name": "Large", "price": 10000, "max_quantity": 1}]
+//	    }]
+//	  }]
+//	}
+//
+// IDs are optional and derived from names when missing. "currency" sets the
+// default for items without their own; "available" defaults to true.
+type jsonMenu struct {
+	Currency string     `json:"currency"`
+	Items    []jsonItem `json:"items"`
+}
+
+type jsonItem struct {
+	ID           string      `json:"id"`
+	Name         string      `json:"name"`
+	Price        int64       `json:"price"`
+	Currency     string      `json:"currency"`
+	Available    *bool       `json:"available"`
+	OptionGroups []jsonGroup `json:"option_groups"`
+}
+
+type jsonGroup struct {
+	ID          string       `json:"id"`
+	Name        string       `json:"name"`
+	Required    bool         `json:"required"`
+	MultiSelect bool         `json:"multi_select"`
+	MinSelect   int          `json:"min_select"`
+	MaxSelect   int          `json:"max_select"`
+	Options     []jsonOption `json:"options"`
+}
+
+type jsonOption struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Price       int64  `json:"price"`
+	Available   *bool  `json:"available"`
+	MaxQuantity int32  `json:"max_quantity"`
+}
+
+type jsonParser struct{}
+
+// NewJSONParser creates a parser for the generic JSON menu schema
+func NewJSONParser() port.MenuParser {
+	return jsonParser{}
+}
+
+func (jsonParser) Format() domain.MenuImportFormat {
+	return domain.MenuImportJSON
+}
+
+func (jsonParser) Parse(data []byte) (*domain.MenuImport, error) {
+	var menu jsonMenu
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&menu); err != nil {
+		return nil, fmt.Errorf("decode json menu: %w", err)
+	}
+
+	items := make([]*rawItem, len(menu.Items))
+	for i, item := range menu.Items {
+		prefix := fmt.Sprintf("items[%d].", i)
+		raw := &rawItem{
+			row:       i + 1,
+			prefix:    prefix,
+			id:        item.ID,
+			name:      item.Name,
+			price:     item.Price,
+			currency:  item.Currency,
+			available: orTrue(item.Available),
+		}
+		if raw.currency == "" {
+			raw.currency = menu.Currency
+		}
+
+		for j, grp := range item.OptionGroups {
+			groupPrefix := fmt.Sprintf("%soption_groups[%d].", prefix, j)
+			rg := &rawGroup{
+				row:         i + 1,
+				prefix:      groupPrefix,
+				id:          grp.ID,
+				name:        grp.Name,
+				required:    grp.Required,
+				multiSelect: grp.MultiSelect,
+				minSelect:   grp.MinSelect,
+				maxSelect:   grp.MaxSelect,
+			}
+			for k, opt := range grp.Options {
+				rg.options = append(rg.options, &rawOption{
+					row:         i + 1,
+					prefix:      fmt.Sprintf("%soptions[%d].", groupPrefix, k),
+					id:          opt.ID,
+					name:        opt.Name,
+					price:       opt.Price,
+					available:   orTrue(opt.Available),
+					maxQuantity: opt.MaxQuantity,
+				})
+			}
+			raw.groups = append(raw.groups, rg)
+		}
+		items[i] = raw
+	}
+
+	return newNormalizer().normalize(items), nil
+}
+
+func orTrue(b *bool) bool {
+	return b == nil || *b
+}
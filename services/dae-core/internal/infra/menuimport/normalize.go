@@ -0,0 +1,243 @@
+package menuimport
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+)
+
+// rawItem, rawGroup and rawOption hold a menu as read from a payload, before
+// validation. prefix is prepended to attribute names in import errors so
+// they point at the payload's own fields (items[0].price, item_price, ...).
+type rawItem struct {
+	row       int
+	prefix    string
+	id        string
+	name      string
+	price     int64
+	currency  string
+	available bool
+	groups    []*rawGroup
+}
+
+type rawGroup struct {
+	row         int
+	prefix      string
+	id          string
+	name        string
+	required    bool
+	multiSelect bool
+	minSelect   int
+	maxSelect   int
+	options     []*rawOption
+}
+
+type rawOption struct {
+	row         int
+	prefix      string
+	id          string
+	name        string
+	price       int64
+	available   bool
+	maxQuantity int32
+}
+
+// normalizer validates raw items and turns them into domain menu items
+type normalizer struct {
+	result *domain.MenuImport
+}
+
+func newNormalizer() *normalizer {
+	return &normalizer{result: &domain.MenuImport{}}
+}
+
+func (n *normalizer) fail(row int, field, format string, args ...any) {
+	n.result.Errors = append(n.result.Errors, domain.MenuImportError{
+		Row:     row,
+		Field:   field,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// normalize validates every item and keeps the ones without errors
+func (n *normalizer) normalize(items []*rawItem) *domain.MenuImport {
+	seen := make(map[string]int, len(items))
+	for _, raw := range items {
+		item, ok := n.item(raw)
+		if !ok {
+			continue
+		}
+		if row, dup := seen[item.ID]; dup {
+			n.fail(raw.row, raw.prefix+"id", "duplicate item id %q, first used on row %d", item.ID, row)
+			continue
+		}
+		seen[item.ID] = raw.row
+		n.result.Items = append(n.result.Items, item)
+	}
+	return n.result
+}
+
+func (n *normalizer) item(raw *rawItem) (*domain.MenuItem, bool) {
+	ok := true
+	if raw.name == "" {
+		n.fail(raw.row, raw.prefix+"name", "name is required")
+		ok = false
+	}
+	if raw.price < 0 {
+		n.fail(raw.row, raw.prefix+"price", "price must not be negative")
+		ok = false
+	}
+	currency := strings.ToUpper(raw.currency)
+	if !validCurrency(currency) {
+		n.fail(raw.row, raw.prefix+"currency", "currency %q is not a 3-letter ISO code", raw.currency)
+		ok = false
+	}
+
+	groups := make(map[string]domain.OptionGroup, len(raw.groups))
+	for _, rg := range raw.groups {
+		grp, valid := n.group(rg)
+		if !valid {
+			ok = false
+			continue
+		}
+		if _, dup := groups[grp.ID]; dup {
+			n.fail(rg.row, rg.prefix+"id", "duplicate option group id %q", grp.ID)
+			ok = false
+			continue
+		}
+		groups[grp.ID] = grp
+	}
+	if !ok {
+		return nil, false
+	}
+	if len(groups) == 0 {
+		groups = nil
+	}
+
+	return &domain.MenuItem{
+		ID:           idOrSlug(raw.id, raw.name),
+		Name:         raw.name,
+		Active:       raw.available,
+		Price:        raw.price,
+		Currency:     currency,
+		OptionGroups: groups,
+	}, true
+}
+
+func (n *normalizer) group(raw *rawGroup) (domain.OptionGroup, bool) {
+	ok := true
+	if raw.name == "" {
+		n.fail(raw.row, raw.prefix+"name", "name is required")
+		ok = false
+	}
+	if raw.minSelect < 0 {
+		n.fail(raw.row, raw.prefix+"min_select", "min_select must not be negative")
+		ok = false
+	}
+	if raw.maxSelect < 0 || (raw.multiSelect && raw.maxSelect == 0) {
+		n.fail(raw.row, raw.prefix+"max_select", "max_select must be positive for multi-select groups")
+		ok = false
+	}
+	if raw.maxSelect > 0 && raw.minSelect > raw.maxSelect {
+		n.fail(raw.row, raw.prefix+"min_select", "min_select %d exceeds max_select %d", raw.minSelect, raw.maxSelect)
+		ok = false
+	}
+
+	options := make(map[string]domain.Option, len(raw.options))
+	for _, ro := range raw.options {
+		opt, valid := n.option(ro)
+		if !valid {
+			ok = false
+			continue
+		}
+		if _, dup := options[opt.ID]; dup {
+			n.fail(ro.row, ro.prefix+"id", "duplicate option id %q", opt.ID)
+			ok = false
+			continue
+		}
+		options[opt.ID] = opt
+	}
+	if !ok {
+		return domain.OptionGroup{}, false
+	}
+
+	groupType := domain.GroupSingle
+	if raw.multiSelect {
+		groupType = domain.GroupMulti
+	}
+
+	return domain.OptionGroup{
+		ID:        idOrSlug(raw.id, raw.name),
+		Name:      raw.name,
+		Type:      groupType,
+		Required:  raw.required,
+		MinSelect: raw.minSelect,
+		MaxSelect: raw.maxSelect,
+		Options:   options,
+	}, true
+}
+
+func (n *normalizer) option(raw *rawOption) (domain.Option, bool) {
+	ok := true
+	if raw.name == "" {
+		n.fail(raw.row, raw.prefix+"name", "name is required")
+		ok = false
+	}
+	if raw.price < 0 {
+		n.fail(raw.row, raw.prefix+"price", "price must not be negative")
+		ok = false
+	}
+	if raw.maxQuantity < 0 {
+		n.fail(raw.row, raw.prefix+"max_quantity", "max_quantity must not be negative")
+		ok = false
+	}
+	if !ok {
+		return domain.Option{}, false
+	}
+
+	return domain.Option{
+		ID:          idOrSlug(raw.id, raw.name),
+		Name:        raw.name,
+		Price:       raw.price,
+		Per:         domain.PerUnit,
+		Active:      raw.available,
+		MaxQuantity: raw.maxQuantity,
+	}, true
+}
+
+func validCurrency(code string) bool {
+	if len(code) != 3 {
+		return false
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// idOrSlug returns id, or a stable ID derived from name when id is empty.
+// Stable IDs keep re-imports of the same menu comparable across versions.
+func idOrSlug(id, name string) string {
+	if id != "" {
+		return id
+	}
+
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(strings.TrimSpace(name)) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+			dash = false
+			continue
+		}
+		if !dash && b.Len() > 0 {
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+	return strings.TrimSuffix(b.String(), "-")
+}
@@ -0,0 +1,128 @@
+package menuimport
+
+import (
+	"testing"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+)
+
+func TestJSONParser(t *testing.T) {
+	payload := `{
+		"currency": "vnd",
+		"items": [
+			{"name": "Bún bò Huế", "price": 45000, "option_groups": [
+				{"name": "Size", "required": true, "options": [
+					{"name": "Large", "price": 10000, "max_quantity": 1},
+					{"id": "small", "name": "Small", "available": false}
+				]}
+			]},
+			{"id": "tea", "name": "Trà đá", "price": 5000, "currency": "USD"},
+			{"name": "", "price": -1},
+			{"name": "Toppings", "price": 1000, "option_groups": [
+				{"name": "Extras", "multi_select": true}
+			]},
+			{"id": "tea", "name": "Tea again", "price": 1}
+		]
+	}`
+
+	got, err := NewJSONParser().Parse([]byte(payload))
+	if err != nil {
+		t.Fatalf("Parse error = %v", err)
+	}
+
+	if len(got.Items) != 2 {
+		t.Fatalf("items = %d, want 2", len(got.Items))
+	}
+	bun := got.Items[0]
+	if bun.ID != "bún-bò-huế" || bun.Currency != "VND" || !bun.Active {
+		t.Fatalf("item = %+v, want slug ID, VND, active", bun)
+	}
+	size, ok := bun.OptionGroups["size"]
+	if !ok || size.Type != domain.GroupSingle || !size.Required {
+		t.Fatalf("size group = %+v, want required single group", size)
+	}
+	if size.Options["large"].MaxQuantity != 1 || size.Options["small"].Active {
+		t.Fatalf("size options = %+v", size.Options)
+	}
+	if got.Items[1].Currency != "USD" {
+		t.Fatalf("tea currency = %s, want USD", got.Items[1].Currency)
+	}
+
+	wantErrors := []domain.MenuImportError{
+		{Row: 3, Field: "items[2].name"},
+		{Row: 3, Field: "items[2].price"},
+		{Row: 4, Field: "items[3].option_groups[0].max_select"},
+		{Row: 5, Field: "items[4].id"},
+	}
+	assertErrors(t, got.Errors, wantErrors)
+}
+
+func TestJSONParserRejectsMalformedPayload(t *testing.T) {
+	for name, payload := range map[string]string{
+		"syntax":        `{"items": [`,
+		"unknown field": `{"items": [{"name": "x", "cost": 1}]}`,
+	} {
+		if _, err := NewJSONParser().Parse([]byte(payload)); err == nil {
+			t.Fatalf("%s: Parse error = nil, want error", name)
+		}
+	}
+}
+
+func TestCSVParser(t *testing.T) {
+	payload := "item_name,item_price,item_currency,group_name,group_multi_select,group_max_select,option_name,option_price\n" +
+		"Cơm tấm,40000,VND,Toppings,true,2,Egg,5000\n" +
+		"Cơm tấm,,,Toppings,,,Pork,15000\n" +
+		"Trà đá,5000,VND,,,,,\n" +
+		"Bánh mì,abc,VND,,,,,\n" +
+		"Phở,50000,VND,,,,Extra beef,20000\n" +
+		"Chè,15000,VNDX,,,,,\n"
+
+	got, err := NewCSVParser().Parse([]byte(payload))
+	if err != nil {
+		t.Fatalf("Parse error = %v", err)
+	}
+
+	if len(got.Items) != 2 {
+		t.Fatalf("items = %d, want 2", len(got.Items))
+	}
+	com := got.Items[0]
+	toppings := com.OptionGroups["toppings"]
+	if com.Price != 40000 || toppings.Type != domain.GroupMulti || len(toppings.Options) != 2 {
+		t.Fatalf("merged item = %+v, want both toppings in one multi group", com)
+	}
+	if toppings.Options["pork"].Price != 15000 {
+		t.Fatalf("pork price = %d, want 15000", toppings.Options["pork"].Price)
+	}
+
+	wantErrors := []domain.MenuImportError{
+		{Row: 5, Field: "item_price"},
+		{Row: 6, Field: "group_name"},
+		{Row: 7, Field: "item_currency"},
+	}
+	assertErrors(t, got.Errors, wantErrors)
+}
+
+func TestCSVParserHeader(t *testing.T) {
+	for name, payload := range map[string]string{
+		"empty":          "",
+		"missing column": "item_name,item_price\nTea,1\n",
+		"unknown column": "item_name,item_price,item_currency,color\nTea,1,VND,red\n",
+	} {
+		if _, err := NewCSVParser().Parse([]byte(payload)); err == nil {
+			t.Fatalf("%s: Parse error = nil, want error", name)
+		}
+	}
+}
+
+func assertErrors(t *testing.T, got, want []domain.MenuImportError) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("errors = %+v, want %d errors", got, len(want))
+	}
+	for i := range want {
+		if got[i].Row != want[i].Row || got[i].Field != want[i].Field {
+			t.Fatalf("error %d = %+v, want row %d field %s", i, got[i], want[i].Row, want[i].Field)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var errMalformedHash = errors.New("malformed password hash")
+
+// Argon2Params are the argon2id cost parameters
+type Argon2Params struct {
+	Memory  uint32 // KiB
+	Time    uint32
+	Threads uint8
+	SaltLen uint32
+	KeyLen  uint32
+}
+
+// DefaultArgon2Params follow the OWASP baseline for argon2id
+var DefaultArgon2Params = Argon2Params{Memory: 64 * 1024, Time: 3, Threads: 2, SaltLen: 16, KeyLen: 32}
+
+type hasher struct {
+	preferred  string
+	argon2     Argon2Params
+	bcryptCost int
+}
+
+// NewHasher hashes new passwords with the preferred algorithm. Hashes made
+// with any other algorithm, or with weaker parameters, verify but are flagged
+// for rehashing.
+func NewHasher(preferred string, params Argon2Params) (port.PasswordHasher, error) {
+	switch preferred {
+	case domain.PasswordAlgoArgon2id, domain.PasswordAlgoBcrypt:
+	default:
+		return nil, fmt.Errorf("unsupported password algorithm %q", preferred)
+	}
+
+	return &hasher{
+		preferred:  preferred,
+		argon2:     params,
+		bcryptCost: bcrypt.DefaultCost,
+	}, nil
+}
+
+func (h *hasher) Hash(password string) (string, string, error) {
+	if h.preferred == domain.PasswordAlgoBcrypt {
+		hash, err := bcrypt.GenerateFromPassword([]byte(password), h.bcryptCost)
+		if err != nil {
+			return "", "", fmt.Errorf("bcrypt: %w", err)
+		}
+		return string(hash), domain.PasswordAlgoBcrypt, nil
+	}
+
+	salt := make([]byte, h.argon2.SaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", "", fmt.Errorf("generate salt: %w", err)
+	}
+	key := argon2.IDKey([]byte(password), salt, h.argon2.Time, h.argon2.Memory, h.argon2.Threads, h.argon2.KeyLen)
+	return encodeArgon2(h.argon2, salt, key), domain.PasswordAlgoArgon2id, nil
+}
+
+func (h *hasher) Verify(hash, algo, password string) (bool, bool, error) {
+	switch algo {
+	case domain.PasswordAlgoBcrypt:
+		err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, false, nil
+		}
+		if err != nil {
+			return false, false, fmt.Errorf("bcrypt: %w", err)
+		}
+		cost, _ := bcrypt.Cost([]byte(hash))
+		return true, h.preferred != algo || cost < h.bcryptCost, nil
+
+	case domain.PasswordAlgoArgon2id:
+		params, salt, key, err := decodeArgon2(hash)
+		if err != nil {
+			return false, false, err
+		}
+		got := argon2.IDKey([]byte(password), salt, params.Time, params.Memory, params.Threads, uint32(len(key)))
+		if subtle.ConstantTimeCompare(got, key) != 1 {
+			return false, false, nil
+		}
+		weaker := params.Memory < h.argon2.Memory || params.Time < h.argon2.Time || params.Threads < h.argon2.Threads
+		return true, h.preferred != algo || weaker, nil
+
+	default:
+		return false, false, fmt.Errorf("unsupported password algorithm %q", algo)
+	}
+}
+
+// encodeArgon2 uses the PHC string format, e.g.
+// $argon2id$v=19$m=65536,t=3,p=2$<salt>$<key>
+func encodeArgon2(p Argon2Params, salt, key []byte) string {
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, p.Memory, p.Time, p.Threads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key))
+}
+
+func decodeArgon2(hash string) (Argon2Params, []byte, []byte, error) {
+	var p Argon2Params
+
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return p, nil, nil, errMalformedHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return p, nil, nil, errMalformedHash
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.Memory, &p.Time, &p.Threads); err != nil {
+		return p, nil, nil, errMalformedHash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return p, nil, nil, errMalformedHash
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return p, nil, nil, errMalformedHash
+	}
+
+	return p, salt, key, nil
+}
@@ -0,0 +1,87 @@
+package password
+
+import (
+	"testing"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// testParams keep argon2 cheap in tests
+var testParams = Argon2Params{Memory: 1024, Time: 1, Threads: 1, SaltLen: 16, KeyLen: 32}
+
+func TestHasherRoundTrip(t *testing.T) {
+	for _, algo := range []string{domain.PasswordAlgoArgon2id, domain.PasswordAlgoBcrypt} {
+		t.Run(algo, func(t *testing.T) {
+			h, err := NewHasher(algo, testParams)
+			if err != nil {
+				t.Fatalf("new hasher: %v", err)
+			}
+
+			hash, gotAlgo, err := h.Hash("correct horse")
+			if err != nil {
+				t.Fatalf("hash: %v", err)
+			}
+			if gotAlgo != algo {
+				t.Fatalf("algo = %q, want %q", gotAlgo, algo)
+			}
+
+			ok, rehash, err := h.Verify(hash, gotAlgo, "correct horse")
+			if err != nil || !ok || rehash {
+				t.Fatalf("verify = %v, %v, %v; want true, false, nil", ok, rehash, err)
+			}
+			ok, _, err = h.Verify(hash, gotAlgo, "wrong horse")
+			if err != nil || ok {
+				t.Fatalf("verify wrong password = %v, %v; want false, nil", ok, err)
+			}
+		})
+	}
+}
+
+func TestHasherFlagsOutdatedHashes(t *testing.T) {
+	h, err := NewHasher(domain.PasswordAlgoArgon2id, testParams)
+	if err != nil {
+		t.Fatalf("new hasher: %v", err)
+	}
+
+	legacy, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("bcrypt: %v", err)
+	}
+	ok, rehash, err := h.Verify(string(legacy), domain.PasswordAlgoBcrypt, "secret")
+	if err != nil || !ok || !rehash {
+		t.Fatalf("bcrypt hash: verify = %v, %v, %v; want true, true, nil", ok, rehash, err)
+	}
+
+	weak := &hasher{preferred: domain.PasswordAlgoArgon2id, argon2: Argon2Params{Memory: 512, Time: 1, Threads: 1, SaltLen: 8, KeyLen: 16}}
+	hash, _, err := weak.Hash("secret")
+	if err != nil {
+		t.Fatalf("hash: %v", err)
+	}
+	ok, rehash, err = h.Verify(hash, domain.PasswordAlgoArgon2id, "secret")
+	if err != nil || !ok || !rehash {
+		t.Fatalf("weak argon2 hash: verify = %v, %v, %v; want true, true, nil", ok, rehash, err)
+	}
+}
+
+func TestHasherRejectsMalformedHashes(t *testing.T) {
+	h, err := NewHasher(domain.PasswordAlgoArgon2id, testParams)
+	if err != nil {
+		t.Fatalf("new hasher: %v", err)
+	}
+
+	for name, hash := range map[string]string{
+		"empty":       "",
+		"wrong algo":  "$argon2i$v=19$m=1024,t=1,p=1$c2FsdA$a2V5",
+		"bad params":  "$argon2id$v=19$m=x,t=1,p=1$c2FsdA$a2V5",
+		"bad version": "$argon2id$v=16$m=1024,t=1,p=1$c2FsdA$a2V5",
+		"no key":      "$argon2id$v=19$m=1024,t=1,p=1$c2FsdA$",
+	} {
+		if ok, _, err := h.Verify(hash, domain.PasswordAlgoArgon2id, "secret"); err == nil || ok {
+			t.Fatalf("%s: verify = %v, %v; want error", name, ok, err)
+		}
+	}
+	if _, _, err := h.Verify("x", "md5", "secret"); err == nil {
+		t.Fatalf("unknown algo: want error")
+	}
+}
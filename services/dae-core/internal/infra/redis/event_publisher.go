@@ -0,0 +1,61 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"github.com/redis/go-redis/v9"
+)
+
+// luaPublishOnce adds the event to the stream unless its dedup key is already
+// set, so a relay retry after a lost acknowledgement does not add it twice.
+var luaPublishOnce = redis.NewScript(`
+if redis.call("SET", KEYS[1], "1", "NX", "PX", ARGV[1]) == false then
+	return 0
+end
+redis.call("XADD", KEYS[2], "MAXLEN", "~", ARGV[2], "*",
+	"id", ARGV[3], "type", ARGV[4], "aggregate_type", ARGV[5],
+	"aggregate_id", ARGV[6], "payload", ARGV[7], "occurred_at", ARGV[8])
+return 1
+`)
+
+// StreamConfig controls where events are published
+type StreamConfig struct {
+	Stream   string
+	MaxLen   int64         // approximate stream length kept
+	DedupTTL time.Duration // how long a published event ID is remembered
+}
+
+type eventPublisher struct {
+	client *redis.Client
+	cfg    StreamConfig
+}
+
+// NewEventPublisher publishes events to a Redis stream
+func NewEventPublisher(client *redis.Client, cfg StreamConfig) port.EventPublisher {
+	return &eventPublisher{
+		client: client,
+		cfg:    cfg,
+	}
+}
+
+func (p *eventPublisher) Publish(ctx context.Context, event *domain.Event) error {
+	keys := []string{fmt.Sprintf("events:published:%s", event.ID), p.cfg.Stream}
+	args := []any{
+		p.cfg.DedupTTL.Milliseconds(),
+		p.cfg.MaxLen,
+		event.ID,
+		string(event.Type),
+		event.AggregateType,
+		event.AggregateID,
+		event.Payload,
+		event.OccurredAt.UTC().Format(time.RFC3339Nano),
+	}
+	if err := luaPublishOnce.Run(ctx, p.client, keys, args...).Err(); err != nil {
+		return fmt.Errorf("publish event: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+var luaExtendIfMatch = redis.NewScript(`
+if redis.call("Get", KEYS[1]) == ARGV[1] then
+	return redis.call("PExpire", KEYS[1], ARGV[2])
+else
+	return 0
+end
+`)
+
+type locker struct {
+	client *redis.Client
+}
+
+func NewLocker(client *redis.Client) port.Locker {
+	return &locker{
+		client: client,
+	}
+}
+
+func (l *locker) TryDo(ctx context.Context, key string, ttl time.Duration, fn func(ctx context.Context) error) (bool, error) {
+	lockKey := fmt.Sprintf("lock:%s", key)
+	token := uuid.NewString()
+
+	acquired, err := l.client.SetNX(ctx, lockKey, token, ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("redis setnx: %w", err)
+	}
+	if !acquired {
+		return false, nil
+	}
+	defer unlockIfMatch(context.WithoutCancel(ctx), l.client, lockKey, token)
+
+	// Keep the lock while fn runs longer than ttl. Once it cannot be kept
+	// another replica may take it, so fn is told to stop.
+	fnCtx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		ticker := time.NewTicker(ttl / 2)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-fnCtx.Done():
+				return
+			case <-done:
+				return
+			case <-ticker.C:
+				kept, err := luaExtendIfMatch.Run(fnCtx, l.client, []string{lockKey}, token, ttl.Milliseconds()).Int64()
+				if err != nil || kept == 0 {
+					cancel(port.ErrLockLost)
+					return
+				}
+			}
+		}
+	}()
+
+	err = fn(fnCtx)
+	if errors.Is(context.Cause(fnCtx), port.ErrLockLost) {
+		return true, errors.Join(port.ErrLockLost, err)
+	}
+	return true, err
+}
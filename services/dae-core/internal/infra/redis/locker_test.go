@@ -0,0 +1,101 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"github.com/redis/go-redis/v9"
+)
+
+// newTestClient connects to the Redis named by REDIS_ADDR, e.g. the one
+// started by docker-compose, and skips the test when none is configured
+func newTestClient(t *testing.T) *redis.Client {
+	t.Helper()
+	addr := os.Getenv("REDIS_ADDR")
+	if addr == "" {
+		t.Skip("REDIS_ADDR not set")
+	}
+
+	client := redis.NewClient(&redis.Options{Addr: addr, Password: os.Getenv("REDIS_PASSWORD")})
+	t.Cleanup(func() { _ = client.Close() })
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		t.Fatalf("ping redis: %v", err)
+	}
+	return client
+}
+
+func TestTryDoSkipsWhileHeld(t *testing.T) {
+	l := NewLocker(newTestClient(t))
+	ctx := context.Background()
+	key := fmt.Sprintf("test-held-%d", time.Now().UnixNano())
+
+	ran, err := l.TryDo(ctx, key, time.Second, func(ctx context.Context) error {
+		inner, err := l.TryDo(ctx, key, time.Second, func(context.Context) error {
+			t.Fatalf("second holder ran")
+			return nil
+		})
+		if inner || err != nil {
+			t.Fatalf("second TryDo = %v, %v, want skipped", inner, err)
+		}
+		return nil
+	})
+	if !ran || err != nil {
+		t.Fatalf("TryDo = %v, %v, want ran", ran, err)
+	}
+
+	// Released after fn returns
+	ran, err = l.TryDo(ctx, key, time.Second, func(context.Context) error { return nil })
+	if !ran || err != nil {
+		t.Fatalf("TryDo after release = %v, %v, want ran", ran, err)
+	}
+}
+
+func TestTryDoKeepsLockPastTTL(t *testing.T) {
+	client := newTestClient(t)
+	l := NewLocker(client)
+	key := fmt.Sprintf("test-extend-%d", time.Now().UnixNano())
+
+	ran, err := l.TryDo(context.Background(), key, 200*time.Millisecond, func(ctx context.Context) error {
+		time.Sleep(500 * time.Millisecond)
+		if n, err := client.Exists(ctx, "lock:"+key).Result(); err != nil || n != 1 {
+			t.Fatalf("lock exists = %d, %v after ttl, want kept", n, err)
+		}
+		return ctx.Err()
+	})
+	if !ran || err != nil {
+		t.Fatalf("TryDo = %v, %v, want ran", ran, err)
+	}
+}
+
+func TestTryDoCancelsWhenLockLost(t *testing.T) {
+	client := newTestClient(t)
+	l := NewLocker(client)
+	key := fmt.Sprintf("test-lost-%d", time.Now().UnixNano())
+
+	ran, err := l.TryDo(context.Background(), key, 200*time.Millisecond, func(ctx context.Context) error {
+		// Another replica takes over the lock
+		if err := client.Set(ctx, "lock:"+key, "someone-else", time.Minute).Err(); err != nil {
+			t.Fatalf("steal lock: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+			t.Fatalf("fn was not cancelled after losing the lock")
+			return nil
+		}
+	})
+	if !ran || !errors.Is(err, port.ErrLockLost) {
+		t.Fatalf("TryDo = %v, %v, want ErrLockLost", ran, err)
+	}
+
+	// The new holder's lock is left alone
+	if v, _ := client.Get(context.Background(), "lock:"+key).Result(); v != "someone-else" {
+		t.Fatalf("lock = %q, want the new holder's", v)
+	}
+}
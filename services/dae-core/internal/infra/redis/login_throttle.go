@@ -0,0 +1,98 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/port"
+	"github.com/redis/go-redis/v9"
+)
+
+// ThrottleConfig bounds password attempts per key
+type ThrottleConfig struct {
+	MaxAttempts   int64 // attempts allowed per AttemptWindow
+	AttemptWindow time.Duration
+	MaxFailures   int64 // failures within FailureWindow that trigger a lockout
+	FailureWindow time.Duration
+	Lockout       time.Duration
+}
+
+type loginThrottle struct {
+	client *redis.Client
+	cfg    ThrottleConfig
+}
+
+func NewLoginThrottle(client *redis.Client, cfg ThrottleConfig) port.LoginThrottle {
+	return &loginThrottle{
+		client: client,
+		cfg:    cfg,
+	}
+}
+
+func (t *loginThrottle) Allow(ctx context.Context, key string) (time.Duration, error) {
+	lockKey := fmt.Sprintf("login:lock:%s", key)
+	locked, err := t.client.PTTL(ctx, lockKey).Result()
+	if err != nil {
+		return 0, fmt.Errorf("check lockout: %w", err)
+	}
+	if locked > 0 {
+		return locked, nil
+	}
+
+	rateKey := fmt.Sprintf("login:rate:%s", key)
+	n, err := t.incrWithin(ctx, rateKey, t.cfg.AttemptWindow)
+	if err != nil {
+		return 0, fmt.Errorf("count attempt: %w", err)
+	}
+	if n <= t.cfg.MaxAttempts {
+		return 0, nil
+	}
+
+	wait, err := t.client.PTTL(ctx, rateKey).Result()
+	if err != nil || wait <= 0 {
+		wait = t.cfg.AttemptWindow
+	}
+	return wait, nil
+}
+
+func (t *loginThrottle) Fail(ctx context.Context, key string) (time.Duration, error) {
+	failKey := fmt.Sprintf("login:fail:%s", key)
+	n, err := t.incrWithin(ctx, failKey, t.cfg.FailureWindow)
+	if err != nil {
+		return 0, fmt.Errorf("count failure: %w", err)
+	}
+	if n < t.cfg.MaxFailures {
+		return 0, nil
+	}
+
+	pipe := t.client.TxPipeline()
+	pipe.Set(ctx, fmt.Sprintf("login:lock:%s", key), 1, t.cfg.Lockout)
+	pipe.Del(ctx, failKey)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, fmt.Errorf("lock out: %w", err)
+	}
+	return t.cfg.Lockout, nil
+}
+
+func (t *loginThrottle) Reset(ctx context.Context, key string) error {
+	err := t.client.Del(ctx,
+		fmt.Sprintf("login:fail:%s", key),
+		fmt.Sprintf("login:lock:%s", key),
+	).Err()
+	if err != nil {
+		return fmt.Errorf("reset login throttle: %w", err)
+	}
+	return nil
+}
+
+// incrWithin increments a counter that expires window after its first hit
+func (t *loginThrottle) incrWithin(ctx context.Context, key string, window time.Duration) (int64, error) {
+	pipe := t.client.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.ExpireNX(ctx, key, window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return incr.Val(), nil
+}
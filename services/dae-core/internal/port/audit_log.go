@@ -0,0 +1,33 @@
+package port
+
+import (
+	"context"
+	"errors"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+)
+
+// ErrInvalidAuditCursor is returned by List when the cursor event is gone
+var ErrInvalidAuditCursor = errors.New("invalid audit cursor")
+
+// ListAuditQuery filters audit events, newest first. ResourceID is only
+// matched together with ResourceType.
+type ListAuditQuery struct {
+	Limit        int32
+	Cursor       string // ID of the last event of the previous page
+	ResourceType domain.AuditResourceType
+	ResourceID   string
+	ActorID      string
+}
+
+type ListAuditEventsResp struct {
+	Events     []*domain.AuditEvent
+	NextCursor string
+}
+
+// AuditLog reads the audit trail of state-changing operations. Events are
+// written by the repositories with audit.Write, in the transaction of the
+// change they describe. They are only rewritten to erase a user.
+type AuditLog interface {
+	List(ctx context.Context, query ListAuditQuery) (*ListAuditEventsResp, error)
+}
@@ -0,0 +1,20 @@
+package port
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrLockLost is returned by TryDo when the lock could not be kept while fn
+// ran, after cancelling fn's context
+var ErrLockLost = errors.New("lock lost")
+
+// Locker serializes work across replicas
+type Locker interface {
+	// TryDo runs fn while holding the lock key and reports whether it ran.
+	// When another holder has the lock, fn is skipped. The lock expires after
+	// ttl if its holder dies without releasing it; if the holder cannot keep
+	// it, fn's context is cancelled and TryDo returns ErrLockLost.
+	TryDo(ctx context.Context, key string, ttl time.Duration, fn func(ctx context.Context) error) (bool, error)
+}
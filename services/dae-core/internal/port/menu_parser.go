@@ -0,0 +1,11 @@
+package port
+
+import "github.com/deni12345/dae-services/services/dae-core/internal/domain"
+
+// MenuParser normalizes an external menu payload into domain menu items.
+// Problems with single rows are reported in MenuImport.Errors; an error is
+// returned only when the payload cannot be read at all.
+type MenuParser interface {
+	Format() domain.MenuImportFormat
+	Parse(data []byte) (*domain.MenuImport, error)
+}
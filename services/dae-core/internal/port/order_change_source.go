@@ -0,0 +1,23 @@
+package port
+
+import (
+	"context"
+	"errors"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+)
+
+// ErrInvalidCursor is returned by Watch when the resume cursor is malformed
+// or points to events the source no longer retains
+var ErrInvalidCursor = errors.New("invalid order event cursor")
+
+// OrderChangeSource delivers order change events for a sheet
+type OrderChangeSource interface {
+	// Watch calls fn for every order event of the sheet until ctx is done or fn
+	// returns an error. When cursor is set, events that happened after it are
+	// replayed before live events; an empty cursor starts from now.
+	Watch(ctx context.Context, sheetID string, cursor string, fn func(ev *domain.OrderEvent) error) error
+	// LatestCursor returns the cursor of the sheet's latest retained event, or
+	// "" when there is none. It changes whenever an order of the sheet does.
+	LatestCursor(ctx context.Context, sheetID string) (string, error)
+}
@@ -2,13 +2,20 @@ package port
 
 import (
 	"context"
+	"time"
 
 	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
 )
 
+// ListOrdersQuery filters orders newest first. With UpdatedSince set they are
+// ordered by updated_at instead of created_at.
 type ListOrdersQuery struct {
-	Limit  int32
-	Cursor string
+	Limit        int32
+	Cursor       string
+	SheetID      string // optional; all sheets when empty
+	UserID       string // optional
+	Status       domain.OrderStatus
+	UpdatedSince time.Time // zero for no bound
 }
 
 // OrdersRepo defines the interface for persisting and retrieving orders
@@ -17,4 +24,13 @@ type OrdersRepo interface {
 	Update(ctx context.Context, id string, fn func(o *domain.Order) error) (*domain.Order, error)
 	GetByID(ctx context.Context, id string) (*domain.Order, error)
 	List(ctx context.Context, query ListOrdersQuery) ([]*domain.Order, error)
+	// ListBySheet returns every order of a sheet
+	ListBySheet(ctx context.Context, sheetID string) ([]*domain.Order, error)
+	// ListByUser returns every order a user placed, across all sheets
+	ListByUser(ctx context.Context, userID string) ([]*domain.Order, error)
+	// BackfillStatuses stores the pending status on up to limit orders after
+	// afterID in ID order that were written without one. It returns the last
+	// ID read, "" when none were left, and how many orders it rewrote. limit
+	// must keep two writes per order within a transaction.
+	BackfillStatuses(ctx context.Context, afterID string, limit int) (string, int, error)
 }
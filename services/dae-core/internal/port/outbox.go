@@ -0,0 +1,31 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+)
+
+// Outbox is read by the relay. Events are added by the repositories inside
+// the transaction of the change that raised them.
+type Outbox interface {
+	// Pending returns up to limit unpublished events, oldest first
+	Pending(ctx context.Context, limit int) ([]*domain.Event, error)
+	MarkPublished(ctx context.Context, id string) error
+	// MarkFailed counts a failed publish and keeps the event pending
+	MarkFailed(ctx context.Context, id string, cause error) error
+	// DeadLetter counts a failed publish and moves the event out of the
+	// pending queue into the dead letters, where it is kept for inspection
+	DeadLetter(ctx context.Context, id string, cause error) error
+	// DeletePublished deletes up to limit events published before before and
+	// returns how many it deleted
+	DeletePublished(ctx context.Context, before time.Time, limit int) (int, error)
+}
+
+// EventPublisher delivers events to downstream consumers. Implementations
+// skip event IDs they have already published where they can; delivery is at
+// least once, so consumers still drop duplicates by ID.
+type EventPublisher interface {
+	Publish(ctx context.Context, event *domain.Event) error
+}
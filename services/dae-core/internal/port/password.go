@@ -0,0 +1,27 @@
+package port
+
+import (
+	"context"
+	"time"
+)
+
+// PasswordHasher hashes passwords with the preferred algorithm and verifies
+// hashes made by any supported one
+type PasswordHasher interface {
+	Hash(password string) (hash, algo string, err error)
+	// Verify reports whether password matches, and whether the hash should be
+	// replaced because it uses an outdated algorithm or parameters
+	Verify(hash, algo, password string) (ok, rehash bool, err error)
+}
+
+// LoginThrottle rate limits password attempts per key and locks a key out
+// after repeated failures
+type LoginThrottle interface {
+	// Allow records an attempt. It returns how long the caller must wait when
+	// the key is locked out or over its rate limit, zero otherwise.
+	Allow(ctx context.Context, key string) (time.Duration, error)
+	// Fail records a failed attempt and returns the lockout it triggered, if any
+	Fail(ctx context.Context, key string) (time.Duration, error)
+	// Reset clears failures and any lockout after a successful attempt
+	Reset(ctx context.Context, key string) error
+}
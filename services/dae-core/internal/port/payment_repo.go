@@ -0,0 +1,17 @@
+package port
+
+import (
+	"context"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+)
+
+// PaymentsRepo defines the interface for persisting members' payments to sheet hosts
+type PaymentsRepo interface {
+	// Save stores payment as the newest record of payment.UserID on
+	// payment.SheetID and returns it with its ID and sequence set. Earlier
+	// records are kept.
+	Save(ctx context.Context, payment *domain.Payment) (*domain.Payment, error)
+	ListBySheet(ctx context.Context, sheetID string) ([]*domain.Payment, error)
+	ListByUser(ctx context.Context, userID string) ([]*domain.Payment, error)
+}
@@ -0,0 +1,27 @@
+package port
+
+import (
+	"context"
+	"errors"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+)
+
+var (
+	ErrSheetInviteNotFound = errors.New("sheet invite not found")
+	// ErrInviteCodeTaken is returned by Create when the code is already in use
+	ErrInviteCodeTaken = errors.New("invite code already in use")
+)
+
+// SheetInviteRepo stores sheet invites, keyed by their normalized code
+type SheetInviteRepo interface {
+	Create(ctx context.Context, invite *domain.SheetInvite) (*domain.SheetInvite, error)
+	GetByCode(ctx context.Context, code string) (*domain.SheetInvite, error)
+	Update(ctx context.Context, code string, fn func(invite *domain.SheetInvite) error) (*domain.SheetInvite, error)
+	// Redeem adds userID to the invite's sheet with the invite's role and
+	// counts the use, in one transaction. check runs inside the transaction
+	// before anything is written and can refuse the redemption. A user who is
+	// already a member gets their membership back and uses nothing; joined
+	// reports whether the user was added.
+	Redeem(ctx context.Context, code, userID string, check func(invite *domain.SheetInvite, sheet *domain.Sheet) error) (member *domain.SheetMember, joined bool, err error)
+}
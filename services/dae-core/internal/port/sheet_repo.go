@@ -2,10 +2,22 @@ package port
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
 )
 
+var (
+	// ErrMenuItemNotFound is returned by GetMenuItemByID when the sheet has no such item
+	ErrMenuItemNotFound = errors.New("menu item not found")
+	// ErrMenuVersionNotFound is returned by GetMenuVersion when the sheet has no such menu
+	ErrMenuVersionNotFound = errors.New("menu version not found")
+	// ErrTooManyPendingOrders is returned by Update when a sheet has more
+	// pending orders than one close can confirm
+	ErrTooManyPendingOrders = errors.New("too many pending orders to close sheet")
+)
+
 type ListSheetsQuery struct {
 	Limit  int32
 	Cursor string
@@ -23,22 +35,50 @@ type ListSheetsForUserResp struct {
 	NextCursor string
 }
 
+type ListMembersQuery struct {
+	SheetID string
+	Limit   int32
+	Cursor  string // user ID of the last member of the previous page
+}
+
+type ListMembersResp struct {
+	Members    []*domain.SheetMember
+	NextCursor string
+}
+
 // SheetRepo defines the interface for persisting and retrieving sheets
 type SheetRepo interface {
 	GetByID(ctx context.Context, id string) (*domain.Sheet, error)
-	Create(ctx context.Context, sheet *domain.Sheet) (*domain.Sheet, error)
+	// Create writes the sheet, its members subcollection and, when menuItems
+	// is not empty, its first menu version menuID atomically, with the audit
+	// event, the created event and a joined event per member
+	Create(ctx context.Context, sheet *domain.Sheet, menuID string, menuItems []*domain.MenuItem) (*domain.Sheet, error)
+	// Update applies fn in a transaction. Closing a sheet confirms its
+	// pending orders in that same transaction.
 	Update(ctx context.Context, id string, fn func(sheet *domain.Sheet) error) (*domain.Sheet, error)
 	List(ctx context.Context, query ListSheetsQuery) ([]*domain.Sheet, error)
 	ListForUser(ctx context.Context, query ListSheetsForUserQuery) (*ListSheetsForUserResp, error)
+	// ListScheduleDue returns sheets whose opening or closing time has passed
+	// without the transition having been made
+	ListScheduleDue(ctx context.Context, now time.Time, limit int) ([]*domain.Sheet, error)
 
-	AddMember(ctx context.Context, sheetID string, userID string) error
+	// AddMember is idempotent and returns the stored membership
+	AddMember(ctx context.Context, sheetID string, userID string) (*domain.SheetMember, error)
 	RemoveMember(ctx context.Context, sheetID string, userID string) error
 	ListMemberIDs(ctx context.Context, sheetID string) ([]string, error)
+	// ListMembers pages through the members subcollection, oldest first
+	ListMembers(ctx context.Context, query ListMembersQuery) (*ListMembersResp, error)
 
 	// Menu Items by Sheet ID
 	GetMenuItems(ctx context.Context, sheetID string) ([]*domain.MenuItem, error)
 	// Menu particular Item by ID
 	GetMenuItemByID(ctx context.Context, sheetID string, id string) (*domain.MenuItem, error)
 
-	AttachMenuItems(ctx context.Context, sheetID string, menuItems []*domain.MenuItem) error
+	// ReplaceMenu swaps the whole menu for menuItems, stores them as the
+	// immutable menu version menuID and makes it the sheet's active menu.
+	// Replacing with a menuID that is already stored changes nothing
+	ReplaceMenu(ctx context.Context, sheetID string, menuID string, menuItems []*domain.MenuItem) (*domain.Sheet, error)
+	GetMenuVersion(ctx context.Context, sheetID string, menuID string) (*domain.MenuVersion, error)
+	// ListMenuVersions returns every menu version of a sheet without items, newest first
+	ListMenuVersions(ctx context.Context, sheetID string) ([]*domain.MenuVersion, error)
 }
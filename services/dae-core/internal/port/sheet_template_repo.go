@@ -0,0 +1,37 @@
+package port
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+)
+
+var (
+	ErrSheetTemplateNotFound      = errors.New("sheet template not found")
+	ErrInvalidSheetTemplateCursor = errors.New("invalid sheet template cursor")
+)
+
+type ListSheetTemplatesQuery struct {
+	HostUserID string
+	Limit      int32
+	Cursor     string // ID of the last template of the previous page
+}
+
+type ListSheetTemplatesResp struct {
+	Templates  []*domain.SheetTemplate
+	NextCursor string
+}
+
+// SheetTemplateRepo stores recurring sheet templates
+type SheetTemplateRepo interface {
+	Create(ctx context.Context, template *domain.SheetTemplate) (*domain.SheetTemplate, error)
+	GetByID(ctx context.Context, id string) (*domain.SheetTemplate, error)
+	Update(ctx context.Context, id string, fn func(template *domain.SheetTemplate) error) (*domain.SheetTemplate, error)
+	Delete(ctx context.Context, id string) error
+	// List pages through a host's templates, oldest first
+	List(ctx context.Context, query ListSheetTemplatesQuery) (*ListSheetTemplatesResp, error)
+	// ListDue returns up to limit templates whose next run is at or before now
+	ListDue(ctx context.Context, now time.Time, limit int) ([]*domain.SheetTemplate, error)
+}
@@ -0,0 +1,27 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+)
+
+// UserDataStore reads and erases a user's data across every collection that
+// refers to them
+type UserDataStore interface {
+	Export(ctx context.Context, userID string) (*domain.UserDataExport, error)
+
+	// Erase strips the personal data of a soft-deleted user. Orders, sheet
+	// memberships and payments are moved to a pseudonymous user ID and lose
+	// their free-text notes; amounts are left untouched. Templates the user
+	// hosted move to the pseudonym paused. The invites they created and the
+	// pending outbox and audit events naming them move to the pseudonym;
+	// published outbox events naming them are deleted. Erase is safe to
+	// retry after a partial failure.
+	Erase(ctx context.Context, userID string) error
+
+	// ListErasable returns up to limit users deleted before the given time
+	// and not yet erased, oldest deletion first
+	ListErasable(ctx context.Context, deletedBefore time.Time, limit int) ([]string, error)
+}
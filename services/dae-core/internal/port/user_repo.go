@@ -2,14 +2,48 @@ package port
 
 import (
 	"context"
+	"errors"
 
 	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
 )
 
+// ErrIdentityNotFound is returned by GetUserIDByIdentity when no user is linked
+// to the provider subject
+var ErrIdentityNotFound = errors.New("identity not found")
+
+// ErrUserNotFound is returned when no user has the requested ID or email
+var ErrUserNotFound = errors.New("user not found")
+
+// Identity link errors
+var (
+	ErrIdentityTaken     = errors.New("identity is linked to a user")
+	ErrProviderLinked    = errors.New("user already has an identity for the provider")
+	ErrLastLoginMethod   = errors.New("cannot remove the last login method")
+	ErrIdentityNotLinked = errors.New("provider is not linked to the user")
+)
+
+// ErrUserNotDeleted is returned by Erase for a user that was not soft-deleted
+var ErrUserNotDeleted = errors.New("user is not deleted")
+
+// ErrInvalidUserCursor is returned by List when the cursor user is gone
+var ErrInvalidUserCursor = errors.New("invalid user cursor")
+
+// ListUserQuery filters users, newest first. EmailExact is resolved through
+// unique_emails; Search matches word prefixes of the name, display name and
+// email. Both are normalized by the caller.
 type ListUserQuery struct {
 	Limit           int32
-	Cursor          string
+	Cursor          string // ID of the last user of the previous page
 	IncludeDisabled bool
+	EmailExact      string
+	Search          string
+	Role            domain.Role
+	Status          domain.UserStatus
+}
+
+type ListUsersResp struct {
+	Users      []*domain.User
+	NextCursor string
 }
 
 type UpdateUserRequest struct {
@@ -27,7 +61,22 @@ type CreateUserRequest struct {
 	Phone       string
 	Provider    domain.IdentityProvider
 	Subject     string
-	Password    string // Only for local provider
+
+	// Set for the local provider, hashed by the usecase
+	PasswordHash string
+	PasswordAlgo string
+}
+
+// LinkIdentityRequest adds a login method to an existing user. Linking the
+// local provider also sets the user's password.
+type LinkIdentityRequest struct {
+	UserID   string
+	Provider domain.IdentityProvider
+	Subject  string
+	Email    string
+
+	PasswordHash string
+	PasswordAlgo string
 }
 
 type UsersRepo interface {
@@ -36,11 +85,23 @@ type UsersRepo interface {
 	GetByID(ctx context.Context, id string) (*domain.User, error)
 	GetByEmail(ctx context.Context, email string) (*domain.User, error)
 	Update(ctx context.Context, id string, fn func(u *domain.User) error) (*domain.User, error)
-	List(ctx context.Context, query ListUserQuery) ([]*domain.User, error)
+	List(ctx context.Context, query ListUserQuery) (*ListUsersResp, error)
+	// SoftDelete marks the user deleted, releases their email and identity
+	// reservations and pauses or leaves their sheet templates. Deleting a
+	// deleted user returns it unchanged.
+	SoftDelete(ctx context.Context, id string) (*domain.User, error)
+	// BackfillSearchPrefixes rebuilds the search prefixes of up to limit
+	// users after afterID in ID order and writes those that changed. It
+	// returns the last ID read, "" when none were left, and how many users
+	// it rewrote. limit must keep two writes per user within a transaction.
+	BackfillSearchPrefixes(ctx context.Context, afterID string, limit int) (string, int, error)
 
 	// Identity management
-	CreateIdentity(ctx context.Context, userID string, identity *domain.UserIdentity) error
+	LinkIdentity(ctx context.Context, req LinkIdentityRequest) (*domain.UserIdentity, error)
+	UnlinkIdentity(ctx context.Context, userID string, provider domain.IdentityProvider) error
+	ListIdentities(ctx context.Context, userID string) ([]*domain.UserIdentity, error)
 	GetIdentityByProvider(ctx context.Context, userID string, provider domain.IdentityProvider) (*domain.UserIdentity, error)
+	GetUserIDByIdentity(ctx context.Context, provider domain.IdentityProvider, subject string) (string, error)
 
 	// Uniqueness checks (for transactions)
 	CheckEmailUnique(ctx context.Context, email string) (bool, error)
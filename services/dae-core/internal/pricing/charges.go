@@ -0,0 +1,110 @@
+package pricing
+
+import (
+	"math/bits"
+	"sort"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+)
+
+// SheetCharges splits the sheet's delivery fee and percentage discount
+// across the given orders and sets each order's shares and total.
+//
+// The discount is taken off the combined subtotal, rounded half up, and
+// divided in proportion to subtotals. The fee is divided equally or in
+// proportion to subtotals depending on the sheet's split mode. Both are
+// allocated in minor units with the largest remainder method, ties going to
+// the smaller order ID, so per-order totals always add up to the sheet total.
+//
+// Cancelled orders take no part in the split: they get no shares and their
+// total is their subtotal.
+func SheetCharges(sheet *domain.Sheet, orders []*domain.Order) error {
+	sorted := make([]*domain.Order, 0, len(orders))
+	for _, o := range orders {
+		if o.IsCancelled() {
+			o.FeeShare = domain.NewMoney(0, o.Subtotal.CurrencyCode)
+			o.DiscountShare = domain.NewMoney(0, o.Subtotal.CurrencyCode)
+			Total(o)
+			continue
+		}
+		sorted = append(sorted, o)
+	}
+	if len(sorted) == 0 {
+		return nil
+	}
+
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ID < sorted[j].ID })
+
+	currency := sheet.DeliveryFee.CurrencyCode
+	var subtotal int64
+	subtotals := make([]int64, len(sorted))
+	for i, o := range sorted {
+		if c := o.Subtotal.CurrencyCode; c != "" {
+			if currency == "" {
+				currency = c
+			} else if c != currency {
+				return domain.ErrCurrencyMismatch
+			}
+		}
+		subtotals[i] = o.Subtotal.GetAmount()
+		subtotal += subtotals[i]
+	}
+
+	feeWeights := subtotals
+	if sheet.FeeSplit != domain.FeeSplitProportional || subtotal == 0 {
+		feeWeights = make([]int64, len(sorted))
+		for i := range feeWeights {
+			feeWeights[i] = 1
+		}
+	}
+	feeShares := allocate(sheet.DeliveryFee.GetAmount(), feeWeights)
+
+	percent := int64(min(max(sheet.Discount, 0), 100))
+	discountShares := allocate((subtotal*percent+50)/100, subtotals)
+
+	for i, o := range sorted {
+		o.FeeShare = domain.NewMoney(feeShares[i], currency)
+		o.DiscountShare = domain.NewMoney(discountShares[i], currency)
+		Total(o)
+	}
+
+	return nil
+}
+
+// allocate divides amount between weights with the largest remainder method.
+// Shares always sum to amount; leftover units go to the largest fractional
+// parts, earlier indexes first on ties.
+func allocate(amount int64, weights []int64) []int64 {
+	shares := make([]int64, len(weights))
+
+	var total uint64
+	for _, w := range weights {
+		total += uint64(max(w, 0))
+	}
+	if amount <= 0 || total == 0 {
+		return shares
+	}
+
+	remainders := make([]uint64, len(weights))
+	allocated := int64(0)
+	for i, w := range weights {
+		// 128-bit product so large amounts can't overflow
+		hi, lo := bits.Mul64(uint64(amount), uint64(max(w, 0)))
+		q, r := bits.Div64(hi, lo, total)
+		shares[i] = int64(q)
+		remainders[i] = r
+		allocated += shares[i]
+	}
+
+	order := make([]int, len(weights))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool { return remainders[order[a]] > remainders[order[b]] })
+
+	for i := int64(0); i < amount-allocated; i++ {
+		shares[order[i]]++
+	}
+
+	return shares
+}
@@ -0,0 +1,138 @@
+package pricing
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+)
+
+func TestSheetCharges(t *testing.T) {
+	tests := map[string]struct {
+		sheet     domain.Sheet
+		subtotals []int64
+		fees      []int64
+		discounts []int64
+	}{
+		"equal split with remainder": {
+			sheet:     domain.Sheet{DeliveryFee: domain.NewMoney(100, "VND"), FeeSplit: domain.FeeSplitEqual},
+			subtotals: []int64{1000, 2000, 3000},
+			fees:      []int64{34, 33, 33},
+			discounts: []int64{0, 0, 0},
+		},
+		"unset mode splits equally": {
+			sheet:     domain.Sheet{DeliveryFee: domain.NewMoney(10, "VND")},
+			subtotals: []int64{1, 99},
+			fees:      []int64{5, 5},
+			discounts: []int64{0, 0},
+		},
+		"proportional split": {
+			sheet:     domain.Sheet{DeliveryFee: domain.NewMoney(100, "VND"), FeeSplit: domain.FeeSplitProportional},
+			subtotals: []int64{1000, 1000, 1000},
+			fees:      []int64{34, 33, 33},
+			discounts: []int64{0, 0, 0},
+		},
+		"proportional split largest remainder": {
+			sheet:     domain.Sheet{DeliveryFee: domain.NewMoney(10, "VND"), FeeSplit: domain.FeeSplitProportional},
+			subtotals: []int64{1, 2, 7},
+			fees:      []int64{1, 2, 7},
+			discounts: []int64{0, 0, 0},
+		},
+		"proportional with zero subtotals falls back to equal": {
+			sheet:     domain.Sheet{DeliveryFee: domain.NewMoney(9, "VND"), FeeSplit: domain.FeeSplitProportional},
+			subtotals: []int64{0, 0},
+			fees:      []int64{5, 4},
+			discounts: []int64{0, 0},
+		},
+		"discount rounds half up and splits by subtotal": {
+			sheet:     domain.Sheet{Discount: 15},
+			subtotals: []int64{333, 333, 334},
+			fees:      []int64{0, 0, 0},
+			discounts: []int64{50, 50, 50},
+		},
+		"fee and discount together": {
+			sheet:     domain.Sheet{DeliveryFee: domain.NewMoney(15000, "VND"), Discount: 10, FeeSplit: domain.FeeSplitProportional},
+			subtotals: []int64{45000, 30000, 25000},
+			fees:      []int64{6750, 4500, 3750},
+			discounts: []int64{4500, 3000, 2500},
+		},
+		"full discount": {
+			sheet:     domain.Sheet{Discount: 100},
+			subtotals: []int64{7, 3},
+			fees:      []int64{0, 0},
+			discounts: []int64{7, 3},
+		},
+	}
+
+	for name, tc := range tests {
+		ids := []string{"a", "b", "c"}
+		orders := make([]*domain.Order, len(tc.subtotals))
+		var sheetTotal int64
+		for i, amount := range tc.subtotals {
+			orders[i] = &domain.Order{ID: ids[i], Subtotal: domain.NewMoney(amount, "VND")}
+			sheetTotal += amount
+		}
+		sheetTotal += tc.sheet.DeliveryFee.Amount
+
+		if err := SheetCharges(&tc.sheet, orders); err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+
+		var discount, sum int64
+		for i, o := range orders {
+			if o.FeeShare.Amount != tc.fees[i] {
+				t.Fatalf("%s: order %s fee share = %d, want %d", name, o.ID, o.FeeShare.Amount, tc.fees[i])
+			}
+			if o.DiscountShare.Amount != tc.discounts[i] {
+				t.Fatalf("%s: order %s discount share = %d, want %d", name, o.ID, o.DiscountShare.Amount, tc.discounts[i])
+			}
+			if want := tc.subtotals[i] + tc.fees[i] - tc.discounts[i]; o.Total.Amount != want {
+				t.Fatalf("%s: order %s total = %d, want %d", name, o.ID, o.Total.Amount, want)
+			}
+			discount += o.DiscountShare.Amount
+			sum += o.Total.Amount
+		}
+		if sum != sheetTotal-discount {
+			t.Fatalf("%s: totals sum to %d, want %d", name, sum, sheetTotal-discount)
+		}
+	}
+}
+
+func TestSheetChargesOrderIndependent(t *testing.T) {
+	sheet := &domain.Sheet{DeliveryFee: domain.NewMoney(100, "VND"), Discount: 7}
+	a := &domain.Order{ID: "a", Subtotal: domain.NewMoney(101, "VND")}
+	b := &domain.Order{ID: "b", Subtotal: domain.NewMoney(101, "VND")}
+	c := &domain.Order{ID: "c", Subtotal: domain.NewMoney(101, "VND")}
+
+	if err := SheetCharges(sheet, []*domain.Order{c, a, b}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if a.FeeShare.Amount != 34 || b.FeeShare.Amount != 33 || c.FeeShare.Amount != 33 {
+		t.Fatalf("fee shares = %d/%d/%d, want 34/33/33", a.FeeShare.Amount, b.FeeShare.Amount, c.FeeShare.Amount)
+	}
+}
+
+func TestSheetChargesMixedCurrencies(t *testing.T) {
+	sheet := &domain.Sheet{DeliveryFee: domain.NewMoney(100, "VND")}
+	orders := []*domain.Order{{ID: "a", Subtotal: domain.NewMoney(100, "USD")}}
+
+	if err := SheetCharges(sheet, orders); !errors.Is(err, domain.ErrCurrencyMismatch) {
+		t.Fatalf("error = %v, want %v", err, domain.ErrCurrencyMismatch)
+	}
+}
+
+func TestSheetChargesSkipsCancelled(t *testing.T) {
+	sheet := &domain.Sheet{DeliveryFee: domain.NewMoney(100, "VND"), Discount: 10}
+	a := &domain.Order{ID: "a", Subtotal: domain.NewMoney(500, "VND")}
+	b := &domain.Order{ID: "b", Subtotal: domain.NewMoney(500, "VND"), Status: domain.OrderStatusCancelled}
+
+	if err := SheetCharges(sheet, []*domain.Order{a, b}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if a.FeeShare.Amount != 100 || a.DiscountShare.Amount != 50 || a.Total.Amount != 550 {
+		t.Fatalf("active order shares = %d/%d total %d, want 100/50 total 550", a.FeeShare.Amount, a.DiscountShare.Amount, a.Total.Amount)
+	}
+	if b.FeeShare.Amount != 0 || b.DiscountShare.Amount != 0 || b.Total.Amount != 500 {
+		t.Fatalf("cancelled order shares = %d/%d total %d, want 0/0 total 500", b.FeeShare.Amount, b.DiscountShare.Amount, b.Total.Amount)
+	}
+}
@@ -0,0 +1,82 @@
+// Package pricing computes order line and order totals, the sheet charges
+// and settlements built on them. It is the only place they are computed:
+// lines store unit prices and quantities, and every total is derived from
+// those, so pricing a new order, an updated order or a stored order again
+// always gives the same result.
+package pricing
+
+import "github.com/deni12345/dae-services/services/dae-core/internal/domain"
+
+// OptionAmount is what a selected option adds to its line. Per-unit options
+// are charged for every item on the line, per-order options once.
+func OptionAmount(opt domain.OrderLineOption, lineQuantity int32) int64 {
+	amount := opt.PriceDelta.GetAmount() * int64(opt.Quantity)
+	if opt.Per == domain.PerOrder {
+		return amount
+	}
+	return amount * int64(lineQuantity)
+}
+
+// Line sets the options total and line total of line from its base price,
+// option prices and quantities
+func Line(line *domain.OrderLine) {
+	currency := line.OrderBasePrice.CurrencyCode
+
+	var options int64
+	for _, opt := range line.Options {
+		options += OptionAmount(opt, line.Quantity)
+	}
+
+	line.OrderOptionsTotal = domain.NewMoney(options, currency)
+	line.OrderTotal = domain.NewMoney(line.OrderBasePrice.GetAmount()*int64(line.Quantity)+options, currency)
+}
+
+// Order prices every line of order, then its subtotal and total, and marks
+// it with the current pricing version. The total includes the fee and
+// discount shares already set on the order; they are filled in later from the
+// sheet by SheetCharges.
+func Order(order *domain.Order) {
+	var subtotal int64
+	currency := ""
+	for i := range order.Lines {
+		Line(&order.Lines[i])
+		subtotal += order.Lines[i].OrderTotal.GetAmount()
+		if currency == "" {
+			currency = order.Lines[i].OrderBasePrice.CurrencyCode
+		}
+	}
+
+	order.Subtotal = domain.NewMoney(subtotal, currency)
+	order.PricingVersion = domain.PricingVersion
+	Total(order)
+}
+
+// Total sets the total of order from its subtotal and the fee and discount
+// shares set on it
+func Total(order *domain.Order) {
+	currency := order.Subtotal.CurrencyCode
+	if currency == "" {
+		currency = order.FeeShare.CurrencyCode
+	}
+	order.Total = domain.NewMoney(order.Subtotal.GetAmount()+order.FeeShare.GetAmount()-order.DiscountShare.GetAmount(), currency)
+}
+
+// Upgrade converts an order stored with an older pricing version to the
+// current one and prices it again. Orders stored before versions existed
+// kept option price deltas already multiplied by the option quantity and an
+// options total for one unit of the line; their line totals are unchanged by
+// the conversion. Orders already on the current version are left alone.
+func Upgrade(order *domain.Order) {
+	if order.PricingVersion >= domain.PricingVersion {
+		return
+	}
+	for i := range order.Lines {
+		for j := range order.Lines[i].Options {
+			opt := &order.Lines[i].Options[j]
+			if opt.Quantity > 0 {
+				opt.PriceDelta.Amount /= int64(opt.Quantity)
+			}
+		}
+	}
+	Order(order)
+}
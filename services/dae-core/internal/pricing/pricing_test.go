@@ -0,0 +1,177 @@
+package pricing
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files")
+
+// pricingCase is an order to price. Shares are never part of an order's
+// JSON, so they are given beside it.
+type pricingCase struct {
+	Name          string       `json:"name"`
+	Order         domain.Order `json:"order"`
+	FeeShare      domain.Money `json:"fee_share"`
+	DiscountShare domain.Money `json:"discount_share"`
+}
+
+func TestOrderGolden(t *testing.T) {
+	raw, err := os.ReadFile(filepath.Join("testdata", "orders.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cases []pricingCase
+	if err := json.Unmarshal(raw, &cases); err != nil {
+		t.Fatal(err)
+	}
+
+	var got bytes.Buffer
+	for i := range cases {
+		o := &cases[i].Order
+		o.FeeShare, o.DiscountShare = cases[i].FeeShare, cases[i].DiscountShare
+		Order(o)
+
+		fmt.Fprintf(&got, "%s\n", cases[i].Name)
+		for _, line := range o.Lines {
+			fmt.Fprintf(&got, "  %-10s x%d  base %d  options %d  total %d\n",
+				line.MenuItemID, line.Quantity, line.OrderBasePrice.Amount, line.OrderOptionsTotal.Amount, line.OrderTotal.Amount)
+		}
+		fmt.Fprintf(&got, "  subtotal %d  total %d %s\n", o.Subtotal.Amount, o.Total.Amount, o.Total.CurrencyCode)
+	}
+
+	golden := filepath.Join("testdata", "orders.golden")
+	if *update {
+		if err := os.WriteFile(golden, got.Bytes(), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got.Bytes(), want) {
+		t.Fatalf("priced orders differ from %s; run with -update to accept:\n%s", golden, got.String())
+	}
+
+	// Pricing a stored order again must not change it
+	for _, c := range cases {
+		again := c.Order
+		again.Lines = append([]domain.OrderLine(nil), c.Order.Lines...)
+		Order(&again)
+		if !reflect.DeepEqual(again, c.Order) {
+			t.Fatalf("%s: repricing changed the order", c.Name)
+		}
+	}
+}
+
+func TestUpgrade(t *testing.T) {
+	// As stored before pricing versions: 2 x (45000 + 2 x 5000 topping)
+	legacy := domain.Order{
+		Lines: []domain.OrderLine{{
+			Quantity:          2,
+			OrderBasePrice:    domain.NewMoney(45000, "VND"),
+			OrderOptionsTotal: domain.NewMoney(10000, "VND"),
+			OrderTotal:        domain.NewMoney(110000, "VND"),
+			Options:           []domain.OrderLineOption{{OptionID: "topping", PriceDelta: domain.NewMoney(10000, "VND"), Quantity: 2}},
+		}},
+		Subtotal: domain.NewMoney(110000, "VND"),
+		Total:    domain.NewMoney(110000, "VND"),
+	}
+
+	Upgrade(&legacy)
+
+	line := legacy.Lines[0]
+	if line.Options[0].PriceDelta.Amount != 5000 || line.OrderOptionsTotal.Amount != 20000 {
+		t.Fatalf("upgraded option delta %d, options total %d, want 5000 and 20000", line.Options[0].PriceDelta.Amount, line.OrderOptionsTotal.Amount)
+	}
+	if line.OrderTotal.Amount != 110000 || legacy.Subtotal.Amount != 110000 || legacy.Total.Amount != 110000 {
+		t.Fatalf("upgrade changed the totals: line %d, subtotal %d, total %d", line.OrderTotal.Amount, legacy.Subtotal.Amount, legacy.Total.Amount)
+	}
+	if legacy.PricingVersion != domain.PricingVersion {
+		t.Fatalf("pricing version = %d, want %d", legacy.PricingVersion, domain.PricingVersion)
+	}
+
+	// Upgrading again is a no-op
+	again := legacy
+	again.Lines = []domain.OrderLine{line}
+	again.Lines[0].Options = append([]domain.OrderLineOption(nil), line.Options...)
+	Upgrade(&again)
+	if !reflect.DeepEqual(again, legacy) {
+		t.Fatalf("second upgrade changed the order:\n got %+v\nwant %+v", again, legacy)
+	}
+}
+
+func FuzzOrder(f *testing.F) {
+	f.Add(int64(45000), int32(2), int64(5000), int32(1), false, int64(3000), int32(1), true)
+	f.Add(int64(30000), int32(3), int64(-10000), int32(2), false, int64(0), int32(0), false)
+	f.Add(int64(0), int32(1), int64(1), int32(5), true, int64(7), int32(3), true)
+
+	f.Fuzz(func(t *testing.T, base int64, qty int32, priceA int64, qtyA int32, perOrderA bool, priceB int64, qtyB int32, perOrderB bool) {
+		// Keep amounts within what a menu can hold so products cannot overflow
+		const maxAmount, maxQuantity = 1 << 30, 1 << 10
+		if base < 0 || base > maxAmount || qty < 1 || qty > maxQuantity ||
+			priceA < -maxAmount || priceA > maxAmount || qtyA < 0 || qtyA > maxQuantity ||
+			priceB < -maxAmount || priceB > maxAmount || qtyB < 0 || qtyB > maxQuantity {
+			t.Skip()
+		}
+
+		per := func(perOrder bool) domain.Per {
+			if perOrder {
+				return domain.PerOrder
+			}
+			return domain.PerUnit
+		}
+		order := domain.Order{Lines: []domain.OrderLine{{
+			Quantity:       qty,
+			OrderBasePrice: domain.NewMoney(base, "VND"),
+			Options: []domain.OrderLineOption{
+				{OptionID: "a", PriceDelta: domain.NewMoney(priceA, "VND"), Quantity: qtyA, Per: per(perOrderA)},
+				{OptionID: "b", PriceDelta: domain.NewMoney(priceB, "VND"), Quantity: qtyB, Per: per(perOrderB)},
+			},
+		}}}
+		Order(&order)
+
+		line := order.Lines[0]
+		var perUnit, perLine int64
+		for _, opt := range line.Options {
+			amount := opt.PriceDelta.Amount * int64(opt.Quantity)
+			if opt.Per == domain.PerOrder {
+				perLine += amount
+			} else {
+				perUnit += amount
+			}
+		}
+		if want := (base+perUnit)*int64(qty) + perLine; line.OrderTotal.Amount != want {
+			t.Fatalf("line total = %d, want %d", line.OrderTotal.Amount, want)
+		}
+		if line.OrderTotal.Amount != base*int64(qty)+line.OrderOptionsTotal.Amount {
+			t.Fatalf("line total %d != base %d x %d + options %d", line.OrderTotal.Amount, base, qty, line.OrderOptionsTotal.Amount)
+		}
+		if order.Subtotal != line.OrderTotal || order.Total != line.OrderTotal {
+			t.Fatalf("subtotal %v, total %v, want %v", order.Subtotal, order.Total, line.OrderTotal)
+		}
+
+		// A stored order read back and priced again keeps its totals
+		stored, err := json.Marshal(order)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var reloaded domain.Order
+		if err := json.Unmarshal(stored, &reloaded); err != nil {
+			t.Fatal(err)
+		}
+		Order(&reloaded)
+		if !reflect.DeepEqual(reloaded, order) {
+			t.Fatalf("repriced order drifted:\n got %+v\nwant %+v", reloaded, order)
+		}
+	})
+}
@@ -0,0 +1,73 @@
+package pricing
+
+import (
+	"sort"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+)
+
+// Settlement applies the sheet charges to orders and aggregates them per
+// user, leaving out cancelled orders. Lines are sorted by user ID with the
+// host first. The result is provisional until the sheet is closed.
+func Settlement(sheet *domain.Sheet, orders []*domain.Order) (*domain.Settlement, error) {
+	if err := SheetCharges(sheet, orders); err != nil {
+		return nil, err
+	}
+
+	currency := sheet.DeliveryFee.CurrencyCode
+	byUser := make(map[string]*domain.SettlementLine)
+	for _, o := range orders {
+		if o.IsCancelled() {
+			continue
+		}
+		if currency == "" {
+			currency = o.Total.CurrencyCode
+		}
+		line, ok := byUser[o.UserID]
+		if !ok {
+			line = &domain.SettlementLine{UserID: o.UserID, IsHost: o.UserID == sheet.HostUserID}
+			byUser[o.UserID] = line
+		}
+		line.OrderCount++
+		line.Subtotal.Amount += o.Subtotal.GetAmount()
+		line.FeeShare.Amount += o.FeeShare.GetAmount()
+		line.DiscountShare.Amount += o.DiscountShare.GetAmount()
+		line.Total.Amount += o.Total.GetAmount()
+	}
+
+	s := &domain.Settlement{
+		SheetID:       sheet.ID,
+		HostUserID:    sheet.HostUserID,
+		Lines:         make([]domain.SettlementLine, 0, len(byUser)),
+		Subtotal:      domain.NewMoney(0, currency),
+		FeeTotal:      domain.NewMoney(0, currency),
+		DiscountTotal: domain.NewMoney(0, currency),
+		Total:         domain.NewMoney(0, currency),
+		OwedToHost:    domain.NewMoney(0, currency),
+		Provisional:   sheet.Status != domain.Status_CLOSED,
+	}
+	for _, line := range byUser {
+		line.Subtotal.CurrencyCode = currency
+		line.FeeShare.CurrencyCode = currency
+		line.DiscountShare.CurrencyCode = currency
+		line.Total.CurrencyCode = currency
+
+		s.Subtotal.Amount += line.Subtotal.Amount
+		s.FeeTotal.Amount += line.FeeShare.Amount
+		s.DiscountTotal.Amount += line.DiscountShare.Amount
+		s.Total.Amount += line.Total.Amount
+		if !line.IsHost {
+			s.OwedToHost.Amount += line.Total.Amount
+		}
+		s.Lines = append(s.Lines, *line)
+	}
+
+	sort.Slice(s.Lines, func(i, j int) bool {
+		if s.Lines[i].IsHost != s.Lines[j].IsHost {
+			return s.Lines[i].IsHost
+		}
+		return s.Lines[i].UserID < s.Lines[j].UserID
+	})
+
+	return s, nil
+}
@@ -0,0 +1,65 @@
+package pricing
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/deni12345/dae-services/services/dae-core/internal/domain"
+)
+
+func TestSettlement(t *testing.T) {
+	sheet := &domain.Sheet{ID: "s1", HostUserID: "host", DeliveryFee: domain.NewMoney(90, "VND"), Discount: 10}
+	orders := []*domain.Order{
+		{ID: "o1", UserID: "zed", Subtotal: domain.NewMoney(100, "VND")},
+		{ID: "o2", UserID: "host", Subtotal: domain.NewMoney(200, "VND")},
+		{ID: "o3", UserID: "zed", Subtotal: domain.NewMoney(300, "VND")},
+	}
+
+	s, err := Settlement(sheet, orders)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []domain.SettlementLine{
+		{UserID: "host", IsHost: true, OrderCount: 1, Subtotal: domain.NewMoney(200, "VND"), FeeShare: domain.NewMoney(30, "VND"), DiscountShare: domain.NewMoney(20, "VND"), Total: domain.NewMoney(210, "VND")},
+		{UserID: "zed", OrderCount: 2, Subtotal: domain.NewMoney(400, "VND"), FeeShare: domain.NewMoney(60, "VND"), DiscountShare: domain.NewMoney(40, "VND"), Total: domain.NewMoney(420, "VND")},
+	}
+	if len(s.Lines) != len(want) {
+		t.Fatalf("got %d lines, want %d", len(s.Lines), len(want))
+	}
+	for i, line := range s.Lines {
+		if line != want[i] {
+			t.Fatalf("line %d = %+v, want %+v", i, line, want[i])
+		}
+	}
+	if s.Total.Amount != 630 || s.OwedToHost.Amount != 420 {
+		t.Fatalf("total = %d, owed to host = %d, want 630 and 420", s.Total.Amount, s.OwedToHost.Amount)
+	}
+	if s.FeeTotal.Amount != 90 || s.DiscountTotal.Amount != 60 {
+		t.Fatalf("fee total = %d, discount total = %d, want 90 and 60", s.FeeTotal.Amount, s.DiscountTotal.Amount)
+	}
+	if !s.Provisional {
+		t.Fatal("settlement of an unclosed sheet is not provisional")
+	}
+
+	sheet.Status = domain.Status_CLOSED
+	s, err = Settlement(sheet, orders)
+	if err != nil {
+		t.Fatalf("closed sheet settlement: %v", err)
+	}
+	if s.Provisional {
+		t.Fatal("settlement of a closed sheet is provisional")
+	}
+}
+
+func TestSettlementMixedCurrencies(t *testing.T) {
+	sheet := &domain.Sheet{ID: "s1"}
+	orders := []*domain.Order{
+		{ID: "o1", Subtotal: domain.NewMoney(100, "VND")},
+		{ID: "o2", Subtotal: domain.NewMoney(100, "USD")},
+	}
+
+	if _, err := Settlement(sheet, orders); !errors.Is(err, domain.ErrCurrencyMismatch) {
+		t.Fatalf("error = %v, want %v", err, domain.ErrCurrencyMismatch)
+	}
+}
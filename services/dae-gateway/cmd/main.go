@@ -6,23 +6,94 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 
+	auth "github.com/deni1234/dae-services/dae-gateway/internal/auth/google"
+	"github.com/deni1234/dae-services/dae-gateway/internal/auth/session"
+	"github.com/deni1234/dae-services/dae-gateway/internal/auth/token"
+	daecore "github.com/deni1234/dae-services/dae-gateway/internal/client/dae-core"
+	"github.com/deni1234/dae-services/dae-gateway/internal/rest"
 	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/redis/go-redis/v9"
 )
 
 func main() {
+	addr := getEnv("GATEWAY_ADDRESS", ":8084")
+	coreInsecure, _ := strconv.ParseBool(getEnv("DAE_CORE_INSECURE", "true"))
+
+	core, err := daecore.NewClient(context.Background(), daecore.Config{
+		Addr:           getEnv("DAE_CORE_ADDRESS", "localhost:50051"),
+		Insecure:       coreInsecure,
+		DefaultTimeOut: 10 * time.Second,
+	})
+	if err != nil {
+		slog.Error("failed to create dae-core client", "error", err)
+		os.Exit(1)
+	}
+	defer core.Close()
+
+	signer, err := token.LoadSigner(
+		os.Getenv("AUTH_SIGNING_KEY_FILE"),
+		getEnv("AUTH_ISSUER", "dae-gateway"),
+		getEnv("AUTH_AUDIENCE", "dae-core"),
+		5*time.Minute,
+	)
+	if err != nil {
+		slog.Error("failed to load token signing key", "error", err)
+		os.Exit(1)
+	}
+
+	sessions, err := initSessions()
+	if err != nil {
+		slog.Error("failed to create session manager", "error", err)
+		os.Exit(1)
+	}
+
+	var resets rest.ResetSender
+	if logTokens, _ := strconv.ParseBool(os.Getenv("PASSWORD_RESET_LOG_TOKENS")); logTokens {
+		slog.Warn("PASSWORD_RESET_LOG_TOKENS set, password reset tokens are written to the log")
+		resets = rest.LogResetSender{}
+	} else {
+		slog.Warn("no password reset sender configured, password reset requests disabled")
+	}
+
 	r := chi.NewRouter()
+	r.Use(middleware.RequestID, middleware.RealIP, middleware.Recoverer)
+
+	r.Route("/auth", func(r chi.Router) {
+		if clientID := os.Getenv("GOOGLE_CLIENT_ID"); clientID != "" {
+			google, err := auth.NewGoogleOIDC(context.Background(), auth.GoogleOAuthConfig{
+				ClientID:     clientID,
+				ClientSecret: os.Getenv("GOOGLE_CLIENT_SECRET"),
+				RedirectURL:  os.Getenv("GOOGLE_REDIRECT_URL"),
+				PostLoginURL: os.Getenv("GOOGLE_POST_LOGIN_URL"),
+			}, core, signer, sessions)
+			if err != nil {
+				slog.Error("failed to discover google oidc provider", "error", err)
+				os.Exit(1)
+			}
+			r.Get("/google/start", google.Start)
+			r.Get("/google/callback", google.Callback)
+			r.Get("/google/link", google.Link)
+		} else {
+			slog.Warn("GOOGLE_CLIENT_ID not set, google login disabled")
+		}
+		r.Post("/logout", sessions.Logout)
+	})
+
+	r.With(rest.SessionAuth(sessions, signer)).Mount("/v1", rest.NewHandler(core, sessions, signer, resets).Routes())
 
 	server := http.Server{
-		Addr:    ":8084",
+		Addr:    addr,
 		Handler: r,
 	}
 
 	go func() {
-		slog.Info("gateway listening on :8084")
-		if err := server.ListenAndServe(); err != nil {
+		slog.Info("gateway listening", "address", addr)
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 			slog.Error("gateway server failed", "error", err)
 		}
 	}()
@@ -41,3 +112,31 @@ func main() {
 
 	slog.Info("gateway server shutdown complete")
 }
+
+// initSessions stores sessions in Redis when REDIS_ADDR is set, in memory otherwise
+func initSessions() (*session.Manager, error) {
+	secure, _ := strconv.ParseBool(getEnv("SESSION_SECURE_COOKIE", "false"))
+
+	var store session.Store
+	if addr := os.Getenv("REDIS_ADDR"); addr != "" {
+		store = session.NewRedisStore(redis.NewClient(&redis.Options{
+			Addr:     addr,
+			Password: os.Getenv("REDIS_PASSWORD"),
+		}))
+	} else {
+		slog.Warn("REDIS_ADDR not set, sessions are kept in memory")
+		store = session.NewMemoryStore()
+	}
+
+	return session.NewManager(store, session.Config{
+		Secret: []byte(os.Getenv("SESSION_SECRET")),
+		Secure: secure,
+	})
+}
+
+func getEnv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
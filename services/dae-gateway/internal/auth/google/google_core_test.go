@@ -0,0 +1,115 @@
+package auth
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"path"
+	"testing"
+	"time"
+
+	daecore "github.com/deni1234/dae-services/dae-gateway/internal/client/dae-core"
+	pb "github.com/deni12345/dae-services/proto/gen"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// usersServer serves fakeUsers over gRPC so the gateway's real client and
+// metadata handling sit between the flow and the directory
+type usersServer struct {
+	pb.UnimplementedUsersServiceServer
+	users *fakeUsers
+	keys  []string
+}
+
+// outgoing hands the incoming metadata to fakeUsers, which reads it as the
+// gateway would have sent it
+func outgoing(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+func (s *usersServer) GetUserByIdentity(ctx context.Context, req *pb.GetUserByIdentityReq) (*pb.GetUserByIdentityResp, error) {
+	return s.users.GetUserByIdentity(outgoing(ctx), req)
+}
+
+func (s *usersServer) CreateUser(ctx context.Context, req *pb.CreateUserReq) (*pb.CreateUserResp, error) {
+	return s.users.CreateUser(outgoing(ctx), req)
+}
+
+func (s *usersServer) RecordLogin(ctx context.Context, req *pb.RecordLoginReq) (*pb.RecordLoginResp, error) {
+	return s.users.RecordLogin(outgoing(ctx), req)
+}
+
+func (s *usersServer) LinkIdentity(ctx context.Context, req *pb.LinkIdentityReq) (*pb.LinkIdentityResp, error) {
+	return s.users.LinkIdentity(outgoing(ctx), req)
+}
+
+// requireIdempotencyKey rejects CreateUser without a key the way
+// dae-core's IdemInterceptor does
+func (s *usersServer) requireIdempotencyKey(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if path.Base(info.FullMethod) == "CreateUser" {
+		md, _ := metadata.FromIncomingContext(ctx)
+		v := md.Get(idempotencyMDKey)
+		if len(v) == 0 || v[0] == "" {
+			return nil, status.Error(codes.InvalidArgument, "missing idempotency key")
+		}
+		s.keys = append(s.keys, v[0])
+	}
+	return handler(ctx, req)
+}
+
+// newCoreFlow is newTestFlow with the directory reached through a
+// daecore.Client over an in-memory connection
+func newCoreFlow(t *testing.T) (*testFlow, *usersServer) {
+	t.Helper()
+	f := newTestFlow(t)
+
+	srv := &usersServer{users: f.users}
+	lis := bufconn.Listen(1 << 20)
+	gs := grpc.NewServer(grpc.UnaryInterceptor(srv.requireIdempotencyKey))
+	pb.RegisterUsersServiceServer(gs, srv)
+	go func() { _ = gs.Serve(lis) }()
+	t.Cleanup(gs.Stop)
+
+	conn, err := daecore.Dial(context.Background(), daecore.DialConfig{Addr: "passthrough:///bufnet", Insecure: true},
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	core, err := daecore.New(conn, 5*time.Second)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	f.oauth.users = core
+	return f, srv
+}
+
+func TestCallbackFirstLoginThroughCore(t *testing.T) {
+	f, srv := newCoreFlow(t)
+
+	rec := f.login(t, googleClaimsFor("g-1", "a@example.com"))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	if f.users.created != 1 || f.users.logins != 1 {
+		t.Fatalf("created = %d, logins = %d, want 1 and 1", f.users.created, f.users.logins)
+	}
+	if len(srv.keys) != 1 || srv.keys[0] != "google:g-1" {
+		t.Fatalf("idempotency keys = %v, want [google:g-1]", srv.keys)
+	}
+
+	// A second login finds the linked identity and creates nothing
+	rec = f.login(t, googleClaimsFor("g-1", "a@example.com"))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("second login status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	if f.users.created != 1 || len(srv.keys) != 1 {
+		t.Fatalf("created = %d, keys = %v after second login", f.users.created, srv.keys)
+	}
+}
@@ -5,36 +5,100 @@ import (
 	"crypto/rand"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
+	"log/slog"
 	"net/http"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/deni1234/dae-services/dae-gateway/internal/auth/session"
+	"github.com/deni1234/dae-services/dae-gateway/internal/auth/token"
+	pb "github.com/deni12345/dae-services/proto/gen"
 	"golang.org/x/oauth2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const googleIssuer = "https://accounts.google.com"
+
+// idempotencyMDKey is the metadata dae-core reads idempotency keys from
+const idempotencyMDKey = "idempotency-key"
+
+// maxNameLen matches the name limit dae-core validates on CreateUser
+const maxNameLen = 50
+
+var (
+	errEmailTaken    = errors.New("email is registered with another sign-in method")
+	errAlreadyLinked = errors.New("google account is already linked to a user")
 )
 
 type GoogleOAuthConfig struct {
 	ClientID     string
 	ClientSecret string
 	RedirectURL  string
+	IssuerURL    string // defaults to Google
+	PostLoginURL string // where the browser goes after login; empty returns the user as JSON
+}
+
+// Provider is the discovered OIDC issuer. *oidc.Provider implements it; tests
+// pass one pointed at a local fake issuer.
+type Provider interface {
+	Endpoint() oauth2.Endpoint
+	Verifier(config *oidc.Config) *oidc.IDTokenVerifier
+}
+
+// UserDirectory is the part of dae-core the login flow needs
+type UserDirectory interface {
+	GetUserByIdentity(ctx context.Context, req *pb.GetUserByIdentityReq) (*pb.GetUserByIdentityResp, error)
+	CreateUser(ctx context.Context, req *pb.CreateUserReq) (*pb.CreateUserResp, error)
+	RecordLogin(ctx context.Context, req *pb.RecordLoginReq) (*pb.RecordLoginResp, error)
+	LinkIdentity(ctx context.Context, req *pb.LinkIdentityReq) (*pb.LinkIdentityResp, error)
 }
 
 type GoogleOAuth struct {
-	conf     oauth2.Config
-	verifier *oidc.IDTokenVerifier
+	conf         oauth2.Config
+	verifier     *oidc.IDTokenVerifier
+	users        UserDirectory
+	signer       *token.Signer
+	sessions     *session.Manager
+	postLoginURL string
+}
+
+type googleClaims struct {
+	Sub           string `json:"sub"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	Name          string `json:"name"`
+	Picture       string `json:"picture"`
 }
 
 type startState struct {
 	State string `json:"state"`
 	Nonce string `json:"nonce"`
 	Exp   int64  `json:"timestamp"`
+	Link  bool   `json:"link,omitempty"` // link to the signed-in user instead of logging in
 }
 
-func NewGoogleOIDC(ctx context.Context, cfg GoogleOAuthConfig) (*GoogleOAuth, error) {
-	provider, err := oidc.NewProvider(ctx, "https://accounts.google.com")
+// NewGoogleOIDC discovers the issuer and builds the login flow
+func NewGoogleOIDC(ctx context.Context, cfg GoogleOAuthConfig, users UserDirectory, signer *token.Signer, sessions *session.Manager) (*GoogleOAuth, error) {
+	issuer := cfg.IssuerURL
+	if issuer == "" {
+		issuer = googleIssuer
+	}
+
+	provider, err := oidc.NewProvider(ctx, issuer)
 	if err != nil {
 		return nil, err
 	}
 
+	return NewGoogleOAuth(provider, cfg, users, signer, sessions), nil
+}
+
+// NewGoogleOAuth builds the login flow against an already discovered provider
+func NewGoogleOAuth(provider Provider, cfg GoogleOAuthConfig, users UserDirectory, signer *token.Signer, sessions *session.Manager) *GoogleOAuth {
 	oauth2Config := oauth2.Config{
 		ClientID:     cfg.ClientID,
 		ClientSecret: cfg.ClientSecret,
@@ -45,12 +109,29 @@ func NewGoogleOIDC(ctx context.Context, cfg GoogleOAuthConfig) (*GoogleOAuth, er
 
 	verify := provider.Verifier(&oidc.Config{ClientID: cfg.ClientID})
 	return &GoogleOAuth{
-		conf:     oauth2Config,
-		verifier: verify,
-	}, nil
+		conf:         oauth2Config,
+		verifier:     verify,
+		users:        users,
+		signer:       signer,
+		sessions:     sessions,
+		postLoginURL: cfg.PostLoginURL,
+	}
 }
 
 func (g *GoogleOAuth) Start(w http.ResponseWriter, r *http.Request) {
+	g.redirect(w, r, false)
+}
+
+// Link starts the flow for a signed-in user adding Google as a login method
+func (g *GoogleOAuth) Link(w http.ResponseWriter, r *http.Request) {
+	if _, err := g.sessions.FromRequest(r); err != nil {
+		http.Error(w, "sign in before linking an account", http.StatusUnauthorized)
+		return
+	}
+	g.redirect(w, r, true)
+}
+
+func (g *GoogleOAuth) redirect(w http.ResponseWriter, r *http.Request, link bool) {
 	state := randB64(32)
 	nonce := randB64(32)
 
@@ -58,6 +139,7 @@ func (g *GoogleOAuth) Start(w http.ResponseWriter, r *http.Request) {
 		State: state,
 		Nonce: nonce,
 		Exp:   time.Now().Add(10 * time.Minute).Unix(),
+		Link:  link,
 	}
 	setJSONCookie(w, "g_oidc", ss)
 
@@ -140,18 +222,180 @@ func (g *GoogleOAuth) Callback(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var claims struct {
-		Sub           string `json:"sub"`
-		Email         string `json:"email"`
-		EmailVerified bool   `json:"email_verified"`
-		Name          string `json:"name"`
-		Picture       string `json:"picture"`
-	}
+	var claims googleClaims
 	if err := idToken.Claims(&claims); err != nil {
 		http.Error(w, "failed to parse id_token claims: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
+	if claims.Sub == "" || claims.Email == "" || !claims.EmailVerified {
+		http.Error(w, "google account has no verified email", http.StatusForbidden)
+		return
+	}
+
+	if ss.Link {
+		g.finishLink(w, r, claims)
+		return
+	}
+
+	user, err := g.login(r.Context(), claims)
+	if err != nil {
+		switch {
+		case errors.Is(err, errEmailTaken):
+			http.Error(w, err.Error(), http.StatusConflict)
+		case status.Code(err) == codes.PermissionDenied:
+			http.Error(w, "account is disabled", http.StatusForbidden)
+		default:
+			slog.ErrorContext(r.Context(), "google login failed", "error", err)
+			http.Error(w, "login failed", http.StatusBadGateway)
+		}
+		return
+	}
+
+	if _, err := g.sessions.Issue(r.Context(), w, user.GetId(), token.RoleNames(user.GetRoles())); err != nil {
+		slog.ErrorContext(r.Context(), "failed to issue session", "error", err)
+		http.Error(w, "failed to start session", http.StatusInternalServerError)
+		return
+	}
+	clearCookie(w, "g_oidc")
+
+	if g.postLoginURL != "" {
+		http.Redirect(w, r, g.postLoginURL, http.StatusFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]string{"user_id": user.GetId()})
+}
+
+// finishLink links the verified Google identity to the session's user. The
+// user comes from the session, never from the unsigned state cookie.
+func (g *GoogleOAuth) finishLink(w http.ResponseWriter, r *http.Request, claims googleClaims) {
+	s, err := g.sessions.FromRequest(r)
+	if err != nil {
+		http.Error(w, "sign in before linking an account", http.StatusUnauthorized)
+		return
+	}
+
+	if err := g.link(r.Context(), s.UserID, claims); err != nil {
+		switch {
+		case errors.Is(err, errAlreadyLinked):
+			http.Error(w, err.Error(), http.StatusConflict)
+		default:
+			slog.ErrorContext(r.Context(), "google link failed", "error", err)
+			http.Error(w, "link failed", http.StatusBadGateway)
+		}
+		return
+	}
+	clearCookie(w, "g_oidc")
 
+	if g.postLoginURL != "" {
+		http.Redirect(w, r, g.postLoginURL, http.StatusFound)
+		return
+	}
 	w.Header().Set("Content-Type", "application/json")
-	_ = json.NewEncoder(w).Encode(claims)
+	_ = json.NewEncoder(w).Encode(map[string]string{"user_id": s.UserID, "linked": "google"})
+}
+
+func (g *GoogleOAuth) link(ctx context.Context, userID string, claims googleClaims) error {
+	ctx, err := g.signer.ServiceContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = g.users.LinkIdentity(ctx, &pb.LinkIdentityReq{
+		UserId:   userID,
+		Provider: pb.IdentityProvider_IDENTITY_PROVIDER_GOOGLE,
+		Subject:  claims.Sub,
+		Email:    claims.Email,
+	})
+	if status.Code(err) == codes.AlreadyExists {
+		return errAlreadyLinked
+	}
+	return err
+}
+
+// login resolves the Google identity to a dae-core user, creating the user on
+// first login, and records the login
+func (g *GoogleOAuth) login(ctx context.Context, claims googleClaims) (*pb.User, error) {
+	ctx, err := g.signer.ServiceContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := g.lookup(ctx, claims.Sub)
+	if status.Code(err) == codes.NotFound {
+		userID, err = g.register(ctx, claims)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := g.users.RecordLogin(ctx, &pb.RecordLoginReq{UserId: userID})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetUser(), nil
+}
+
+func (g *GoogleOAuth) lookup(ctx context.Context, subject string) (string, error) {
+	resp, err := g.users.GetUserByIdentity(ctx, &pb.GetUserByIdentityReq{
+		Provider: pb.IdentityProvider_IDENTITY_PROVIDER_GOOGLE,
+		Subject:  subject,
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.GetUser().GetId(), nil
+}
+
+func (g *GoogleOAuth) register(ctx context.Context, claims googleClaims) (string, error) {
+	req := &pb.CreateUserReq{
+		Email:    claims.Email,
+		Name:     displayName(claims),
+		Provider: pb.IdentityProvider_IDENTITY_PROVIDER_GOOGLE,
+		Subject:  claims.Sub,
+	}
+	if claims.Picture != "" {
+		req.PhotoUrl = &claims.Picture
+	}
+
+	// dae-core rejects writes without an idempotency key. The Google subject
+	// is stable across retries of the same first login, so a replayed
+	// callback gets the account the first attempt created
+	createCtx := metadata.AppendToOutgoingContext(ctx, idempotencyMDKey, "google:"+claims.Sub)
+	resp, err := g.users.CreateUser(createCtx, req)
+	if status.Code(err) == codes.AlreadyExists {
+		// Either a concurrent first login linked this identity, or the email
+		// belongs to an account using another provider
+		userID, lookupErr := g.lookup(ctx, claims.Sub)
+		if status.Code(lookupErr) == codes.NotFound {
+			return "", errEmailTaken
+		}
+		return userID, lookupErr
+	}
+	if err != nil {
+		return "", err
+	}
+	return resp.GetUser().GetId(), nil
+}
+
+func displayName(claims googleClaims) string {
+	name := strings.TrimSpace(claims.Name)
+	if name == "" {
+		name, _, _ = strings.Cut(claims.Email, "@")
+	}
+	if utf8.RuneCountInString(name) > maxNameLen {
+		name = string([]rune(name)[:maxNameLen])
+	}
+	return name
+}
+
+func clearCookie(w http.ResponseWriter, name string) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     name,
+		Value:    "",
+		Path:     "/",
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+		MaxAge:   -1,
+	})
 }
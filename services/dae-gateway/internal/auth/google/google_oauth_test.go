@@ -0,0 +1,406 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/deni1234/dae-services/dae-gateway/internal/auth/session"
+	"github.com/deni1234/dae-services/dae-gateway/internal/auth/token"
+	pb "github.com/deni12345/dae-services/proto/gen"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const testClientID = "test-client"
+
+// fakeIssuer is a minimal OIDC provider that hands out ID tokens for claims
+// set by the test
+type fakeIssuer struct {
+	srv    *httptest.Server
+	key    *ecdsa.PrivateKey
+	mu     sync.Mutex
+	nonce  string
+	claims map[string]any
+}
+
+func newFakeIssuer(t *testing.T) *fakeIssuer {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+
+	f := &fakeIssuer{key: key}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"issuer":                                f.srv.URL,
+			"authorization_endpoint":                f.srv.URL + "/auth",
+			"token_endpoint":                        f.srv.URL + "/token",
+			"jwks_uri":                              f.srv.URL + "/jwks",
+			"id_token_signing_alg_values_supported": []string{"ES256"},
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+			{Key: &key.PublicKey, KeyID: "k1", Algorithm: "ES256", Use: "sig"},
+		}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "access",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     f.idToken(t),
+		})
+	})
+	f.srv = httptest.NewServer(mux)
+	t.Cleanup(f.srv.Close)
+	return f
+}
+
+func (f *fakeIssuer) idToken(t *testing.T) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.ES256, Key: jose.JSONWebKey{Key: f.key, KeyID: "k1"}}, nil)
+	if err != nil {
+		t.Fatalf("new signer: %v", err)
+	}
+	now := time.Now()
+	std := jwt.Claims{
+		Issuer:   f.srv.URL,
+		Audience: jwt.Audience{testClientID},
+		IssuedAt: jwt.NewNumericDate(now),
+		Expiry:   jwt.NewNumericDate(now.Add(time.Hour)),
+	}
+	extra := map[string]any{"nonce": f.nonce}
+	for k, v := range f.claims {
+		extra[k] = v
+	}
+	raw, err := jwt.Signed(signer).Claims(std).Claims(extra).Serialize()
+	if err != nil {
+		t.Fatalf("sign id token: %v", err)
+	}
+	return raw
+}
+
+// fakeUsers stands in for dae-core's UsersService
+type fakeUsers struct {
+	bySubject  map[string]*pb.User
+	emails     map[string]bool
+	disabled   map[string]bool
+	created    int
+	logins     int
+	lastCaller string
+}
+
+func newFakeUsers() *fakeUsers {
+	return &fakeUsers{bySubject: map[string]*pb.User{}, emails: map[string]bool{}, disabled: map[string]bool{}}
+}
+
+func (f *fakeUsers) caller(ctx context.Context) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	if v := md.Get("authorization"); len(v) == 1 {
+		f.lastCaller = strings.TrimPrefix(v[0], "Bearer ")
+	}
+}
+
+func (f *fakeUsers) GetUserByIdentity(ctx context.Context, req *pb.GetUserByIdentityReq) (*pb.GetUserByIdentityResp, error) {
+	f.caller(ctx)
+	u, ok := f.bySubject[req.GetSubject()]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "identity is not linked to any user")
+	}
+	return &pb.GetUserByIdentityResp{User: u}, nil
+}
+
+func (f *fakeUsers) CreateUser(ctx context.Context, req *pb.CreateUserReq) (*pb.CreateUserResp, error) {
+	f.caller(ctx)
+	if f.emails[req.GetEmail()] {
+		return nil, status.Error(codes.AlreadyExists, "email already exists")
+	}
+	f.created++
+	u := &pb.User{Id: "user-" + req.GetSubject(), Email: req.GetEmail(), Name: req.GetName(), Roles: []pb.UserRole{pb.UserRole_USER_ROLE_USER}}
+	f.bySubject[req.GetSubject()] = u
+	f.emails[req.GetEmail()] = true
+	return &pb.CreateUserResp{User: u}, nil
+}
+
+func (f *fakeUsers) RecordLogin(ctx context.Context, req *pb.RecordLoginReq) (*pb.RecordLoginResp, error) {
+	f.caller(ctx)
+	if f.disabled[req.GetUserId()] {
+		return nil, status.Error(codes.PermissionDenied, "user is disabled or inactive")
+	}
+	for _, u := range f.bySubject {
+		if u.GetId() == req.GetUserId() {
+			f.logins++
+			return &pb.RecordLoginResp{User: u}, nil
+		}
+	}
+	return nil, status.Error(codes.NotFound, "user not found")
+}
+
+func (f *fakeUsers) LinkIdentity(ctx context.Context, req *pb.LinkIdentityReq) (*pb.LinkIdentityResp, error) {
+	f.caller(ctx)
+	if _, ok := f.bySubject[req.GetSubject()]; ok {
+		return nil, status.Error(codes.AlreadyExists, "identity already linked to another user")
+	}
+	for _, u := range f.bySubject {
+		if u.GetId() == req.GetUserId() {
+			f.bySubject[req.GetSubject()] = u
+			return &pb.LinkIdentityResp{Identity: &pb.ExternalIdentity{UserId: u.GetId(), Subject: req.GetSubject()}}, nil
+		}
+	}
+	return nil, status.Error(codes.NotFound, "user not found")
+}
+
+type testFlow struct {
+	issuer   *fakeIssuer
+	users    *fakeUsers
+	sessions *session.Manager
+	oauth    *GoogleOAuth
+}
+
+func newTestFlow(t *testing.T) *testFlow {
+	t.Helper()
+	issuer := newFakeIssuer(t)
+
+	provider, err := oidc.NewProvider(context.Background(), issuer.srv.URL)
+	if err != nil {
+		t.Fatalf("discover fake issuer: %v", err)
+	}
+
+	gwKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	signer, err := token.NewSigner(jose.JSONWebKey{Key: gwKey, KeyID: "gw", Algorithm: "ES256"}, "dae-gateway", "dae-core", time.Minute)
+	if err != nil {
+		t.Fatalf("new signer: %v", err)
+	}
+
+	sessions, err := session.NewManager(session.NewMemoryStore(), session.Config{Secret: []byte(strings.Repeat("s", 32))})
+	if err != nil {
+		t.Fatalf("new session manager: %v", err)
+	}
+
+	users := newFakeUsers()
+	cfg := GoogleOAuthConfig{ClientID: testClientID, ClientSecret: "secret", RedirectURL: "http://gateway.test/auth/google/callback"}
+	return &testFlow{
+		issuer:   issuer,
+		users:    users,
+		sessions: sessions,
+		oauth:    NewGoogleOAuth(provider, cfg, users, signer, sessions),
+	}
+}
+
+// login runs Start then Callback as a browser would, with the issuer
+// returning claims in the ID token
+func (f *testFlow) login(t *testing.T, claims map[string]any) *httptest.ResponseRecorder {
+	t.Helper()
+	return f.run(t, f.oauth.Start, claims)
+}
+
+// run drives the flow from the given start handler, sending cookies with
+// both requests
+func (f *testFlow) run(t *testing.T, startHandler http.HandlerFunc, claims map[string]any, cookies ...*http.Cookie) *httptest.ResponseRecorder {
+	t.Helper()
+
+	start := httptest.NewRecorder()
+	startReq := httptest.NewRequest(http.MethodGet, "/auth/google/start", nil)
+	for _, c := range cookies {
+		startReq.AddCookie(c)
+	}
+	startHandler(start, startReq)
+	if start.Code != http.StatusFound {
+		return start
+	}
+	loc, err := url.Parse(start.Header().Get("Location"))
+	if err != nil {
+		t.Fatalf("parse redirect: %v", err)
+	}
+
+	f.issuer.mu.Lock()
+	f.issuer.nonce = loc.Query().Get("nonce")
+	f.issuer.claims = claims
+	f.issuer.mu.Unlock()
+
+	cb := httptest.NewRequest(http.MethodGet, "/auth/google/callback?code=abc&state="+url.QueryEscape(loc.Query().Get("state")), nil)
+	for _, c := range append(start.Result().Cookies(), cookies...) {
+		cb.AddCookie(c)
+	}
+	rec := httptest.NewRecorder()
+	f.oauth.Callback(rec, cb)
+	return rec
+}
+
+func googleClaimsFor(sub, email string) map[string]any {
+	return map[string]any{"sub": sub, "email": email, "email_verified": true, "name": "Test User"}
+}
+
+func sessionCookie(rec *httptest.ResponseRecorder) *http.Cookie {
+	for _, c := range rec.Result().Cookies() {
+		if c.Name == "dae_session" && c.Value != "" {
+			return c
+		}
+	}
+	return nil
+}
+
+func TestCallbackCreatesUserOnFirstLogin(t *testing.T) {
+	f := newTestFlow(t)
+
+	rec := f.login(t, googleClaimsFor("g-1", "a@example.com"))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	if f.users.created != 1 || f.users.logins != 1 {
+		t.Fatalf("created = %d, logins = %d, want 1 and 1", f.users.created, f.users.logins)
+	}
+
+	cookie := sessionCookie(rec)
+	if cookie == nil {
+		t.Fatalf("no session cookie set")
+	}
+	r := httptest.NewRequest(http.MethodGet, "/v1/me", nil)
+	r.AddCookie(cookie)
+	s, err := f.sessions.FromRequest(r)
+	if err != nil {
+		t.Fatalf("session: %v", err)
+	}
+	if s.UserID != "user-g-1" || len(s.Roles) != 1 || s.Roles[0] != "user" {
+		t.Fatalf("session = %+v", s)
+	}
+
+	// dae-core was called with the gateway's service token
+	parsed, err := jwt.ParseSigned(f.users.lastCaller, []jose.SignatureAlgorithm{jose.ES256})
+	if err != nil {
+		t.Fatalf("parse service token: %v", err)
+	}
+	var got struct {
+		jwt.Claims
+		Roles []string `json:"roles"`
+	}
+	if err := parsed.UnsafeClaimsWithoutVerification(&got); err != nil {
+		t.Fatalf("claims: %v", err)
+	}
+	if got.Subject != token.ServiceSubject || len(got.Roles) != 1 || got.Roles[0] != token.RoleService {
+		t.Fatalf("service token claims = %+v", got)
+	}
+
+	// A second login finds the linked user instead of creating another
+	rec = f.login(t, googleClaimsFor("g-1", "a@example.com"))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("second login status = %d", rec.Code)
+	}
+	if f.users.created != 1 || f.users.logins != 2 {
+		t.Fatalf("created = %d, logins = %d, want 1 and 2", f.users.created, f.users.logins)
+	}
+}
+
+func TestCallbackRejections(t *testing.T) {
+	tests := map[string]struct {
+		setup  func(f *testFlow)
+		claims map[string]any
+		want   int
+	}{
+		"disabled user": {
+			setup: func(f *testFlow) {
+				f.users.bySubject["g-2"] = &pb.User{Id: "u2"}
+				f.users.disabled["u2"] = true
+			},
+			claims: googleClaimsFor("g-2", "b@example.com"),
+			want:   http.StatusForbidden,
+		},
+		"email owned by another account": {
+			setup:  func(f *testFlow) { f.users.emails["c@example.com"] = true },
+			claims: googleClaimsFor("g-3", "c@example.com"),
+			want:   http.StatusConflict,
+		},
+		"unverified email": {
+			claims: map[string]any{"sub": "g-4", "email": "d@example.com", "email_verified": false},
+			want:   http.StatusForbidden,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newTestFlow(t)
+			if tt.setup != nil {
+				tt.setup(f)
+			}
+			rec := f.login(t, tt.claims)
+			if rec.Code != tt.want {
+				t.Fatalf("status = %d, want %d (body %s)", rec.Code, tt.want, rec.Body.String())
+			}
+			if sessionCookie(rec) != nil {
+				t.Fatalf("session issued for rejected login")
+			}
+		})
+	}
+}
+
+func TestCallbackRejectsStateMismatch(t *testing.T) {
+	f := newTestFlow(t)
+
+	start := httptest.NewRecorder()
+	f.oauth.Start(start, httptest.NewRequest(http.MethodGet, "/auth/google/start", nil))
+
+	cb := httptest.NewRequest(http.MethodGet, "/auth/google/callback?code=abc&state=forged", nil)
+	for _, c := range start.Result().Cookies() {
+		cb.AddCookie(c)
+	}
+	rec := httptest.NewRecorder()
+	f.oauth.Callback(rec, cb)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want 400", rec.Code)
+	}
+}
+
+func TestLinkGoogleIdentity(t *testing.T) {
+	f := newTestFlow(t)
+	f.users.bySubject["g-existing"] = &pb.User{Id: "u-other"}
+
+	rec := f.login(t, googleClaimsFor("g-1", "a@example.com"))
+	cookie := sessionCookie(rec)
+	if cookie == nil {
+		t.Fatalf("no session cookie set")
+	}
+
+	rec = f.run(t, f.oauth.Link, googleClaimsFor("g-2", "second@example.com"))
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("link without session: status = %d, want 401", rec.Code)
+	}
+
+	rec = f.run(t, f.oauth.Link, googleClaimsFor("g-2", "second@example.com"), cookie)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("link: status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	if u := f.users.bySubject["g-2"]; u == nil || u.GetId() != "user-g-1" {
+		t.Fatalf("g-2 linked to %v, want user-g-1", u)
+	}
+	if f.users.created != 1 {
+		t.Fatalf("created = %d, linking must not create users", f.users.created)
+	}
+
+	rec = f.run(t, f.oauth.Link, googleClaimsFor("g-existing", "other@example.com"), cookie)
+	if rec.Code != http.StatusConflict {
+		t.Fatalf("link taken identity: status = %d, want 409", rec.Code)
+	}
+}
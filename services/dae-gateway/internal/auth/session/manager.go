@@ -0,0 +1,165 @@
+package session
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const defaultCookieName = "dae_session"
+
+var errBadCookie = errors.New("invalid session cookie")
+
+type Config struct {
+	CookieName string
+	Secret     []byte // HMAC key for the cookie, at least 32 bytes
+	TTL        time.Duration
+	Secure     bool // send the cookie over HTTPS only
+}
+
+// Manager issues sessions as signed cookies backed by a Store. The cookie
+// only carries the session ID and its MAC; revoking the stored session ends
+// it even while the cookie is still valid.
+type Manager struct {
+	store Store
+	cfg   Config
+	now   func() time.Time
+	newID func() string
+}
+
+func NewManager(store Store, cfg Config) (*Manager, error) {
+	if len(cfg.Secret) < 32 {
+		return nil, errors.New("session secret must be at least 32 bytes")
+	}
+	if cfg.CookieName == "" {
+		cfg.CookieName = defaultCookieName
+	}
+	if cfg.TTL <= 0 {
+		cfg.TTL = 7 * 24 * time.Hour
+	}
+
+	return &Manager{
+		store: store,
+		cfg:   cfg,
+		now:   time.Now,
+		newID: randomID,
+	}, nil
+}
+
+// Issue starts a session for the user and sets its cookie
+func (m *Manager) Issue(ctx context.Context, w http.ResponseWriter, userID string, roles []string) (*Session, error) {
+	now := m.now().UTC()
+	s := &Session{
+		ID:        m.newID(),
+		UserID:    userID,
+		Roles:     roles,
+		CreatedAt: now,
+		ExpiresAt: now.Add(m.cfg.TTL),
+	}
+	if err := m.store.Save(ctx, s); err != nil {
+		return nil, err
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     m.cfg.CookieName,
+		Value:    m.sign(s.ID),
+		Path:     "/",
+		Expires:  s.ExpiresAt,
+		HttpOnly: true,
+		Secure:   m.cfg.Secure,
+		SameSite: http.SameSiteLaxMode,
+	})
+	return s, nil
+}
+
+// FromRequest returns the live session named by the request's cookie
+func (m *Manager) FromRequest(r *http.Request) (*Session, error) {
+	cookie, err := r.Cookie(m.cfg.CookieName)
+	if err != nil {
+		return nil, ErrNotFound
+	}
+	id, err := m.verify(cookie.Value)
+	if err != nil {
+		return nil, ErrNotFound
+	}
+
+	s, err := m.store.Get(r.Context(), id)
+	if err != nil {
+		return nil, err
+	}
+	if s.Expired(m.now()) {
+		return nil, ErrNotFound
+	}
+	return s, nil
+}
+
+// Revoke ends the request's session, if any, and clears its cookie
+func (m *Manager) Revoke(w http.ResponseWriter, r *http.Request) error {
+	http.SetCookie(w, &http.Cookie{
+		Name:     m.cfg.CookieName,
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   m.cfg.Secure,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	cookie, err := r.Cookie(m.cfg.CookieName)
+	if err != nil {
+		return nil
+	}
+	id, err := m.verify(cookie.Value)
+	if err != nil {
+		return nil
+	}
+	return m.store.Revoke(r.Context(), id)
+}
+
+// RevokeUser ends every session of the user
+func (m *Manager) RevokeUser(ctx context.Context, userID string) error {
+	return m.store.RevokeUser(ctx, userID)
+}
+
+func (m *Manager) sign(id string) string {
+	return id + "." + base64.RawURLEncoding.EncodeToString(m.mac(id))
+}
+
+func (m *Manager) verify(value string) (string, error) {
+	id, sig, ok := strings.Cut(value, ".")
+	if !ok || id == "" {
+		return "", errBadCookie
+	}
+	got, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil || !hmac.Equal(got, m.mac(id)) {
+		return "", errBadCookie
+	}
+	return id, nil
+}
+
+func (m *Manager) mac(id string) []byte {
+	h := hmac.New(sha256.New, m.cfg.Secret)
+	h.Write([]byte(id))
+	return h.Sum(nil)
+}
+
+func randomID() string {
+	b := make([]byte, 32)
+	_, _ = rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// Logout is an HTTP handler that revokes the caller's session
+func (m *Manager) Logout(w http.ResponseWriter, r *http.Request) {
+	if err := m.Revoke(w, r); err != nil {
+		http.Error(w, "failed to revoke session", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,109 @@
+package session
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestManager(t *testing.T) *Manager {
+	t.Helper()
+	m, err := NewManager(NewMemoryStore(), Config{Secret: []byte(strings.Repeat("k", 32)), TTL: time.Hour})
+	if err != nil {
+		t.Fatalf("new manager: %v", err)
+	}
+	return m
+}
+
+func issue(t *testing.T, m *Manager, userID string) *http.Cookie {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	if _, err := m.Issue(context.Background(), rec, userID, []string{"user"}); err != nil {
+		t.Fatalf("issue: %v", err)
+	}
+	cookies := rec.Result().Cookies()
+	if len(cookies) != 1 {
+		t.Fatalf("cookies = %d, want 1", len(cookies))
+	}
+	return cookies[0]
+}
+
+func requestWith(c *http.Cookie) *http.Request {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.AddCookie(c)
+	return r
+}
+
+func TestManagerRoundTrip(t *testing.T) {
+	m := newTestManager(t)
+	cookie := issue(t, m, "u1")
+	if !cookie.HttpOnly {
+		t.Fatalf("session cookie must be HttpOnly")
+	}
+
+	s, err := m.FromRequest(requestWith(cookie))
+	if err != nil {
+		t.Fatalf("from request: %v", err)
+	}
+	if s.UserID != "u1" || len(s.Roles) != 1 {
+		t.Fatalf("session = %+v", s)
+	}
+}
+
+func TestManagerRejectsTamperedCookie(t *testing.T) {
+	m := newTestManager(t)
+	cookie := issue(t, m, "u1")
+
+	tests := map[string]string{
+		"unsigned":      strings.SplitN(cookie.Value, ".", 2)[0],
+		"other id":      "forged." + strings.SplitN(cookie.Value, ".", 2)[1],
+		"bad signature": cookie.Value + "x",
+		"empty":         "",
+	}
+	for name, value := range tests {
+		t.Run(name, func(t *testing.T) {
+			forged := &http.Cookie{Name: cookie.Name, Value: value}
+			if _, err := m.FromRequest(requestWith(forged)); err != ErrNotFound {
+				t.Fatalf("err = %v, want ErrNotFound", err)
+			}
+		})
+	}
+}
+
+func TestManagerRevoke(t *testing.T) {
+	m := newTestManager(t)
+	cookie := issue(t, m, "u1")
+
+	rec := httptest.NewRecorder()
+	m.Logout(rec, requestWith(cookie))
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("logout status = %d", rec.Code)
+	}
+	if _, err := m.FromRequest(requestWith(cookie)); err != ErrNotFound {
+		t.Fatalf("revoked session: err = %v, want ErrNotFound", err)
+	}
+
+	// Revoking a user ends all of their sessions
+	a, b := issue(t, m, "u2"), issue(t, m, "u2")
+	if err := m.RevokeUser(context.Background(), "u2"); err != nil {
+		t.Fatalf("revoke user: %v", err)
+	}
+	for _, c := range []*http.Cookie{a, b} {
+		if _, err := m.FromRequest(requestWith(c)); err != ErrNotFound {
+			t.Fatalf("err = %v, want ErrNotFound", err)
+		}
+	}
+}
+
+func TestManagerExpiry(t *testing.T) {
+	m := newTestManager(t)
+	cookie := issue(t, m, "u1")
+
+	m.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	if _, err := m.FromRequest(requestWith(cookie)); err != ErrNotFound {
+		t.Fatalf("expired session: err = %v, want ErrNotFound", err)
+	}
+}
@@ -0,0 +1,65 @@
+package session
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type memoryStore struct {
+	mu       sync.Mutex
+	sessions map[string]Session
+	now      func() time.Time
+}
+
+// NewMemoryStore keeps sessions in process. Sessions are lost on restart and
+// not shared between gateway replicas.
+func NewMemoryStore() Store {
+	return &memoryStore{
+		sessions: make(map[string]Session),
+		now:      time.Now,
+	}
+}
+
+func (m *memoryStore) Save(_ context.Context, s *Session) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.sessions[s.ID] = *s
+	return nil
+}
+
+func (m *memoryStore) Get(_ context.Context, id string) (*Session, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s, ok := m.sessions[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	if s.Expired(m.now()) {
+		delete(m.sessions, id)
+		return nil, ErrNotFound
+	}
+	return &s, nil
+}
+
+func (m *memoryStore) Revoke(_ context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.sessions, id)
+	return nil
+}
+
+func (m *memoryStore) RevokeUser(_ context.Context, userID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for id, s := range m.sessions {
+		if s.UserID == userID {
+			delete(m.sessions, id)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package session
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type redisStore struct {
+	client *redis.Client
+}
+
+// NewRedisStore keeps sessions in Redis, expiring with the session. Each user
+// has a set of session IDs so RevokeUser can find them.
+func NewRedisStore(client *redis.Client) Store {
+	return &redisStore{client: client}
+}
+
+func sessionKey(id string) string      { return fmt.Sprintf("session:%s", id) }
+func userSessionsKey(id string) string { return fmt.Sprintf("session:user:%s", id) }
+
+func (r *redisStore) Save(ctx context.Context, s *Session) error {
+	raw, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("marshal session: %w", err)
+	}
+
+	ttl := time.Until(s.ExpiresAt)
+	if ttl <= 0 {
+		return errors.New("session already expired")
+	}
+
+	pipe := r.client.TxPipeline()
+	pipe.Set(ctx, sessionKey(s.ID), raw, ttl)
+	pipe.SAdd(ctx, userSessionsKey(s.UserID), s.ID)
+	pipe.ExpireGT(ctx, userSessionsKey(s.UserID), ttl)
+	pipe.ExpireNX(ctx, userSessionsKey(s.UserID), ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("save session: %w", err)
+	}
+	return nil
+}
+
+func (r *redisStore) Get(ctx context.Context, id string) (*Session, error) {
+	raw, err := r.client.Get(ctx, sessionKey(id)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get session: %w", err)
+	}
+
+	var s Session
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return nil, fmt.Errorf("unmarshal session: %w", err)
+	}
+	return &s, nil
+}
+
+func (r *redisStore) Revoke(ctx context.Context, id string) error {
+	s, err := r.Get(ctx, id)
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	pipe := r.client.TxPipeline()
+	pipe.Del(ctx, sessionKey(id))
+	pipe.SRem(ctx, userSessionsKey(s.UserID), id)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("revoke session: %w", err)
+	}
+	return nil
+}
+
+func (r *redisStore) RevokeUser(ctx context.Context, userID string) error {
+	ids, err := r.client.SMembers(ctx, userSessionsKey(userID)).Result()
+	if err != nil {
+		return fmt.Errorf("list user sessions: %w", err)
+	}
+
+	keys := []string{userSessionsKey(userID)}
+	for _, id := range ids {
+		keys = append(keys, sessionKey(id))
+	}
+	if err := r.client.Del(ctx, keys...).Err(); err != nil {
+		return fmt.Errorf("revoke user sessions: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrNotFound is returned when a session does not exist, expired or was revoked
+var ErrNotFound = errors.New("session not found")
+
+// Session is a signed-in browser. The roles are copied from the user at login
+// and refresh on the next login.
+type Session struct {
+	ID        string    `json:"id"`
+	UserID    string    `json:"user_id"`
+	Roles     []string  `json:"roles"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func (s *Session) Expired(now time.Time) bool { return !now.Before(s.ExpiresAt) }
+
+// Store keeps sessions server side so they can be revoked before they expire
+type Store interface {
+	Save(ctx context.Context, s *Session) error
+	Get(ctx context.Context, id string) (*Session, error)
+	Revoke(ctx context.Context, id string) error
+	// RevokeUser ends every session of a user
+	RevokeUser(ctx context.Context, userID string) error
+}
@@ -0,0 +1,117 @@
+package token
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	pb "github.com/deni12345/dae-services/proto/gen"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"google.golang.org/grpc/metadata"
+)
+
+// ServiceSubject is the subject of tokens the gateway mints for itself
+const ServiceSubject = "svc:dae-gateway"
+
+// RoleService lets the gateway call dae-core's login RPCs
+const RoleService = "service"
+
+// Signer mints the short-lived tokens dae-core verifies on every call
+type Signer struct {
+	signer   jose.Signer
+	issuer   string
+	audience string
+	ttl      time.Duration
+	now      func() time.Time
+}
+
+type claims struct {
+	jwt.Claims
+	Roles []string `json:"roles,omitempty"`
+}
+
+// NewSigner creates a signer from a private JSON Web Key. Its public half
+// must be in the JWKS dae-core is configured with.
+func NewSigner(key jose.JSONWebKey, issuer, audience string, ttl time.Duration) (*Signer, error) {
+	if key.IsPublic() {
+		return nil, errors.New("signing key must be a private key")
+	}
+	if key.Algorithm == "" {
+		return nil, errors.New("signing key has no alg")
+	}
+	if ttl <= 0 {
+		ttl = 5 * time.Minute
+	}
+
+	opts := (&jose.SignerOptions{}).WithType("JWT")
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.SignatureAlgorithm(key.Algorithm), Key: key}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("create signer: %w", err)
+	}
+
+	return &Signer{
+		signer:   signer,
+		issuer:   issuer,
+		audience: audience,
+		ttl:      ttl,
+		now:      time.Now,
+	}, nil
+}
+
+// LoadSigner reads a private JSON Web Key from path
+func LoadSigner(path, issuer, audience string, ttl time.Duration) (*Signer, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read signing key: %w", err)
+	}
+
+	var key jose.JSONWebKey
+	if err := json.Unmarshal(raw, &key); err != nil {
+		return nil, fmt.Errorf("parse signing key: %w", err)
+	}
+	return NewSigner(key, issuer, audience, ttl)
+}
+
+// Token mints a token for subject holding roles
+func (s *Signer) Token(subject string, roles []string) (string, error) {
+	now := s.now()
+	c := claims{
+		Claims: jwt.Claims{
+			Issuer:    s.issuer,
+			Subject:   subject,
+			Audience:  jwt.Audience{s.audience},
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			Expiry:    jwt.NewNumericDate(now.Add(s.ttl)),
+		},
+		Roles: roles,
+	}
+
+	return jwt.Signed(s.signer).Claims(c).Serialize()
+}
+
+// ServiceContext attaches a gateway service token to outgoing gRPC metadata
+func (s *Signer) ServiceContext(ctx context.Context) (context.Context, error) {
+	tok, err := s.Token(ServiceSubject, []string{RoleService})
+	if err != nil {
+		return nil, err
+	}
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+tok), nil
+}
+
+// RoleNames converts roles to the names dae-core expects in token claims
+func RoleNames(roles []pb.UserRole) []string {
+	names := make([]string, 0, len(roles))
+	for _, r := range roles {
+		if r == pb.UserRole_USER_ROLE_UNSPECIFIED {
+			continue
+		}
+		names = append(names, strings.ToLower(strings.TrimPrefix(r.String(), "USER_ROLE_")))
+	}
+	return names
+}
@@ -0,0 +1,14 @@
+package daecore
+
+import (
+	"context"
+
+	pb "github.com/deni12345/dae-services/proto/gen"
+)
+
+func (c *Client) ListAuditEvents(ctx context.Context, req *pb.ListAuditEventsReq) (*pb.ListAuditEventsResp, error) {
+	ctx, cancel := withTimeout(ctx, c.defaultTimeOut)
+	defer cancel()
+
+	return c.Audit.ListAuditEvents(ctx, req)
+}
@@ -9,10 +9,13 @@ import (
 )
 
 type Client struct {
-	Health pb.HealthServiceClient
-	User   pb.UsersServiceClient
-	Sheet  pb.SheetsServiceClient
-	Order  pb.OrdersServiceClient
+	Health        pb.HealthServiceClient
+	User          pb.UsersServiceClient
+	Sheet         pb.SheetsServiceClient
+	SheetTemplate pb.SheetTemplatesServiceClient
+	Order         pb.OrdersServiceClient
+	Payment       pb.PaymentsServiceClient
+	Audit         pb.AuditServiceClient
 
 	defaultTimeOut time.Duration
 	conn           *grpc.ClientConn
@@ -43,10 +46,13 @@ func New(conn *grpc.ClientConn, defaultTimeout time.Duration) (*Client, error) {
 	}
 
 	return &Client{
-		Health: pb.NewHealthServiceClient(conn),
-		User:   pb.NewUsersServiceClient(conn),
-		Sheet:  pb.NewSheetsServiceClient(conn),
-		Order:  pb.NewOrdersServiceClient(conn),
+		Health:        pb.NewHealthServiceClient(conn),
+		User:          pb.NewUsersServiceClient(conn),
+		Sheet:         pb.NewSheetsServiceClient(conn),
+		SheetTemplate: pb.NewSheetTemplatesServiceClient(conn),
+		Order:         pb.NewOrdersServiceClient(conn),
+		Payment:       pb.NewPaymentsServiceClient(conn),
+		Audit:         pb.NewAuditServiceClient(conn),
 
 		defaultTimeOut: defaultTimeout,
 		conn:           conn,
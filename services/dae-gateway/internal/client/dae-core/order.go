@@ -33,3 +33,17 @@ func (c *Client) ListOrders(ctx context.Context, req *pb.ListOrdersReq) (*pb.Lis
 
 	return c.Order.ListOrders(ctx, req)
 }
+
+func (c *Client) CancelOrder(ctx context.Context, req *pb.CancelOrderReq) (*pb.CancelOrderResp, error) {
+	ctx, cancel := withTimeout(ctx, c.defaultTimeOut)
+	defer cancel()
+
+	return c.Order.CancelOrder(ctx, req)
+}
+
+func (c *Client) ConfirmOrder(ctx context.Context, req *pb.ConfirmOrderReq) (*pb.ConfirmOrderResp, error) {
+	ctx, cancel := withTimeout(ctx, c.defaultTimeOut)
+	defer cancel()
+
+	return c.Order.ConfirmOrder(ctx, req)
+}
@@ -0,0 +1,28 @@
+package daecore
+
+import (
+	"context"
+
+	pb "github.com/deni12345/dae-services/proto/gen"
+)
+
+func (c *Client) SetPaymentStatus(ctx context.Context, req *pb.SetPaymentStatusReq) (*pb.SetPaymentStatusResp, error) {
+	ctx, cancel := withTimeout(ctx, c.defaultTimeOut)
+	defer cancel()
+
+	return c.Payment.SetPaymentStatus(ctx, req)
+}
+
+func (c *Client) ListSheetBalances(ctx context.Context, req *pb.ListSheetBalancesReq) (*pb.ListSheetBalancesResp, error) {
+	ctx, cancel := withTimeout(ctx, c.defaultTimeOut)
+	defer cancel()
+
+	return c.Payment.ListSheetBalances(ctx, req)
+}
+
+func (c *Client) ListUserDebts(ctx context.Context, req *pb.ListUserDebtsReq) (*pb.ListUserDebtsResp, error) {
+	ctx, cancel := withTimeout(ctx, c.defaultTimeOut)
+	defer cancel()
+
+	return c.Payment.ListUserDebts(ctx, req)
+}
@@ -41,6 +41,27 @@ func (c *Client) JoinSheet(ctx context.Context, req *pb.JoinSheetRequest) (*pb.J
 	return c.Sheet.JoinSheet(ctx, req)
 }
 
+func (c *Client) CreateSheetInvite(ctx context.Context, req *pb.CreateSheetInviteReq) (*pb.CreateSheetInviteResp, error) {
+	ctx, cancel := withTimeout(ctx, c.defaultTimeOut)
+	defer cancel()
+
+	return c.Sheet.CreateSheetInvite(ctx, req)
+}
+
+func (c *Client) RedeemSheetInvite(ctx context.Context, req *pb.RedeemSheetInviteReq) (*pb.RedeemSheetInviteResp, error) {
+	ctx, cancel := withTimeout(ctx, c.defaultTimeOut)
+	defer cancel()
+
+	return c.Sheet.RedeemSheetInvite(ctx, req)
+}
+
+func (c *Client) RevokeSheetInvite(ctx context.Context, req *pb.RevokeSheetInviteReq) (*pb.RevokeSheetInviteResp, error) {
+	ctx, cancel := withTimeout(ctx, c.defaultTimeOut)
+	defer cancel()
+
+	return c.Sheet.RevokeSheetInvite(ctx, req)
+}
+
 func (c *Client) RemoveMember(ctx context.Context, req *pb.RemoveMemberRequest) (*pb.RemoveMemberResponse, error) {
 	ctx, cancel := withTimeout(ctx, c.defaultTimeOut)
 	defer cancel()
@@ -68,3 +89,31 @@ func (c *Client) GetMenu(ctx context.Context, req *pb.GetMenuReq) (*pb.GetMenuRe
 
 	return c.Sheet.GetMenu(ctx, req)
 }
+
+func (c *Client) ImportMenu(ctx context.Context, req *pb.ImportMenuReq) (*pb.ImportMenuResp, error) {
+	ctx, cancel := withTimeout(ctx, c.defaultTimeOut)
+	defer cancel()
+
+	return c.Sheet.ImportMenu(ctx, req)
+}
+
+func (c *Client) ListMenuVersions(ctx context.Context, req *pb.ListMenuVersionsReq) (*pb.ListMenuVersionsResp, error) {
+	ctx, cancel := withTimeout(ctx, c.defaultTimeOut)
+	defer cancel()
+
+	return c.Sheet.ListMenuVersions(ctx, req)
+}
+
+func (c *Client) DiffMenuVersions(ctx context.Context, req *pb.DiffMenuVersionsReq) (*pb.DiffMenuVersionsResp, error) {
+	ctx, cancel := withTimeout(ctx, c.defaultTimeOut)
+	defer cancel()
+
+	return c.Sheet.DiffMenuVersions(ctx, req)
+}
+
+func (c *Client) GetSheetSettlement(ctx context.Context, req *pb.GetSheetSettlementReq) (*pb.GetSheetSettlementResp, error) {
+	ctx, cancel := withTimeout(ctx, c.defaultTimeOut)
+	defer cancel()
+
+	return c.Sheet.GetSheetSettlement(ctx, req)
+}
@@ -0,0 +1,42 @@
+package daecore
+
+import (
+	"context"
+
+	pb "github.com/deni12345/dae-services/proto/gen"
+)
+
+func (c *Client) CreateSheetTemplate(ctx context.Context, req *pb.CreateSheetTemplateReq) (*pb.CreateSheetTemplateResp, error) {
+	ctx, cancel := withTimeout(ctx, c.defaultTimeOut)
+	defer cancel()
+
+	return c.SheetTemplate.CreateSheetTemplate(ctx, req)
+}
+
+func (c *Client) GetSheetTemplate(ctx context.Context, req *pb.GetSheetTemplateReq) (*pb.GetSheetTemplateResp, error) {
+	ctx, cancel := withTimeout(ctx, c.defaultTimeOut)
+	defer cancel()
+
+	return c.SheetTemplate.GetSheetTemplate(ctx, req)
+}
+
+func (c *Client) ListSheetTemplates(ctx context.Context, req *pb.ListSheetTemplatesReq) (*pb.ListSheetTemplatesResp, error) {
+	ctx, cancel := withTimeout(ctx, c.defaultTimeOut)
+	defer cancel()
+
+	return c.SheetTemplate.ListSheetTemplates(ctx, req)
+}
+
+func (c *Client) UpdateSheetTemplate(ctx context.Context, req *pb.UpdateSheetTemplateReq) (*pb.UpdateSheetTemplateResp, error) {
+	ctx, cancel := withTimeout(ctx, c.defaultTimeOut)
+	defer cancel()
+
+	return c.SheetTemplate.UpdateSheetTemplate(ctx, req)
+}
+
+func (c *Client) DeleteSheetTemplate(ctx context.Context, req *pb.DeleteSheetTemplateReq) (*pb.DeleteSheetTemplateResp, error) {
+	ctx, cancel := withTimeout(ctx, c.defaultTimeOut)
+	defer cancel()
+
+	return c.SheetTemplate.DeleteSheetTemplate(ctx, req)
+}
@@ -47,3 +47,80 @@ func (c *Client) AdminSetUserDisabled(ctx context.Context, req *pb.AdminSetUserD
 
 	return c.User.AdminSetUserDisabled(ctx, req)
 }
+
+func (c *Client) GetUserByIdentity(ctx context.Context, req *pb.GetUserByIdentityReq) (*pb.GetUserByIdentityResp, error) {
+	ctx, cancel := withTimeout(ctx, c.defaultTimeOut)
+	defer cancel()
+
+	return c.User.GetUserByIdentity(ctx, req)
+}
+
+func (c *Client) RecordLogin(ctx context.Context, req *pb.RecordLoginReq) (*pb.RecordLoginResp, error) {
+	ctx, cancel := withTimeout(ctx, c.defaultTimeOut)
+	defer cancel()
+
+	return c.User.RecordLogin(ctx, req)
+}
+
+func (c *Client) AuthenticateLocal(ctx context.Context, req *pb.AuthenticateLocalReq) (*pb.AuthenticateLocalResp, error) {
+	ctx, cancel := withTimeout(ctx, c.defaultTimeOut)
+	defer cancel()
+
+	return c.User.AuthenticateLocal(ctx, req)
+}
+
+func (c *Client) RequestPasswordReset(ctx context.Context, req *pb.RequestPasswordResetReq) (*pb.RequestPasswordResetResp, error) {
+	ctx, cancel := withTimeout(ctx, c.defaultTimeOut)
+	defer cancel()
+
+	return c.User.RequestPasswordReset(ctx, req)
+}
+
+func (c *Client) ConfirmPasswordReset(ctx context.Context, req *pb.ConfirmPasswordResetReq) (*pb.ConfirmPasswordResetResp, error) {
+	ctx, cancel := withTimeout(ctx, c.defaultTimeOut)
+	defer cancel()
+
+	return c.User.ConfirmPasswordReset(ctx, req)
+}
+
+func (c *Client) ChangePassword(ctx context.Context, req *pb.ChangePasswordReq) (*pb.ChangePasswordResp, error) {
+	ctx, cancel := withTimeout(ctx, c.defaultTimeOut)
+	defer cancel()
+
+	return c.User.ChangePassword(ctx, req)
+}
+
+func (c *Client) LinkIdentity(ctx context.Context, req *pb.LinkIdentityReq) (*pb.LinkIdentityResp, error) {
+	ctx, cancel := withTimeout(ctx, c.defaultTimeOut)
+	defer cancel()
+
+	return c.User.LinkIdentity(ctx, req)
+}
+
+func (c *Client) UnlinkIdentity(ctx context.Context, req *pb.UnlinkIdentityReq) (*pb.UnlinkIdentityResp, error) {
+	ctx, cancel := withTimeout(ctx, c.defaultTimeOut)
+	defer cancel()
+
+	return c.User.UnlinkIdentity(ctx, req)
+}
+
+func (c *Client) ListIdentities(ctx context.Context, req *pb.ListIdentitiesReq) (*pb.ListIdentitiesResp, error) {
+	ctx, cancel := withTimeout(ctx, c.defaultTimeOut)
+	defer cancel()
+
+	return c.User.ListIdentities(ctx, req)
+}
+
+func (c *Client) DeleteUser(ctx context.Context, req *pb.DeleteUserReq) (*pb.DeleteUserResp, error) {
+	ctx, cancel := withTimeout(ctx, c.defaultTimeOut)
+	defer cancel()
+
+	return c.User.DeleteUser(ctx, req)
+}
+
+func (c *Client) ExportUserData(ctx context.Context, req *pb.ExportUserDataReq) (*pb.ExportUserDataResp, error) {
+	ctx, cancel := withTimeout(ctx, c.defaultTimeOut)
+	defer cancel()
+
+	return c.User.ExportUserData(ctx, req)
+}
@@ -0,0 +1,77 @@
+package rest
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	pb "github.com/deni12345/dae-services/proto/gen"
+	"github.com/go-chi/chi/v5"
+)
+
+func (h *Handler) deleteMe(w http.ResponseWriter, r *http.Request) {
+	userID, err := bearerSubject(r)
+	if err != nil {
+		unauthorized(w, r, err.Error())
+		return
+	}
+
+	if !h.softDelete(w, r, userID) {
+		return
+	}
+	if err := h.sessions.Revoke(w, r); err != nil {
+		slog.ErrorContext(r.Context(), "failed to revoke session", "error", err)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) deleteUser(w http.ResponseWriter, r *http.Request) {
+	if h.softDelete(w, r, chi.URLParam(r, "userID")) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// softDelete deletes the account and ends its sessions, which would otherwise
+// keep minting tokens until they expire. It writes the error response and
+// returns false when dae-core refuses.
+func (h *Handler) softDelete(w http.ResponseWriter, r *http.Request, userID string) bool {
+	req := &pb.DeleteUserReq{UserId: userID}
+	if _, err := h.core.DeleteUser(outgoingContext(r, req), req); err != nil {
+		writeError(w, r, err)
+		return false
+	}
+
+	if err := h.sessions.RevokeUser(r.Context(), userID); err != nil {
+		slog.ErrorContext(r.Context(), "failed to revoke sessions of deleted user", "user_id", userID, "error", err)
+	}
+	return true
+}
+
+func (h *Handler) exportMyData(w http.ResponseWriter, r *http.Request) {
+	userID, err := bearerSubject(r)
+	if err != nil {
+		unauthorized(w, r, err.Error())
+		return
+	}
+
+	h.export(w, r, userID)
+}
+
+func (h *Handler) exportUserData(w http.ResponseWriter, r *http.Request) {
+	h.export(w, r, chi.URLParam(r, "userID"))
+}
+
+// export sends the archive built by dae-core as a JSON download
+func (h *Handler) export(w http.ResponseWriter, r *http.Request, userID string) {
+	req := &pb.ExportUserDataReq{UserId: userID}
+	resp, err := h.core.ExportUserData(outgoingContext(r, req), req)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", "dae-export-"+userID+".json"))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(resp.GetArchive())
+}
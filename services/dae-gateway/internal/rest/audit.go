@@ -0,0 +1,30 @@
+package rest
+
+import (
+	"net/http"
+
+	pb "github.com/deni12345/dae-services/proto/gen"
+)
+
+func (h *Handler) listAuditEvents(w http.ResponseWriter, r *http.Request) {
+	size, cursor, err := pagination(r)
+	if err != nil {
+		badRequest(w, r, err.Error())
+		return
+	}
+
+	q := r.URL.Query()
+	resourceType, ok := enumParam(q.Get("resource_type"), "AUDIT_RESOURCE_TYPE_", pb.AuditResourceType_value)
+	if !ok {
+		badRequest(w, r, "unknown resource_type")
+		return
+	}
+	filter := &pb.ListAuditEventsFilter{
+		ResourceType: pb.AuditResourceType(resourceType),
+		ResourceId:   q.Get("resource_id"),
+		ActorId:      q.Get("actor_id"),
+	}
+
+	req := &pb.ListAuditEventsReq{PageSize: size, Cursor: cursor, Filter: filter}
+	serve(w, r, req, h.core.ListAuditEvents, http.StatusOK)
+}
@@ -0,0 +1,119 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	pb "github.com/deni12345/dae-services/proto/gen"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// maxBodyBytes caps request bodies; menu imports are the largest payloads
+const maxBodyBytes = 1 << 20
+
+var (
+	marshaler   = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+	unmarshaler = protojson.UnmarshalOptions{}
+)
+
+// rpc is the shape shared by every daecore.Client call
+type rpc[Req, Resp proto.Message] func(ctx context.Context, req Req) (Resp, error)
+
+// serve forwards req to dae-core and writes the response with code,
+// or the error as problem details
+func serve[Req, Resp proto.Message](w http.ResponseWriter, r *http.Request, req Req, call rpc[Req, Resp], code int) {
+	resp, err := call(outgoingContext(r, req), req)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	if code == http.StatusNoContent {
+		w.WriteHeader(code)
+		return
+	}
+	writeMessage(w, code, resp)
+}
+
+// writeMessage writes m as JSON using proto field names
+func writeMessage(w http.ResponseWriter, code int, m proto.Message) {
+	body, err := marshaler.Marshal(m)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_, _ = w.Write(body)
+}
+
+// readBody reads at most maxBodyBytes of the request body
+func readBody(w http.ResponseWriter, r *http.Request) ([]byte, error) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			return nil, errors.New("request body is too large")
+		}
+		return nil, errors.New("failed to read request body")
+	}
+	return body, nil
+}
+
+// decode fills m from a JSON request body. An empty body leaves m untouched.
+// It writes a problem and returns false when the body is malformed.
+func decode(w http.ResponseWriter, r *http.Request, m proto.Message) bool {
+	body, err := readBody(w, r)
+	if err != nil {
+		badRequest(w, r, err.Error())
+		return false
+	}
+	if len(body) == 0 {
+		return true
+	}
+
+	if err := unmarshaler.Unmarshal(body, m); err != nil {
+		badRequest(w, r, "invalid JSON body: "+err.Error())
+		return false
+	}
+	return true
+}
+
+// pagination reads the page_size and cursor query parameters
+func pagination(r *http.Request) (int32, *pb.Cursor, error) {
+	q := r.URL.Query()
+
+	var size int32
+	if v := q.Get("page_size"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return 0, nil, errors.New("page_size must be an integer")
+		}
+		size = int32(n)
+	}
+
+	var cursor *pb.Cursor
+	if v := q.Get("cursor"); v != "" {
+		cursor = &pb.Cursor{Id: v}
+	}
+
+	return size, cursor, nil
+}
+
+// queryBool reads a boolean query parameter, treating absence as false
+func queryBool(r *http.Request, key string) (bool, error) {
+	v := r.URL.Query().Get(key)
+	if v == "" {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, errors.New(key + " must be a boolean")
+	}
+	return b, nil
+}
@@ -0,0 +1,87 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	idempotencyHeader = "Idempotency-Key"
+	idempotencyMDKey  = "idempotency-key"
+	authorizationMD   = "authorization"
+	idempotencyField  = "idempotency_key"
+)
+
+var errMissingToken = errors.New("missing or malformed bearer token")
+
+// outgoingContext carries the caller's credentials and idempotency key to
+// dae-core. The key is also copied into the request's idempotency_key field
+// when the message has one and the body left it empty.
+func outgoingContext(r *http.Request, req proto.Message) context.Context {
+	ctx := r.Context()
+
+	if auth := r.Header.Get("Authorization"); auth != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, authorizationMD, auth)
+	}
+
+	key := strings.TrimSpace(r.Header.Get(idempotencyHeader))
+	if key == "" {
+		return ctx
+	}
+	ctx = metadata.AppendToOutgoingContext(ctx, idempotencyMDKey, key)
+
+	msg := req.ProtoReflect()
+	fd := msg.Descriptor().Fields().ByName(idempotencyField)
+	if fd != nil && fd.Kind() == protoreflect.StringKind && msg.Get(fd).String() == "" {
+		msg.Set(fd, protoreflect.ValueOfString(key))
+	}
+
+	return ctx
+}
+
+// idempotent rejects a request without an Idempotency-Key header. It guards
+// the routes whose dae-core RPC is a write, so a client learns it must send a
+// key before the request reaches dae-core.
+func idempotent(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.TrimSpace(r.Header.Get(idempotencyHeader)) == "" {
+			writeProblem(w, r, Problem{
+				Type:   "about:blank",
+				Title:  "Missing Idempotency-Key",
+				Status: http.StatusBadRequest,
+				Detail: "this request changes data and must carry an Idempotency-Key header; retries must reuse the same key",
+			})
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// bearerSubject returns the sub claim of the caller's bearer token. The
+// signature is not checked here; dae-core verifies the token on every call,
+// so the subject only routes /v1/me requests to the right user.
+func bearerSubject(r *http.Request) (string, error) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "bearer") || token == "" {
+		return "", errMissingToken
+	}
+
+	parsed, err := jwt.ParseSigned(token, []jose.SignatureAlgorithm{jose.RS256, jose.ES256, jose.EdDSA})
+	if err != nil {
+		return "", errMissingToken
+	}
+
+	var claims jwt.Claims
+	if err := parsed.UnsafeClaimsWithoutVerification(&claims); err != nil || claims.Subject == "" {
+		return "", errMissingToken
+	}
+	return claims.Subject, nil
+}
@@ -0,0 +1,73 @@
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	pb "github.com/deni12345/dae-services/proto/gen"
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *Handler) createOrder(w http.ResponseWriter, r *http.Request) {
+	req := &pb.CreateOrderReq{}
+	if !decode(w, r, req) {
+		return
+	}
+	req.SheetId = chi.URLParam(r, "sheetID")
+
+	serve(w, r, req, h.core.CreateOrder, http.StatusCreated)
+}
+
+func (h *Handler) listOrders(w http.ResponseWriter, r *http.Request) {
+	size, cursor, err := pagination(r)
+	if err != nil {
+		badRequest(w, r, err.Error())
+		return
+	}
+
+	q := r.URL.Query()
+	filter := &pb.ListOrdersFilter{
+		SheetId: chi.URLParam(r, "sheetID"),
+		UserId:  q.Get("user_id"),
+	}
+	if v := q.Get("since"); v != "" {
+		since, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			badRequest(w, r, "since must be an RFC 3339 timestamp")
+			return
+		}
+		filter.Since = timestamppb.New(since)
+	}
+	status, ok := enumParam(q.Get("status"), "ORDER_STATUS_", pb.OrderStatus_value)
+	if !ok {
+		badRequest(w, r, "unknown status")
+		return
+	}
+	filter.Status = pb.OrderStatus(status)
+
+	req := &pb.ListOrdersReq{PageSize: size, Cursor: cursor, Filter: filter}
+	serve(w, r, req, h.core.ListOrders, http.StatusOK)
+}
+
+func (h *Handler) getOrder(w http.ResponseWriter, r *http.Request) {
+	serve(w, r, &pb.GetOrderReq{Id: chi.URLParam(r, "orderID")}, h.core.GetOrder, http.StatusOK)
+}
+
+func (h *Handler) updateOrder(w http.ResponseWriter, r *http.Request) {
+	req := &pb.UpdateOrderReq{}
+	if !decode(w, r, req) {
+		return
+	}
+	req.Id = chi.URLParam(r, "orderID")
+
+	serve(w, r, req, h.core.UpdateOrder, http.StatusOK)
+}
+
+func (h *Handler) cancelOrder(w http.ResponseWriter, r *http.Request) {
+	serve(w, r, &pb.CancelOrderReq{Id: chi.URLParam(r, "orderID")}, h.core.CancelOrder, http.StatusOK)
+}
+
+func (h *Handler) confirmOrder(w http.ResponseWriter, r *http.Request) {
+	serve(w, r, &pb.ConfirmOrderReq{Id: chi.URLParam(r, "orderID")}, h.core.ConfirmOrder, http.StatusOK)
+}
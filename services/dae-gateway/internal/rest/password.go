@@ -0,0 +1,159 @@
+package rest
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/deni1234/dae-services/dae-gateway/internal/auth/token"
+	pb "github.com/deni12345/dae-services/proto/gen"
+	"google.golang.org/protobuf/proto"
+)
+
+// ResetSender delivers a password reset token to the owner of email
+type ResetSender interface {
+	SendPasswordReset(ctx context.Context, email, token string, expiresAt time.Time) error
+}
+
+// LogResetSender writes reset tokens to the log instead of mailing them. It is
+// meant for local development only.
+type LogResetSender struct{}
+
+func (LogResetSender) SendPasswordReset(ctx context.Context, email, token string, expiresAt time.Time) error {
+	slog.WarnContext(ctx, "password reset token issued", "email", email, "reset_token", token, "expires_at", expiresAt)
+	return nil
+}
+
+// loginLocal checks an email and password and starts a session for the user
+func (h *Handler) loginLocal(w http.ResponseWriter, r *http.Request) {
+	req := &pb.AuthenticateLocalReq{}
+	if !decode(w, r, req) {
+		return
+	}
+	ctx, ok := h.serviceContext(w, r, req)
+	if !ok {
+		return
+	}
+
+	resp, err := h.core.AuthenticateLocal(ctx, req)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	user := resp.GetUser()
+	if _, err := h.sessions.Issue(r.Context(), w, user.GetId(), token.RoleNames(user.GetRoles())); err != nil {
+		slog.ErrorContext(r.Context(), "failed to issue session", "error", err)
+		internalError(w, r)
+		return
+	}
+	writeMessage(w, http.StatusOK, resp)
+}
+
+// requestPasswordReset sends a reset token to the account's owner. The reply
+// is the same whether or not the email has a password login, and never
+// carries the token.
+func (h *Handler) requestPasswordReset(w http.ResponseWriter, r *http.Request) {
+	if h.resets == nil {
+		writeProblem(w, r, Problem{
+			Type:   "about:blank",
+			Title:  http.StatusText(http.StatusNotImplemented),
+			Status: http.StatusNotImplemented,
+			Detail: "password reset delivery is not configured",
+		})
+		return
+	}
+
+	req := &pb.RequestPasswordResetReq{}
+	if !decode(w, r, req) {
+		return
+	}
+	ctx, ok := h.serviceContext(w, r, req)
+	if !ok {
+		return
+	}
+
+	resp, err := h.core.RequestPasswordReset(ctx, req)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	if resp.GetResetToken() != "" {
+		if err := h.resets.SendPasswordReset(r.Context(), req.GetEmail(), resp.GetResetToken(), resp.GetExpiresAt().AsTime()); err != nil {
+			slog.ErrorContext(r.Context(), "failed to send password reset", "error", err)
+			internalError(w, r)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// confirmPasswordReset sets a new password with a reset token and ends every
+// session of the account
+func (h *Handler) confirmPasswordReset(w http.ResponseWriter, r *http.Request) {
+	req := &pb.ConfirmPasswordResetReq{}
+	if !decode(w, r, req) {
+		return
+	}
+	ctx, ok := h.serviceContext(w, r, req)
+	if !ok {
+		return
+	}
+
+	resp, err := h.core.ConfirmPasswordReset(ctx, req)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	if err := h.sessions.RevokeUser(r.Context(), resp.GetUserId()); err != nil {
+		slog.ErrorContext(r.Context(), "failed to revoke sessions after password reset", "user_id", resp.GetUserId(), "error", err)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// changeMyPassword replaces the caller's password and ends every session of
+// the account, the caller's included, so a stolen session does not outlive
+// the old password
+func (h *Handler) changeMyPassword(w http.ResponseWriter, r *http.Request) {
+	userID, err := bearerSubject(r)
+	if err != nil {
+		unauthorized(w, r, err.Error())
+		return
+	}
+
+	req := &pb.ChangePasswordReq{}
+	if !decode(w, r, req) {
+		return
+	}
+	if _, err := h.core.ChangePassword(outgoingContext(r, req), req); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	if err := h.sessions.RevokeUser(r.Context(), userID); err != nil {
+		slog.ErrorContext(r.Context(), "failed to revoke sessions after password change", "user_id", userID, "error", err)
+	}
+	if err := h.sessions.Revoke(w, r); err != nil {
+		slog.ErrorContext(r.Context(), "failed to revoke session", "error", err)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// serviceContext calls dae-core as the gateway itself, for the login RPCs
+// only it may call. Credentials the request carries are not forwarded. It
+// writes a problem and returns false when no service token can be minted.
+func (h *Handler) serviceContext(w http.ResponseWriter, r *http.Request, req proto.Message) (context.Context, bool) {
+	anon := r.Clone(r.Context())
+	anon.Header.Del("Authorization")
+
+	ctx, err := h.signer.ServiceContext(outgoingContext(anon, req))
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to mint service token", "error", err)
+		internalError(w, r)
+		return nil, false
+	}
+	return ctx, true
+}
@@ -0,0 +1,228 @@
+package rest
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/deni1234/dae-services/dae-gateway/internal/auth/session"
+	"github.com/deni1234/dae-services/dae-gateway/internal/auth/token"
+	daecore "github.com/deni1234/dae-services/dae-gateway/internal/client/dae-core"
+	pb "github.com/deni12345/dae-services/proto/gen"
+	"github.com/go-jose/go-jose/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// passwordServer answers the password RPCs for one account and records the
+// subject of each call's bearer token
+type passwordServer struct {
+	pb.UnimplementedUsersServiceServer
+	callers []string
+}
+
+func (s *passwordServer) record(ctx context.Context) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.callers = append(s.callers, strings.Join(md.Get(authorizationMD), ","))
+}
+
+func (s *passwordServer) AuthenticateLocal(ctx context.Context, req *pb.AuthenticateLocalReq) (*pb.AuthenticateLocalResp, error) {
+	s.record(ctx)
+	if req.GetPassword() != "old-password" {
+		return nil, status.Error(codes.Unauthenticated, "invalid email or password")
+	}
+	return &pb.AuthenticateLocalResp{User: &pb.User{Id: "u-1", Roles: []pb.UserRole{pb.UserRole_USER_ROLE_USER}}}, nil
+}
+
+func (s *passwordServer) RequestPasswordReset(ctx context.Context, req *pb.RequestPasswordResetReq) (*pb.RequestPasswordResetResp, error) {
+	s.record(ctx)
+	if req.GetEmail() != "a@example.com" {
+		return &pb.RequestPasswordResetResp{}, nil
+	}
+	return &pb.RequestPasswordResetResp{ResetToken: "u-1.secret", ExpiresAt: timestamppb.Now()}, nil
+}
+
+func (s *passwordServer) ConfirmPasswordReset(ctx context.Context, req *pb.ConfirmPasswordResetReq) (*pb.ConfirmPasswordResetResp, error) {
+	s.record(ctx)
+	if req.GetResetToken() != "u-1.secret" {
+		return nil, status.Error(codes.InvalidArgument, "invalid or expired reset token")
+	}
+	return &pb.ConfirmPasswordResetResp{UserId: "u-1"}, nil
+}
+
+func (s *passwordServer) ChangePassword(ctx context.Context, _ *pb.ChangePasswordReq) (*pb.ChangePasswordResp, error) {
+	s.record(ctx)
+	return &pb.ChangePasswordResp{}, nil
+}
+
+type fakeResetSender struct {
+	sent map[string]string
+}
+
+func (s *fakeResetSender) SendPasswordReset(_ context.Context, email, token string, _ time.Time) error {
+	s.sent[email] = token
+	return nil
+}
+
+type passwordAPI struct {
+	handler  http.Handler
+	core     *passwordServer
+	sessions *session.Manager
+	resets   *fakeResetSender
+}
+
+func newPasswordAPI(t *testing.T) *passwordAPI {
+	t.Helper()
+
+	srv := &passwordServer{}
+	lis := bufconn.Listen(1 << 20)
+	gs := grpc.NewServer()
+	pb.RegisterUsersServiceServer(gs, srv)
+	go func() { _ = gs.Serve(lis) }()
+	t.Cleanup(gs.Stop)
+
+	conn, err := daecore.Dial(context.Background(), daecore.DialConfig{Addr: "passthrough:///bufnet", Insecure: true},
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	core, err := daecore.New(conn, 5*time.Second)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	signer, err := token.NewSigner(jose.JSONWebKey{Key: key, KeyID: "gw", Algorithm: "ES256"}, "dae-gateway", "dae-core", time.Minute)
+	if err != nil {
+		t.Fatalf("new signer: %v", err)
+	}
+	sessions, err := session.NewManager(session.NewMemoryStore(), session.Config{Secret: []byte(strings.Repeat("s", 32))})
+	if err != nil {
+		t.Fatalf("new session manager: %v", err)
+	}
+
+	resets := &fakeResetSender{sent: map[string]string{}}
+	h := NewHandler(core, sessions, signer, resets)
+	return &passwordAPI{
+		handler:  SessionAuth(sessions, signer)(h.Routes()),
+		core:     srv,
+		sessions: sessions,
+		resets:   resets,
+	}
+}
+
+// do sends a request with a fresh Idempotency-Key, as clients must for writes
+func (a *passwordAPI) do(method, path, body string, cookies ...*http.Cookie) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set(idempotencyHeader, rand.Text())
+	for _, c := range cookies {
+		req.AddCookie(c)
+	}
+	rec := httptest.NewRecorder()
+	a.handler.ServeHTTP(rec, req)
+	return rec
+}
+
+// login starts a session for u-1 and returns its cookie
+func (a *passwordAPI) login(t *testing.T) *http.Cookie {
+	t.Helper()
+	rec := a.do(http.MethodPost, "/auth/login", `{"email":"a@example.com","password":"old-password"}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("login status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	cookies := rec.Result().Cookies()
+	if len(cookies) != 1 {
+		t.Fatalf("login set %d cookies, want 1", len(cookies))
+	}
+	return cookies[0]
+}
+
+func (a *passwordAPI) live(c *http.Cookie) bool {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.AddCookie(c)
+	_, err := a.sessions.FromRequest(req)
+	return err == nil
+}
+
+func TestLoginLocal(t *testing.T) {
+	a := newPasswordAPI(t)
+
+	cookie := a.login(t)
+	if !a.live(cookie) {
+		t.Fatal("login cookie does not name a live session")
+	}
+	if len(a.core.callers) != 1 || !strings.HasPrefix(a.core.callers[0], "Bearer ") {
+		t.Fatalf("AuthenticateLocal callers = %v, want a service token", a.core.callers)
+	}
+
+	rec := a.do(http.MethodPost, "/auth/login", `{"email":"a@example.com","password":"wrong"}`)
+	if rec.Code != http.StatusUnauthorized || len(rec.Result().Cookies()) != 0 {
+		t.Fatalf("wrong password: status = %d, cookies = %v", rec.Code, rec.Result().Cookies())
+	}
+}
+
+func TestRequestPasswordResetHidesToken(t *testing.T) {
+	a := newPasswordAPI(t)
+
+	for _, email := range []string{"a@example.com", "nobody@example.com"} {
+		rec := a.do(http.MethodPost, "/auth/password-reset", `{"email":"`+email+`"}`)
+		if rec.Code != http.StatusAccepted || rec.Body.Len() != 0 {
+			t.Fatalf("%s: status = %d, body = %q, want 202 and no body", email, rec.Code, rec.Body.String())
+		}
+	}
+	if len(a.resets.sent) != 1 || a.resets.sent["a@example.com"] != "u-1.secret" {
+		t.Fatalf("sent = %v, want one token mailed to a@example.com", a.resets.sent)
+	}
+}
+
+func TestConfirmPasswordResetRevokesSessions(t *testing.T) {
+	a := newPasswordAPI(t)
+	cookie := a.login(t)
+
+	rec := a.do(http.MethodPost, "/auth/password-reset/confirm", `{"reset_token":"forged","new_password":"new-password"}`)
+	if rec.Code != http.StatusBadRequest || !a.live(cookie) {
+		t.Fatalf("forged token: status = %d, session live = %v", rec.Code, a.live(cookie))
+	}
+
+	rec = a.do(http.MethodPost, "/auth/password-reset/confirm", `{"reset_token":"u-1.secret","new_password":"new-password"}`)
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	if a.live(cookie) {
+		t.Fatal("session survived the password reset")
+	}
+}
+
+func TestChangeMyPasswordRevokesSessions(t *testing.T) {
+	a := newPasswordAPI(t)
+	other := a.login(t)
+	mine := a.login(t)
+
+	rec := a.do(http.MethodPut, "/me/password", `{"current_password":"old-password","new_password":"new-password"}`, mine)
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	if a.live(mine) || a.live(other) {
+		t.Fatalf("sessions live after password change: mine = %v, other = %v", a.live(mine), a.live(other))
+	}
+
+	rec = a.do(http.MethodPut, "/me/password", `{"current_password":"old-password","new_password":"new-password"}`)
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("anonymous change: status = %d, want 401", rec.Code)
+	}
+}
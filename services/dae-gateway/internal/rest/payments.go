@@ -0,0 +1,23 @@
+package rest
+
+import (
+	"net/http"
+
+	pb "github.com/deni12345/dae-services/proto/gen"
+	"github.com/go-chi/chi/v5"
+)
+
+func (h *Handler) listSheetBalances(w http.ResponseWriter, r *http.Request) {
+	serve(w, r, &pb.ListSheetBalancesReq{SheetId: chi.URLParam(r, "sheetID")}, h.core.ListSheetBalances, http.StatusOK)
+}
+
+func (h *Handler) setPaymentStatus(w http.ResponseWriter, r *http.Request) {
+	req := &pb.SetPaymentStatusReq{}
+	if !decode(w, r, req) {
+		return
+	}
+	req.SheetId = chi.URLParam(r, "sheetID")
+	req.UserId = chi.URLParam(r, "userID")
+
+	serve(w, r, req, h.core.SetPaymentStatus, http.StatusOK)
+}
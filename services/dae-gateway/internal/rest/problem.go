@@ -0,0 +1,132 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const problemContentType = "application/problem+json"
+
+// Problem is an RFC 9457 problem details body
+type Problem struct {
+	Type          string         `json:"type"`
+	Title         string         `json:"title"`
+	Status        int            `json:"status"`
+	Detail        string         `json:"detail,omitempty"`
+	Instance      string         `json:"instance,omitempty"`
+	InvalidParams []InvalidParam `json:"invalid_params,omitempty"`
+}
+
+// InvalidParam points at one rejected request field
+type InvalidParam struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+var grpcToHTTP = map[codes.Code]int{
+	codes.OK:                 http.StatusOK,
+	codes.Canceled:           499,
+	codes.InvalidArgument:    http.StatusBadRequest,
+	codes.DeadlineExceeded:   http.StatusGatewayTimeout,
+	codes.NotFound:           http.StatusNotFound,
+	codes.AlreadyExists:      http.StatusConflict,
+	codes.PermissionDenied:   http.StatusForbidden,
+	codes.ResourceExhausted:  http.StatusTooManyRequests,
+	codes.FailedPrecondition: http.StatusConflict,
+	codes.Aborted:            http.StatusConflict,
+	codes.OutOfRange:         http.StatusBadRequest,
+	codes.Unimplemented:      http.StatusNotImplemented,
+	codes.Unavailable:        http.StatusServiceUnavailable,
+	codes.Unauthenticated:    http.StatusUnauthorized,
+}
+
+// ProblemFromError translates a dae-core gRPC error into problem details.
+// BadRequest field violations become invalid_params.
+func ProblemFromError(err error) Problem {
+	st := status.Convert(err)
+
+	code, ok := grpcToHTTP[st.Code()]
+	if !ok {
+		code = http.StatusInternalServerError
+	}
+
+	p := Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(code),
+		Status: code,
+		Detail: st.Message(),
+	}
+	if code == 499 {
+		p.Title = "Client Closed Request"
+	}
+	// Do not leak internal error text to clients
+	if code == http.StatusInternalServerError {
+		p.Detail = ""
+	}
+
+	for _, d := range st.Details() {
+		br, ok := d.(*errdetails.BadRequest)
+		if !ok {
+			continue
+		}
+		for _, v := range br.GetFieldViolations() {
+			p.InvalidParams = append(p.InvalidParams, InvalidParam{
+				Name:   v.GetField(),
+				Reason: v.GetDescription(),
+			})
+		}
+	}
+	if len(p.InvalidParams) > 0 {
+		p.Title = "Invalid Request Parameters"
+	}
+
+	return p
+}
+
+// writeProblem writes p as application/problem+json
+func writeProblem(w http.ResponseWriter, r *http.Request, p Problem) {
+	if p.Instance == "" {
+		p.Instance = r.URL.Path
+	}
+	w.Header().Set("Content-Type", problemContentType)
+	w.WriteHeader(p.Status)
+	_ = json.NewEncoder(w).Encode(p)
+}
+
+// writeError writes a gRPC error from dae-core as problem details
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	writeProblem(w, r, ProblemFromError(err))
+}
+
+// badRequest reports a malformed HTTP request that never reached dae-core
+func badRequest(w http.ResponseWriter, r *http.Request, detail string) {
+	writeProblem(w, r, Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(http.StatusBadRequest),
+		Status: http.StatusBadRequest,
+		Detail: detail,
+	})
+}
+
+// unauthorized reports a request without usable credentials
+func unauthorized(w http.ResponseWriter, r *http.Request, detail string) {
+	writeProblem(w, r, Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(http.StatusUnauthorized),
+		Status: http.StatusUnauthorized,
+		Detail: detail,
+	})
+}
+
+// internalError reports a gateway failure without details
+func internalError(w http.ResponseWriter, r *http.Request) {
+	writeProblem(w, r, Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(http.StatusInternalServerError),
+		Status: http.StatusInternalServerError,
+	})
+}
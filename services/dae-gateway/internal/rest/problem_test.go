@@ -0,0 +1,138 @@
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pb "github.com/deni12345/dae-services/proto/gen"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestProblemFromError(t *testing.T) {
+	tests := map[string]struct {
+		err    error
+		status int
+		detail string
+	}{
+		"not found":         {status.Error(codes.NotFound, "sheet not found"), http.StatusNotFound, "sheet not found"},
+		"unauthenticated":   {status.Error(codes.Unauthenticated, "missing token"), http.StatusUnauthorized, "missing token"},
+		"permission denied": {status.Error(codes.PermissionDenied, "not the host"), http.StatusForbidden, "not the host"},
+		"already exists":    {status.Error(codes.AlreadyExists, "duplicate"), http.StatusConflict, "duplicate"},
+		"precondition":      {status.Error(codes.FailedPrecondition, "sheet is closed"), http.StatusConflict, "sheet is closed"},
+		"unavailable":       {status.Error(codes.Unavailable, "down"), http.StatusServiceUnavailable, "down"},
+		"internal hides":    {status.Error(codes.Internal, "firestore: boom"), http.StatusInternalServerError, ""},
+		"plain error":       {errors.New("dial failed"), http.StatusInternalServerError, ""},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := ProblemFromError(tt.err)
+			if p.Status != tt.status {
+				t.Fatalf("status = %d, want %d", p.Status, tt.status)
+			}
+			if p.Detail != tt.detail {
+				t.Fatalf("detail = %q, want %q", p.Detail, tt.detail)
+			}
+			if p.Title != http.StatusText(tt.status) {
+				t.Fatalf("title = %q, want %q", p.Title, http.StatusText(tt.status))
+			}
+		})
+	}
+}
+
+func TestProblemFromErrorFieldViolations(t *testing.T) {
+	st, err := status.New(codes.InvalidArgument, "invalid request").WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: "name", Description: "is required"},
+			{Field: "rows[2].price", Description: "must be positive"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("with details: %v", err)
+	}
+
+	p := ProblemFromError(st.Err())
+	if p.Status != http.StatusBadRequest || p.Type != "about:blank" || p.Title != "Invalid Request Parameters" {
+		t.Fatalf("problem = %+v, want a 400 about invalid parameters", p)
+	}
+	if len(p.InvalidParams) != 2 {
+		t.Fatalf("invalid params = %v, want 2", p.InvalidParams)
+	}
+	if p.InvalidParams[1] != (InvalidParam{Name: "rows[2].price", Reason: "must be positive"}) {
+		t.Fatalf("invalid param = %+v", p.InvalidParams[1])
+	}
+
+	rec := httptest.NewRecorder()
+	writeProblem(rec, httptest.NewRequest(http.MethodPost, "/v1/sheets", nil), p)
+	if ct := rec.Header().Get("Content-Type"); ct != problemContentType {
+		t.Fatalf("content type = %q", ct)
+	}
+	var body map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("decode body: %v", err)
+	}
+	if body["instance"] != "/v1/sheets" || body["invalid_params"] == nil {
+		t.Fatalf("body = %v", body)
+	}
+}
+
+func TestOutgoingContextForwardsIdempotencyKey(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/v1/sheets", nil)
+	r.Header.Set("Idempotency-Key", "key-1")
+	r.Header.Set("Authorization", "Bearer token")
+
+	req := &pb.CreateSheetReq{}
+	md, _ := metadata.FromOutgoingContext(outgoingContext(r, req))
+
+	if got := md.Get(idempotencyMDKey); len(got) != 1 || got[0] != "key-1" {
+		t.Fatalf("idempotency-key metadata = %v", got)
+	}
+	if got := md.Get(authorizationMD); len(got) != 1 || got[0] != "Bearer token" {
+		t.Fatalf("authorization metadata = %v", got)
+	}
+	if req.IdempotencyKey != "key-1" {
+		t.Fatalf("idempotency_key = %q, want key-1", req.IdempotencyKey)
+	}
+
+	// A key already set in the body wins over the header
+	req = &pb.CreateSheetReq{IdempotencyKey: "from-body"}
+	outgoingContext(r, req)
+	if req.IdempotencyKey != "from-body" {
+		t.Fatalf("idempotency_key = %q, want from-body", req.IdempotencyKey)
+	}
+}
+
+func TestIdempotentRequiresHeader(t *testing.T) {
+	reached := 0
+	h := idempotent(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reached++
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/sheets", nil))
+	if rec.Code != http.StatusBadRequest || reached != 0 {
+		t.Fatalf("status = %d, reached = %d, want 400 before the handler", rec.Code, reached)
+	}
+	var p Problem
+	if err := json.Unmarshal(rec.Body.Bytes(), &p); err != nil {
+		t.Fatalf("decode body: %v", err)
+	}
+	if p.Type != "about:blank" || p.Title != "Missing Idempotency-Key" || p.Detail == "" {
+		t.Fatalf("problem = %+v", p)
+	}
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/sheets", nil)
+	r.Header.Set(idempotencyHeader, "key-1")
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, r)
+	if rec.Code != http.StatusCreated || reached != 1 {
+		t.Fatalf("with key: status = %d, reached = %d", rec.Code, reached)
+	}
+}
@@ -0,0 +1,118 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/deni1234/dae-services/dae-gateway/internal/auth/session"
+	"github.com/deni1234/dae-services/dae-gateway/internal/auth/token"
+	daecore "github.com/deni1234/dae-services/dae-gateway/internal/client/dae-core"
+	"github.com/go-chi/chi/v5"
+)
+
+// Handler exposes dae-core over a JSON REST API
+type Handler struct {
+	core     *daecore.Client
+	sessions *session.Manager // starts local logins, ends sessions of deleted users and changed passwords
+	signer   *token.Signer    // service token for the login RPCs
+	resets   ResetSender      // nil disables password reset requests
+}
+
+func NewHandler(core *daecore.Client, sessions *session.Manager, signer *token.Signer, resets ResetSender) *Handler {
+	return &Handler{core: core, sessions: sessions, signer: signer, resets: resets}
+}
+
+// Routes returns the /v1 API. Path parameters override the same fields in a
+// request body. Routes that write through dae-core require an Idempotency-Key
+// header.
+func (h *Handler) Routes() http.Handler {
+	r := chi.NewRouter()
+
+	r.NotFound(func(w http.ResponseWriter, r *http.Request) {
+		writeProblem(w, r, Problem{Type: "about:blank", Title: http.StatusText(http.StatusNotFound), Status: http.StatusNotFound})
+	})
+	r.MethodNotAllowed(func(w http.ResponseWriter, r *http.Request) {
+		writeProblem(w, r, Problem{Type: "about:blank", Title: http.StatusText(http.StatusMethodNotAllowed), Status: http.StatusMethodNotAllowed})
+	})
+
+	r.Route("/auth", func(r chi.Router) {
+		r.Post("/login", h.loginLocal)
+		r.Post("/password-reset", h.requestPasswordReset)
+		r.With(idempotent).Post("/password-reset/confirm", h.confirmPasswordReset)
+	})
+
+	r.Route("/me", func(r chi.Router) {
+		r.Get("/", h.getMe)
+		r.With(idempotent).Patch("/", h.updateMe)
+		r.With(idempotent).Delete("/", h.deleteMe)
+		r.Put("/password", h.changeMyPassword)
+		r.Get("/export", h.exportMyData)
+		r.Get("/debts", h.listMyDebts)
+		r.Get("/identities", h.listMyIdentities)
+		r.Delete("/identities/{provider}", h.unlinkMyIdentity)
+	})
+
+	r.Route("/users", func(r chi.Router) {
+		r.Get("/", h.listUsers)
+		r.Get("/{userID}", h.getUser)
+		r.With(idempotent).Delete("/{userID}", h.deleteUser)
+		r.Get("/{userID}/export", h.exportUserData)
+		r.Get("/{userID}/debts", h.listUserDebts)
+		r.With(idempotent).Put("/{userID}/roles", h.setUserRoles)
+		r.With(idempotent).Put("/{userID}/disabled", h.setUserDisabled)
+		r.Get("/{userID}/identities", h.listUserIdentities)
+		r.Delete("/{userID}/identities/{provider}", h.unlinkUserIdentity)
+	})
+
+	r.Route("/sheets", func(r chi.Router) {
+		r.With(idempotent).Post("/", h.createSheet)
+		r.Get("/", h.listSheets)
+
+		r.Route("/{sheetID}", func(r chi.Router) {
+			r.Get("/", h.getSheet)
+			r.With(idempotent).Patch("/", h.updateSheet)
+
+			r.With(idempotent).Post("/members", h.joinSheet)
+			r.Get("/members", h.listMembers)
+			r.With(idempotent).Delete("/members/{userID}", h.removeMember)
+			r.With(idempotent).Post("/invites", h.createSheetInvite)
+
+			r.Get("/menu", h.getMenu)
+			r.With(idempotent).Put("/menu", h.replaceMenu)
+			r.With(idempotent).Post("/menu/import", h.importMenu)
+			r.Get("/menu/versions", h.listMenuVersions)
+			r.Get("/menu/diff", h.diffMenuVersions)
+
+			r.Get("/settlement", h.getSettlement)
+			r.Get("/balances", h.listSheetBalances)
+			r.With(idempotent).Put("/payments/{userID}", h.setPaymentStatus)
+
+			r.With(idempotent).Post("/template", h.createSheetTemplate)
+
+			r.With(idempotent).Post("/orders", h.createOrder)
+			r.Get("/orders", h.listOrders)
+		})
+	})
+
+	r.Route("/invites/{code}", func(r chi.Router) {
+		r.Post("/redeem", h.redeemSheetInvite)
+		r.Post("/revoke", h.revokeSheetInvite)
+	})
+
+	r.Route("/sheet-templates", func(r chi.Router) {
+		r.Get("/", h.listSheetTemplates)
+		r.Get("/{templateID}", h.getSheetTemplate)
+		r.With(idempotent).Patch("/{templateID}", h.updateSheetTemplate)
+		r.With(idempotent).Delete("/{templateID}", h.deleteSheetTemplate)
+	})
+
+	r.Get("/audit-events", h.listAuditEvents)
+
+	r.Route("/orders/{orderID}", func(r chi.Router) {
+		r.Get("/", h.getOrder)
+		r.With(idempotent).Patch("/", h.updateOrder)
+		r.With(idempotent).Post("/cancel", h.cancelOrder)
+		r.With(idempotent).Post("/confirm", h.confirmOrder)
+	})
+
+	return r
+}
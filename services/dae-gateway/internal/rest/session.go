@@ -0,0 +1,40 @@
+package rest
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/deni1234/dae-services/dae-gateway/internal/auth/session"
+	"github.com/deni1234/dae-services/dae-gateway/internal/auth/token"
+)
+
+// SessionAuth lets browser sessions call the API. A request with a live
+// session cookie and no Authorization header is given a short-lived bearer
+// token for the session's user, which dae-core then verifies as usual.
+func SessionAuth(sessions *session.Manager, signer *token.Signer) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			s, err := sessions.FromRequest(r)
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			tok, err := signer.Token(s.UserID, s.Roles)
+			if err != nil {
+				slog.ErrorContext(r.Context(), "failed to mint session token", "error", err)
+				internalError(w, r)
+				return
+			}
+
+			r = r.Clone(r.Context())
+			r.Header.Set("Authorization", "Bearer "+tok)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package rest
+
+import (
+	"net/http"
+
+	pb "github.com/deni12345/dae-services/proto/gen"
+	"github.com/go-chi/chi/v5"
+)
+
+func (h *Handler) createSheetTemplate(w http.ResponseWriter, r *http.Request) {
+	req := &pb.CreateSheetTemplateReq{}
+	if !decode(w, r, req) {
+		return
+	}
+	req.SheetId = chi.URLParam(r, "sheetID")
+
+	serve(w, r, req, h.core.CreateSheetTemplate, http.StatusCreated)
+}
+
+func (h *Handler) listSheetTemplates(w http.ResponseWriter, r *http.Request) {
+	size, cursor, err := pagination(r)
+	if err != nil {
+		badRequest(w, r, err.Error())
+		return
+	}
+
+	req := &pb.ListSheetTemplatesReq{
+		HostUserId: r.URL.Query().Get("host_user_id"),
+		PageSize:   size,
+		Cursor:     cursor,
+	}
+	serve(w, r, req, h.core.ListSheetTemplates, http.StatusOK)
+}
+
+func (h *Handler) getSheetTemplate(w http.ResponseWriter, r *http.Request) {
+	serve(w, r, &pb.GetSheetTemplateReq{Id: chi.URLParam(r, "templateID")}, h.core.GetSheetTemplate, http.StatusOK)
+}
+
+func (h *Handler) updateSheetTemplate(w http.ResponseWriter, r *http.Request) {
+	req := &pb.UpdateSheetTemplateReq{}
+	if !decode(w, r, req) {
+		return
+	}
+	req.Id = chi.URLParam(r, "templateID")
+
+	serve(w, r, req, h.core.UpdateSheetTemplate, http.StatusOK)
+}
+
+func (h *Handler) deleteSheetTemplate(w http.ResponseWriter, r *http.Request) {
+	req := &pb.DeleteSheetTemplateReq{Id: chi.URLParam(r, "templateID")}
+	serve(w, r, req, h.core.DeleteSheetTemplate, http.StatusNoContent)
+}
@@ -0,0 +1,192 @@
+package rest
+
+import (
+	"mime"
+	"net/http"
+	"strings"
+
+	pb "github.com/deni12345/dae-services/proto/gen"
+	"github.com/go-chi/chi/v5"
+)
+
+func (h *Handler) createSheet(w http.ResponseWriter, r *http.Request) {
+	req := &pb.CreateSheetReq{}
+	if !decode(w, r, req) {
+		return
+	}
+
+	serve(w, r, req, h.core.CreateSheet, http.StatusCreated)
+}
+
+func (h *Handler) listSheets(w http.ResponseWriter, r *http.Request) {
+	size, cursor, err := pagination(r)
+	if err != nil {
+		badRequest(w, r, err.Error())
+		return
+	}
+
+	q := r.URL.Query()
+	req := &pb.ListSheetsReq{
+		PageSize: size,
+		Cursor:   cursor,
+		Filter: &pb.ListSheetsFilter{
+			OwnerUserId: q.Get("owner_user_id"),
+			NameQuery:   q.Get("q"),
+		},
+	}
+
+	serve(w, r, req, h.core.ListSheets, http.StatusOK)
+}
+
+func (h *Handler) getSheet(w http.ResponseWriter, r *http.Request) {
+	serve(w, r, &pb.GetSheetReq{Id: chi.URLParam(r, "sheetID")}, h.core.GetSheet, http.StatusOK)
+}
+
+func (h *Handler) updateSheet(w http.ResponseWriter, r *http.Request) {
+	req := &pb.UpdateSheetReq{}
+	if !decode(w, r, req) {
+		return
+	}
+	req.Id = chi.URLParam(r, "sheetID")
+
+	serve(w, r, req, h.core.UpdateSheet, http.StatusOK)
+}
+
+// joinSheet adds the user_id in the body, or the caller, as the host
+func (h *Handler) joinSheet(w http.ResponseWriter, r *http.Request) {
+	req := &pb.JoinSheetRequest{}
+	if !decode(w, r, req) {
+		return
+	}
+	req.SheetId = chi.URLParam(r, "sheetID")
+
+	serve(w, r, req, h.core.JoinSheet, http.StatusCreated)
+}
+
+func (h *Handler) listMembers(w http.ResponseWriter, r *http.Request) {
+	size, cursor, err := pagination(r)
+	if err != nil {
+		badRequest(w, r, err.Error())
+		return
+	}
+
+	req := &pb.ListMembersRequest{
+		SheetId:  chi.URLParam(r, "sheetID"),
+		PageSize: size,
+		Cursor:   cursor,
+	}
+	serve(w, r, req, h.core.ListMembers, http.StatusOK)
+}
+
+// removeMember removes a member; "me" leaves the sheet as the caller
+func (h *Handler) removeMember(w http.ResponseWriter, r *http.Request) {
+	userID := chi.URLParam(r, "userID")
+	if userID == "me" {
+		userID = ""
+	}
+
+	req := &pb.RemoveMemberRequest{SheetId: chi.URLParam(r, "sheetID"), UserId: userID}
+	serve(w, r, req, h.core.RemoveMember, http.StatusNoContent)
+}
+
+func (h *Handler) createSheetInvite(w http.ResponseWriter, r *http.Request) {
+	req := &pb.CreateSheetInviteReq{}
+	if !decode(w, r, req) {
+		return
+	}
+	req.SheetId = chi.URLParam(r, "sheetID")
+
+	serve(w, r, req, h.core.CreateSheetInvite, http.StatusCreated)
+}
+
+func (h *Handler) redeemSheetInvite(w http.ResponseWriter, r *http.Request) {
+	req := &pb.RedeemSheetInviteReq{Code: chi.URLParam(r, "code")}
+	serve(w, r, req, h.core.RedeemSheetInvite, http.StatusOK)
+}
+
+func (h *Handler) revokeSheetInvite(w http.ResponseWriter, r *http.Request) {
+	req := &pb.RevokeSheetInviteReq{Code: chi.URLParam(r, "code")}
+	serve(w, r, req, h.core.RevokeSheetInvite, http.StatusOK)
+}
+
+func (h *Handler) getMenu(w http.ResponseWriter, r *http.Request) {
+	serve(w, r, &pb.GetMenuReq{SheetId: chi.URLParam(r, "sheetID")}, h.core.GetMenu, http.StatusOK)
+}
+
+func (h *Handler) replaceMenu(w http.ResponseWriter, r *http.Request) {
+	req := &pb.AttachMenuWithPayloadReq{}
+	if !decode(w, r, req) {
+		return
+	}
+	req.SheetId = chi.URLParam(r, "sheetID")
+
+	serve(w, r, req, h.core.AttachMenuWithPayload, http.StatusOK)
+}
+
+// importMenu takes the raw JSON or CSV file as the body. The format comes from
+// the format query parameter, falling back to the Content-Type.
+func (h *Handler) importMenu(w http.ResponseWriter, r *http.Request) {
+	format := importFormat(r)
+	if format == pb.MenuImportFormat_MENU_IMPORT_FORMAT_UNSPECIFIED {
+		badRequest(w, r, "menu format must be json or csv")
+		return
+	}
+	dryRun, err := queryBool(r, "dry_run")
+	if err != nil {
+		badRequest(w, r, err.Error())
+		return
+	}
+	payload, err := readBody(w, r)
+	if err != nil {
+		badRequest(w, r, err.Error())
+		return
+	}
+
+	req := &pb.ImportMenuReq{
+		SheetId: chi.URLParam(r, "sheetID"),
+		Format:  format,
+		Payload: payload,
+		DryRun:  dryRun,
+	}
+	serve(w, r, req, h.core.ImportMenu, http.StatusOK)
+}
+
+func importFormat(r *http.Request) pb.MenuImportFormat {
+	format := strings.ToLower(r.URL.Query().Get("format"))
+	if format == "" {
+		mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		switch mediaType {
+		case "application/json":
+			format = "json"
+		case "text/csv":
+			format = "csv"
+		}
+	}
+
+	switch format {
+	case "json":
+		return pb.MenuImportFormat_MENU_IMPORT_FORMAT_JSON
+	case "csv":
+		return pb.MenuImportFormat_MENU_IMPORT_FORMAT_CSV
+	default:
+		return pb.MenuImportFormat_MENU_IMPORT_FORMAT_UNSPECIFIED
+	}
+}
+
+func (h *Handler) listMenuVersions(w http.ResponseWriter, r *http.Request) {
+	serve(w, r, &pb.ListMenuVersionsReq{SheetId: chi.URLParam(r, "sheetID")}, h.core.ListMenuVersions, http.StatusOK)
+}
+
+func (h *Handler) diffMenuVersions(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	req := &pb.DiffMenuVersionsReq{
+		SheetId:    chi.URLParam(r, "sheetID"),
+		FromMenuId: q.Get("from"),
+		ToMenuId:   q.Get("to"),
+	}
+	serve(w, r, req, h.core.DiffMenuVersions, http.StatusOK)
+}
+
+func (h *Handler) getSettlement(w http.ResponseWriter, r *http.Request) {
+	serve(w, r, &pb.GetSheetSettlementReq{SheetId: chi.URLParam(r, "sheetID")}, h.core.GetSheetSettlement, http.StatusOK)
+}
@@ -0,0 +1,169 @@
+package rest
+
+import (
+	"net/http"
+	"strings"
+
+	pb "github.com/deni12345/dae-services/proto/gen"
+	"github.com/go-chi/chi/v5"
+)
+
+func (h *Handler) getMe(w http.ResponseWriter, r *http.Request) {
+	userID, err := bearerSubject(r)
+	if err != nil {
+		unauthorized(w, r, err.Error())
+		return
+	}
+
+	serve(w, r, &pb.GetUserReq{Id: userID}, h.core.GetUser, http.StatusOK)
+}
+
+func (h *Handler) updateMe(w http.ResponseWriter, r *http.Request) {
+	userID, err := bearerSubject(r)
+	if err != nil {
+		unauthorized(w, r, err.Error())
+		return
+	}
+
+	req := &pb.UpdateUserReq{}
+	if !decode(w, r, req) {
+		return
+	}
+	req.Id = userID
+
+	serve(w, r, req, h.core.UpdateUser, http.StatusOK)
+}
+
+func (h *Handler) listMyDebts(w http.ResponseWriter, r *http.Request) {
+	userID, err := bearerSubject(r)
+	if err != nil {
+		unauthorized(w, r, err.Error())
+		return
+	}
+
+	serve(w, r, &pb.ListUserDebtsReq{UserId: userID}, h.core.ListUserDebts, http.StatusOK)
+}
+
+func (h *Handler) listMyIdentities(w http.ResponseWriter, r *http.Request) {
+	userID, err := bearerSubject(r)
+	if err != nil {
+		unauthorized(w, r, err.Error())
+		return
+	}
+
+	serve(w, r, &pb.ListIdentitiesReq{UserId: userID}, h.core.ListIdentities, http.StatusOK)
+}
+
+func (h *Handler) unlinkMyIdentity(w http.ResponseWriter, r *http.Request) {
+	userID, err := bearerSubject(r)
+	if err != nil {
+		unauthorized(w, r, err.Error())
+		return
+	}
+
+	h.unlinkIdentity(w, r, userID)
+}
+
+func (h *Handler) listUsers(w http.ResponseWriter, r *http.Request) {
+	size, cursor, err := pagination(r)
+	if err != nil {
+		badRequest(w, r, err.Error())
+		return
+	}
+	includeDisabled, err := queryBool(r, "include_disabled")
+	if err != nil {
+		badRequest(w, r, err.Error())
+		return
+	}
+
+	q := r.URL.Query()
+	role, ok := enumParam(q.Get("role"), "USER_ROLE_", pb.UserRole_value)
+	if !ok {
+		badRequest(w, r, "unknown role")
+		return
+	}
+	status, ok := enumParam(q.Get("status"), "USER_STATUS_", pb.UserStatus_value)
+	if !ok {
+		badRequest(w, r, "unknown status")
+		return
+	}
+
+	req := &pb.ListUsersReq{
+		PageSize: size,
+		Cursor:   cursor,
+		Filter: &pb.ListUsersFilter{
+			Query:           q.Get("q"),
+			EmailExact:      q.Get("email"),
+			IncludeDisabled: includeDisabled,
+			Role:            pb.UserRole(role),
+			Status:          pb.UserStatus(status),
+		},
+	}
+
+	serve(w, r, req, h.core.ListUser, http.StatusOK)
+}
+
+func (h *Handler) getUser(w http.ResponseWriter, r *http.Request) {
+	serve(w, r, &pb.GetUserReq{Id: chi.URLParam(r, "userID")}, h.core.GetUser, http.StatusOK)
+}
+
+func (h *Handler) listUserDebts(w http.ResponseWriter, r *http.Request) {
+	serve(w, r, &pb.ListUserDebtsReq{UserId: chi.URLParam(r, "userID")}, h.core.ListUserDebts, http.StatusOK)
+}
+
+func (h *Handler) setUserRoles(w http.ResponseWriter, r *http.Request) {
+	req := &pb.AdminSetUserRolesReq{}
+	if !decode(w, r, req) {
+		return
+	}
+	req.UserId = chi.URLParam(r, "userID")
+
+	serve(w, r, req, h.core.AdminSetUserRoles, http.StatusOK)
+}
+
+func (h *Handler) setUserDisabled(w http.ResponseWriter, r *http.Request) {
+	req := &pb.AdminSetUserDisabledReq{}
+	if !decode(w, r, req) {
+		return
+	}
+	req.UserId = chi.URLParam(r, "userID")
+
+	serve(w, r, req, h.core.AdminSetUserDisabled, http.StatusOK)
+}
+
+func (h *Handler) listUserIdentities(w http.ResponseWriter, r *http.Request) {
+	serve(w, r, &pb.ListIdentitiesReq{UserId: chi.URLParam(r, "userID")}, h.core.ListIdentities, http.StatusOK)
+}
+
+func (h *Handler) unlinkUserIdentity(w http.ResponseWriter, r *http.Request) {
+	h.unlinkIdentity(w, r, chi.URLParam(r, "userID"))
+}
+
+func (h *Handler) unlinkIdentity(w http.ResponseWriter, r *http.Request, userID string) {
+	provider, ok := identityProvider(chi.URLParam(r, "provider"))
+	if !ok {
+		badRequest(w, r, "unknown identity provider")
+		return
+	}
+
+	serve(w, r, &pb.UnlinkIdentityReq{UserId: userID, Provider: provider}, h.core.UnlinkIdentity, http.StatusNoContent)
+}
+
+// identityProvider parses a provider path segment such as "google"
+func identityProvider(name string) (pb.IdentityProvider, bool) {
+	v, ok := pb.IdentityProvider_value["IDENTITY_PROVIDER_"+strings.ToUpper(name)]
+	if !ok || v == int32(pb.IdentityProvider_IDENTITY_PROVIDER_UNSPECIFIED) {
+		return pb.IdentityProvider_IDENTITY_PROVIDER_UNSPECIFIED, false
+	}
+	return pb.IdentityProvider(v), true
+}
+
+// enumParam parses an optional query value such as "admin" into the enum
+// number of prefix+"ADMIN"; empty values are unspecified
+func enumParam(value, prefix string, values map[string]int32) (int32, bool) {
+	if value == "" {
+		return 0, true
+	}
+	v, ok := values[prefix+strings.ToUpper(value)]
+	return v, ok && v != 0
+}